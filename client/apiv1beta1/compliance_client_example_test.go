@@ -14,12 +14,12 @@
 
 // Code generated by protoc-gen-go_gapic. DO NOT EDIT.
 
-package client_test
+package showcase_test
 
 import (
 	"context"
 
-	client "github.com/googleapis/gapic-showcase/client"
+	showcase "github.com/googleapis/gapic-showcase/client/apiv1beta1"
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
 	"google.golang.org/api/iterator"
 	locationpb "google.golang.org/genproto/googleapis/cloud/location"
@@ -29,7 +29,7 @@ import (
 
 func ExampleNewComplianceClient() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -41,7 +41,7 @@ func ExampleNewComplianceClient() {
 
 func ExampleComplianceClient_RepeatDataBody() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -60,7 +60,7 @@ func ExampleComplianceClient_RepeatDataBody() {
 
 func ExampleComplianceClient_RepeatDataBodyInfo() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -79,7 +79,7 @@ func ExampleComplianceClient_RepeatDataBodyInfo() {
 
 func ExampleComplianceClient_RepeatDataQuery() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -98,7 +98,7 @@ func ExampleComplianceClient_RepeatDataQuery() {
 
 func ExampleComplianceClient_RepeatDataSimplePath() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -117,7 +117,7 @@ func ExampleComplianceClient_RepeatDataSimplePath() {
 
 func ExampleComplianceClient_RepeatDataPathResource() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -136,7 +136,7 @@ func ExampleComplianceClient_RepeatDataPathResource() {
 
 func ExampleComplianceClient_RepeatDataPathTrailingResource() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -155,7 +155,7 @@ func ExampleComplianceClient_RepeatDataPathTrailingResource() {
 
 func ExampleComplianceClient_RepeatDataBodyPut() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -174,7 +174,7 @@ func ExampleComplianceClient_RepeatDataBodyPut() {
 
 func ExampleComplianceClient_RepeatDataBodyPatch() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -193,7 +193,7 @@ func ExampleComplianceClient_RepeatDataBodyPatch() {
 
 func ExampleComplianceClient_ListLocations() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -218,7 +218,7 @@ func ExampleComplianceClient_ListLocations() {
 
 func ExampleComplianceClient_GetLocation() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -237,7 +237,7 @@ func ExampleComplianceClient_GetLocation() {
 
 func ExampleComplianceClient_SetIamPolicy() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -256,7 +256,7 @@ func ExampleComplianceClient_SetIamPolicy() {
 
 func ExampleComplianceClient_GetIamPolicy() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -275,7 +275,7 @@ func ExampleComplianceClient_GetIamPolicy() {
 
 func ExampleComplianceClient_TestIamPermissions() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -294,7 +294,7 @@ func ExampleComplianceClient_TestIamPermissions() {
 
 func ExampleComplianceClient_ListOperations() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -319,7 +319,7 @@ func ExampleComplianceClient_ListOperations() {
 
 func ExampleComplianceClient_GetOperation() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -338,7 +338,7 @@ func ExampleComplianceClient_GetOperation() {
 
 func ExampleComplianceClient_DeleteOperation() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -355,7 +355,7 @@ func ExampleComplianceClient_DeleteOperation() {
 
 func ExampleComplianceClient_CancelOperation() {
 	ctx := context.Background()
-	c, err := client.NewComplianceClient(ctx)
+	c, err := showcase.NewComplianceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
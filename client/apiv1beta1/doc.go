@@ -14,7 +14,7 @@
 
 // Code generated by protoc-gen-go_gapic. DO NOT EDIT.
 
-// Package client is an auto-generated package for the
+// Package showcase is an auto-generated package for the
 // Client Libraries Showcase API.
 //
 // Showcase represents both a model API and an integration testing surface
@@ -30,7 +30,7 @@
 //
 // For information about setting deadlines, reusing contexts, and more
 // please visit https://pkg.go.dev/cloud.google.com/go.
-package client // import "github.com/googleapis/gapic-showcase/client"
+package showcase // import "github.com/googleapis/gapic-showcase/client/apiv1beta1"
 
 import (
 	"context"
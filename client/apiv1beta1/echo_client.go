@@ -14,7 +14,7 @@
 
 // Code generated by protoc-gen-go_gapic. DO NOT EDIT.
 
-package client
+package showcase
 
 import (
 	"context"
@@ -42,23 +42,29 @@ var newEchoClientHook clientHook
 
 // EchoCallOptions contains the retry settings for each method of EchoClient.
 type EchoCallOptions struct {
-	Echo               []gax.CallOption
-	Expand             []gax.CallOption
-	Collect            []gax.CallOption
-	Chat               []gax.CallOption
-	PagedExpand        []gax.CallOption
-	PagedExpandLegacy  []gax.CallOption
-	Wait               []gax.CallOption
-	Block              []gax.CallOption
-	ListLocations      []gax.CallOption
-	GetLocation        []gax.CallOption
-	SetIamPolicy       []gax.CallOption
-	GetIamPolicy       []gax.CallOption
-	TestIamPermissions []gax.CallOption
-	ListOperations     []gax.CallOption
-	GetOperation       []gax.CallOption
-	DeleteOperation    []gax.CallOption
-	CancelOperation    []gax.CallOption
+	Echo                   []gax.CallOption
+	Expand                 []gax.CallOption
+	Collect                []gax.CallOption
+	Chat                   []gax.CallOption
+	PagedExpand            []gax.CallOption
+	LongRunningPagedExpand []gax.CallOption
+	PagedExpandLegacy      []gax.CallOption
+	Wait                   []gax.CallOption
+	NestedWait             []gax.CallOption
+	Block                  []gax.CallOption
+	EchoHeaders            []gax.CallOption
+	ProbeDeadline          []gax.CallOption
+	GeneratePayload        []gax.CallOption
+	FanOut                 []gax.CallOption
+	ListLocations          []gax.CallOption
+	GetLocation            []gax.CallOption
+	SetIamPolicy           []gax.CallOption
+	GetIamPolicy           []gax.CallOption
+	TestIamPermissions     []gax.CallOption
+	ListOperations         []gax.CallOption
+	GetOperation           []gax.CallOption
+	DeleteOperation        []gax.CallOption
+	CancelOperation        []gax.CallOption
 }
 
 func defaultEchoGRPCClientOptions() []option.ClientOption {
@@ -114,18 +120,24 @@ func defaultEchoCallOptions() *EchoCallOptions {
 				})
 			}),
 		},
-		PagedExpandLegacy:  []gax.CallOption{},
-		Wait:               []gax.CallOption{},
-		Block:              []gax.CallOption{},
-		ListLocations:      []gax.CallOption{},
-		GetLocation:        []gax.CallOption{},
-		SetIamPolicy:       []gax.CallOption{},
-		GetIamPolicy:       []gax.CallOption{},
-		TestIamPermissions: []gax.CallOption{},
-		ListOperations:     []gax.CallOption{},
-		GetOperation:       []gax.CallOption{},
-		DeleteOperation:    []gax.CallOption{},
-		CancelOperation:    []gax.CallOption{},
+		LongRunningPagedExpand: []gax.CallOption{},
+		PagedExpandLegacy:      []gax.CallOption{},
+		Wait:                   []gax.CallOption{},
+		NestedWait:             []gax.CallOption{},
+		Block:                  []gax.CallOption{},
+		EchoHeaders:            []gax.CallOption{},
+		ProbeDeadline:          []gax.CallOption{},
+		GeneratePayload:        []gax.CallOption{},
+		FanOut:                 []gax.CallOption{},
+		ListLocations:          []gax.CallOption{},
+		GetLocation:            []gax.CallOption{},
+		SetIamPolicy:           []gax.CallOption{},
+		GetIamPolicy:           []gax.CallOption{},
+		TestIamPermissions:     []gax.CallOption{},
+		ListOperations:         []gax.CallOption{},
+		GetOperation:           []gax.CallOption{},
+		DeleteOperation:        []gax.CallOption{},
+		CancelOperation:        []gax.CallOption{},
 	}
 }
 
@@ -139,10 +151,18 @@ type internalEchoClient interface {
 	Collect(context.Context, ...gax.CallOption) (genprotopb.Echo_CollectClient, error)
 	Chat(context.Context, ...gax.CallOption) (genprotopb.Echo_ChatClient, error)
 	PagedExpand(context.Context, *genprotopb.PagedExpandRequest, ...gax.CallOption) *EchoResponseIterator
+	LongRunningPagedExpand(context.Context, *genprotopb.LongRunningPagedExpandRequest, ...gax.CallOption) (*LongRunningPagedExpandOperation, error)
+	LongRunningPagedExpandOperation(name string) *LongRunningPagedExpandOperation
 	PagedExpandLegacy(context.Context, *genprotopb.PagedExpandLegacyRequest, ...gax.CallOption) (*genprotopb.PagedExpandResponse, error)
 	Wait(context.Context, *genprotopb.WaitRequest, ...gax.CallOption) (*WaitOperation, error)
 	WaitOperation(name string) *WaitOperation
+	NestedWait(context.Context, *genprotopb.NestedWaitRequest, ...gax.CallOption) (*NestedWaitOperation, error)
+	NestedWaitOperation(name string) *NestedWaitOperation
 	Block(context.Context, *genprotopb.BlockRequest, ...gax.CallOption) (*genprotopb.BlockResponse, error)
+	EchoHeaders(context.Context, *genprotopb.EchoHeadersRequest, ...gax.CallOption) (*genprotopb.EchoHeadersResponse, error)
+	ProbeDeadline(context.Context, *genprotopb.ProbeDeadlineRequest, ...gax.CallOption) (*genprotopb.ProbeDeadlineResponse, error)
+	GeneratePayload(context.Context, *genprotopb.GeneratePayloadRequest, ...gax.CallOption) (*genprotopb.GeneratePayloadResponse, error)
+	FanOut(context.Context, *genprotopb.FanOutRequest, ...gax.CallOption) (*genprotopb.FanOutResponse, error)
 	ListLocations(context.Context, *locationpb.ListLocationsRequest, ...gax.CallOption) *LocationIterator
 	GetLocation(context.Context, *locationpb.GetLocationRequest, ...gax.CallOption) (*locationpb.Location, error)
 	SetIamPolicy(context.Context, *iampb.SetIamPolicyRequest, ...gax.CallOption) (*iampb.Policy, error)
@@ -161,7 +181,11 @@ type internalEchoClient interface {
 // side streaming, client side streaming, and bidirectional streaming. This
 // service also exposes methods that explicitly implement server delay, and
 // paginated calls. Set the ‘showcase-trailer’ metadata key on any method
-// to have the values echoed in the response trailers.
+// to have the values echoed in the response trailers. Over gRPC, streaming
+// methods report the messages the server received and sent, and their
+// serialized bytes, in the ‘x-showcase-received-messages’,
+// ‘x-showcase-received-bytes’, ‘x-showcase-sent-messages’ and
+// ‘x-showcase-sent-bytes’ trailers.
 type EchoClient struct {
 	// The internal transport-dependent client.
 	internalClient internalEchoClient
@@ -198,36 +222,70 @@ func (c *EchoClient) Connection() *grpc.ClientConn {
 }
 
 // Echo this method simply echoes the request. This method showcases unary RPCs.
+// Over gRPC, the x-showcase-request-compression response header names the
+// compression the request was sent with, or “identity”, so that clients can
+// verify their compression settings take effect.
 func (c *EchoClient) Echo(ctx context.Context, req *genprotopb.EchoRequest, opts ...gax.CallOption) (*genprotopb.EchoResponse, error) {
 	return c.internalClient.Echo(ctx, req, opts...)
 }
 
 // Expand this method splits the given content into words and will pass each word back
 // through the stream. This method showcases server-side streaming RPCs.
+// Each word carries a stream_resume_token, so that clients can resume
+// dropped streams where they left off.
 func (c *EchoClient) Expand(ctx context.Context, req *genprotopb.ExpandRequest, opts ...gax.CallOption) (genprotopb.Echo_ExpandClient, error) {
 	return c.internalClient.Expand(ctx, req, opts...)
 }
 
 // Collect this method will collect the words given to it. When the stream is closed
 // by the client, this method will return the a concatenation of the strings
-// passed to it. This method showcases client-side streaming RPCs.
+// passed to it. This method showcases client-side streaming RPCs. Set
+// fail_after on the first request to fail the call after a given number
+// of requests, and read_delay to have the server read the requests
+// slowly. The response reports how many requests, and bytes of them, the
+// server received.
 func (c *EchoClient) Collect(ctx context.Context, opts ...gax.CallOption) (genprotopb.Echo_CollectClient, error) {
 	return c.internalClient.Collect(ctx, opts...)
 }
 
 // Chat this method, upon receiving a request on the stream, will pass the same
 // content back on the stream. This method showcases bidirectional
-// streaming RPCs.
+// streaming RPCs. Send the x-showcase-push-count header to have the server
+// also push that many messages of its own, one every
+// x-showcase-push-interval (a duration such as “250ms”, “1s” by default),
+// whatever the client sends; the call ends once the client half-closes the
+// stream and all the messages are pushed.
 func (c *EchoClient) Chat(ctx context.Context, opts ...gax.CallOption) (genprotopb.Echo_ChatClient, error) {
 	return c.internalClient.Chat(ctx, opts...)
 }
 
 // PagedExpand this is similar to the Expand method but instead of returning a stream of
-// expanded words, this method returns a paged list of expanded words.
+// expanded words, this method returns a paged list of expanded words. Send
+// the x-showcase-page-tokens: signed header to be given opaque page tokens
+// signed by the server instead of the index of the next word; calls sending
+// it fail with a PAGE_TOKEN_TAMPERED ErrorInfo for any other token. Send
+// the x-showcase-page-token-ttl header, a duration such as “30s”, to also
+// have the tokens expire: calls sending an expired token fail with
+// FAILED_PRECONDITION and a PAGE_TOKEN_EXPIRED ErrorInfo.
 func (c *EchoClient) PagedExpand(ctx context.Context, req *genprotopb.PagedExpandRequest, opts ...gax.CallOption) *EchoResponseIterator {
 	return c.internalClient.PagedExpand(ctx, req, opts...)
 }
 
+// LongRunningPagedExpand this is similar to the PagedExpand method, but returns a long-running
+// operation whose response is the first page of expanded words. Its
+// next_page_token is consumed by PagedExpand, with the same content and
+// page_size, to retrieve the following pages. This method showcases
+// long-running operations whose results are paginated.
+func (c *EchoClient) LongRunningPagedExpand(ctx context.Context, req *genprotopb.LongRunningPagedExpandRequest, opts ...gax.CallOption) (*LongRunningPagedExpandOperation, error) {
+	return c.internalClient.LongRunningPagedExpand(ctx, req, opts...)
+}
+
+// LongRunningPagedExpandOperation returns a new LongRunningPagedExpandOperation from a given name.
+// The name must be that of a previously created LongRunningPagedExpandOperation, possibly from a different process.
+func (c *EchoClient) LongRunningPagedExpandOperation(name string) *LongRunningPagedExpandOperation {
+	return c.internalClient.LongRunningPagedExpandOperation(name)
+}
+
 // PagedExpandLegacy this is similar to the PagedExpand except that it uses
 // max_results instead of page_size, as some legacy APIs still
 // do. New APIs should NOT use this pattern.
@@ -236,7 +294,9 @@ func (c *EchoClient) PagedExpandLegacy(ctx context.Context, req *genprotopb.Page
 }
 
 // Wait this method will wait for the requested amount of time and then return.
-// This method showcases how a client handles a request timeout.
+// This method showcases how a client handles a request timeout. Cancelling
+// the operation while it is pending completes it with a CANCELLED error;
+// deleting it makes later polls fail with NOT_FOUND.
 func (c *EchoClient) Wait(ctx context.Context, req *genprotopb.WaitRequest, opts ...gax.CallOption) (*WaitOperation, error) {
 	return c.internalClient.Wait(ctx, req, opts...)
 }
@@ -247,6 +307,21 @@ func (c *EchoClient) WaitOperation(name string) *WaitOperation {
 	return c.internalClient.WaitOperation(name)
 }
 
+// NestedWait this method will wait for the requested amount of time and then complete
+// with a response holding a second operation, started as the first
+// completed, which must be polled in turn for the final result.
+// This method showcases how a client handles chained long-running
+// operations.
+func (c *EchoClient) NestedWait(ctx context.Context, req *genprotopb.NestedWaitRequest, opts ...gax.CallOption) (*NestedWaitOperation, error) {
+	return c.internalClient.NestedWait(ctx, req, opts...)
+}
+
+// NestedWaitOperation returns a new NestedWaitOperation from a given name.
+// The name must be that of a previously created NestedWaitOperation, possibly from a different process.
+func (c *EchoClient) NestedWaitOperation(name string) *NestedWaitOperation {
+	return c.internalClient.NestedWaitOperation(name)
+}
+
 // Block this method will block (wait) for the requested amount of time
 // and then return the response or error.
 // This method showcases how a client handles delays or retries.
@@ -254,6 +329,37 @@ func (c *EchoClient) Block(ctx context.Context, req *genprotopb.BlockRequest, op
 	return c.internalClient.Block(ctx, req, opts...)
 }
 
+// EchoHeaders this method returns the metadata the server received with the call, such
+// as the x-goog-request-params routing header, the user-agent and the
+// x-goog-api-client header, so that clients can assert on what they send.
+// Over REST, the metadata is the request’s headers.
+func (c *EchoClient) EchoHeaders(ctx context.Context, req *genprotopb.EchoHeadersRequest, opts ...gax.CallOption) (*genprotopb.EchoHeadersResponse, error) {
+	return c.internalClient.EchoHeaders(ctx, req, opts...)
+}
+
+// ProbeDeadline this method returns the deadline the server observed for the call, so
+// that clients can verify their timeout settings are sent: over gRPC in the
+// grpc-timeout header, and over REST in the X-Server-Timeout header, in
+// seconds.
+func (c *EchoClient) ProbeDeadline(ctx context.Context, req *genprotopb.ProbeDeadlineRequest, opts ...gax.CallOption) (*genprotopb.ProbeDeadlineResponse, error) {
+	return c.internalClient.ProbeDeadline(ctx, req, opts...)
+}
+
+// GeneratePayload this method returns a payload of the requested size, optionally split
+// into a number of chunks, so that clients can test message size limits,
+// chunking and memory behavior without crafting large requests.
+func (c *EchoClient) GeneratePayload(ctx context.Context, req *genprotopb.GeneratePayloadRequest, opts ...gax.CallOption) (*genprotopb.GeneratePayloadResponse, error) {
+	return c.internalClient.GeneratePayload(ctx, req, opts...)
+}
+
+// FanOut this method runs a number of sub-tasks concurrently, as batch APIs do for
+// the items of a batch, and returns the status of each, so that clients can
+// test surfacing per-item results, including partial failures, and that
+// servers can be stressed with many concurrent sub-tasks per call.
+func (c *EchoClient) FanOut(ctx context.Context, req *genprotopb.FanOutRequest, opts ...gax.CallOption) (*genprotopb.FanOutResponse, error) {
+	return c.internalClient.FanOut(ctx, req, opts...)
+}
+
 // ListLocations is a utility method from google.cloud.location.Locations.
 func (c *EchoClient) ListLocations(ctx context.Context, req *locationpb.ListLocationsRequest, opts ...gax.CallOption) *LocationIterator {
 	return c.internalClient.ListLocations(ctx, req, opts...)
@@ -337,7 +443,11 @@ type echoGRPCClient struct {
 // side streaming, client side streaming, and bidirectional streaming. This
 // service also exposes methods that explicitly implement server delay, and
 // paginated calls. Set the ‘showcase-trailer’ metadata key on any method
-// to have the values echoed in the response trailers.
+// to have the values echoed in the response trailers. Over gRPC, streaming
+// methods report the messages the server received and sent, and their
+// serialized bytes, in the ‘x-showcase-received-messages’,
+// ‘x-showcase-received-bytes’, ‘x-showcase-sent-messages’ and
+// ‘x-showcase-sent-bytes’ trailers.
 func NewEchoClient(ctx context.Context, opts ...option.ClientOption) (*EchoClient, error) {
 	clientOpts := defaultEchoGRPCClientOptions()
 	if newEchoClientHook != nil {
@@ -511,6 +621,28 @@ func (c *echoGRPCClient) PagedExpand(ctx context.Context, req *genprotopb.PagedE
 	return it
 }
 
+func (c *echoGRPCClient) LongRunningPagedExpand(ctx context.Context, req *genprotopb.LongRunningPagedExpandRequest, opts ...gax.CallOption) (*LongRunningPagedExpandOperation, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).LongRunningPagedExpand[0:len((*c.CallOptions).LongRunningPagedExpand):len((*c.CallOptions).LongRunningPagedExpand)], opts...)
+	var resp *longrunningpb.Operation
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.echoClient.LongRunningPagedExpand(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &LongRunningPagedExpandOperation{
+		lro: longrunning.InternalNewOperation(*c.LROClient, resp),
+	}, nil
+}
+
 func (c *echoGRPCClient) PagedExpandLegacy(ctx context.Context, req *genprotopb.PagedExpandLegacyRequest, opts ...gax.CallOption) (*genprotopb.PagedExpandResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
@@ -553,6 +685,28 @@ func (c *echoGRPCClient) Wait(ctx context.Context, req *genprotopb.WaitRequest,
 	}, nil
 }
 
+func (c *echoGRPCClient) NestedWait(ctx context.Context, req *genprotopb.NestedWaitRequest, opts ...gax.CallOption) (*NestedWaitOperation, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).NestedWait[0:len((*c.CallOptions).NestedWait):len((*c.CallOptions).NestedWait)], opts...)
+	var resp *longrunningpb.Operation
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.echoClient.NestedWait(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &NestedWaitOperation{
+		lro: longrunning.InternalNewOperation(*c.LROClient, resp),
+	}, nil
+}
+
 func (c *echoGRPCClient) Block(ctx context.Context, req *genprotopb.BlockRequest, opts ...gax.CallOption) (*genprotopb.BlockResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
@@ -573,6 +727,86 @@ func (c *echoGRPCClient) Block(ctx context.Context, req *genprotopb.BlockRequest
 	return resp, nil
 }
 
+func (c *echoGRPCClient) EchoHeaders(ctx context.Context, req *genprotopb.EchoHeadersRequest, opts ...gax.CallOption) (*genprotopb.EchoHeadersResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).EchoHeaders[0:len((*c.CallOptions).EchoHeaders):len((*c.CallOptions).EchoHeaders)], opts...)
+	var resp *genprotopb.EchoHeadersResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.echoClient.EchoHeaders(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *echoGRPCClient) ProbeDeadline(ctx context.Context, req *genprotopb.ProbeDeadlineRequest, opts ...gax.CallOption) (*genprotopb.ProbeDeadlineResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ProbeDeadline[0:len((*c.CallOptions).ProbeDeadline):len((*c.CallOptions).ProbeDeadline)], opts...)
+	var resp *genprotopb.ProbeDeadlineResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.echoClient.ProbeDeadline(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *echoGRPCClient) GeneratePayload(ctx context.Context, req *genprotopb.GeneratePayloadRequest, opts ...gax.CallOption) (*genprotopb.GeneratePayloadResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).GeneratePayload[0:len((*c.CallOptions).GeneratePayload):len((*c.CallOptions).GeneratePayload)], opts...)
+	var resp *genprotopb.GeneratePayloadResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.echoClient.GeneratePayload(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *echoGRPCClient) FanOut(ctx context.Context, req *genprotopb.FanOutRequest, opts ...gax.CallOption) (*genprotopb.FanOutResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).FanOut[0:len((*c.CallOptions).FanOut):len((*c.CallOptions).FanOut)], opts...)
+	var resp *genprotopb.FanOutResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.echoClient.FanOut(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *echoGRPCClient) ListLocations(ctx context.Context, req *locationpb.ListLocationsRequest, opts ...gax.CallOption) *LocationIterator {
 	ctx = insertMetadata(ctx, c.xGoogMetadata)
 	opts = append((*c.CallOptions).ListLocations[0:len((*c.CallOptions).ListLocations):len((*c.CallOptions).ListLocations)], opts...)
@@ -748,6 +982,144 @@ func (c *echoGRPCClient) CancelOperation(ctx context.Context, req *longrunningpb
 	return err
 }
 
+// LongRunningPagedExpandOperation manages a long-running operation from LongRunningPagedExpand.
+type LongRunningPagedExpandOperation struct {
+	lro *longrunning.Operation
+}
+
+// LongRunningPagedExpandOperation returns a new LongRunningPagedExpandOperation from a given name.
+// The name must be that of a previously created LongRunningPagedExpandOperation, possibly from a different process.
+func (c *echoGRPCClient) LongRunningPagedExpandOperation(name string) *LongRunningPagedExpandOperation {
+	return &LongRunningPagedExpandOperation{
+		lro: longrunning.InternalNewOperation(*c.LROClient, &longrunningpb.Operation{Name: name}),
+	}
+}
+
+// Wait blocks until the long-running operation is completed, returning the response and any errors encountered.
+//
+// See documentation of Poll for error-handling information.
+func (op *LongRunningPagedExpandOperation) Wait(ctx context.Context, opts ...gax.CallOption) (*genprotopb.PagedExpandResponse, error) {
+	var resp genprotopb.PagedExpandResponse
+	if err := op.lro.WaitWithInterval(ctx, &resp, time.Minute, opts...); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Poll fetches the latest state of the long-running operation.
+//
+// Poll also fetches the latest metadata, which can be retrieved by Metadata.
+//
+// If Poll fails, the error is returned and op is unmodified. If Poll succeeds and
+// the operation has completed with failure, the error is returned and op.Done will return true.
+// If Poll succeeds and the operation has completed successfully,
+// op.Done will return true, and the response of the operation is returned.
+// If Poll succeeds and the operation has not completed, the returned response and error are both nil.
+func (op *LongRunningPagedExpandOperation) Poll(ctx context.Context, opts ...gax.CallOption) (*genprotopb.PagedExpandResponse, error) {
+	var resp genprotopb.PagedExpandResponse
+	if err := op.lro.Poll(ctx, &resp, opts...); err != nil {
+		return nil, err
+	}
+	if !op.Done() {
+		return nil, nil
+	}
+	return &resp, nil
+}
+
+// Metadata returns metadata associated with the long-running operation.
+// Metadata itself does not contact the server, but Poll does.
+// To get the latest metadata, call this method after a successful call to Poll.
+// If the metadata is not available, the returned metadata and error are both nil.
+func (op *LongRunningPagedExpandOperation) Metadata() (*genprotopb.WaitMetadata, error) {
+	var meta genprotopb.WaitMetadata
+	if err := op.lro.Metadata(&meta); err == longrunning.ErrNoMetadata {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// Done reports whether the long-running operation has completed.
+func (op *LongRunningPagedExpandOperation) Done() bool {
+	return op.lro.Done()
+}
+
+// Name returns the name of the long-running operation.
+// The name is assigned by the server and is unique within the service from which the operation is created.
+func (op *LongRunningPagedExpandOperation) Name() string {
+	return op.lro.Name()
+}
+
+// NestedWaitOperation manages a long-running operation from NestedWait.
+type NestedWaitOperation struct {
+	lro *longrunning.Operation
+}
+
+// NestedWaitOperation returns a new NestedWaitOperation from a given name.
+// The name must be that of a previously created NestedWaitOperation, possibly from a different process.
+func (c *echoGRPCClient) NestedWaitOperation(name string) *NestedWaitOperation {
+	return &NestedWaitOperation{
+		lro: longrunning.InternalNewOperation(*c.LROClient, &longrunningpb.Operation{Name: name}),
+	}
+}
+
+// Wait blocks until the long-running operation is completed, returning the response and any errors encountered.
+//
+// See documentation of Poll for error-handling information.
+func (op *NestedWaitOperation) Wait(ctx context.Context, opts ...gax.CallOption) (*genprotopb.NestedWaitResponse, error) {
+	var resp genprotopb.NestedWaitResponse
+	if err := op.lro.WaitWithInterval(ctx, &resp, time.Minute, opts...); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Poll fetches the latest state of the long-running operation.
+//
+// Poll also fetches the latest metadata, which can be retrieved by Metadata.
+//
+// If Poll fails, the error is returned and op is unmodified. If Poll succeeds and
+// the operation has completed with failure, the error is returned and op.Done will return true.
+// If Poll succeeds and the operation has completed successfully,
+// op.Done will return true, and the response of the operation is returned.
+// If Poll succeeds and the operation has not completed, the returned response and error are both nil.
+func (op *NestedWaitOperation) Poll(ctx context.Context, opts ...gax.CallOption) (*genprotopb.NestedWaitResponse, error) {
+	var resp genprotopb.NestedWaitResponse
+	if err := op.lro.Poll(ctx, &resp, opts...); err != nil {
+		return nil, err
+	}
+	if !op.Done() {
+		return nil, nil
+	}
+	return &resp, nil
+}
+
+// Metadata returns metadata associated with the long-running operation.
+// Metadata itself does not contact the server, but Poll does.
+// To get the latest metadata, call this method after a successful call to Poll.
+// If the metadata is not available, the returned metadata and error are both nil.
+func (op *NestedWaitOperation) Metadata() (*genprotopb.WaitMetadata, error) {
+	var meta genprotopb.WaitMetadata
+	if err := op.lro.Metadata(&meta); err == longrunning.ErrNoMetadata {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// Done reports whether the long-running operation has completed.
+func (op *NestedWaitOperation) Done() bool {
+	return op.lro.Done()
+}
+
+// Name returns the name of the long-running operation.
+// The name is assigned by the server and is unique within the service from which the operation is created.
+func (op *NestedWaitOperation) Name() string {
+	return op.lro.Name()
+}
+
 // WaitOperation manages a long-running operation from Wait.
 type WaitOperation struct {
 	lro *longrunning.Operation
@@ -0,0 +1,13 @@
+module github.com/googleapis/gapic-showcase/client/apiv1beta1
+
+go 1.16
+
+require (
+	cloud.google.com/go v0.88.0
+	github.com/googleapis/gapic-showcase/server/genproto v0.16.0
+	github.com/googleapis/gax-go/v2 v2.0.5
+	google.golang.org/api v0.51.0
+	google.golang.org/genproto v0.0.0-20211118181313-81c1377c94b1
+	google.golang.org/grpc v1.40.0
+	google.golang.org/protobuf v1.27.1
+)
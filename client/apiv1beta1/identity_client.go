@@ -14,7 +14,7 @@
 
 // Code generated by protoc-gen-go_gapic. DO NOT EDIT.
 
-package client
+package showcase
 
 import (
 	"context"
@@ -47,6 +47,8 @@ type IdentityCallOptions struct {
 	UpdateUser         []gax.CallOption
 	DeleteUser         []gax.CallOption
 	ListUsers          []gax.CallOption
+	BatchWrite         []gax.CallOption
+	WatchUsers         []gax.CallOption
 	ListLocations      []gax.CallOption
 	GetLocation        []gax.CallOption
 	SetIamPolicy       []gax.CallOption
@@ -100,6 +102,8 @@ func defaultIdentityCallOptions() *IdentityCallOptions {
 				})
 			}),
 		},
+		BatchWrite:         []gax.CallOption{},
+		WatchUsers:         []gax.CallOption{},
 		ListLocations:      []gax.CallOption{},
 		GetLocation:        []gax.CallOption{},
 		SetIamPolicy:       []gax.CallOption{},
@@ -122,6 +126,8 @@ type internalIdentityClient interface {
 	UpdateUser(context.Context, *genprotopb.UpdateUserRequest, ...gax.CallOption) (*genprotopb.User, error)
 	DeleteUser(context.Context, *genprotopb.DeleteUserRequest, ...gax.CallOption) error
 	ListUsers(context.Context, *genprotopb.ListUsersRequest, ...gax.CallOption) *UserIterator
+	BatchWrite(context.Context, *genprotopb.BatchWriteRequest, ...gax.CallOption) (*genprotopb.BatchWriteResponse, error)
+	WatchUsers(context.Context, *genprotopb.WatchUsersRequest, ...gax.CallOption) (genprotopb.Identity_WatchUsersClient, error)
 	ListLocations(context.Context, *locationpb.ListLocationsRequest, ...gax.CallOption) *LocationIterator
 	GetLocation(context.Context, *locationpb.GetLocationRequest, ...gax.CallOption) (*locationpb.Location, error)
 	SetIamPolicy(context.Context, *iampb.SetIamPolicyRequest, ...gax.CallOption) (*iampb.Policy, error)
@@ -192,6 +198,20 @@ func (c *IdentityClient) ListUsers(ctx context.Context, req *genprotopb.ListUser
 	return c.internalClient.ListUsers(ctx, req, opts...)
 }
 
+// BatchWrite applies a batch of mutations to users atomically: either all of them are
+// committed, or, if any fails, none is. Batches may be made to abort
+// mid-way, so that the retries of aborted transactions can be tested.
+func (c *IdentityClient) BatchWrite(ctx context.Context, req *genprotopb.BatchWriteRequest, opts ...gax.CallOption) (*genprotopb.BatchWriteResponse, error) {
+	return c.internalClient.BatchWrite(ctx, req, opts...)
+}
+
+// WatchUsers this returns a stream that emits the users as they are created, updated,
+// or deleted. Each response carries a token resuming the stream from after
+// it, so that an interrupted stream may be resumed without missing changes.
+func (c *IdentityClient) WatchUsers(ctx context.Context, req *genprotopb.WatchUsersRequest, opts ...gax.CallOption) (genprotopb.Identity_WatchUsersClient, error) {
+	return c.internalClient.WatchUsers(ctx, req, opts...)
+}
+
 // ListLocations is a utility method from google.cloud.location.Locations.
 func (c *IdentityClient) ListLocations(ctx context.Context, req *locationpb.ListLocationsRequest, opts ...gax.CallOption) *LocationIterator {
 	return c.internalClient.ListLocations(ctx, req, opts...)
@@ -429,6 +449,35 @@ func (c *identityGRPCClient) ListUsers(ctx context.Context, req *genprotopb.List
 	return it
 }
 
+func (c *identityGRPCClient) BatchWrite(ctx context.Context, req *genprotopb.BatchWriteRequest, opts ...gax.CallOption) (*genprotopb.BatchWriteResponse, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).BatchWrite[0:len((*c.CallOptions).BatchWrite):len((*c.CallOptions).BatchWrite)], opts...)
+	var resp *genprotopb.BatchWriteResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.identityClient.BatchWrite(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *identityGRPCClient) WatchUsers(ctx context.Context, req *genprotopb.WatchUsersRequest, opts ...gax.CallOption) (genprotopb.Identity_WatchUsersClient, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	var resp genprotopb.Identity_WatchUsersClient
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.identityClient.WatchUsers(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *identityGRPCClient) ListLocations(ctx context.Context, req *locationpb.ListLocationsRequest, opts ...gax.CallOption) *LocationIterator {
 	ctx = insertMetadata(ctx, c.xGoogMetadata)
 	opts = append((*c.CallOptions).ListLocations[0:len((*c.CallOptions).ListLocations):len((*c.CallOptions).ListLocations)], opts...)
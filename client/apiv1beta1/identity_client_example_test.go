@@ -14,12 +14,12 @@
 
 // Code generated by protoc-gen-go_gapic. DO NOT EDIT.
 
-package client_test
+package showcase_test
 
 import (
 	"context"
 
-	client "github.com/googleapis/gapic-showcase/client"
+	showcase "github.com/googleapis/gapic-showcase/client/apiv1beta1"
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
 	"google.golang.org/api/iterator"
 	locationpb "google.golang.org/genproto/googleapis/cloud/location"
@@ -29,7 +29,7 @@ import (
 
 func ExampleNewIdentityClient() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -41,7 +41,7 @@ func ExampleNewIdentityClient() {
 
 func ExampleIdentityClient_CreateUser() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -60,7 +60,7 @@ func ExampleIdentityClient_CreateUser() {
 
 func ExampleIdentityClient_GetUser() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -79,7 +79,7 @@ func ExampleIdentityClient_GetUser() {
 
 func ExampleIdentityClient_UpdateUser() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -98,7 +98,7 @@ func ExampleIdentityClient_UpdateUser() {
 
 func ExampleIdentityClient_DeleteUser() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -115,7 +115,7 @@ func ExampleIdentityClient_DeleteUser() {
 
 func ExampleIdentityClient_ListUsers() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -138,9 +138,28 @@ func ExampleIdentityClient_ListUsers() {
 	}
 }
 
+func ExampleIdentityClient_BatchWrite() {
+	ctx := context.Background()
+	c, err := showcase.NewIdentityClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &genprotopb.BatchWriteRequest{
+		// TODO: Fill request struct fields.
+	}
+	resp, err := c.BatchWrite(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
 func ExampleIdentityClient_ListLocations() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -165,7 +184,7 @@ func ExampleIdentityClient_ListLocations() {
 
 func ExampleIdentityClient_GetLocation() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -184,7 +203,7 @@ func ExampleIdentityClient_GetLocation() {
 
 func ExampleIdentityClient_SetIamPolicy() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -203,7 +222,7 @@ func ExampleIdentityClient_SetIamPolicy() {
 
 func ExampleIdentityClient_GetIamPolicy() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -222,7 +241,7 @@ func ExampleIdentityClient_GetIamPolicy() {
 
 func ExampleIdentityClient_TestIamPermissions() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -241,7 +260,7 @@ func ExampleIdentityClient_TestIamPermissions() {
 
 func ExampleIdentityClient_ListOperations() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -266,7 +285,7 @@ func ExampleIdentityClient_ListOperations() {
 
 func ExampleIdentityClient_GetOperation() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -285,7 +304,7 @@ func ExampleIdentityClient_GetOperation() {
 
 func ExampleIdentityClient_DeleteOperation() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -302,7 +321,7 @@ func ExampleIdentityClient_DeleteOperation() {
 
 func ExampleIdentityClient_CancelOperation() {
 	ctx := context.Background()
-	c, err := client.NewIdentityClient(ctx)
+	c, err := showcase.NewIdentityClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
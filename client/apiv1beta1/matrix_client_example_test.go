@@ -14,13 +14,13 @@
 
 // Code generated by protoc-gen-go_gapic. DO NOT EDIT.
 
-package client_test
+package showcase_test
 
 import (
 	"context"
 	"io"
 
-	client "github.com/googleapis/gapic-showcase/client"
+	showcase "github.com/googleapis/gapic-showcase/client/apiv1beta1"
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
 	"google.golang.org/api/iterator"
 	locationpb "google.golang.org/genproto/googleapis/cloud/location"
@@ -28,9 +28,9 @@ import (
 	longrunningpb "google.golang.org/genproto/googleapis/longrunning"
 )
 
-func ExampleNewEchoClient() {
+func ExampleNewMatrixClient() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -40,18 +40,18 @@ func ExampleNewEchoClient() {
 	_ = c
 }
 
-func ExampleEchoClient_Echo() {
+func ExampleMatrixClient_Unary() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
 	defer c.Close()
 
-	req := &genprotopb.EchoRequest{
+	req := &genprotopb.MatrixRequest{
 		// TODO: Fill request struct fields.
 	}
-	resp, err := c.Echo(ctx, req)
+	resp, err := c.Unary(ctx, req)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -59,19 +59,36 @@ func ExampleEchoClient_Echo() {
 	_ = resp
 }
 
-func ExampleEchoClient_Chat() {
+func ExampleMatrixClient_NoContent() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
 	defer c.Close()
-	stream, err := c.Chat(ctx)
+
+	req := &genprotopb.MatrixRequest{
+		// TODO: Fill request struct fields.
+	}
+	err = c.NoContent(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+}
+
+func ExampleMatrixClient_BidiStream() {
+	ctx := context.Background()
+	c, err := showcase.NewMatrixClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+	stream, err := c.BidiStream(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
 	go func() {
-		reqs := []*genprotopb.EchoRequest{
+		reqs := []*genprotopb.MatrixRequest{
 			// TODO: Create requests.
 		}
 		for _, req := range reqs {
@@ -94,62 +111,18 @@ func ExampleEchoClient_Chat() {
 	}
 }
 
-func ExampleEchoClient_PagedExpand() {
+func ExampleMatrixClient_LongRunning() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
 	defer c.Close()
 
-	req := &genprotopb.PagedExpandRequest{
+	req := &genprotopb.MatrixRequest{
 		// TODO: Fill request struct fields.
 	}
-	it := c.PagedExpand(ctx, req)
-	for {
-		resp, err := it.Next()
-		if err == iterator.Done {
-			break
-		}
-		if err != nil {
-			// TODO: Handle error.
-		}
-		// TODO: Use resp.
-		_ = resp
-	}
-}
-
-func ExampleEchoClient_PagedExpandLegacy() {
-	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
-	if err != nil {
-		// TODO: Handle error.
-	}
-	defer c.Close()
-
-	req := &genprotopb.PagedExpandLegacyRequest{
-		// TODO: Fill request struct fields.
-	}
-	resp, err := c.PagedExpandLegacy(ctx, req)
-	if err != nil {
-		// TODO: Handle error.
-	}
-	// TODO: Use resp.
-	_ = resp
-}
-
-func ExampleEchoClient_Wait() {
-	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
-	if err != nil {
-		// TODO: Handle error.
-	}
-	defer c.Close()
-
-	req := &genprotopb.WaitRequest{
-		// TODO: Fill request struct fields.
-	}
-	op, err := c.Wait(ctx, req)
+	op, err := c.LongRunning(ctx, req)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -162,28 +135,34 @@ func ExampleEchoClient_Wait() {
 	_ = resp
 }
 
-func ExampleEchoClient_Block() {
+func ExampleMatrixClient_PagedList() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
 	defer c.Close()
 
-	req := &genprotopb.BlockRequest{
+	req := &genprotopb.PagedListRequest{
 		// TODO: Fill request struct fields.
 	}
-	resp, err := c.Block(ctx, req)
-	if err != nil {
-		// TODO: Handle error.
+	it := c.PagedList(ctx, req)
+	for {
+		resp, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			// TODO: Handle error.
+		}
+		// TODO: Use resp.
+		_ = resp
 	}
-	// TODO: Use resp.
-	_ = resp
 }
 
-func ExampleEchoClient_ListLocations() {
+func ExampleMatrixClient_ListLocations() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -206,9 +185,9 @@ func ExampleEchoClient_ListLocations() {
 	}
 }
 
-func ExampleEchoClient_GetLocation() {
+func ExampleMatrixClient_GetLocation() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -225,9 +204,9 @@ func ExampleEchoClient_GetLocation() {
 	_ = resp
 }
 
-func ExampleEchoClient_SetIamPolicy() {
+func ExampleMatrixClient_SetIamPolicy() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -244,9 +223,9 @@ func ExampleEchoClient_SetIamPolicy() {
 	_ = resp
 }
 
-func ExampleEchoClient_GetIamPolicy() {
+func ExampleMatrixClient_GetIamPolicy() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -263,9 +242,9 @@ func ExampleEchoClient_GetIamPolicy() {
 	_ = resp
 }
 
-func ExampleEchoClient_TestIamPermissions() {
+func ExampleMatrixClient_TestIamPermissions() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -282,9 +261,9 @@ func ExampleEchoClient_TestIamPermissions() {
 	_ = resp
 }
 
-func ExampleEchoClient_ListOperations() {
+func ExampleMatrixClient_ListOperations() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -307,9 +286,9 @@ func ExampleEchoClient_ListOperations() {
 	}
 }
 
-func ExampleEchoClient_GetOperation() {
+func ExampleMatrixClient_GetOperation() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -326,9 +305,9 @@ func ExampleEchoClient_GetOperation() {
 	_ = resp
 }
 
-func ExampleEchoClient_DeleteOperation() {
+func ExampleMatrixClient_DeleteOperation() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -343,9 +322,9 @@ func ExampleEchoClient_DeleteOperation() {
 	}
 }
 
-func ExampleEchoClient_CancelOperation() {
+func ExampleMatrixClient_CancelOperation() {
 	ctx := context.Background()
-	c, err := client.NewEchoClient(ctx)
+	c, err := showcase.NewMatrixClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
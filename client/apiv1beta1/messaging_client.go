@@ -14,7 +14,7 @@
 
 // Code generated by protoc-gen-go_gapic. DO NOT EDIT.
 
-package client
+package showcase
 
 import (
 	"context"
@@ -49,6 +49,7 @@ type MessagingCallOptions struct {
 	UpdateRoom         []gax.CallOption
 	DeleteRoom         []gax.CallOption
 	ListRooms          []gax.CallOption
+	WatchRoom          []gax.CallOption
 	CreateBlurb        []gax.CallOption
 	GetBlurb           []gax.CallOption
 	UpdateBlurb        []gax.CallOption
@@ -111,6 +112,7 @@ func defaultMessagingCallOptions() *MessagingCallOptions {
 				})
 			}),
 		},
+		WatchRoom:   []gax.CallOption{},
 		CreateBlurb: []gax.CallOption{},
 		GetBlurb: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
@@ -186,6 +188,7 @@ type internalMessagingClient interface {
 	UpdateRoom(context.Context, *genprotopb.UpdateRoomRequest, ...gax.CallOption) (*genprotopb.Room, error)
 	DeleteRoom(context.Context, *genprotopb.DeleteRoomRequest, ...gax.CallOption) error
 	ListRooms(context.Context, *genprotopb.ListRoomsRequest, ...gax.CallOption) *RoomIterator
+	WatchRoom(context.Context, *genprotopb.WatchRoomRequest, ...gax.CallOption) (genprotopb.Messaging_WatchRoomClient, error)
 	CreateBlurb(context.Context, *genprotopb.CreateBlurbRequest, ...gax.CallOption) (*genprotopb.Blurb, error)
 	GetBlurb(context.Context, *genprotopb.GetBlurbRequest, ...gax.CallOption) (*genprotopb.Blurb, error)
 	UpdateBlurb(context.Context, *genprotopb.UpdateBlurbRequest, ...gax.CallOption) (*genprotopb.Blurb, error)
@@ -249,7 +252,9 @@ func (c *MessagingClient) Connection() *grpc.ClientConn {
 	return c.internalClient.Connection()
 }
 
-// CreateRoom creates a room.
+// CreateRoom creates a room. Over REST, retries sending the Idempotency-Key header
+// of an earlier create are given its response, with an
+// Idempotent-Replayed: true header, rather than creating another room.
 func (c *MessagingClient) CreateRoom(ctx context.Context, req *genprotopb.CreateRoomRequest, opts ...gax.CallOption) (*genprotopb.Room, error) {
 	return c.internalClient.CreateRoom(ctx, req, opts...)
 }
@@ -274,9 +279,19 @@ func (c *MessagingClient) ListRooms(ctx context.Context, req *genprotopb.ListRoo
 	return c.internalClient.ListRooms(ctx, req, opts...)
 }
 
+// WatchRoom this returns a stream that emits the changes made to a chat room as it is
+// updated and deleted, closing after its deletion. Each response carries a
+// token resuming the stream from after it, so that an interrupted stream may
+// be resumed without missing changes.
+func (c *MessagingClient) WatchRoom(ctx context.Context, req *genprotopb.WatchRoomRequest, opts ...gax.CallOption) (genprotopb.Messaging_WatchRoomClient, error) {
+	return c.internalClient.WatchRoom(ctx, req, opts...)
+}
+
 // CreateBlurb creates a blurb. If the parent is a room, the blurb is understood to be a
 // message in that room. If the parent is a profile, the blurb is understood
-// to be a post on the profile.
+// to be a post on the profile. Over REST, retries sending the
+// Idempotency-Key header of an earlier create are given its response, as
+// for CreateRoom.
 func (c *MessagingClient) CreateBlurb(ctx context.Context, req *genprotopb.CreateBlurbRequest, opts ...gax.CallOption) (*genprotopb.Blurb, error) {
 	return c.internalClient.CreateBlurb(ctx, req, opts...)
 }
@@ -606,6 +621,21 @@ func (c *messagingGRPCClient) ListRooms(ctx context.Context, req *genprotopb.Lis
 	return it
 }
 
+func (c *messagingGRPCClient) WatchRoom(ctx context.Context, req *genprotopb.WatchRoomRequest, opts ...gax.CallOption) (genprotopb.Messaging_WatchRoomClient, error) {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	var resp genprotopb.Messaging_WatchRoomClient
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.messagingClient.WatchRoom(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *messagingGRPCClient) CreateBlurb(ctx context.Context, req *genprotopb.CreateBlurbRequest, opts ...gax.CallOption) (*genprotopb.Blurb, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
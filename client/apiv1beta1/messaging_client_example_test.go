@@ -14,13 +14,13 @@
 
 // Code generated by protoc-gen-go_gapic. DO NOT EDIT.
 
-package client_test
+package showcase_test
 
 import (
 	"context"
 	"io"
 
-	client "github.com/googleapis/gapic-showcase/client"
+	showcase "github.com/googleapis/gapic-showcase/client/apiv1beta1"
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
 	"google.golang.org/api/iterator"
 	locationpb "google.golang.org/genproto/googleapis/cloud/location"
@@ -30,7 +30,7 @@ import (
 
 func ExampleNewMessagingClient() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -42,7 +42,7 @@ func ExampleNewMessagingClient() {
 
 func ExampleMessagingClient_CreateRoom() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -61,7 +61,7 @@ func ExampleMessagingClient_CreateRoom() {
 
 func ExampleMessagingClient_GetRoom() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -80,7 +80,7 @@ func ExampleMessagingClient_GetRoom() {
 
 func ExampleMessagingClient_UpdateRoom() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -99,7 +99,7 @@ func ExampleMessagingClient_UpdateRoom() {
 
 func ExampleMessagingClient_DeleteRoom() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -116,7 +116,7 @@ func ExampleMessagingClient_DeleteRoom() {
 
 func ExampleMessagingClient_ListRooms() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -141,7 +141,7 @@ func ExampleMessagingClient_ListRooms() {
 
 func ExampleMessagingClient_CreateBlurb() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -160,7 +160,7 @@ func ExampleMessagingClient_CreateBlurb() {
 
 func ExampleMessagingClient_GetBlurb() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -179,7 +179,7 @@ func ExampleMessagingClient_GetBlurb() {
 
 func ExampleMessagingClient_UpdateBlurb() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -198,7 +198,7 @@ func ExampleMessagingClient_UpdateBlurb() {
 
 func ExampleMessagingClient_DeleteBlurb() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -215,7 +215,7 @@ func ExampleMessagingClient_DeleteBlurb() {
 
 func ExampleMessagingClient_ListBlurbs() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -240,7 +240,7 @@ func ExampleMessagingClient_ListBlurbs() {
 
 func ExampleMessagingClient_SearchBlurbs() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -264,7 +264,7 @@ func ExampleMessagingClient_SearchBlurbs() {
 
 func ExampleMessagingClient_Connect() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -299,7 +299,7 @@ func ExampleMessagingClient_Connect() {
 
 func ExampleMessagingClient_ListLocations() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -324,7 +324,7 @@ func ExampleMessagingClient_ListLocations() {
 
 func ExampleMessagingClient_GetLocation() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -343,7 +343,7 @@ func ExampleMessagingClient_GetLocation() {
 
 func ExampleMessagingClient_SetIamPolicy() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -362,7 +362,7 @@ func ExampleMessagingClient_SetIamPolicy() {
 
 func ExampleMessagingClient_GetIamPolicy() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -381,7 +381,7 @@ func ExampleMessagingClient_GetIamPolicy() {
 
 func ExampleMessagingClient_TestIamPermissions() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -400,7 +400,7 @@ func ExampleMessagingClient_TestIamPermissions() {
 
 func ExampleMessagingClient_ListOperations() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -425,7 +425,7 @@ func ExampleMessagingClient_ListOperations() {
 
 func ExampleMessagingClient_GetOperation() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -444,7 +444,7 @@ func ExampleMessagingClient_GetOperation() {
 
 func ExampleMessagingClient_DeleteOperation() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -461,7 +461,7 @@ func ExampleMessagingClient_DeleteOperation() {
 
 func ExampleMessagingClient_CancelOperation() {
 	ctx := context.Background()
-	c, err := client.NewMessagingClient(ctx)
+	c, err := showcase.NewMessagingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
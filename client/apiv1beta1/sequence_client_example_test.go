@@ -14,12 +14,12 @@
 
 // Code generated by protoc-gen-go_gapic. DO NOT EDIT.
 
-package client_test
+package showcase_test
 
 import (
 	"context"
 
-	client "github.com/googleapis/gapic-showcase/client"
+	showcase "github.com/googleapis/gapic-showcase/client/apiv1beta1"
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
 	"google.golang.org/api/iterator"
 	locationpb "google.golang.org/genproto/googleapis/cloud/location"
@@ -29,7 +29,7 @@ import (
 
 func ExampleNewSequenceClient() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -41,7 +41,7 @@ func ExampleNewSequenceClient() {
 
 func ExampleSequenceClient_CreateSequence() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -60,7 +60,7 @@ func ExampleSequenceClient_CreateSequence() {
 
 func ExampleSequenceClient_GetSequenceReport() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -79,7 +79,7 @@ func ExampleSequenceClient_GetSequenceReport() {
 
 func ExampleSequenceClient_AttemptSequence() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -96,7 +96,7 @@ func ExampleSequenceClient_AttemptSequence() {
 
 func ExampleSequenceClient_ListLocations() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -121,7 +121,7 @@ func ExampleSequenceClient_ListLocations() {
 
 func ExampleSequenceClient_GetLocation() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -140,7 +140,7 @@ func ExampleSequenceClient_GetLocation() {
 
 func ExampleSequenceClient_SetIamPolicy() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -159,7 +159,7 @@ func ExampleSequenceClient_SetIamPolicy() {
 
 func ExampleSequenceClient_GetIamPolicy() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -178,7 +178,7 @@ func ExampleSequenceClient_GetIamPolicy() {
 
 func ExampleSequenceClient_TestIamPermissions() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -197,7 +197,7 @@ func ExampleSequenceClient_TestIamPermissions() {
 
 func ExampleSequenceClient_ListOperations() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -222,7 +222,7 @@ func ExampleSequenceClient_ListOperations() {
 
 func ExampleSequenceClient_GetOperation() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -241,7 +241,7 @@ func ExampleSequenceClient_GetOperation() {
 
 func ExampleSequenceClient_DeleteOperation() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -258,7 +258,7 @@ func ExampleSequenceClient_DeleteOperation() {
 
 func ExampleSequenceClient_CancelOperation() {
 	ctx := context.Background()
-	c, err := client.NewSequenceClient(ctx)
+	c, err := showcase.NewSequenceClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
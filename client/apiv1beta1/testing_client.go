@@ -14,7 +14,7 @@
 
 // Code generated by protoc-gen-go_gapic. DO NOT EDIT.
 
-package client
+package showcase
 
 import (
 	"context"
@@ -40,23 +40,27 @@ var newTestingClientHook clientHook
 
 // TestingCallOptions contains the retry settings for each method of TestingClient.
 type TestingCallOptions struct {
-	CreateSession      []gax.CallOption
-	GetSession         []gax.CallOption
-	ListSessions       []gax.CallOption
-	DeleteSession      []gax.CallOption
-	ReportSession      []gax.CallOption
-	ListTests          []gax.CallOption
-	DeleteTest         []gax.CallOption
-	VerifyTest         []gax.CallOption
-	ListLocations      []gax.CallOption
-	GetLocation        []gax.CallOption
-	SetIamPolicy       []gax.CallOption
-	GetIamPolicy       []gax.CallOption
-	TestIamPermissions []gax.CallOption
-	ListOperations     []gax.CallOption
-	GetOperation       []gax.CallOption
-	DeleteOperation    []gax.CallOption
-	CancelOperation    []gax.CallOption
+	CreateSession         []gax.CallOption
+	GetSession            []gax.CallOption
+	ListSessions          []gax.CallOption
+	DeleteSession         []gax.CallOption
+	ReportSession         []gax.CallOption
+	GetConformanceSummary []gax.CallOption
+	ListTests             []gax.CallOption
+	DeleteTest            []gax.CallOption
+	VerifyTest            []gax.CallOption
+	GetScratchpadEntry    []gax.CallOption
+	SetScratchpadEntry    []gax.CallOption
+	DeleteScratchpadEntry []gax.CallOption
+	ListLocations         []gax.CallOption
+	GetLocation           []gax.CallOption
+	SetIamPolicy          []gax.CallOption
+	GetIamPolicy          []gax.CallOption
+	TestIamPermissions    []gax.CallOption
+	ListOperations        []gax.CallOption
+	GetOperation          []gax.CallOption
+	DeleteOperation       []gax.CallOption
+	CancelOperation       []gax.CallOption
 }
 
 func defaultTestingGRPCClientOptions() []option.ClientOption {
@@ -74,23 +78,27 @@ func defaultTestingGRPCClientOptions() []option.ClientOption {
 
 func defaultTestingCallOptions() *TestingCallOptions {
 	return &TestingCallOptions{
-		CreateSession:      []gax.CallOption{},
-		GetSession:         []gax.CallOption{},
-		ListSessions:       []gax.CallOption{},
-		DeleteSession:      []gax.CallOption{},
-		ReportSession:      []gax.CallOption{},
-		ListTests:          []gax.CallOption{},
-		DeleteTest:         []gax.CallOption{},
-		VerifyTest:         []gax.CallOption{},
-		ListLocations:      []gax.CallOption{},
-		GetLocation:        []gax.CallOption{},
-		SetIamPolicy:       []gax.CallOption{},
-		GetIamPolicy:       []gax.CallOption{},
-		TestIamPermissions: []gax.CallOption{},
-		ListOperations:     []gax.CallOption{},
-		GetOperation:       []gax.CallOption{},
-		DeleteOperation:    []gax.CallOption{},
-		CancelOperation:    []gax.CallOption{},
+		CreateSession:         []gax.CallOption{},
+		GetSession:            []gax.CallOption{},
+		ListSessions:          []gax.CallOption{},
+		DeleteSession:         []gax.CallOption{},
+		ReportSession:         []gax.CallOption{},
+		GetConformanceSummary: []gax.CallOption{},
+		ListTests:             []gax.CallOption{},
+		DeleteTest:            []gax.CallOption{},
+		VerifyTest:            []gax.CallOption{},
+		GetScratchpadEntry:    []gax.CallOption{},
+		SetScratchpadEntry:    []gax.CallOption{},
+		DeleteScratchpadEntry: []gax.CallOption{},
+		ListLocations:         []gax.CallOption{},
+		GetLocation:           []gax.CallOption{},
+		SetIamPolicy:          []gax.CallOption{},
+		GetIamPolicy:          []gax.CallOption{},
+		TestIamPermissions:    []gax.CallOption{},
+		ListOperations:        []gax.CallOption{},
+		GetOperation:          []gax.CallOption{},
+		DeleteOperation:       []gax.CallOption{},
+		CancelOperation:       []gax.CallOption{},
 	}
 }
 
@@ -104,9 +112,13 @@ type internalTestingClient interface {
 	ListSessions(context.Context, *genprotopb.ListSessionsRequest, ...gax.CallOption) *SessionIterator
 	DeleteSession(context.Context, *genprotopb.DeleteSessionRequest, ...gax.CallOption) error
 	ReportSession(context.Context, *genprotopb.ReportSessionRequest, ...gax.CallOption) (*genprotopb.ReportSessionResponse, error)
+	GetConformanceSummary(context.Context, *genprotopb.GetConformanceSummaryRequest, ...gax.CallOption) (*genprotopb.ConformanceSummary, error)
 	ListTests(context.Context, *genprotopb.ListTestsRequest, ...gax.CallOption) *TestIterator
 	DeleteTest(context.Context, *genprotopb.DeleteTestRequest, ...gax.CallOption) error
 	VerifyTest(context.Context, *genprotopb.VerifyTestRequest, ...gax.CallOption) (*genprotopb.VerifyTestResponse, error)
+	GetScratchpadEntry(context.Context, *genprotopb.GetScratchpadEntryRequest, ...gax.CallOption) (*genprotopb.ScratchpadEntry, error)
+	SetScratchpadEntry(context.Context, *genprotopb.SetScratchpadEntryRequest, ...gax.CallOption) (*genprotopb.ScratchpadEntry, error)
+	DeleteScratchpadEntry(context.Context, *genprotopb.DeleteScratchpadEntryRequest, ...gax.CallOption) error
 	ListLocations(context.Context, *locationpb.ListLocationsRequest, ...gax.CallOption) *LocationIterator
 	GetLocation(context.Context, *locationpb.GetLocationRequest, ...gax.CallOption) (*locationpb.Location, error)
 	SetIamPolicy(context.Context, *iampb.SetIamPolicyRequest, ...gax.CallOption) (*iampb.Policy, error)
@@ -180,6 +192,16 @@ func (c *TestingClient) ReportSession(ctx context.Context, req *genprotopb.Repor
 	return c.internalClient.ReportSession(ctx, req, opts...)
 }
 
+// GetConformanceSummary summarize the reported outcomes of sessions by client language and
+// version.
+//
+// Every ReportSession call records the outcome of the session under the
+// client that reported it, so a long-running Showcase deployment can serve
+// as a conformance dashboard across client libraries.
+func (c *TestingClient) GetConformanceSummary(ctx context.Context, req *genprotopb.GetConformanceSummaryRequest, opts ...gax.CallOption) (*genprotopb.ConformanceSummary, error) {
+	return c.internalClient.GetConformanceSummary(ctx, req, opts...)
+}
+
 // ListTests list the tests of a sessesion.
 func (c *TestingClient) ListTests(ctx context.Context, req *genprotopb.ListTestsRequest, opts ...gax.CallOption) *TestIterator {
 	return c.internalClient.ListTests(ctx, req, opts...)
@@ -203,6 +225,27 @@ func (c *TestingClient) VerifyTest(ctx context.Context, req *genprotopb.VerifyTe
 	return c.internalClient.VerifyTest(ctx, req, opts...)
 }
 
+// GetScratchpadEntry gets an entry of the scratchpad of a session.
+//
+// The scratchpad is a key-value store scoped to a session, through which
+// the processes of a multi-process test can coordinate, without sharing
+// files. It is dropped along with its session.
+func (c *TestingClient) GetScratchpadEntry(ctx context.Context, req *genprotopb.GetScratchpadEntryRequest, opts ...gax.CallOption) (*genprotopb.ScratchpadEntry, error) {
+	return c.internalClient.GetScratchpadEntry(ctx, req, opts...)
+}
+
+// SetScratchpadEntry creates or replaces an entry of the scratchpad of a session, optionally
+// only if its version is the one expected, so that concurrent writers can
+// compare-and-swap.
+func (c *TestingClient) SetScratchpadEntry(ctx context.Context, req *genprotopb.SetScratchpadEntryRequest, opts ...gax.CallOption) (*genprotopb.ScratchpadEntry, error) {
+	return c.internalClient.SetScratchpadEntry(ctx, req, opts...)
+}
+
+// DeleteScratchpadEntry deletes an entry of the scratchpad of a session.
+func (c *TestingClient) DeleteScratchpadEntry(ctx context.Context, req *genprotopb.DeleteScratchpadEntryRequest, opts ...gax.CallOption) error {
+	return c.internalClient.DeleteScratchpadEntry(ctx, req, opts...)
+}
+
 // ListLocations is a utility method from google.cloud.location.Locations.
 func (c *TestingClient) ListLocations(ctx context.Context, req *locationpb.ListLocationsRequest, opts ...gax.CallOption) *LocationIterator {
 	return c.internalClient.ListLocations(ctx, req, opts...)
@@ -436,6 +479,21 @@ func (c *testingGRPCClient) ReportSession(ctx context.Context, req *genprotopb.R
 	return resp, nil
 }
 
+func (c *testingGRPCClient) GetConformanceSummary(ctx context.Context, req *genprotopb.GetConformanceSummaryRequest, opts ...gax.CallOption) (*genprotopb.ConformanceSummary, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).GetConformanceSummary[0:len((*c.CallOptions).GetConformanceSummary):len((*c.CallOptions).GetConformanceSummary)], opts...)
+	var resp *genprotopb.ConformanceSummary
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.testingClient.GetConformanceSummary(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *testingGRPCClient) ListTests(ctx context.Context, req *genprotopb.ListTestsRequest, opts ...gax.CallOption) *TestIterator {
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
@@ -504,6 +562,50 @@ func (c *testingGRPCClient) VerifyTest(ctx context.Context, req *genprotopb.Veri
 	return resp, nil
 }
 
+func (c *testingGRPCClient) GetScratchpadEntry(ctx context.Context, req *genprotopb.GetScratchpadEntryRequest, opts ...gax.CallOption) (*genprotopb.ScratchpadEntry, error) {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).GetScratchpadEntry[0:len((*c.CallOptions).GetScratchpadEntry):len((*c.CallOptions).GetScratchpadEntry)], opts...)
+	var resp *genprotopb.ScratchpadEntry
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.testingClient.GetScratchpadEntry(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *testingGRPCClient) SetScratchpadEntry(ctx context.Context, req *genprotopb.SetScratchpadEntryRequest, opts ...gax.CallOption) (*genprotopb.ScratchpadEntry, error) {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "entry.name", url.QueryEscape(req.GetEntry().GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).SetScratchpadEntry[0:len((*c.CallOptions).SetScratchpadEntry):len((*c.CallOptions).SetScratchpadEntry)], opts...)
+	var resp *genprotopb.ScratchpadEntry
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.testingClient.SetScratchpadEntry(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *testingGRPCClient) DeleteScratchpadEntry(ctx context.Context, req *genprotopb.DeleteScratchpadEntryRequest, opts ...gax.CallOption) error {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).DeleteScratchpadEntry[0:len((*c.CallOptions).DeleteScratchpadEntry):len((*c.CallOptions).DeleteScratchpadEntry)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		_, err = c.testingClient.DeleteScratchpadEntry(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	return err
+}
+
 func (c *testingGRPCClient) ListLocations(ctx context.Context, req *locationpb.ListLocationsRequest, opts ...gax.CallOption) *LocationIterator {
 	ctx = insertMetadata(ctx, c.xGoogMetadata)
 	opts = append((*c.CallOptions).ListLocations[0:len((*c.CallOptions).ListLocations):len((*c.CallOptions).ListLocations)], opts...)
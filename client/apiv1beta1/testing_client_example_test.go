@@ -14,12 +14,12 @@
 
 // Code generated by protoc-gen-go_gapic. DO NOT EDIT.
 
-package client_test
+package showcase_test
 
 import (
 	"context"
 
-	client "github.com/googleapis/gapic-showcase/client"
+	showcase "github.com/googleapis/gapic-showcase/client/apiv1beta1"
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
 	"google.golang.org/api/iterator"
 	locationpb "google.golang.org/genproto/googleapis/cloud/location"
@@ -29,7 +29,7 @@ import (
 
 func ExampleNewTestingClient() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -41,7 +41,7 @@ func ExampleNewTestingClient() {
 
 func ExampleTestingClient_CreateSession() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -60,7 +60,7 @@ func ExampleTestingClient_CreateSession() {
 
 func ExampleTestingClient_GetSession() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -79,7 +79,7 @@ func ExampleTestingClient_GetSession() {
 
 func ExampleTestingClient_ListSessions() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -104,7 +104,7 @@ func ExampleTestingClient_ListSessions() {
 
 func ExampleTestingClient_DeleteSession() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -121,7 +121,7 @@ func ExampleTestingClient_DeleteSession() {
 
 func ExampleTestingClient_ReportSession() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -138,9 +138,28 @@ func ExampleTestingClient_ReportSession() {
 	_ = resp
 }
 
+func ExampleTestingClient_GetConformanceSummary() {
+	ctx := context.Background()
+	c, err := showcase.NewTestingClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &genprotopb.GetConformanceSummaryRequest{
+		// TODO: Fill request struct fields.
+	}
+	resp, err := c.GetConformanceSummary(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
 func ExampleTestingClient_ListTests() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -165,7 +184,7 @@ func ExampleTestingClient_ListTests() {
 
 func ExampleTestingClient_DeleteTest() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -182,7 +201,7 @@ func ExampleTestingClient_DeleteTest() {
 
 func ExampleTestingClient_VerifyTest() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -199,9 +218,64 @@ func ExampleTestingClient_VerifyTest() {
 	_ = resp
 }
 
+func ExampleTestingClient_GetScratchpadEntry() {
+	ctx := context.Background()
+	c, err := showcase.NewTestingClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &genprotopb.GetScratchpadEntryRequest{
+		// TODO: Fill request struct fields.
+	}
+	resp, err := c.GetScratchpadEntry(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleTestingClient_SetScratchpadEntry() {
+	ctx := context.Background()
+	c, err := showcase.NewTestingClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &genprotopb.SetScratchpadEntryRequest{
+		// TODO: Fill request struct fields.
+	}
+	resp, err := c.SetScratchpadEntry(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleTestingClient_DeleteScratchpadEntry() {
+	ctx := context.Background()
+	c, err := showcase.NewTestingClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &genprotopb.DeleteScratchpadEntryRequest{
+		// TODO: Fill request struct fields.
+	}
+	err = c.DeleteScratchpadEntry(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+}
+
 func ExampleTestingClient_ListLocations() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -226,7 +300,7 @@ func ExampleTestingClient_ListLocations() {
 
 func ExampleTestingClient_GetLocation() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -245,7 +319,7 @@ func ExampleTestingClient_GetLocation() {
 
 func ExampleTestingClient_SetIamPolicy() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -264,7 +338,7 @@ func ExampleTestingClient_SetIamPolicy() {
 
 func ExampleTestingClient_GetIamPolicy() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -283,7 +357,7 @@ func ExampleTestingClient_GetIamPolicy() {
 
 func ExampleTestingClient_TestIamPermissions() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -302,7 +376,7 @@ func ExampleTestingClient_TestIamPermissions() {
 
 func ExampleTestingClient_ListOperations() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -327,7 +401,7 @@ func ExampleTestingClient_ListOperations() {
 
 func ExampleTestingClient_GetOperation() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -346,7 +420,7 @@ func ExampleTestingClient_GetOperation() {
 
 func ExampleTestingClient_DeleteOperation() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
@@ -363,7 +437,7 @@ func ExampleTestingClient_DeleteOperation() {
 
 func ExampleTestingClient_CancelOperation() {
 	ctx := context.Background()
-	c, err := client.NewTestingClient(ctx)
+	c, err := showcase.NewTestingClient(ctx)
 	if err != nil {
 		// TODO: Handle error.
 	}
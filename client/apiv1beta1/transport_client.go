@@ -0,0 +1,722 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go_gapic. DO NOT EDIT.
+
+package showcase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"time"
+
+	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
+	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+	"google.golang.org/api/option/internaloption"
+	gtransport "google.golang.org/api/transport/grpc"
+	locationpb "google.golang.org/genproto/googleapis/cloud/location"
+	iampb "google.golang.org/genproto/googleapis/iam/v1"
+	longrunningpb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+var newTransportClientHook clientHook
+
+// TransportCallOptions contains the retry settings for each method of TransportClient.
+type TransportCallOptions struct {
+	GetStreamQueueReport []gax.CallOption
+	TriggerGoAway        []gax.CallOption
+	ListBinaryLogEntries []gax.CallOption
+	StartPacketCapture   []gax.CallOption
+	StopPacketCapture    []gax.CallOption
+	ListAuthorities      []gax.CallOption
+	ExpectAuthority      []gax.CallOption
+	VerifySignature      []gax.CallOption
+	ListCapturedCalls    []gax.CallOption
+	ListLocations        []gax.CallOption
+	GetLocation          []gax.CallOption
+	SetIamPolicy         []gax.CallOption
+	GetIamPolicy         []gax.CallOption
+	TestIamPermissions   []gax.CallOption
+	ListOperations       []gax.CallOption
+	GetOperation         []gax.CallOption
+	DeleteOperation      []gax.CallOption
+	CancelOperation      []gax.CallOption
+}
+
+func defaultTransportGRPCClientOptions() []option.ClientOption {
+	return []option.ClientOption{
+		internaloption.WithDefaultEndpoint("localhost:7469"),
+		internaloption.WithDefaultMTLSEndpoint("localhost:7469"),
+		internaloption.WithDefaultAudience("https://localhost/"),
+		internaloption.WithDefaultScopes(DefaultAuthScopes()...),
+		internaloption.EnableJwtWithScope(),
+		option.WithGRPCDialOption(grpc.WithDisableServiceConfig()),
+		option.WithGRPCDialOption(grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(math.MaxInt32))),
+	}
+}
+
+func defaultTransportCallOptions() *TransportCallOptions {
+	return &TransportCallOptions{
+		GetStreamQueueReport: []gax.CallOption{},
+		TriggerGoAway:        []gax.CallOption{},
+		ListBinaryLogEntries: []gax.CallOption{},
+		StartPacketCapture:   []gax.CallOption{},
+		StopPacketCapture:    []gax.CallOption{},
+		ListAuthorities:      []gax.CallOption{},
+		ExpectAuthority:      []gax.CallOption{},
+		VerifySignature:      []gax.CallOption{},
+		ListCapturedCalls:    []gax.CallOption{},
+		ListLocations:        []gax.CallOption{},
+		GetLocation:          []gax.CallOption{},
+		SetIamPolicy:         []gax.CallOption{},
+		GetIamPolicy:         []gax.CallOption{},
+		TestIamPermissions:   []gax.CallOption{},
+		ListOperations:       []gax.CallOption{},
+		GetOperation:         []gax.CallOption{},
+		DeleteOperation:      []gax.CallOption{},
+		CancelOperation:      []gax.CallOption{},
+	}
+}
+
+// internalTransportClient is an interface that defines the methods availaible from Client Libraries Showcase API.
+type internalTransportClient interface {
+	Close() error
+	setGoogleClientInfo(...string)
+	Connection() *grpc.ClientConn
+	GetStreamQueueReport(context.Context, *genprotopb.GetStreamQueueReportRequest, ...gax.CallOption) (*genprotopb.StreamQueueReport, error)
+	TriggerGoAway(context.Context, *genprotopb.TriggerGoAwayRequest, ...gax.CallOption) (*genprotopb.TriggerGoAwayResponse, error)
+	ListBinaryLogEntries(context.Context, *genprotopb.ListBinaryLogEntriesRequest, ...gax.CallOption) (*genprotopb.ListBinaryLogEntriesResponse, error)
+	StartPacketCapture(context.Context, *genprotopb.StartPacketCaptureRequest, ...gax.CallOption) (*genprotopb.PacketCapture, error)
+	StopPacketCapture(context.Context, *genprotopb.StopPacketCaptureRequest, ...gax.CallOption) (*genprotopb.PacketCapture, error)
+	ListAuthorities(context.Context, *genprotopb.ListAuthoritiesRequest, ...gax.CallOption) (*genprotopb.ListAuthoritiesResponse, error)
+	ExpectAuthority(context.Context, *genprotopb.ExpectAuthorityRequest, ...gax.CallOption) (*genprotopb.ExpectAuthorityResponse, error)
+	VerifySignature(context.Context, *genprotopb.VerifySignatureRequest, ...gax.CallOption) (*genprotopb.VerifySignatureResponse, error)
+	ListCapturedCalls(context.Context, *genprotopb.ListCapturedCallsRequest, ...gax.CallOption) (*genprotopb.ListCapturedCallsResponse, error)
+	ListLocations(context.Context, *locationpb.ListLocationsRequest, ...gax.CallOption) *LocationIterator
+	GetLocation(context.Context, *locationpb.GetLocationRequest, ...gax.CallOption) (*locationpb.Location, error)
+	SetIamPolicy(context.Context, *iampb.SetIamPolicyRequest, ...gax.CallOption) (*iampb.Policy, error)
+	GetIamPolicy(context.Context, *iampb.GetIamPolicyRequest, ...gax.CallOption) (*iampb.Policy, error)
+	TestIamPermissions(context.Context, *iampb.TestIamPermissionsRequest, ...gax.CallOption) (*iampb.TestIamPermissionsResponse, error)
+	ListOperations(context.Context, *longrunningpb.ListOperationsRequest, ...gax.CallOption) *OperationIterator
+	GetOperation(context.Context, *longrunningpb.GetOperationRequest, ...gax.CallOption) (*longrunningpb.Operation, error)
+	DeleteOperation(context.Context, *longrunningpb.DeleteOperationRequest, ...gax.CallOption) error
+	CancelOperation(context.Context, *longrunningpb.CancelOperationRequest, ...gax.CallOption) error
+}
+
+// TransportClient is a client for interacting with Client Libraries Showcase API.
+// Methods, except Close, may be called concurrently. However, fields must not be modified concurrently with method calls.
+//
+// This service reports and drives the behavior of the HTTP/2 connections gRPC
+// clients hold to the server, so that clients’ connection management can be
+// tested.
+type TransportClient struct {
+	// The internal transport-dependent client.
+	internalClient internalTransportClient
+
+	// The call options for this service.
+	CallOptions *TransportCallOptions
+}
+
+// Wrapper methods routed to the internal client.
+
+// Close closes the connection to the API service. The user should invoke this when
+// the client is no longer required.
+func (c *TransportClient) Close() error {
+	return c.internalClient.Close()
+}
+
+// setGoogleClientInfo sets the name and version of the application in
+// the `x-goog-api-client` header passed on each request. Intended for
+// use by Google-written clients.
+func (c *TransportClient) setGoogleClientInfo(keyval ...string) {
+	c.internalClient.setGoogleClientInfo(keyval...)
+}
+
+// Connection returns a connection to the API service.
+//
+// Deprecated.
+func (c *TransportClient) Connection() *grpc.ClientConn {
+	return c.internalClient.Connection()
+}
+
+// GetStreamQueueReport reports the streams gRPC clients have opened on each of their connections,
+// including the streams clients held back until a stream slot was freed
+// because the connection was at its limit of concurrent streams.
+func (c *TransportClient) GetStreamQueueReport(ctx context.Context, req *genprotopb.GetStreamQueueReportRequest, opts ...gax.CallOption) (*genprotopb.StreamQueueReport, error) {
+	return c.internalClient.GetStreamQueueReport(ctx, req, opts...)
+}
+
+// TriggerGoAway makes the server send a GOAWAY on the caller’s connection right after the
+// response to this call. A GOAWAY with an error code is followed by the
+// server closing the connection. This is only available over gRPC.
+func (c *TransportClient) TriggerGoAway(ctx context.Context, req *genprotopb.TriggerGoAwayRequest, opts ...gax.CallOption) (*genprotopb.TriggerGoAwayResponse, error) {
+	return c.internalClient.TriggerGoAway(ctx, req, opts...)
+}
+
+// ListBinaryLogEntries lists the gRPC binary log entries the server captured for calls matching
+// the request. The server only captures calls to the methods selected with
+// its –binary-log-methods flag, and keeps the most recent entries.
+func (c *TransportClient) ListBinaryLogEntries(ctx context.Context, req *genprotopb.ListBinaryLogEntriesRequest, opts ...gax.CallOption) (*genprotopb.ListBinaryLogEntriesResponse, error) {
+	return c.internalClient.ListBinaryLogEntries(ctx, req, opts...)
+}
+
+// StartPacketCapture starts capturing the traffic of the server’s connections. The capture
+// holds the bytes the server read and wrote, still encrypted when TLS is on,
+// as the TCP packets that would have carried them on the loopback
+// interface. Connections opened before the capture started cannot be
+// decrypted, since their TLS handshake is not in the capture.
+func (c *TransportClient) StartPacketCapture(ctx context.Context, req *genprotopb.StartPacketCaptureRequest, opts ...gax.CallOption) (*genprotopb.PacketCapture, error) {
+	return c.internalClient.StartPacketCapture(ctx, req, opts...)
+}
+
+// StopPacketCapture stops a packet capture, and returns it in pcap format, which packet
+// analyzers such as Wireshark open. Given the TLS key log the server writes
+// with –tls-key-log-file, they also decrypt it.
+func (c *TransportClient) StopPacketCapture(ctx context.Context, req *genprotopb.StopPacketCaptureRequest, opts ...gax.CallOption) (*genprotopb.PacketCapture, error) {
+	return c.internalClient.StopPacketCapture(ctx, req, opts...)
+}
+
+// ListAuthorities lists the authorities recent calls were sent to: the :authority of gRPC
+// calls and the Host header of REST calls. This lets tests check which
+// endpoint a client resolved, e.g. after an endpoint override or with a
+// universe domain set.
+func (c *TransportClient) ListAuthorities(ctx context.Context, req *genprotopb.ListAuthoritiesRequest, opts ...gax.CallOption) (*genprotopb.ListAuthoritiesResponse, error) {
+	return c.internalClient.ListAuthorities(ctx, req, opts...)
+}
+
+// ExpectAuthority makes the server fail the calls that are not sent to the given authority,
+// with FAILED_PRECONDITION over gRPC and 421 Misdirected Request over REST.
+// Calls to this service are never failed. An empty authority lifts the
+// expectation.
+func (c *TransportClient) ExpectAuthority(ctx context.Context, req *genprotopb.ExpectAuthorityRequest, opts ...gax.CallOption) (*genprotopb.ExpectAuthorityResponse, error) {
+	return c.internalClient.ExpectAuthority(ctx, req, opts...)
+}
+
+// VerifySignature verifies the HMAC-SHA256 signature of this very call, computed with the
+// server’s signing key over the canonical form of the call’s method, path,
+// signed headers and body. The response carries the canonical request the
+// server computed, so that clients implementing request signing can check
+// their canonicalization exactly. Mismatches are reported, not failed.
+func (c *TransportClient) VerifySignature(ctx context.Context, req *genprotopb.VerifySignatureRequest, opts ...gax.CallOption) (*genprotopb.VerifySignatureResponse, error) {
+	return c.internalClient.VerifySignature(ctx, req, opts...)
+}
+
+// ListCapturedCalls lists the most recent gRPC calls to a method, as captured when they hit
+// the wire: their request metadata, the messages exchanged, the response
+// metadata and the status they ended with. This lets tests assert on exactly
+// what a client sent without standing up a proxy. The server keeps the last
+// 100 calls to each method.
+func (c *TransportClient) ListCapturedCalls(ctx context.Context, req *genprotopb.ListCapturedCallsRequest, opts ...gax.CallOption) (*genprotopb.ListCapturedCallsResponse, error) {
+	return c.internalClient.ListCapturedCalls(ctx, req, opts...)
+}
+
+// ListLocations is a utility method from google.cloud.location.Locations.
+func (c *TransportClient) ListLocations(ctx context.Context, req *locationpb.ListLocationsRequest, opts ...gax.CallOption) *LocationIterator {
+	return c.internalClient.ListLocations(ctx, req, opts...)
+}
+
+// GetLocation is a utility method from google.cloud.location.Locations.
+func (c *TransportClient) GetLocation(ctx context.Context, req *locationpb.GetLocationRequest, opts ...gax.CallOption) (*locationpb.Location, error) {
+	return c.internalClient.GetLocation(ctx, req, opts...)
+}
+
+// SetIamPolicy is a utility method from google.iam.v1.IAMPolicy.
+func (c *TransportClient) SetIamPolicy(ctx context.Context, req *iampb.SetIamPolicyRequest, opts ...gax.CallOption) (*iampb.Policy, error) {
+	return c.internalClient.SetIamPolicy(ctx, req, opts...)
+}
+
+// GetIamPolicy is a utility method from google.iam.v1.IAMPolicy.
+func (c *TransportClient) GetIamPolicy(ctx context.Context, req *iampb.GetIamPolicyRequest, opts ...gax.CallOption) (*iampb.Policy, error) {
+	return c.internalClient.GetIamPolicy(ctx, req, opts...)
+}
+
+// TestIamPermissions is a utility method from google.iam.v1.IAMPolicy.
+func (c *TransportClient) TestIamPermissions(ctx context.Context, req *iampb.TestIamPermissionsRequest, opts ...gax.CallOption) (*iampb.TestIamPermissionsResponse, error) {
+	return c.internalClient.TestIamPermissions(ctx, req, opts...)
+}
+
+// ListOperations is a utility method from google.longrunning.Operations.
+func (c *TransportClient) ListOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest, opts ...gax.CallOption) *OperationIterator {
+	return c.internalClient.ListOperations(ctx, req, opts...)
+}
+
+// GetOperation is a utility method from google.longrunning.Operations.
+func (c *TransportClient) GetOperation(ctx context.Context, req *longrunningpb.GetOperationRequest, opts ...gax.CallOption) (*longrunningpb.Operation, error) {
+	return c.internalClient.GetOperation(ctx, req, opts...)
+}
+
+// DeleteOperation is a utility method from google.longrunning.Operations.
+func (c *TransportClient) DeleteOperation(ctx context.Context, req *longrunningpb.DeleteOperationRequest, opts ...gax.CallOption) error {
+	return c.internalClient.DeleteOperation(ctx, req, opts...)
+}
+
+// CancelOperation is a utility method from google.longrunning.Operations.
+func (c *TransportClient) CancelOperation(ctx context.Context, req *longrunningpb.CancelOperationRequest, opts ...gax.CallOption) error {
+	return c.internalClient.CancelOperation(ctx, req, opts...)
+}
+
+// transportGRPCClient is a client for interacting with Client Libraries Showcase API over gRPC transport.
+//
+// Methods, except Close, may be called concurrently. However, fields must not be modified concurrently with method calls.
+type transportGRPCClient struct {
+	// Connection pool of gRPC connections to the service.
+	connPool gtransport.ConnPool
+
+	// flag to opt out of default deadlines via GOOGLE_API_GO_EXPERIMENTAL_DISABLE_DEFAULT_DEADLINE
+	disableDeadlines bool
+
+	// Points back to the CallOptions field of the containing TransportClient
+	CallOptions **TransportCallOptions
+
+	// The gRPC API client.
+	transportClient genprotopb.TransportClient
+
+	operationsClient longrunningpb.OperationsClient
+
+	iamPolicyClient iampb.IAMPolicyClient
+
+	locationsClient locationpb.LocationsClient
+
+	// The x-goog-* metadata to be sent with each request.
+	xGoogMetadata metadata.MD
+}
+
+// NewTransportClient creates a new transport client based on gRPC.
+// The returned client must be Closed when it is done being used to clean up its underlying connections.
+//
+// This service reports and drives the behavior of the HTTP/2 connections gRPC
+// clients hold to the server, so that clients’ connection management can be
+// tested.
+func NewTransportClient(ctx context.Context, opts ...option.ClientOption) (*TransportClient, error) {
+	clientOpts := defaultTransportGRPCClientOptions()
+	if newTransportClientHook != nil {
+		hookOpts, err := newTransportClientHook(ctx, clientHookParams{})
+		if err != nil {
+			return nil, err
+		}
+		clientOpts = append(clientOpts, hookOpts...)
+	}
+
+	disableDeadlines, err := checkDisableDeadlines()
+	if err != nil {
+		return nil, err
+	}
+
+	connPool, err := gtransport.DialPool(ctx, append(clientOpts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	client := TransportClient{CallOptions: defaultTransportCallOptions()}
+
+	c := &transportGRPCClient{
+		connPool:         connPool,
+		disableDeadlines: disableDeadlines,
+		transportClient:  genprotopb.NewTransportClient(connPool),
+		CallOptions:      &client.CallOptions,
+		operationsClient: longrunningpb.NewOperationsClient(connPool),
+		iamPolicyClient:  iampb.NewIAMPolicyClient(connPool),
+		locationsClient:  locationpb.NewLocationsClient(connPool),
+	}
+	c.setGoogleClientInfo()
+
+	client.internalClient = c
+
+	return &client, nil
+}
+
+// Connection returns a connection to the API service.
+//
+// Deprecated.
+func (c *transportGRPCClient) Connection() *grpc.ClientConn {
+	return c.connPool.Conn()
+}
+
+// setGoogleClientInfo sets the name and version of the application in
+// the `x-goog-api-client` header passed on each request. Intended for
+// use by Google-written clients.
+func (c *transportGRPCClient) setGoogleClientInfo(keyval ...string) {
+	kv := append([]string{"gl-go", versionGo()}, keyval...)
+	kv = append(kv, "gapic", versionClient, "gax", gax.Version, "grpc", grpc.Version)
+	c.xGoogMetadata = metadata.Pairs("x-goog-api-client", gax.XGoogHeader(kv...))
+}
+
+// Close closes the connection to the API service. The user should invoke this when
+// the client is no longer required.
+func (c *transportGRPCClient) Close() error {
+	return c.connPool.Close()
+}
+
+func (c *transportGRPCClient) GetStreamQueueReport(ctx context.Context, req *genprotopb.GetStreamQueueReportRequest, opts ...gax.CallOption) (*genprotopb.StreamQueueReport, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).GetStreamQueueReport[0:len((*c.CallOptions).GetStreamQueueReport):len((*c.CallOptions).GetStreamQueueReport)], opts...)
+	var resp *genprotopb.StreamQueueReport
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.transportClient.GetStreamQueueReport(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) TriggerGoAway(ctx context.Context, req *genprotopb.TriggerGoAwayRequest, opts ...gax.CallOption) (*genprotopb.TriggerGoAwayResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).TriggerGoAway[0:len((*c.CallOptions).TriggerGoAway):len((*c.CallOptions).TriggerGoAway)], opts...)
+	var resp *genprotopb.TriggerGoAwayResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.transportClient.TriggerGoAway(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) ListBinaryLogEntries(ctx context.Context, req *genprotopb.ListBinaryLogEntriesRequest, opts ...gax.CallOption) (*genprotopb.ListBinaryLogEntriesResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ListBinaryLogEntries[0:len((*c.CallOptions).ListBinaryLogEntries):len((*c.CallOptions).ListBinaryLogEntries)], opts...)
+	var resp *genprotopb.ListBinaryLogEntriesResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.transportClient.ListBinaryLogEntries(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) StartPacketCapture(ctx context.Context, req *genprotopb.StartPacketCaptureRequest, opts ...gax.CallOption) (*genprotopb.PacketCapture, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).StartPacketCapture[0:len((*c.CallOptions).StartPacketCapture):len((*c.CallOptions).StartPacketCapture)], opts...)
+	var resp *genprotopb.PacketCapture
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.transportClient.StartPacketCapture(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) StopPacketCapture(ctx context.Context, req *genprotopb.StopPacketCaptureRequest, opts ...gax.CallOption) (*genprotopb.PacketCapture, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "capture_id", url.QueryEscape(req.GetCaptureId())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).StopPacketCapture[0:len((*c.CallOptions).StopPacketCapture):len((*c.CallOptions).StopPacketCapture)], opts...)
+	var resp *genprotopb.PacketCapture
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.transportClient.StopPacketCapture(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) ListAuthorities(ctx context.Context, req *genprotopb.ListAuthoritiesRequest, opts ...gax.CallOption) (*genprotopb.ListAuthoritiesResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ListAuthorities[0:len((*c.CallOptions).ListAuthorities):len((*c.CallOptions).ListAuthorities)], opts...)
+	var resp *genprotopb.ListAuthoritiesResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.transportClient.ListAuthorities(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) ExpectAuthority(ctx context.Context, req *genprotopb.ExpectAuthorityRequest, opts ...gax.CallOption) (*genprotopb.ExpectAuthorityResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ExpectAuthority[0:len((*c.CallOptions).ExpectAuthority):len((*c.CallOptions).ExpectAuthority)], opts...)
+	var resp *genprotopb.ExpectAuthorityResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.transportClient.ExpectAuthority(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) VerifySignature(ctx context.Context, req *genprotopb.VerifySignatureRequest, opts ...gax.CallOption) (*genprotopb.VerifySignatureResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).VerifySignature[0:len((*c.CallOptions).VerifySignature):len((*c.CallOptions).VerifySignature)], opts...)
+	var resp *genprotopb.VerifySignatureResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.transportClient.VerifySignature(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) ListCapturedCalls(ctx context.Context, req *genprotopb.ListCapturedCallsRequest, opts ...gax.CallOption) (*genprotopb.ListCapturedCallsResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 5000*time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ListCapturedCalls[0:len((*c.CallOptions).ListCapturedCalls):len((*c.CallOptions).ListCapturedCalls)], opts...)
+	var resp *genprotopb.ListCapturedCallsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.transportClient.ListCapturedCalls(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) ListLocations(ctx context.Context, req *locationpb.ListLocationsRequest, opts ...gax.CallOption) *LocationIterator {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ListLocations[0:len((*c.CallOptions).ListLocations):len((*c.CallOptions).ListLocations)], opts...)
+	it := &LocationIterator{}
+	req = proto.Clone(req).(*locationpb.ListLocationsRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*locationpb.Location, string, error) {
+		var resp *locationpb.ListLocationsResponse
+		req.PageToken = pageToken
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.locationsClient.ListLocations(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetLocations(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+	return it
+}
+
+func (c *transportGRPCClient) GetLocation(ctx context.Context, req *locationpb.GetLocationRequest, opts ...gax.CallOption) (*locationpb.Location, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).GetLocation[0:len((*c.CallOptions).GetLocation):len((*c.CallOptions).GetLocation)], opts...)
+	var resp *locationpb.Location
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.locationsClient.GetLocation(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) SetIamPolicy(ctx context.Context, req *iampb.SetIamPolicyRequest, opts ...gax.CallOption) (*iampb.Policy, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).SetIamPolicy[0:len((*c.CallOptions).SetIamPolicy):len((*c.CallOptions).SetIamPolicy)], opts...)
+	var resp *iampb.Policy
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.iamPolicyClient.SetIamPolicy(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) GetIamPolicy(ctx context.Context, req *iampb.GetIamPolicyRequest, opts ...gax.CallOption) (*iampb.Policy, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).GetIamPolicy[0:len((*c.CallOptions).GetIamPolicy):len((*c.CallOptions).GetIamPolicy)], opts...)
+	var resp *iampb.Policy
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.iamPolicyClient.GetIamPolicy(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) TestIamPermissions(ctx context.Context, req *iampb.TestIamPermissionsRequest, opts ...gax.CallOption) (*iampb.TestIamPermissionsResponse, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).TestIamPermissions[0:len((*c.CallOptions).TestIamPermissions):len((*c.CallOptions).TestIamPermissions)], opts...)
+	var resp *iampb.TestIamPermissionsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.iamPolicyClient.TestIamPermissions(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) ListOperations(ctx context.Context, req *longrunningpb.ListOperationsRequest, opts ...gax.CallOption) *OperationIterator {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ListOperations[0:len((*c.CallOptions).ListOperations):len((*c.CallOptions).ListOperations)], opts...)
+	it := &OperationIterator{}
+	req = proto.Clone(req).(*longrunningpb.ListOperationsRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*longrunningpb.Operation, string, error) {
+		var resp *longrunningpb.ListOperationsResponse
+		req.PageToken = pageToken
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.operationsClient.ListOperations(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetOperations(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+	return it
+}
+
+func (c *transportGRPCClient) GetOperation(ctx context.Context, req *longrunningpb.GetOperationRequest, opts ...gax.CallOption) (*longrunningpb.Operation, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).GetOperation[0:len((*c.CallOptions).GetOperation):len((*c.CallOptions).GetOperation)], opts...)
+	var resp *longrunningpb.Operation
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.operationsClient.GetOperation(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *transportGRPCClient) DeleteOperation(ctx context.Context, req *longrunningpb.DeleteOperationRequest, opts ...gax.CallOption) error {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).DeleteOperation[0:len((*c.CallOptions).DeleteOperation):len((*c.CallOptions).DeleteOperation)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		_, err = c.operationsClient.DeleteOperation(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	return err
+}
+
+func (c *transportGRPCClient) CancelOperation(ctx context.Context, req *longrunningpb.CancelOperationRequest, opts ...gax.CallOption) error {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).CancelOperation[0:len((*c.CallOptions).CancelOperation):len((*c.CallOptions).CancelOperation)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		_, err = c.operationsClient.CancelOperation(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	return err
+}
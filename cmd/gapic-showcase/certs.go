@@ -0,0 +1,200 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// The names of the files generated by `certs generate`, and read by `run --mtls-dir`.
+const (
+	caCertFile     = "ca.pem"
+	caKeyFile      = "ca-key.pem"
+	serverCertFile = "server.pem"
+	serverKeyFile  = "server-key.pem"
+)
+
+func init() {
+	var dir, trustDomain string
+	var hosts, clients []string
+	var validity time.Duration
+	certsCmd := &cobra.Command{
+		Use:   "certs",
+		Short: "Manages the test certificates of mutual TLS connections",
+	}
+	generateCmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Mints a test certificate authority and the server and client certificates it issues",
+		Long: "Mints a test certificate authority, a server certificate for the given hosts and client certificates, " +
+			"all signed by the authority, into a directory that `gapic-showcase run --mtls-dir` serves mutual TLS with. " +
+			"The keys are not protected: the certificates are only meant for tests.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := generateCerts(dir, hosts, clients, trustDomain, validity, time.Now()); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Wrote the certificates to %s. Serve mutual TLS with them by running:\n  gapic-showcase run --mtls-dir %s\n", dir, dir)
+			return nil
+		},
+	}
+	certsCmd.AddCommand(generateCmd)
+	rootCmd.AddCommand(certsCmd)
+	generateCmd.Flags().StringVar(
+		&dir,
+		"dir",
+		"showcase-certs",
+		"The directory the certificates and their keys are written to.")
+	generateCmd.Flags().StringSliceVar(
+		&hosts,
+		"hosts",
+		[]string{"localhost", "127.0.0.1", "::1"},
+		"The DNS names and IP addresses the server certificate is valid for.")
+	generateCmd.Flags().StringSliceVar(
+		&clients,
+		"clients",
+		[]string{"client"},
+		"The common names of the client certificates minted, each written to <name>.pem and <name>-key.pem.")
+	generateCmd.Flags().StringVar(
+		&trustDomain,
+		"spiffe-trust-domain",
+		"",
+		"The SPIFFE trust domain of the client certificates, e.g. \"showcase.test\", giving each the SPIFFE ID spiffe://<domain>/<name>. The certificates have no SPIFFE ID if empty.")
+	generateCmd.Flags().DurationVar(
+		&validity,
+		"validity",
+		365*24*time.Hour,
+		"How long the certificates are valid for.")
+}
+
+// generateCerts writes to dir a certificate authority, a server certificate valid for hosts and
+// a client certificate for each of clients, with a SPIFFE ID in trustDomain if it is not empty,
+// all valid from now for the validity duration.
+func generateCerts(dir string, hosts, clients []string, trustDomain string, validity time.Duration, now time.Time) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	caTemplate := certTemplate("Showcase Test CA", now, validity)
+	caTemplate.IsCA = true
+	caTemplate.BasicConstraintsValid = true
+	caTemplate.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	caCert, caKey, err := mintCert(dir, caCertFile, caKeyFile, caTemplate, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	serverTemplate := certTemplate("Showcase Test Server", now, validity)
+	serverTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			serverTemplate.IPAddresses = append(serverTemplate.IPAddresses, ip)
+		} else {
+			serverTemplate.DNSNames = append(serverTemplate.DNSNames, host)
+		}
+	}
+	if _, _, err := mintCert(dir, serverCertFile, serverKeyFile, serverTemplate, caCert, caKey); err != nil {
+		return err
+	}
+
+	for _, client := range clients {
+		clientTemplate := certTemplate(client, now, validity)
+		clientTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+		if trustDomain != "" {
+			clientTemplate.URIs = []*url.URL{{Scheme: "spiffe", Host: trustDomain, Path: "/" + client}}
+		}
+		if _, _, err := mintCert(dir, client+".pem", client+"-key.pem", clientTemplate, caCert, caKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// certTemplate returns the template of a certificate for commonName, valid from now for the
+// validity duration.
+func certTemplate(commonName string, now time.Time, validity time.Duration) *x509.Certificate {
+	serial, _ := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	return &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{"GAPIC Showcase"}},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+}
+
+// mintCert creates a certificate from template for a new key, signed by parent with parentKey,
+// or self-signed if parent is nil, and writes them to certFile and keyFile in dir.
+func mintCert(dir, certFile, keyFile string, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating %s: %v", certFile, err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, certFile), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644); err != nil {
+		return nil, nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, keyFile), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+// applyMTLSDir defaults the mutual TLS files of config that are not set to those generated by
+// `certs generate` in config.mtlsDir, if set.
+func applyMTLSDir(config *RuntimeConfig) {
+	if config.mtlsDir == "" {
+		return
+	}
+	for _, file := range []struct {
+		path *string
+		name string
+	}{
+		{&config.tlsCaCert, caCertFile},
+		{&config.tlsCert, serverCertFile},
+		{&config.tlsKey, serverKeyFile},
+	} {
+		if *file.path == "" {
+			*file.path = filepath.Join(config.mtlsDir, file.name)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGenerateCerts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "showcase-certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	now := time.Now()
+	if err := generateCerts(dir, []string{"localhost", "127.0.0.1"}, []string{"alice"}, "", time.Hour, now); err != nil {
+		t.Fatalf("generateCerts: unexpected err %v", err)
+	}
+
+	caPEM, err := ioutil.ReadFile(filepath.Join(dir, caCertFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caPEM) {
+		t.Fatalf("%s holds no certificate", caCertFile)
+	}
+
+	for _, testCase := range []struct {
+		certFile, keyFile string
+		usage             x509.ExtKeyUsage
+		hosts             []string
+	}{
+		{serverCertFile, serverKeyFile, x509.ExtKeyUsageServerAuth, []string{"localhost", "127.0.0.1"}},
+		{"alice.pem", "alice-key.pem", x509.ExtKeyUsageClientAuth, nil},
+	} {
+		pair, err := tls.LoadX509KeyPair(filepath.Join(dir, testCase.certFile), filepath.Join(dir, testCase.keyFile))
+		if err != nil {
+			t.Fatalf("%s: %v", testCase.certFile, err)
+		}
+		cert, err := x509.ParseCertificate(pair.Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		options := x509.VerifyOptions{Roots: roots, CurrentTime: now, KeyUsages: []x509.ExtKeyUsage{testCase.usage}}
+		if _, err := cert.Verify(options); err != nil {
+			t.Errorf("%s: does not verify against the CA: %v", testCase.certFile, err)
+		}
+		for _, host := range testCase.hosts {
+			if err := cert.VerifyHostname(host); err != nil {
+				t.Errorf("%s: not valid for %s: %v", testCase.certFile, host, err)
+			}
+		}
+		if !cert.NotAfter.Before(now.Add(2 * time.Hour)) {
+			t.Errorf("%s: want it valid for an hour, valid until %v", testCase.certFile, cert.NotAfter)
+		}
+	}
+}
+
+func TestApplyMTLSDir(t *testing.T) {
+	config := RuntimeConfig{mtlsDir: "certs", tlsKey: "other-key.pem"}
+	applyMTLSDir(&config)
+	if config.tlsCaCert != filepath.Join("certs", caCertFile) || config.tlsCert != filepath.Join("certs", serverCertFile) {
+		t.Errorf("want the files in the directory, got %q and %q", config.tlsCaCert, config.tlsCert)
+	}
+	if config.tlsKey != "other-key.pem" {
+		t.Errorf("want the files set kept, got %q", config.tlsKey)
+	}
+
+	config = RuntimeConfig{}
+	applyMTLSDir(&config)
+	if config.tlsCaCert != "" || config.tlsCert != "" || config.tlsKey != "" {
+		t.Errorf("want no files without a directory, got %+v", config)
+	}
+}
+
+func TestMTLSPeerIdentity(t *testing.T) {
+	dir, err := ioutil.TempDir("", "showcase-certs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := generateCerts(dir, []string{"127.0.0.1"}, []string{"alice"}, "showcase.test", time.Hour, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	config := RuntimeConfig{mtlsDir: dir, reflectionVersion: "all"}
+	applyMTLSDir(&config)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoint := newEndpointGRPC(lis, config, createBackends(config)).(*endpointGRPC)
+	go endpoint.server.Serve(lis)
+	defer endpoint.server.Stop()
+
+	pair, err := tls.LoadX509KeyPair(filepath.Join(dir, "alice.pem"), filepath.Join(dir, "alice-key.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	caPEM, err := ioutil.ReadFile(filepath.Join(dir, caCertFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(caPEM)
+	creds := credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{pair}, RootCAs: roots})
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	var header metadata.MD
+	request := &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hi"}}
+	if _, err := pb.NewEchoClient(conn).Echo(context.Background(), request, grpc.Header(&header)); err != nil {
+		t.Fatalf("Echo over mutual TLS: %v", err)
+	}
+	if got, want := header.Get(server.PeerSPIFFEIDHeader), "spiffe://showcase.test/alice"; len(got) != 1 || got[0] != want {
+		t.Errorf("want the SPIFFE ID %q echoed, got %v", want, got)
+	}
+}
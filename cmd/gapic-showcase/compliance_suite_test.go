@@ -332,6 +332,7 @@ func prepRepeatDataTestsQueryParams(request *genproto.RepeatRequest, exclude map
 	addParam("info.f_child.f_float", info.GetFChild().GetFFloat() != 0, url.QueryEscape(fmt.Sprintf("%g", info.GetFChild().GetFFloat())))
 	addParam("info.f_child.f_double", info.GetFChild().GetFDouble() != 0, url.QueryEscape(fmt.Sprintf("%g", info.GetFChild().GetFDouble())))
 	addParam("info.f_child.f_bool", info.GetFChild().GetFBool(), "true")
+	addParam("info.f_child.p_string", info.GetFChild() != nil && info.GetFChild().PString != nil, url.QueryEscape(info.GetFChild().GetPString()))
 
 	// If needed for test cases, we'll have to add remaining nested message fields.
 
@@ -351,8 +352,8 @@ func prepQueryString(queryParams []string) string {
 func complianceSuiteTestSetup() (suite *pb.ComplianceSuite, server *httptest.Server, err error) {
 	// Run the Showcase REST server locally.
 	server = httptest.NewUnstartedServer(nil)
-	backend := createBackends()
-	restServer := newEndpointREST(nil, backend)
+	backend := createBackends(RuntimeConfig{})
+	restServer := newEndpointREST(nil, RuntimeConfig{}, backend)
 	server.Config = restServer.server
 
 	suite, err = getCleanComplianceSuite()
@@ -29,14 +29,16 @@ func init() {
 
 	CreateRoomCmd.Flags().StringVar(&CreateRoomInput.Room.Description, "room.description", "", "The description of the chat room.")
 
+	CreateRoomCmd.Flags().StringVar(&CreateRoomInput.Room.Etag, "room.etag", "", "A checksum of the room's state, changing every...")
+
 	CreateRoomCmd.Flags().StringVar(&CreateRoomFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
 
 var CreateRoomCmd = &cobra.Command{
 	Use:   "create-room",
-	Short: "Creates a room.",
-	Long:  "Creates a room.",
+	Short: "Creates a room. Over REST, retries sending the...",
+	Long:  "Creates a room. Over REST, retries sending the `Idempotency-Key` header  of an earlier create are given its response, with an  `Idempotent-Replayed:...",
 	PreRun: func(cmd *cobra.Command, args []string) {
 
 		if CreateRoomFromFile == "" {
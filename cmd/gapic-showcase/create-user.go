@@ -45,6 +45,8 @@ func init() {
 
 	CreateUserCmd.Flags().BoolVar(&createUserInputUserEnableNotifications, "user.enable_notifications", false, "Enables the receiving of notifications. The...")
 
+	CreateUserCmd.Flags().StringVar(&CreateUserInput.User.Etag, "user.etag", "", "A checksum of the user's state, changing every...")
+
 	CreateUserCmd.Flags().StringVar(&CreateUserFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
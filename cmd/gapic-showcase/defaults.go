@@ -19,12 +19,23 @@ import (
 	"os"
 )
 
+// emulatorHostEnv is the environment variable pointing clients at a showcase server, the way
+// the hosts of Cloud emulators are set, e.g. "localhost:7469".
+const emulatorHostEnv = "SHOWCASE_EMULATOR_HOST"
+
 func init() {
 	// Since the showcase server is locally ran, the default address needs to be set
-	// since the go gapic assumes port :443.
-	services := []string{"ECHO", "IDENTITY", "MESSAGING", "TESTING", "SEQUENCE"}
+	// since the go gapic assumes port :443. An emulator host, when set, is used instead.
+	address := "localhost:7469"
+	if host := os.Getenv(emulatorHostEnv); host != "" {
+		address = host
+	}
+	services := []string{
+		"BARRIER", "CLOCK", "COMPLIANCE", "DEBUG", "ECHO", "FAILOVER", "FIXTURES",
+		"IDENTITY", "MATRIX", "MESSAGING", "ROUTING", "SEQUENCE", "TESTING", "TRANSPORT",
+	}
 	envVars := map[string]string{
-		"ADDRESS":  "localhost:7469",
+		"ADDRESS":  address,
 		"INSECURE": "true",
 	}
 	for _, service := range services {
@@ -7,6 +7,8 @@ import (
 
 	anypb "google.golang.org/protobuf/types/known/anypb"
 
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+
 	"fmt"
 
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
@@ -34,11 +36,27 @@ var EchoInputResponseErrorDetails []string
 
 var EchoInputSeverity string
 
+var EchoInputFailAfterErrorDetails []string
+
+var EchoInputFailAfterResponsesErrorDetails []string
+
 func init() {
 	EchoServiceCmd.AddCommand(EchoCmd)
 
 	EchoInputResponseError.Error = new(statuspb.Status)
 
+	EchoInput.ResponseDelay = new(durationpb.Duration)
+
+	EchoInput.FailAfter = new(genprotopb.CollectFailure)
+
+	EchoInput.FailAfter.Error = new(statuspb.Status)
+
+	EchoInput.FailAfterResponses = new(genprotopb.StreamFailure)
+
+	EchoInput.FailAfterResponses.Error = new(statuspb.Status)
+
+	EchoInput.ReadDelay = new(durationpb.Duration)
+
 	EchoCmd.Flags().StringVar(&EchoInputResponseContent.Content, "response.content", "", "The content to be echoed by the server.")
 
 	EchoCmd.Flags().Int32Var(&EchoInputResponseError.Error.Code, "response.error.code", 0, "The status code, which should be an enum value of...")
@@ -49,6 +67,34 @@ func init() {
 
 	EchoCmd.Flags().StringVar(&EchoInputSeverity, "severity", "", "The severity to be echoed by the server.")
 
+	EchoCmd.Flags().StringVar(&EchoInput.ResponseTemplate, "response_template", "", "A template the server renders into the content of...")
+
+	EchoCmd.Flags().StringVar(&EchoInput.Label, "label", "", "A label to be echoed by the server. This field is...")
+
+	EchoCmd.Flags().Int64Var(&EchoInput.ResponseDelay.Seconds, "response_delay.seconds", 0, "Signed seconds of the span of time. Must be from...")
+
+	EchoCmd.Flags().Int32Var(&EchoInput.ResponseDelay.Nanos, "response_delay.nanos", 0, "Signed fractions of a second at nanosecond...")
+
+	EchoCmd.Flags().Int32Var(&EchoInput.FailAfter.RequestCount, "fail_after.request_count", 0, "The number of requests, including the first,...")
+
+	EchoCmd.Flags().Int32Var(&EchoInput.FailAfter.Error.Code, "fail_after.error.code", 0, "The status code, which should be an enum value of...")
+
+	EchoCmd.Flags().StringVar(&EchoInput.FailAfter.Error.Message, "fail_after.error.message", "", "A developer-facing error message, which should be...")
+
+	EchoCmd.Flags().StringArrayVar(&EchoInputFailAfterErrorDetails, "fail_after.error.details", []string{}, "A list of messages that carry the error details. ...")
+
+	EchoCmd.Flags().Int32Var(&EchoInput.FailAfterResponses.ResponseCount, "fail_after_responses.response_count", 0, "The number of responses the server sends before...")
+
+	EchoCmd.Flags().Int32Var(&EchoInput.FailAfterResponses.Error.Code, "fail_after_responses.error.code", 0, "The status code, which should be an enum value of...")
+
+	EchoCmd.Flags().StringVar(&EchoInput.FailAfterResponses.Error.Message, "fail_after_responses.error.message", "", "A developer-facing error message, which should be...")
+
+	EchoCmd.Flags().StringArrayVar(&EchoInputFailAfterResponsesErrorDetails, "fail_after_responses.error.details", []string{}, "A list of messages that carry the error details. ...")
+
+	EchoCmd.Flags().Int64Var(&EchoInput.ReadDelay.Seconds, "read_delay.seconds", 0, "Signed seconds of the span of time. Must be from...")
+
+	EchoCmd.Flags().Int32Var(&EchoInput.ReadDelay.Nanos, "read_delay.nanos", 0, "Signed fractions of a second at nanosecond...")
+
 	EchoCmd.Flags().StringVar(&EchoInputResponse, "response", "", "Choices: content, error")
 
 	EchoCmd.Flags().StringVar(&EchoFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
@@ -58,7 +104,7 @@ func init() {
 var EchoCmd = &cobra.Command{
 	Use:   "echo",
 	Short: "This method simply echoes the request. This...",
-	Long:  "This method simply echoes the request. This method showcases unary RPCs.",
+	Long:  "This method simply echoes the request. This method showcases unary RPCs.  Over gRPC, the `x-showcase-request-compression` response header names the ...",
 	PreRun: func(cmd *cobra.Command, args []string) {
 
 		if EchoFromFile == "" {
@@ -112,6 +158,28 @@ var EchoCmd = &cobra.Command{
 			EchoInputResponseError.Error.Details = append(EchoInputResponseError.Error.Details, &tmp)
 		}
 
+		// unmarshal JSON strings into slice of structs
+		for _, item := range EchoInputFailAfterErrorDetails {
+			tmp := anypb.Any{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			EchoInput.FailAfter.Error.Details = append(EchoInput.FailAfter.Error.Details, &tmp)
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range EchoInputFailAfterResponsesErrorDetails {
+			tmp := anypb.Any{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			EchoInput.FailAfterResponses.Error.Details = append(EchoInput.FailAfterResponses.Error.Details, &tmp)
+		}
+
 		if Verbose {
 			printVerboseInput("Echo", "Echo", &EchoInput)
 		}
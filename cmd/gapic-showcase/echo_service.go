@@ -11,7 +11,7 @@ import (
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
 
-	gapic "github.com/googleapis/gapic-showcase/client"
+	gapic "github.com/googleapis/gapic-showcase/client/apiv1beta1"
 )
 
 var EchoConfig *viper.Viper
@@ -22,9 +22,15 @@ var EchoSubCommands []string = []string{
 	"collect",
 	"chat",
 	"paged-expand",
-	"paged-expand-legacy",
+	"long-running-paged-expand",
+	"poll-long-running-paged-expand", "paged-expand-legacy",
 	"wait",
-	"poll-wait", "block",
+	"poll-wait", "nested-wait",
+	"poll-nested-wait", "block",
+	"echo-headers",
+	"probe-deadline",
+	"generate-payload",
+	"fan-out",
 }
 
 func init() {
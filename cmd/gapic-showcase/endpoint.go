@@ -18,13 +18,19 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net"
 	"net/http"
+	"net/http/pprof"
+	"os"
+	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/googleapis/gapic-showcase/server"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
@@ -40,7 +46,11 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	_ "google.golang.org/grpc/encoding/gzip"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/stats"
 )
 
 // RuntimeConfig has the run-time settings necessary to run the
@@ -51,6 +61,186 @@ type RuntimeConfig struct {
 	tlsCaCert    string
 	tlsCert      string
 	tlsKey       string
+
+	// verifyRoutingHeaders rejects gRPC calls whose x-goog-request-params header does
+	// not match the method's routing annotations.
+	verifyRoutingHeaders bool
+
+	// conformanceSummaryFile is where the conformance summary is exported as
+	// JSON after every session report. Nothing is exported if it is empty.
+	conformanceSummaryFile string
+
+	// callLogFile and callLogDir, when set, are where calls are exported as
+	// Cloud Logging entries: appended to a single file, or sharded by hour
+	// under a directory the way Cloud Logging exports to Cloud Storage.
+	callLogFile string
+	callLogDir  string
+
+	// failoverRole and failoverPeer pair this server with another one as
+	// either the "leader" or the "standby".
+	failoverRole string
+	failoverPeer string
+
+	// dnsPort, when set, is the UDP port a DNS server answering from the
+	// records in dnsRecordsFile listens on.
+	dnsPort        string
+	dnsRecordsFile string
+
+	// maxConcurrentStreams is the SETTINGS_MAX_CONCURRENT_STREAMS advertised
+	// to gRPC clients; 0 leaves the number of streams unlimited.
+	maxConcurrentStreams uint32
+
+	// maxConnectionsPerClient is how many gRPC connections each client may
+	// hold, clients being identified by their certificate under mutual TLS and
+	// by their host otherwise; 0 leaves the number of connections unlimited.
+	maxConnectionsPerClient int
+
+	// keepaliveMinTime and keepalivePermitWithoutStream are the keepalive
+	// enforcement policy: clients pinging more often, or pinging without any
+	// active stream when that is not permitted, are sent a GOAWAY with
+	// ENHANCE_YOUR_CALM and "too_many_pings", and disconnected.
+	keepaliveMinTime             time.Duration
+	keepalivePermitWithoutStream bool
+
+	// streamSeed, when not 0, makes the order in which blurb events are
+	// delivered across the streams watching a room or profile deterministic,
+	// derived from the seed.
+	streamSeed int64
+
+	// reflectionVersion is the gRPC reflection protocol served: "v1",
+	// "v1alpha", "all" for both, or "none" to not serve reflection.
+	reflectionVersion string
+
+	// services, when set, are the only services of the Showcase API served,
+	// by the names showcaseServices gives them.
+	services []string
+
+	// proxyBehaviors are the intermediary behaviors, such as adding forwarded
+	// headers or stripping trailers, the server mimics on every call.
+	proxyBehaviors []string
+
+	// binaryLogMethods are the gRPC methods whose calls are captured as
+	// binary log entries, retrievable with Transport.ListBinaryLogEntries and
+	// also appended to binaryLogFile when it is set.
+	binaryLogMethods []string
+	binaryLogFile    string
+
+	// tlsKeyLogFile, when set, is where the secrets of the server's TLS
+	// connections are appended, in the NSS key log format read by packet
+	// analyzers.
+	tlsKeyLogFile string
+
+	// maxProcs, when not 0, is the GOMAXPROCS the server runs with, and
+	// busyWork the CPU time spent on busy work before serving each call, so
+	// that the server behaves like one on a small, CPU-starved instance.
+	maxProcs int
+	busyWork time.Duration
+
+	// adminPort, when set, is the TCP port the net/http/pprof profiling and
+	// runtime trace endpoints, and the Prometheus metrics, are served on.
+	adminPort string
+
+	// restPathPrefix, when set, is the path the REST surface is served under,
+	// e.g. "/api" to serve "/api/v1beta1/...".
+	restPathPrefix string
+
+	// universeDomain, when set, is the universe domain the server simulates
+	// being in: it only accepts calls sent to endpoints in that domain with
+	// credentials for it.
+	universeDomain string
+
+	// corruptFraction, when not 0, is the fraction of serialized responses
+	// corrupted past parsing, each in one of corruptionModes, so that clients'
+	// handling of unparseable messages can be exercised.
+	corruptFraction float64
+	corruptionModes []string
+
+	// deadlineRace, when set, makes the server complete calls with a deadline
+	// deadlineRaceMargin before it, or after it if the margin is negative, so
+	// that clients' races between responses and deadline expiry reproduce.
+	deadlineRace       bool
+	deadlineRaceMargin time.Duration
+
+	// restSessions, when "issue" or "require", makes the REST surface issue
+	// clients session cookies bound to one of restSessionBackends simulated
+	// backends, refusing the calls without one if "require".
+	restSessions        string
+	restSessionBackends int
+
+	// signingKey is the shared key Transport.VerifySignature checks the
+	// HMAC signatures of calls with.
+	signingKey string
+
+	// mtlsDir, when set, is the directory of the certificates generated by
+	// `certs generate`, which the mutual TLS files not set default to.
+	mtlsDir string
+
+	// faultRate is the fraction of calls failed at random with faultCode, so
+	// that clients' retry policies can be tested statistically.
+	faultRate float64
+	faultCode string
+
+	// storage, when set, is the file the users, rooms and blurbs are persisted
+	// to, so that they survive restarts of the server.
+	storage string
+
+	// notServing are the services the standard gRPC health service reports as
+	// NOT_SERVING, until the ShowcaseAdmin service says otherwise.
+	notServing []string
+
+	// responseCacheTTL, when not 0, is how long the successful responses of
+	// idempotent methods are cached for, answering identical calls meanwhile.
+	responseCacheTTL time.Duration
+
+	// otlpEndpoint, when set, is the OpenTelemetry collector the spans of the
+	// calls served are sent to over OTLP/HTTP, e.g. "http://localhost:4318".
+	otlpEndpoint string
+
+	// loadShedLimit, when not 0, is how many calls the server serves at once,
+	// shedding the others with UNAVAILABLE and asking them to retry after
+	// loadShedPushback.
+	loadShedLimit    int
+	loadShedPushback time.Duration
+
+	// prioritySlots, when not 0, is how many calls the server serves at once,
+	// queuing the others and admitting them by the priority they send.
+	prioritySlots int
+
+	// logFormat is the format of the server's logs, "text" or "json".
+	logFormat string
+
+	// forceGzip makes the server compress every response with gzip.
+	forceGzip bool
+
+	// lintRequests makes the server report the problems it finds in the gRPC
+	// messages it receives, such as unknown fields, in trailers.
+	lintRequests bool
+
+	// maxRequestBytes is the size of the largest request the server accepts,
+	// or 0 for the gRPC default and no limit on REST bodies.
+	maxRequestBytes int
+
+	// maxRequestBytesHTML makes the server reject REST bodies larger than
+	// maxRequestBytes with an HTML page, as front ends do, rather than JSON.
+	maxRequestBytesHTML bool
+
+	// retryAfter is the delay the REST responses with 429 or 503 ask clients
+	// to retry after in a Retry-After header, unless 0.
+	retryAfter time.Duration
+
+	// retryAfterFormat is the form of the Retry-After header, "seconds" or
+	// "http-date".
+	retryAfterFormat string
+
+	// responseHooksFile, when set, is the JSON file listing the response hooks
+	// scripting the answers to unary gRPC calls.
+	responseHooksFile string
+
+	// brownout, when set, is the curve the fraction of calls failed with
+	// faultCode follows over time instead of faultRate, repeated if
+	// brownoutRepeat is true.
+	brownout       string
+	brownoutRepeat bool
 }
 
 // Endpoint defines common operations for any of the various types of
@@ -84,6 +274,17 @@ func CreateAllEndpoints(config RuntimeConfig) Endpoint {
 	}
 	stdLog.Printf("Showcase listening on port: %s", config.port)
 
+	if config.maxProcs > 0 {
+		runtime.GOMAXPROCS(config.maxProcs)
+		stdLog.Printf("Showcase running with GOMAXPROCS=%d", config.maxProcs)
+	}
+	return newEndpoints(lis, config, createBackends(config))
+}
+
+// newEndpoints returns an Endpoint serving gRPC and HTTP/REST connections
+// accepted by lis, and the other endpoints enabled in config, with backend.
+func newEndpoints(lis net.Listener, config RuntimeConfig, backend *services.Backend) Endpoint {
+	lis = backend.PacketRecorder.Listener(lis)
 	m := cmux.New(lis)
 	httpListener := m.Match(cmux.HTTP1())
 	// cmux.Any() is needed below to get mTLS to work for
@@ -91,10 +292,16 @@ func CreateAllEndpoints(config RuntimeConfig) Endpoint {
 	// https://github.com/open-telemetry/opentelemetry-collector/issues/2732
 	grpcListener := m.Match(cmux.Any())
 
-	backend := createBackends()
 	gRPCServer := newEndpointGRPC(grpcListener, config, backend)
-	restServer := newEndpointREST(httpListener, backend)
-	cmuxServer := newEndpointMux(m, gRPCServer, restServer)
+	restServer := newEndpointREST(httpListener, config, backend)
+	endpoints := []Endpoint{gRPCServer, restServer}
+	if config.dnsPort != "" {
+		endpoints = append(endpoints, newEndpointDNS(config))
+	}
+	if config.adminPort != "" {
+		endpoints = append(endpoints, newEndpointAdmin(config, backend))
+	}
+	cmuxServer := newEndpointMux(m, endpoints...)
 	return cmuxServer
 }
 
@@ -190,39 +397,347 @@ type endpointGRPC struct {
 }
 
 // createBackends creates services used by both the gRPC and REST servers.
-func createBackends() *services.Backend {
+func createBackends(config RuntimeConfig) *services.Backend {
 	logger := &loggerObserver{}
 	observerRegistry := server.ShowcaseObserverRegistry()
 	observerRegistry.RegisterUnaryObserver(logger)
 	observerRegistry.RegisterStreamRequestObserver(logger)
 	observerRegistry.RegisterStreamResponseObserver(logger)
+	if sinks := callLogSinks(config); len(sinks) > 0 {
+		exporter := server.NewCallLogExporter(errLog, sinks...)
+		observerRegistry.RegisterUnaryObserver(exporter)
+		observerRegistry.RegisterStreamRequestObserver(exporter)
+		observerRegistry.RegisterStreamResponseObserver(exporter)
+	}
+
+	role, ok := pb.FailoverState_Role_value[strings.ToUpper(config.failoverRole)]
+	if config.failoverRole != "" && !ok {
+		log.Fatalf("Unknown failover role %q: must be \"leader\" or \"standby\"", config.failoverRole)
+	}
+	failoverCoordinator := server.NewFailoverCoordinator(pb.FailoverState_Role(role), config.failoverPeer)
+	transportMonitor := server.NewTransportMonitor(config.maxConcurrentStreams)
+	connectionManager := server.NewConnectionManager(config.maxConnectionsPerClient)
+	packetRecorder := server.NewPacketRecorder()
+	barrierManager := server.NewBarrierManager()
+	authorityRecorder := server.NewAuthorityRecorder()
+	schemaRollout := server.NewSchemaRollout()
+	serverEvents := server.NewServerEvents()
+	auditLog := server.NewAuditLog()
+	auditLog.PublishTo(serverEvents)
+	var universeDomain *server.UniverseDomain
+	if config.universeDomain != "" {
+		universeDomain = server.NewUniverseDomain(config.universeDomain)
+	}
+	if config.busyWork < 0 {
+		log.Fatalf("Invalid busy work %v: must not be negative", config.busyWork)
+	}
+	var busyWork *server.BusyWork
+	if config.busyWork > 0 {
+		busyWork = server.NewBusyWork(config.busyWork)
+	}
+	var binaryLogger *server.BinaryLogger
+	if len(config.binaryLogMethods) > 0 {
+		var out io.Writer
+		if config.binaryLogFile != "" {
+			file, err := os.OpenFile(config.binaryLogFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+			if err != nil {
+				log.Fatalf("Failed to open the binary log file: %v", err)
+			}
+			out = file
+		}
+		var err error
+		if binaryLogger, err = server.NewBinaryLogger(config.binaryLogMethods, out); err != nil {
+			log.Fatalf("Invalid binary log methods: %v", err)
+		}
+	}
+	var payloadCorruptor *server.PayloadCorruptor
+	if config.corruptFraction != 0 {
+		var err error
+		if payloadCorruptor, err = server.NewPayloadCorruptor(config.corruptFraction, config.corruptionModes); err != nil {
+			log.Fatalf("Invalid response corruption: %v", err)
+		}
+	}
+	faultInjector, err := server.NewFaultInjector(config.faultRate, config.faultCode)
+	if err != nil {
+		log.Fatalf("Invalid fault injection: %v", err)
+	}
+	if config.brownout != "" {
+		brownout, err := server.ParseBrownout(config.brownout, config.brownoutRepeat)
+		if err != nil {
+			log.Fatalf("Invalid brownout: %v", err)
+		}
+		if err := faultInjector.ConfigureBrownout(brownout); err != nil {
+			log.Fatalf("Invalid brownout: %v", err)
+		}
+	}
+	var responseHooks *server.ResponseHooks
+	if config.responseHooksFile != "" {
+		hooks, err := server.LoadResponseHooks(config.responseHooksFile)
+		if err != nil {
+			log.Fatalf("Failed to load response hooks: %v", err)
+		}
+		if responseHooks, err = server.NewResponseHooks(hooks); err != nil {
+			log.Fatalf("Invalid response hooks: %v", err)
+		}
+	}
+	var deadlineRace *server.DeadlineRace
+	if config.deadlineRace {
+		deadlineRace = server.NewDeadlineRace(config.deadlineRaceMargin)
+	}
+	var stickySessions *server.StickySessions
+	switch config.restSessions {
+	case "":
+	case "issue", "require":
+		var err error
+		if stickySessions, err = server.NewStickySessions(config.restSessionBackends, config.restSessions == "require"); err != nil {
+			log.Fatalf("Invalid REST sessions: %v", err)
+		}
+	default:
+		log.Fatalf("Unknown REST sessions mode %q: must be \"issue\" or \"require\"", config.restSessions)
+	}
+	var proxyMimic *server.ProxyMimic
+	if len(config.proxyBehaviors) > 0 {
+		var err error
+		if proxyMimic, err = server.NewProxyMimic(config.proxyBehaviors); err != nil {
+			log.Fatalf("Invalid proxy behaviors: %v", err)
+		}
+	}
 
 	identityServer := services.NewIdentityServer()
-	messagingServer := services.NewMessagingServer(identityServer)
-	return &services.Backend{
-		EchoServer:            services.NewEchoServer(),
-		SequenceServiceServer: services.NewSequenceServer(),
-		IdentityServer:        identityServer,
-		MessagingServer:       messagingServer,
+	var scheduler *server.StreamScheduler
+	if config.streamSeed != 0 {
+		scheduler = server.NewStreamScheduler(config.streamSeed)
+	}
+	messagingServer := services.NewScheduledMessagingServer(identityServer, scheduler)
+	if config.storage != "" {
+		store, err := server.OpenStore(config.storage)
+		if err != nil {
+			log.Fatalf("Invalid storage: %v", err)
+		}
+		for _, persister := range []services.Persister{identityServer.(services.Persister), messagingServer.(services.Persister)} {
+			if err := persister.Persist(store); err != nil {
+				log.Fatalf("Could not restore the state persisted to %s: %v", config.storage, err)
+			}
+		}
+	}
+	sequenceServer := services.NewSequenceServer()
+	operationsServer := services.NewOperationsServer(messagingServer)
+	testingServer := services.NewTestingServer(observerRegistry, server.NewLeaderboard(config.conformanceSummaryFile))
+	serverControls := server.NewServerControls()
+	healthStatus := server.NewHealthStatus(config.notServing)
+	var spanExporter server.SpanExporter
+	if config.otlpEndpoint != "" {
+		spanExporter = server.NewOTLPExporter(config.otlpEndpoint, errLog)
+	}
+	var loadShedder *server.LoadShedder
+	if config.loadShedLimit > 0 {
+		loadShedder = server.NewLoadShedder(config.loadShedLimit, config.loadShedPushback)
+	}
+	var priorityScheduler *server.PriorityScheduler
+	if config.prioritySlots > 0 {
+		priorityScheduler = server.NewPriorityScheduler(config.prioritySlots)
+	}
+	var responseCache *server.ResponseCache
+	if config.responseCacheTTL > 0 {
+		responseCache = server.NewResponseCache(config.responseCacheTTL, server.ShowcasePackage)
+	}
+	resetters := map[pb.ResetStateRequest_Scope]services.Resetter{
+		pb.ResetStateRequest_IDENTITY:   identityServer.(services.Resetter),
+		pb.ResetStateRequest_MESSAGING:  messagingServer.(services.Resetter),
+		pb.ResetStateRequest_SEQUENCES:  sequenceServer.(services.Resetter),
+		pb.ResetStateRequest_OPERATIONS: operationsServer.(services.Resetter),
+		pb.ResetStateRequest_CALLS:      testingServer.(services.Resetter),
+	}
+	fixturesServer := services.NewFixturesServer(identityServer, messagingServer, resetters)
+	requestSigner := server.NewRequestSigner(config.signingKey)
+	callCapture := server.NewCallCapture()
+	metrics := server.NewMetrics()
+	backend := &services.Backend{
+		AuditLogServer:        services.NewAuditLogServer(auditLog),
+		BarrierServer:         services.NewBarrierServer(barrierManager),
+		ClockServer:           services.NewClockServer(server.GetClockInstance()),
+		CryptoServer:          services.NewCryptoServer(),
+		EchoServer:            services.NewRolloutEchoServer(schemaRollout),
+		FailoverServer:        services.NewFailoverServer(failoverCoordinator),
+		FixturesServer:        fixturesServer,
+		SequenceServiceServer: services.NewAuditedSequenceServer(sequenceServer, auditLog),
+		ShowcaseAdminServer:   services.NewShowcaseAdminServer(serverControls, faultInjector, healthStatus, fixturesServer),
+		IdentityServer:        services.NewAuditedIdentityServer(identityServer, auditLog),
+		MatrixServer:          services.NewMatrixServer(),
+		MessagingServer:       services.NewAuditedMessagingServer(messagingServer, auditLog),
+		RolloutServer:         services.NewRolloutServer(schemaRollout),
+		RoutingServer:         services.NewRoutingServer(),
 		ComplianceServer:      services.NewComplianceServer(),
-		TestingServer:         services.NewTestingServer(observerRegistry),
-		OperationsServer:      services.NewOperationsServer(messagingServer),
+		DebugServer:           services.NewDebugServer(transportMonitor, serverEvents, metrics),
+		TestingServer:         services.NewAuditedTestingServer(testingServer, auditLog),
+		TransportServer:       services.NewTransportServer(transportMonitor, connectionManager, binaryLogger, packetRecorder, authorityRecorder, requestSigner, callCapture),
+		WebhookServiceServer:  services.NewWebhookServer(requestSigner),
+		OperationsServer:      operationsServer,
 		LocationsServer:       services.NewLocationsServer(),
 		IAMPolicyServer:       services.NewIAMPolicyServer(),
 		StdLog:                stdLog,
 		ErrLog:                errLog,
 		ObserverRegistry:      observerRegistry,
+		FailoverCoordinator:   failoverCoordinator,
+		TransportMonitor:      transportMonitor,
+		ConnectionManager:     connectionManager,
+		ProxyMimic:            proxyMimic,
+		BarrierManager:        barrierManager,
+		BusyWork:              busyWork,
+		BinaryLogger:          binaryLogger,
+		PacketRecorder:        packetRecorder,
+		AuthorityRecorder:     authorityRecorder,
+		UniverseDomain:        universeDomain,
+		SchemaRollout:         schemaRollout,
+		PayloadCorruptor:      payloadCorruptor,
+		FaultInjector:         faultInjector,
+		ServerControls:        serverControls,
+		HealthStatus:          healthStatus,
+		Metrics:               metrics,
+		Tracer:                server.NewTracer(spanExporter),
+		ResponseCache:         responseCache,
+		LoadShedder:           loadShedder,
+		PriorityScheduler:     priorityScheduler,
+		CallCapture:           callCapture,
+		DeadlineRace:          deadlineRace,
+		StickySessions:        stickySessions,
+		IdempotencyKeys:       server.NewIdempotencyKeys(server.DefaultIdempotencyKeyTTL),
+		AuditLog:              auditLog,
+		ServerEvents:          serverEvents,
+		ResponseHooks:         responseHooks,
+	}
+	if err := restrictServices(backend, config.services); err != nil {
+		log.Fatalf("Invalid services: %v", err)
+	}
+	return backend
+}
+
+// callLogSinks returns the sinks calls should be exported to, according to config.
+func callLogSinks(config RuntimeConfig) []server.CallLogSink {
+	sinks := []server.CallLogSink{}
+	if config.callLogFile != "" {
+		sink, err := server.NewFileCallLogSink(config.callLogFile)
+		if err != nil {
+			log.Fatalf("Failed to open call log file: %v", err)
+		}
+		sinks = append(sinks, sink)
+	}
+	if config.callLogDir != "" {
+		sinks = append(sinks, server.NewDirectoryCallLogSink(config.callLogDir))
 	}
+	return sinks
 }
 
 func newEndpointGRPC(lis net.Listener, config RuntimeConfig, backend *services.Backend) Endpoint {
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		backend.CallCapture.StreamInterceptor,
+		backend.Metrics.StreamInterceptor,
+		backend.ServerEvents.StreamInterceptor,
+		backend.Tracer.StreamInterceptor,
+		backend.ObserverRegistry.StreamInterceptor,
+		backend.AuthorityRecorder.StreamInterceptor,
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		backend.CallCapture.UnaryInterceptor,
+		backend.Metrics.UnaryInterceptor,
+		backend.ServerEvents.UnaryInterceptor,
+		backend.Tracer.UnaryInterceptor,
+		backend.ObserverRegistry.UnaryInterceptor,
+		backend.AuthorityRecorder.UnaryInterceptor,
+	}
+	if backend.UniverseDomain != nil {
+		streamInterceptors = append(streamInterceptors, backend.UniverseDomain.StreamInterceptor)
+		unaryInterceptors = append(unaryInterceptors, backend.UniverseDomain.UnaryInterceptor)
+	}
+	if backend.LoadShedder != nil {
+		streamInterceptors = append(streamInterceptors, backend.LoadShedder.StreamInterceptor)
+		unaryInterceptors = append(unaryInterceptors, backend.LoadShedder.UnaryInterceptor)
+	}
+	if backend.PriorityScheduler != nil {
+		streamInterceptors = append(streamInterceptors, backend.PriorityScheduler.StreamInterceptor)
+		unaryInterceptors = append(unaryInterceptors, backend.PriorityScheduler.UnaryInterceptor)
+	}
+	if backend.ResponseCache != nil {
+		unaryInterceptors = append(unaryInterceptors, backend.ResponseCache.UnaryInterceptor)
+	}
+	streamInterceptors = append(streamInterceptors,
+		backend.FailoverCoordinator.StreamInterceptor,
+		backend.FaultInjector.StreamInterceptor,
+		backend.ServerControls.StreamInterceptor,
+		backend.BarrierManager.StreamInterceptor)
+	unaryInterceptors = append(unaryInterceptors,
+		backend.FailoverCoordinator.UnaryInterceptor,
+		backend.FaultInjector.UnaryInterceptor,
+		backend.ServerControls.UnaryInterceptor,
+		backend.BarrierManager.UnaryInterceptor)
+	// Streams are counted as the server receives and sends them, whatever the other
+	// interceptors do.
+	streamInterceptors = append([]grpc.StreamServerInterceptor{server.StreamCountsInterceptor}, streamInterceptors...)
+	if backend.ProxyMimic != nil {
+		// The proxy sees calls before anything else in the server does.
+		streamInterceptors = append([]grpc.StreamServerInterceptor{backend.ProxyMimic.StreamInterceptor}, streamInterceptors...)
+		unaryInterceptors = append([]grpc.UnaryServerInterceptor{backend.ProxyMimic.UnaryInterceptor}, unaryInterceptors...)
+	}
+	// Recorded ahead of the interceptors wrapping the transport stream, which hide its encoding.
+	streamInterceptors = append([]grpc.StreamServerInterceptor{server.CompressionStreamInterceptor}, streamInterceptors...)
+	unaryInterceptors = append([]grpc.UnaryServerInterceptor{server.CompressionUnaryInterceptor}, unaryInterceptors...)
+	if backend.BusyWork != nil {
+		streamInterceptors = append(streamInterceptors, backend.BusyWork.StreamInterceptor)
+		unaryInterceptors = append(unaryInterceptors, backend.BusyWork.UnaryInterceptor)
+	}
+	if mtlsEnabled(config) {
+		echo := server.NewPeerIdentityEcho()
+		streamInterceptors = append(streamInterceptors, echo.StreamInterceptor)
+		unaryInterceptors = append(unaryInterceptors, echo.UnaryInterceptor)
+	}
+	if backend.DeadlineRace != nil {
+		streamInterceptors = append(streamInterceptors, backend.DeadlineRace.StreamInterceptor)
+		unaryInterceptors = append(unaryInterceptors, backend.DeadlineRace.UnaryInterceptor)
+	}
+	if config.verifyRoutingHeaders {
+		verifier, err := server.NewRoutingHeaderVerifier(server.ShowcasePackage)
+		if err != nil {
+			log.Fatalf("Failed to load routing header rules: %v", err)
+		}
+		streamInterceptors = append(streamInterceptors, verifier.StreamInterceptor)
+		unaryInterceptors = append(unaryInterceptors, verifier.UnaryInterceptor)
+	}
+	if config.lintRequests {
+		streamInterceptors = append(streamInterceptors, server.LintStreamInterceptor)
+		unaryInterceptors = append(unaryInterceptors, server.LintUnaryInterceptor)
+	}
+	streamInterceptors = append(streamInterceptors, server.ConstraintStreamInterceptor)
+	unaryInterceptors = append(unaryInterceptors, server.ConstraintUnaryInterceptor)
+	if backend.ResponseHooks != nil {
+		unaryInterceptors = append(unaryInterceptors, backend.ResponseHooks.UnaryInterceptor)
+	}
+	var statsHandler stats.Handler = backend.TransportMonitor
+	if backend.BinaryLogger != nil {
+		statsHandler = server.MultiStatsHandler(backend.TransportMonitor, backend.BinaryLogger)
+	}
 	opts := []grpc.ServerOption{
-		grpc.StreamInterceptor(backend.ObserverRegistry.StreamInterceptor),
-		grpc.UnaryInterceptor(backend.ObserverRegistry.UnaryInterceptor),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.StatsHandler(statsHandler),
+		keepaliveEnforcement(config),
+	}
+	if config.maxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(config.maxConcurrentStreams))
+	}
+	if config.maxRequestBytes > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(config.maxRequestBytes))
+	}
+	if backend.PayloadCorruptor != nil {
+		opts = append(opts, grpc.ForceServerCodec(backend.PayloadCorruptor.Codec()))
+	}
+	if config.forceGzip {
+		// Without it, responses are compressed like the requests of the call.
+		opts = append(opts, grpc.RPCCompressor(grpc.NewGZIPCompressor()))
 	}
 
 	// load mutual TLS cert/key and root CA cert
-	if config.tlsCaCert != "" && config.tlsCert != "" && config.tlsKey != "" {
+	var creds credentials.TransportCredentials
+	if mtlsEnabled(config) {
 		keyPair, err := tls.LoadX509KeyPair(config.tlsCert, config.tlsKey)
 		if err != nil {
 			log.Fatalf("Failed to load server TLS cert/key with error:%v", err)
@@ -236,31 +751,48 @@ func newEndpointGRPC(lis net.Listener, config RuntimeConfig, backend *services.B
 		pool := x509.NewCertPool()
 		pool.AppendCertsFromPEM(cert)
 
-		ta := credentials.NewTLS(&tls.Config{
+		tlsConfig := &tls.Config{
 			Certificates: []tls.Certificate{keyPair},
 			ClientCAs:    pool,
 			ClientAuth:   tls.RequireAndVerifyClientCert,
-		})
-
-		opts = append(opts, grpc.Creds(ta))
+		}
+		if config.tlsKeyLogFile != "" {
+			keyLog, err := os.OpenFile(config.tlsKeyLogFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+			if err != nil {
+				log.Fatalf("Failed to open the TLS key log file: %v", err)
+			}
+			tlsConfig.KeyLogWriter = keyLog
+		}
+		creds = credentials.NewTLS(tlsConfig)
 	}
+	// The connections are managed above TLS, where their HTTP/2 frames are in the clear.
+	opts = append(opts, grpc.Creds(backend.ConnectionManager.Credentials(creds)))
 	s := grpc.NewServer(opts...)
 
 	// Register Services to the server.
-	pb.RegisterEchoServer(s, backend.EchoServer)
-	pb.RegisterSequenceServiceServer(s, backend.SequenceServiceServer)
-	pb.RegisterIdentityServer(s, backend.IdentityServer)
-	pb.RegisterMessagingServer(s, backend.MessagingServer)
-	pb.RegisterComplianceServer(s, backend.ComplianceServer)
-	pb.RegisterTestingServer(s, backend.TestingServer)
+	selected, err := selectServices(config.services)
+	if err != nil {
+		log.Fatalf("Invalid services: %v", err)
+	}
+	for _, service := range selected {
+		service.register(s, backend)
+	}
 	lropb.RegisterOperationsServer(s, backend.OperationsServer)
 	locpb.RegisterLocationsServer(s, backend.LocationsServer)
 	iampb.RegisterIAMPolicyServer(s, backend.IAMPolicyServer)
+	healthpb.RegisterHealthServer(s, backend.HealthStatus.Server())
+	registered := []string{}
+	for name := range s.GetServiceInfo() {
+		registered = append(registered, name)
+	}
+	backend.HealthStatus.Register(registered)
 
 	fb := fallback.NewServer(config.fallbackPort, "localhost"+config.port)
 
 	// Register reflection service on gRPC server.
-	reflection.Register(s)
+	if err := registerReflection(s, config.reflectionVersion); err != nil {
+		log.Fatalf("Showcase failed to register reflection: %v", err)
+	}
 
 	return &endpointGRPC{
 		server:         s,
@@ -269,6 +801,55 @@ func newEndpointGRPC(lis net.Listener, config RuntimeConfig, backend *services.B
 	}
 }
 
+// keepaliveEnforcement returns the server option enforcing the keepalive policy in config.
+func keepaliveEnforcement(config RuntimeConfig) grpc.ServerOption {
+	return grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime:             config.keepaliveMinTime,
+		PermitWithoutStream: config.keepalivePermitWithoutStream,
+	})
+}
+
+// mtlsEnabled returns whether config has what gRPC calls need to be served over mutual TLS.
+func mtlsEnabled(config RuntimeConfig) bool {
+	return config.tlsCaCert != "" && config.tlsCert != "" && config.tlsKey != ""
+}
+
+// reflectionVersions are the versions of the gRPC reflection protocol served for each value of
+// the --reflection flag.
+var reflectionVersions = map[string][]string{
+	"all":     {"v1", "v1alpha"},
+	"none":    {},
+	"v1":      {"v1"},
+	"v1alpha": {"v1alpha"},
+}
+
+// registerReflection registers the reflection service on s for the protocol versions selected by
+// version.
+func registerReflection(s *grpc.Server, version string) error {
+	versions, ok := reflectionVersions[version]
+	if !ok {
+		return fmt.Errorf("unknown reflection version %q: must be \"all\", \"v1\", \"v1alpha\" or \"none\"", version)
+	}
+	reflection.Register(&reflectionRegistrar{Server: s, versions: versions})
+	return nil
+}
+
+// reflectionRegistrar registers the v1alpha reflection service under the names of the selected
+// protocol versions. The messages of both versions are the same on the wire; only the service
+// name differs.
+type reflectionRegistrar struct {
+	*grpc.Server
+	versions []string
+}
+
+func (r *reflectionRegistrar) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	for _, version := range r.versions {
+		versioned := *desc
+		versioned.ServiceName = fmt.Sprintf("grpc.reflection.%s.ServerReflection", version)
+		r.Server.RegisterService(&versioned, impl)
+	}
+}
+
 func (eg *endpointGRPC) String() string {
 	return "gRPC endpoint"
 }
@@ -313,14 +894,63 @@ type endpointREST struct {
 	mux      sync.Mutex
 }
 
-func newEndpointREST(lis net.Listener, backend *services.Backend) *endpointREST {
+func newEndpointREST(lis net.Listener, config RuntimeConfig, backend *services.Backend) *endpointREST {
 	router := gmux.NewRouter()
+	// Resource names, such as those of operations encoding their request in base64, may hold
+	// "//", which the router would otherwise redirect away from.
+	router.SkipClean(true)
 	router.HandleFunc("/hello", func(w http.ResponseWriter, _ *http.Request) {
 		w.Write([]byte("GAPIC Showcase: HTTP/REST endpoint using gorilla/mux\n"))
 	})
+	// Registered first, so that the generated handlers do not report them unrecognized.
+	registerOperationHandlers(router, backend)
+	registerPollHandlers(router, backend)
+	// The generated routes of the sessions, registered ahead of them, would match the paths of
+	// the scratchpad entries too.
+	rest := (*genrest.RESTBackend)(backend)
+	router.HandleFunc("/v1beta1/{name:sessions/.+/scratchpad/.+}", rest.HandleGetScratchpadEntry).Methods("GET")
+	router.HandleFunc("/v1beta1/{name:sessions/.+/scratchpad/.+}", rest.HandleDeleteScratchpadEntry).Methods("DELETE")
 	genrest.RegisterHandlers(router, backend)
+	router.Use(proxyMimicMiddleware(backend))
+	router.Use(timeoutMiddleware(backend))
+	router.Use(responseFormatMiddleware(backend))
+	router.Use(bodyLimitMiddleware(backend, config.maxRequestBytes, config.maxRequestBytesHTML))
+	router.Use(retryAfterMiddleware(config.retryAfter, config.retryAfterFormat == retryAfterHTTPDate))
+	router.Use(metricsMiddleware(backend))
+	router.Use(serverEventsMiddleware(backend))
+	router.Use(tracingMiddleware(backend))
+	router.Use(authorityMiddleware(backend))
+	router.Use(universeDomainMiddleware(backend))
+	router.Use(loadShedMiddleware(backend))
+	router.Use(priorityMiddleware(backend))
+	router.Use(stickySessionMiddleware(backend))
+	router.Use(signatureMiddleware(backend))
+	router.Use(metadataMiddleware(backend))
+	router.Use(dripMiddleware(backend))
+	router.Use(framingMiddleware(backend))
+	router.Use(compressionMiddleware(backend, config.forceGzip, config.maxRequestBytes, config.maxRequestBytesHTML))
+	router.Use(corruptionMiddleware(backend))
+	router.Use(responseCacheMiddleware(backend))
+	router.Use(idempotencyKeyMiddleware(backend))
+	router.Use(failoverMiddleware(backend))
+	router.Use(faultMiddleware(backend))
+	router.Use(latencyMiddleware(backend))
+	router.Use(barrierMiddleware(backend))
+	router.Use(busyWorkMiddleware(backend))
+	router.Use(redirectMiddleware(backend))
+
+	var handler http.Handler = router
+	if prefix := strings.TrimSuffix(config.restPathPrefix, "/"); prefix != "" {
+		if !strings.HasPrefix(prefix, "/") {
+			prefix = "/" + prefix
+		}
+		// The prefix is stripped before routing, so that handlers and middlewares see
+		// the paths of the REST surface as if it were served at the root.
+		handler = http.StripPrefix(prefix, router)
+		stdLog.Printf("Serving REST under path prefix: %s", prefix)
+	}
 	return &endpointREST{
-		server:   &http.Server{Handler: router},
+		server:   &http.Server{Handler: handler},
 		listener: lis,
 	}
 }
@@ -350,3 +980,107 @@ func (er *endpointREST) Shutdown() error {
 	stdLog.Printf("Stopped REST")
 	return err
 }
+
+// endpointDNS is an Endpoint for the DNS server fronting Showcase in
+// name-resolution tests.
+type endpointDNS struct {
+	server *server.DNSServer
+	conn   net.PacketConn
+}
+
+func newEndpointDNS(config RuntimeConfig) *endpointDNS {
+	records := []server.DNSRecord{}
+	if config.dnsRecordsFile != "" {
+		var err error
+		records, err = server.LoadDNSRecords(config.dnsRecordsFile)
+		if err != nil {
+			log.Fatalf("Failed to load DNS records: %v", err)
+		}
+	}
+	dnsServer, err := server.NewDNSServer(records)
+	if err != nil {
+		log.Fatalf("Failed to load DNS records: %v", err)
+	}
+
+	port := config.dnsPort
+	if !strings.HasPrefix(port, ":") {
+		port = ":" + port
+	}
+	conn, err := net.ListenPacket("udp", port)
+	if err != nil {
+		log.Fatalf("Showcase failed to listen for DNS on port '%s': %v", port, err)
+	}
+	stdLog.Printf("Showcase serving DNS on UDP port: %s", port)
+	return &endpointDNS{server: dnsServer, conn: conn}
+}
+
+func (ed *endpointDNS) String() string {
+	return "DNS endpoint"
+}
+
+func (ed *endpointDNS) Serve() error {
+	stdLog.Printf("Listening for DNS queries")
+	err := ed.server.Serve(ed.conn)
+	if errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+func (ed *endpointDNS) Shutdown() error {
+	stdLog.Printf("Stopping DNS")
+	return ed.server.Shutdown()
+}
+
+// endpointAdmin is an Endpoint for the net/http/pprof profiling and runtime
+// trace endpoints and the Prometheus metrics, served on a port of their own so that they are never
+// exposed to the clients under test.
+type endpointAdmin struct {
+	server   *http.Server
+	listener net.Listener
+}
+
+func newEndpointAdmin(config RuntimeConfig, backend *services.Backend) *endpointAdmin {
+	port := config.adminPort
+	if !strings.HasPrefix(port, ":") {
+		port = ":" + port
+	}
+	lis, err := net.Listen("tcp", port)
+	if err != nil {
+		log.Fatalf("Showcase failed to listen for admin connections on port '%s': %v", port, err)
+	}
+	stdLog.Printf("Showcase serving admin endpoints on port: %s", port)
+	return &endpointAdmin{server: &http.Server{Handler: newAdminHandler(backend.Metrics)}, listener: lis}
+}
+
+// newAdminHandler serves the net/http/pprof endpoints under /debug/pprof/, including
+// /debug/pprof/trace, which captures a runtime trace for the given number of seconds, and
+// metrics in the Prometheus text format under /metrics.
+func newAdminHandler(metrics *server.Metrics) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics)
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return mux
+}
+
+func (ea *endpointAdmin) String() string {
+	return "admin endpoint"
+}
+
+func (ea *endpointAdmin) Serve() error {
+	stdLog.Printf("Listening for admin connections")
+	err := ea.server.Serve(ea.listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+func (ea *endpointAdmin) Shutdown() error {
+	stdLog.Printf("Stopping admin endpoint")
+	return ea.server.Shutdown(context.Background())
+}
@@ -15,14 +15,31 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
 	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -30,8 +47,8 @@ import (
 // correctly.
 func TestRESTCalls(t *testing.T) {
 	server := httptest.NewUnstartedServer(nil)
-	backend := createBackends()
-	restServer := newEndpointREST(nil, backend)
+	backend := createBackends(RuntimeConfig{})
+	restServer := newEndpointREST(nil, RuntimeConfig{}, backend)
 
 	server.Config = restServer.server
 	server.Start()
@@ -89,6 +106,12 @@ func TestRESTCalls(t *testing.T) {
 			path:       "/v1beta1/repeat:query?info.PKingdom=ANIMALIA",
 			statusCode: 400, // non-lower-camel-cased field name
 		},
+		{
+			verb:       "POST",
+			path:       "/v1beta1/users",
+			body:       `{"user":{"displayName":"mila"}}`,
+			statusCode: 400, // missing required field user.email
+		},
 
 		{
 			// Test responses:
@@ -182,6 +205,72 @@ func TestRESTCalls(t *testing.T) {
 
 }
 
+func TestRESTTranscodingHeader(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	request, err := http.NewRequest("GET", server.URL+"/v1beta1/users/1?name.x=y", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resttools.PopulateRequestHeaders(request)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	trace := &resttools.TranscodingTrace{}
+	if err := json.Unmarshal([]byte(response.Header.Get(resttools.TranscodingHeader)), trace); err != nil {
+		t.Fatalf("%s header: %s", resttools.TranscodingHeader, err)
+	}
+	want := &resttools.TranscodingTrace{
+		Template:              "/v1beta1/{name=users/*}",
+		PathBindings:          map[string]string{"name": "users/1"},
+		UnconsumedQueryParams: []string{"name.x"},
+	}
+	if !reflect.DeepEqual(trace, want) {
+		t.Errorf("%s header: got %+v, want %+v", resttools.TranscodingHeader, trace, want)
+	}
+}
+
+func TestRESTPathPrefix(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{restPathPrefix: "api/"})
+	defer server.Close()
+
+	for _, testCase := range []struct {
+		path     string
+		redirect string
+		want     int
+	}{
+		{path: "/api/hello", want: http.StatusOK},
+		{path: "/api/v1beta1/users", want: http.StatusOK},
+		{path: "/api/v1beta1/users", redirect: "307", want: http.StatusOK},
+		{path: "/hello", want: http.StatusNotFound},
+		{path: "/v1beta1/users", want: http.StatusNotFound},
+	} {
+		request, err := http.NewRequest("GET", server.URL+testCase.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		if testCase.redirect != "" {
+			request.Header.Set(redirectHeader, testCase.redirect)
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		if response.StatusCode != testCase.want {
+			t.Errorf("GET %s: want status %d, got %d", testCase.path, testCase.want, response.StatusCode)
+		}
+		if testCase.redirect != "" && response.Request.URL.Path != testCase.path {
+			t.Errorf("GET %s: redirected to %s, want the same path", testCase.path, response.Request.URL.Path)
+		}
+	}
+}
+
 // allowCompactJSON ensures that resttools JSONMarshaler uses the compact representation until
 // explicitly restored; this makes some tests shorter to configure and easier to understand.
 func allowCompactJSON() *resttools.JSONMarshalOptions {
@@ -208,3 +297,265 @@ func noSpace(src string) string {
 	str := strings.ReplaceAll(src, "\n", "")
 	return strings.ReplaceAll(str, " ", "")
 }
+
+// TestKeepaliveEnforcement tests that clients pinging too often are disconnected with the
+// canonical "too_many_pings" GOAWAY.
+func TestRESTScratchpad(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+	call := func(method, url, body string) (int, string) {
+		request, err := http.NewRequest(method, server.URL+url, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+		data, _ := ioutil.ReadAll(response.Body)
+		return response.StatusCode, string(data)
+	}
+
+	const entry = "/v1beta1/sessions/-/scratchpad/barrier"
+	if code, data := call("PUT", entry+"?expectedVersion=-1", `{"value":"cmVhZHk="}`); code != http.StatusOK {
+		t.Fatalf("SetScratchpadEntry: want 200, got %d: %s", code, data)
+	}
+	if code, data := call("GET", entry, ""); code != http.StatusOK || !strings.Contains(data, `"cmVhZHk="`) {
+		t.Errorf("GetScratchpadEntry: want 200 with the value, got %d: %s", code, data)
+	}
+	if code, data := call("DELETE", entry, ""); code != http.StatusOK {
+		t.Errorf("DeleteScratchpadEntry: want 200, got %d: %s", code, data)
+	}
+	if code, data := call("GET", "/v1beta1/sessions/-", ""); code != http.StatusOK || !strings.Contains(data, `"sessions/-"`) {
+		t.Errorf("GetSession: want 200 with the session, got %d: %s", code, data)
+	}
+}
+
+func TestKeepaliveEnforcement(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer(keepaliveEnforcement(RuntimeConfig{keepaliveMinTime: time.Hour}))
+	go s.Serve(lis)
+	defer s.Stop()
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	conn.Write([]byte(http2.ClientPreface))
+	framer := http2.NewFramer(conn, conn)
+	framer.WriteSettings()
+	for i := 0; i < 5; i++ {
+		framer.WritePing(false, [8]byte{byte(i)})
+	}
+
+	for {
+		frame, err := framer.ReadFrame()
+		if err != nil {
+			t.Fatalf("want a GOAWAY, got %v", err)
+		}
+		if goAway, ok := frame.(*http2.GoAwayFrame); ok {
+			if goAway.ErrCode != http2.ErrCodeEnhanceYourCalm || string(goAway.DebugData()) != "too_many_pings" {
+				t.Errorf("unexpected GOAWAY: %v %q", goAway, goAway.DebugData())
+			}
+			return
+		}
+	}
+}
+
+// compressionStats records the compression of the responses a client receives.
+type compressionStats struct {
+	mu          sync.Mutex
+	compression string
+}
+
+func (s *compressionStats) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (s *compressionStats) HandleRPC(_ context.Context, rs stats.RPCStats) {
+	if header, ok := rs.(*stats.InHeader); ok {
+		s.mu.Lock()
+		s.compression = header.Compression
+		s.mu.Unlock()
+	}
+}
+
+func (s *compressionStats) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (s *compressionStats) HandleConn(context.Context, stats.ConnStats) {}
+
+func TestEchoCompression(t *testing.T) {
+	for _, test := range []struct {
+		forceGzip      bool
+		compressor     string
+		wantRequest    string
+		wantCompressed bool
+	}{
+		{wantRequest: "identity"},
+		{compressor: gzip.Name, wantRequest: "gzip", wantCompressed: true},
+		{forceGzip: true, wantRequest: "identity", wantCompressed: true},
+	} {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		config := RuntimeConfig{forceGzip: test.forceGzip, reflectionVersion: "none"}
+		endpoint := newEndpointGRPC(lis, config, createBackends(config)).(*endpointGRPC)
+		go endpoint.server.Serve(endpoint.listener)
+
+		received := &compressionStats{}
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithStatsHandler(received))
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts := []grpc.CallOption{}
+		if test.compressor != "" {
+			opts = append(opts, grpc.UseCompressor(test.compressor))
+		}
+		var header metadata.MD
+		opts = append(opts, grpc.Header(&header))
+		if _, err := pb.NewEchoClient(conn).Echo(context.Background(), &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "squeezed"}}, opts...); err != nil {
+			t.Fatal(err)
+		}
+		label := fmt.Sprintf("compressor %q, forceGzip %v", test.compressor, test.forceGzip)
+		if got := header.Get(server.RequestCompressionHeader); len(got) != 1 || got[0] != test.wantRequest {
+			t.Errorf("%s: %s: got %q, want %q", label, server.RequestCompressionHeader, got, test.wantRequest)
+		}
+		received.mu.Lock()
+		if compressed := received.compression == "gzip"; compressed != test.wantCompressed {
+			t.Errorf("%s: got response compression %q, want compressed %v", label, received.compression, test.wantCompressed)
+		}
+		received.mu.Unlock()
+		conn.Close()
+		endpoint.server.Stop()
+	}
+}
+
+func TestRegisterReflection(t *testing.T) {
+	if err := registerReflection(grpc.NewServer(), "v2"); err == nil {
+		t.Error("registerReflection with an unknown version: want an error")
+	}
+
+	for _, test := range []struct {
+		version string
+		served  []string
+	}{
+		{"all", []string{"v1", "v1alpha"}},
+		{"v1", []string{"v1"}},
+		{"v1alpha", []string{"v1alpha"}},
+		{"none", nil},
+	} {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := grpc.NewServer()
+		if err := registerReflection(s, test.version); err != nil {
+			t.Fatal(err)
+		}
+		go s.Serve(lis)
+
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, version := range []string{"v1", "v1alpha"} {
+			want := codes.Unimplemented
+			for _, served := range test.served {
+				if served == version {
+					want = codes.OK
+				}
+			}
+			method := fmt.Sprintf("/grpc.reflection.%s.ServerReflection/ServerReflectionInfo", version)
+			stream, err := conn.NewStream(context.Background(), &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}, method)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stream.SendMsg(&rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_ListServices{}})
+			resp := &rpb.ServerReflectionResponse{}
+			err = stream.RecvMsg(resp)
+			if got := status.Code(err); got != want {
+				t.Errorf("%s: %s: got %v, want %v", test.version, method, err, want)
+			}
+			if err == nil && len(resp.GetListServicesResponse().GetService()) != len(test.served) {
+				t.Errorf("%s: %s: unexpected services %v", test.version, method, resp.GetListServicesResponse())
+			}
+		}
+		conn.Close()
+		s.Stop()
+	}
+}
+
+func TestAdminHandler(t *testing.T) {
+	server := httptest.NewServer(newAdminHandler(createBackends(RuntimeConfig{}).Metrics))
+	defer server.Close()
+
+	for _, test := range []struct {
+		path string
+		want string
+	}{
+		{"/debug/pprof/", "goroutine"},
+		{"/debug/pprof/goroutine?debug=1", "goroutine profile"},
+		{"/debug/pprof/trace?seconds=0.05", "go 1."},
+		{"/metrics", "# TYPE showcase_rpc_calls_total counter"},
+	} {
+		response, err := http.Get(server.URL + test.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.StatusCode != http.StatusOK || !strings.Contains(string(body), test.want) {
+			t.Errorf("GET %s: want 200 with %q, got %d %.100q", test.path, test.want, response.StatusCode, body)
+		}
+	}
+}
+
+func TestRESTFieldConstraints(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+	if code, data := postREST(t, server.URL+"/v1beta1/echo:generatePayload", `{"size":"5"}`); code != http.StatusOK {
+		t.Errorf("GeneratePayload: want 200, got %d: %s", code, data)
+	}
+	code, data := postREST(t, server.URL+"/v1beta1/echo:generatePayload", `{"size":"-5"}`)
+	if code != http.StatusBadRequest || !strings.Contains(string(data), "size: must be at least 0, but is -5") {
+		t.Errorf("GeneratePayload of a negative size: want 400 listing the violation, got %d: %s", code, data)
+	}
+}
+
+func TestGRPCMaxRequestBytes(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := RuntimeConfig{maxRequestBytes: 64, reflectionVersion: "none"}
+	endpoint := newEndpointGRPC(lis, config, createBackends(config)).(*endpointGRPC)
+	go endpoint.server.Serve(endpoint.listener)
+	defer endpoint.server.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	client := pb.NewEchoClient(conn)
+	if _, err := client.Echo(context.Background(), &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "small"}}); err != nil {
+		t.Errorf("Echo of a small request: %v", err)
+	}
+	large := &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: strings.Repeat("large ", 20)}}
+	if _, err := client.Echo(context.Background(), large); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Echo of a large request: want ResourceExhausted, got %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var host, port string
+	envInitCmd := &cobra.Command{
+		Use:   "env-init",
+		Short: "Prints the environment variables pointing clients at a showcase server",
+		Long: "Prints the shell commands exporting the environment variables that point clients at a showcase server, " +
+			"as Cloud emulators do, so that a test environment can be set up with $(gapic-showcase env-init). " +
+			"The client commands of this CLI honor " + emulatorHostEnv + ".",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			fmt.Fprint(cmd.OutOrStdout(), envInitLines(host, port))
+		},
+	}
+	rootCmd.AddCommand(envInitCmd)
+	envInitCmd.Flags().StringVar(
+		&host,
+		"host",
+		"localhost",
+		"The host the showcase server is reached at.")
+	envInitCmd.Flags().StringVarP(
+		&port,
+		"port",
+		"p",
+		":7469",
+		"The port the showcase server is served on.")
+}
+
+// envInitLines returns the shell commands exporting the environment variables that point
+// clients at the showcase server on host and port.
+func envInitLines(host, port string) string {
+	return fmt.Sprintf("export %s=%s:%s\n", emulatorHostEnv, host, strings.TrimPrefix(port, ":"))
+}
@@ -0,0 +1,30 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import "testing"
+
+func TestEnvInitLines(t *testing.T) {
+	for _, testCase := range []struct {
+		host, port, want string
+	}{
+		{"localhost", ":7469", "export SHOWCASE_EMULATOR_HOST=localhost:7469\n"},
+		{"127.0.0.1", "8080", "export SHOWCASE_EMULATOR_HOST=127.0.0.1:8080\n"},
+	} {
+		if got := envInitLines(testCase.host, testCase.port); got != testCase.want {
+			t.Errorf("envInitLines(%q, %q): got %q, want %q", testCase.host, testCase.port, got, testCase.want)
+		}
+	}
+}
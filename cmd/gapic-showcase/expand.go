@@ -7,6 +7,8 @@ import (
 
 	anypb "google.golang.org/protobuf/types/known/anypb"
 
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+
 	"fmt"
 
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
@@ -26,11 +28,21 @@ var ExpandFromFile string
 
 var ExpandInputErrorDetails []string
 
+var ExpandInputFailAfterErrorDetails []string
+
 func init() {
 	EchoServiceCmd.AddCommand(ExpandCmd)
 
 	ExpandInput.Error = new(statuspb.Status)
 
+	ExpandInput.ResponseDelay = new(durationpb.Duration)
+
+	ExpandInput.Jitter = new(durationpb.Duration)
+
+	ExpandInput.FailAfter = new(genprotopb.StreamFailure)
+
+	ExpandInput.FailAfter.Error = new(statuspb.Status)
+
 	ExpandCmd.Flags().StringVar(&ExpandInput.Content, "content", "", "The content that will be split into words and...")
 
 	ExpandCmd.Flags().Int32Var(&ExpandInput.Error.Code, "error.code", 0, "The status code, which should be an enum value of...")
@@ -39,6 +51,26 @@ func init() {
 
 	ExpandCmd.Flags().StringArrayVar(&ExpandInputErrorDetails, "error.details", []string{}, "A list of messages that carry the error details. ...")
 
+	ExpandCmd.Flags().Int64Var(&ExpandInput.ResponseDelay.Seconds, "response_delay.seconds", 0, "Signed seconds of the span of time. Must be from...")
+
+	ExpandCmd.Flags().Int32Var(&ExpandInput.ResponseDelay.Nanos, "response_delay.nanos", 0, "Signed fractions of a second at nanosecond...")
+
+	ExpandCmd.Flags().Int64Var(&ExpandInput.Jitter.Seconds, "jitter.seconds", 0, "Signed seconds of the span of time. Must be from...")
+
+	ExpandCmd.Flags().Int32Var(&ExpandInput.Jitter.Nanos, "jitter.nanos", 0, "Signed fractions of a second at nanosecond...")
+
+	ExpandCmd.Flags().Int64Var(&ExpandInput.JitterSeed, "jitter_seed", 0, "The seed of the random jitter. Calls with the...")
+
+	ExpandCmd.Flags().StringVar(&ExpandInput.StreamResumeToken, "stream_resume_token", "", "The `stream_resume_token` of the last word...")
+
+	ExpandCmd.Flags().Int32Var(&ExpandInput.FailAfter.ResponseCount, "fail_after.response_count", 0, "The number of responses the server sends before...")
+
+	ExpandCmd.Flags().Int32Var(&ExpandInput.FailAfter.Error.Code, "fail_after.error.code", 0, "The status code, which should be an enum value of...")
+
+	ExpandCmd.Flags().StringVar(&ExpandInput.FailAfter.Error.Message, "fail_after.error.message", "", "A developer-facing error message, which should be...")
+
+	ExpandCmd.Flags().StringArrayVar(&ExpandInputFailAfterErrorDetails, "fail_after.error.details", []string{}, "A list of messages that carry the error details. ...")
+
 	ExpandCmd.Flags().StringVar(&ExpandFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
@@ -46,7 +78,7 @@ func init() {
 var ExpandCmd = &cobra.Command{
 	Use:   "expand",
 	Short: "This method splits the given content into words...",
-	Long:  "This method splits the given content into words and will pass each word back  through the stream. This method showcases server-side streaming RPCs.",
+	Long:  "This method splits the given content into words and will pass each word back  through the stream. This method showcases server-side streaming RPCs. ...",
 	PreRun: func(cmd *cobra.Command, args []string) {
 
 		if ExpandFromFile == "" {
@@ -82,6 +114,17 @@ var ExpandCmd = &cobra.Command{
 			ExpandInput.Error.Details = append(ExpandInput.Error.Details, &tmp)
 		}
 
+		// unmarshal JSON strings into slice of structs
+		for _, item := range ExpandInputFailAfterErrorDetails {
+			tmp := anypb.Any{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			ExpandInput.FailAfter.Error.Details = append(ExpandInput.FailAfter.Error.Details, &tmp)
+		}
+
 		if Verbose {
 			printVerboseInput("Echo", "Expand", &ExpandInput)
 		}
@@ -0,0 +1,243 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	showcase "github.com/googleapis/gapic-showcase/client/apiv1beta1"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// These tests call an in-process server through the generated GAPIC client, so that changes to
+// the protos reveal regressions in the generated surface.
+
+// startGRPCServer serves the Showcase services over gRPC for tests, returning a client option
+// connecting to them and the server, to be stopped by the caller.
+func startGRPCServer(t *testing.T) (option.ClientOption, *grpc.Server) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := RuntimeConfig{reflectionVersion: "all"}
+	endpoint := newEndpointGRPC(lis, config, createBackends(config)).(*endpointGRPC)
+	go endpoint.server.Serve(endpoint.listener)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return option.WithGRPCConn(conn), endpoint.server
+}
+
+func TestGAPICClient_surface(t *testing.T) {
+	_, s := startGRPCServer(t)
+	defer s.Stop()
+
+	data, err := ioutil.ReadFile(filepath.Join("..", "..", "client", "apiv1beta1", "gapic_metadata.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata := struct {
+		Services map[string]struct {
+			Clients map[string]struct {
+				Rpcs map[string]interface{}
+			}
+		}
+	}{}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		t.Fatal(err)
+	}
+
+	checked := 0
+	for name, info := range s.GetServiceInfo() {
+		if !strings.HasPrefix(name, "google.showcase.v1beta1.") {
+			continue
+		}
+		checked++
+		service, ok := metadata.Services[strings.TrimPrefix(name, "google.showcase.v1beta1.")]
+		if !ok {
+			t.Errorf("service %s has no generated client", name)
+			continue
+		}
+		for _, method := range info.Methods {
+			if _, ok := service.Clients["grpc"].Rpcs[method.Name]; !ok {
+				t.Errorf("method %s.%s has no generated client method", name, method.Name)
+			}
+		}
+	}
+	if checked == 0 {
+		t.Error("no Showcase services are registered")
+	}
+}
+
+func TestGAPICClient_Echo(t *testing.T) {
+	opt, s := startGRPCServer(t)
+	defer s.Stop()
+	ctx := context.Background()
+	c, err := showcase.NewEchoClient(ctx, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	resp, err := c.Echo(ctx, &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hello"}})
+	if err != nil || resp.GetContent() != "hello" {
+		t.Errorf("Echo: got %v, %v", resp, err)
+	}
+	_, err = c.Echo(ctx, &pb.EchoRequest{Response: &pb.EchoRequest_Error{Error: status.New(codes.Aborted, "aborted").Proto()}})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("Echo with an error: want Aborted, got %v", err)
+	}
+
+	expand, err := c.Expand(ctx, &pb.ExpandRequest{Content: "one two three"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := []string{}
+	for {
+		resp, err := expand.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		words = append(words, resp.GetContent())
+	}
+	if got := strings.Join(words, " "); got != "one two three" {
+		t.Errorf("Expand: got %q", got)
+	}
+
+	collect, err := c.Collect(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, word := range []string{"one", "two"} {
+		if err := collect.Send(&pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: word}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if resp, err := collect.CloseAndRecv(); err != nil || resp.GetContent() != "one two" {
+		t.Errorf("Collect: got %v, %v", resp, err)
+	}
+
+	chat, err := c.Chat(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chat.Send(&pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hi"}}); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := chat.Recv(); err != nil || resp.GetContent() != "hi" {
+		t.Errorf("Chat: got %v, %v", resp, err)
+	}
+	chat.CloseSend()
+
+	it := c.PagedExpand(ctx, &pb.PagedExpandRequest{Content: "a b c d e", PageSize: 2})
+	words = []string{}
+	for {
+		resp, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		words = append(words, resp.GetContent())
+	}
+	if got := strings.Join(words, " "); got != "a b c d e" {
+		t.Errorf("PagedExpand: got %q", got)
+	}
+
+	op, err := c.Wait(ctx, &pb.WaitRequest{
+		End:      &pb.WaitRequest_Ttl{Ttl: durationpb.New(10 * time.Millisecond)},
+		Response: &pb.WaitRequest_Success{Success: &pb.WaitResponse{Content: "done"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if resp, err := op.Poll(ctx); err != nil || !op.Done() || resp.GetContent() != "done" {
+		t.Errorf("Wait: got %v, %v, done: %v", resp, err, op.Done())
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	probe, err := c.ProbeDeadline(timeoutCtx, &pb.ProbeDeadlineRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remaining := probe.GetRemaining().AsDuration(); !probe.GetHasDeadline() || remaining <= 0 || remaining > 5*time.Second {
+		t.Errorf("ProbeDeadline with a 5s timeout: got %v", probe)
+	}
+}
+
+func TestGAPICClient_Identity(t *testing.T) {
+	opt, s := startGRPCServer(t)
+	defer s.Stop()
+	ctx := context.Background()
+	c, err := showcase.NewIdentityClient(ctx, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	user, err := c.CreateUser(ctx, &pb.CreateUserRequest{User: &pb.User{DisplayName: "Rumble", Email: "rumble@goodboi.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetUser(ctx, &pb.GetUserRequest{Name: user.GetName()})
+	if err != nil || got.GetDisplayName() != "Rumble" {
+		t.Errorf("GetUser: got %v, %v", got, err)
+	}
+
+	it := c.ListUsers(ctx, &pb.ListUsersRequest{})
+	listed := 0
+	for {
+		_, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		listed++
+	}
+	if listed != 1 {
+		t.Errorf("ListUsers: want 1 user, got %d", listed)
+	}
+
+	if err := c.DeleteUser(ctx, &pb.DeleteUserRequest{Name: user.GetName()}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetUser(ctx, &pb.GetUserRequest{Name: user.GetName()}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetUser after DeleteUser: want NotFound, got %v", err)
+	}
+}
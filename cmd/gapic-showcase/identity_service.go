@@ -11,7 +11,7 @@ import (
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
 
-	gapic "github.com/googleapis/gapic-showcase/client"
+	gapic "github.com/googleapis/gapic-showcase/client/apiv1beta1"
 )
 
 var IdentityConfig *viper.Viper
@@ -22,6 +22,8 @@ var IdentitySubCommands []string = []string{
 	"update-user",
 	"delete-user",
 	"list-users",
+	"batch-write",
+	"watch-users",
 }
 
 func init() {
@@ -16,11 +16,19 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"log"
 	"os"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 var stdLog, errLog *log.Logger
@@ -30,6 +38,85 @@ func init() {
 	errLog = log.New(os.Stderr, "", log.Ldate|log.Ltime)
 }
 
+// configureLogging makes stdLog and errLog write plain text if format is "text", or a JSON
+// object per entry if it is "json".
+func configureLogging(format string) error {
+	switch format {
+	case "text":
+		stdLog = log.New(os.Stdout, "", log.Ldate|log.Ltime)
+		errLog = log.New(os.Stderr, "", log.Ldate|log.Ltime)
+	case "json":
+		stdLog = log.New(&jsonLogWriter{out: os.Stdout, severity: "INFO"}, "", 0)
+		errLog = log.New(&jsonLogWriter{out: os.Stderr, severity: "ERROR"}, "", 0)
+	default:
+		return fmt.Errorf("unknown log format %q, want \"text\" or \"json\"", format)
+	}
+	return nil
+}
+
+// jsonLogWriter writes each log line as a JSON object with its time, severity and message,
+// along with the fields of structured entries.
+type jsonLogWriter struct {
+	out      io.Writer
+	severity string
+}
+
+func (w *jsonLogWriter) Write(p []byte) (int, error) {
+	if err := w.write(strings.TrimRight(string(p), "\n"), nil); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *jsonLogWriter) write(message string, fields map[string]interface{}) error {
+	entry := map[string]interface{}{}
+	for key, value := range fields {
+		entry[key] = value
+	}
+	entry["time"] = time.Now().UTC().Format(time.RFC3339Nano)
+	entry["severity"] = w.severity
+	entry["message"] = message
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = w.out.Write(append(line, '\n'))
+	return err
+}
+
+// logStructured writes a structured entry with fields to logger if it writes JSON, returning
+// false if it does not.
+func logStructured(logger *log.Logger, message string, fields map[string]interface{}) bool {
+	w, ok := logger.Writer().(*jsonLogWriter)
+	if !ok {
+		return false
+	}
+	if err := w.write(message, fields); err != nil {
+		errLog.Printf("Failed to log %q: %v", message, err)
+	}
+	return true
+}
+
+// loggedMessage renders a request or response for a structured log entry.
+func loggedMessage(m interface{}) interface{} {
+	if msg, ok := m.(proto.Message); ok {
+		if data, err := protojson.Marshal(msg); err == nil {
+			return json.RawMessage(data)
+		}
+	}
+	return fmt.Sprintf("%+v", m)
+}
+
+// loggedCall returns the fields of the structured log entry of a call to method.
+func loggedCall(ctx context.Context, method string) map[string]interface{} {
+	fields := map[string]interface{}{"method": method}
+	if Verbose {
+		md, _ := metadata.FromIncomingContext(ctx)
+		fields["requestHeaders"] = md
+	}
+	return fields
+}
+
 type loggerObserver struct{}
 
 func (l *loggerObserver) GetName() string { return "loggerObserver" }
@@ -55,6 +142,17 @@ func (l *loggerObserver) ObserveUnary(
 	resp interface{},
 	info *grpc.UnaryServerInfo,
 	err error) {
+	fields := loggedCall(ctx, info.FullMethod)
+	fields["request"] = loggedMessage(req)
+	if err == nil {
+		fields["response"] = loggedMessage(resp)
+	} else {
+		fields["status"] = loggedMessage(status.Convert(err).Proto())
+	}
+	if logStructured(stdLog, "Received unary request", fields) {
+		return
+	}
+
 	stdLog.Printf("Received Unary Request for Method: %s\n", info.FullMethod)
 	if Verbose {
 		dumpIncomingHeaders(ctx)
@@ -73,6 +171,13 @@ func (l *loggerObserver) ObserveStreamRequest(
 	req interface{},
 	info *grpc.StreamServerInfo,
 	_ error) {
+	fields := loggedCall(ctx, info.FullMethod)
+	fields["stream"] = streamType(info)
+	fields["request"] = loggedMessage(req)
+	if logStructured(stdLog, "Received stream message", fields) {
+		return
+	}
+
 	stdLog.Printf("%s Stream for Method: %s\n", streamType(info), info.FullMethod)
 	if Verbose {
 		dumpIncomingHeaders(ctx)
@@ -86,6 +191,11 @@ func (l *loggerObserver) ObserveStreamResponse(
 	resp interface{},
 	info *grpc.StreamServerInfo,
 	_ error) {
+	fields := map[string]interface{}{"method": info.FullMethod, "stream": streamType(info), "response": loggedMessage(resp)}
+	if logStructured(stdLog, "Sent stream message", fields) {
+		return
+	}
+
 	stdLog.Printf("%s Stream for Method: %s\n", streamType(info), info.FullMethod)
 	stdLog.Printf("    Sending Message:  %+v\n", resp)
 	stdLog.Println("")
@@ -0,0 +1,86 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConfigureLogging(t *testing.T) {
+	defer configureLogging("text")
+	for _, format := range []string{"text", "json"} {
+		if err := configureLogging(format); err != nil {
+			t.Errorf("configureLogging(%q): %v", format, err)
+		}
+	}
+	if err := configureLogging("xml"); err == nil {
+		t.Error("configureLogging(\"xml\"): want an error")
+	}
+}
+
+func TestLoggerObserver_json(t *testing.T) {
+	saved := stdLog
+	defer func() { stdLog = saved }()
+	var out bytes.Buffer
+	stdLog = log.New(&jsonLogWriter{out: &out, severity: "INFO"}, "", 0)
+
+	observer := &loggerObserver{}
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+	request := &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hello"}}
+	observer.ObserveUnary(context.Background(), request, &pb.EchoResponse{Content: "hello"}, info, nil)
+	observer.ObserveUnary(context.Background(), request, nil, info, status.Error(codes.NotFound, "missing"))
+	stdLog.Printf("plain %s", "message")
+
+	var entries []map[string]interface{}
+	decoder := json.NewDecoder(&out)
+	for decoder.More() {
+		var entry map[string]interface{}
+		if err := decoder.Decode(&entry); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("want 3 entries logged, got %d: %v", len(entries), entries)
+	}
+	for _, entry := range entries {
+		if entry["severity"] != "INFO" || entry["time"] == nil {
+			t.Errorf("want entries with a time and severity, got %v", entry)
+		}
+	}
+	served, failed, plain := entries[0], entries[1], entries[2]
+	if served["method"] != info.FullMethod || served["request"].(map[string]interface{})["content"] != "hello" ||
+		served["response"].(map[string]interface{})["content"] != "hello" {
+		t.Errorf("served call: unexpected entry %v", served)
+	}
+	if failed["response"] != nil || failed["status"].(map[string]interface{})["message"] != "missing" {
+		t.Errorf("failed call: unexpected entry %v", failed)
+	}
+	if plain["message"] != "plain message" {
+		t.Errorf("plain message: unexpected entry %v", plain)
+	}
+	if logStructured(log.New(&bytes.Buffer{}, "", 0), "text", nil) {
+		t.Error("logStructured: want false for a text logger")
+	}
+}
@@ -11,7 +11,7 @@ import (
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
 
-	gapic "github.com/googleapis/gapic-showcase/client"
+	gapic "github.com/googleapis/gapic-showcase/client/apiv1beta1"
 )
 
 var MessagingConfig *viper.Viper
@@ -22,6 +22,7 @@ var MessagingSubCommands []string = []string{
 	"update-room",
 	"delete-room",
 	"list-rooms",
+	"watch-room",
 	"create-blurb",
 	"get-blurb",
 	"update-blurb",
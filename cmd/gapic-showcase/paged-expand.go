@@ -29,6 +29,10 @@ func init() {
 
 	PagedExpandCmd.Flags().StringVar(&PagedExpandInput.PageToken, "page_token", "", "The position of the page to be returned.")
 
+	PagedExpandCmd.Flags().Int32Var(&PagedExpandInput.Skip, "skip", 0, "The number of words to skip before the first word...")
+
+	PagedExpandCmd.Flags().BoolVar(&PagedExpandInput.Reverse, "reverse", false, "Whether to expand the words in reverse order,...")
+
 	PagedExpandCmd.Flags().StringVar(&PagedExpandFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
@@ -36,7 +40,7 @@ func init() {
 var PagedExpandCmd = &cobra.Command{
 	Use:   "paged-expand",
 	Short: "This is similar to the Expand method but instead...",
-	Long:  "This is similar to the Expand method but instead of returning a stream of  expanded words, this method returns a paged list of expanded words.",
+	Long:  "This is similar to the Expand method but instead of returning a stream of  expanded words, this method returns a paged list of expanded words. Send ...",
 	PreRun: func(cmd *cobra.Command, args []string) {
 
 		if PagedExpandFromFile == "" {
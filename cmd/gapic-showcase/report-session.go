@@ -23,6 +23,10 @@ func init() {
 
 	ReportSessionCmd.Flags().StringVar(&ReportSessionInput.Name, "name", "", "The session to be reported on.")
 
+	ReportSessionCmd.Flags().StringVar(&ReportSessionInput.ClientLanguage, "client_language", "", "The language of the client reporting the session,...")
+
+	ReportSessionCmd.Flags().StringVar(&ReportSessionInput.ClientVersion, "client_version", "", "The version of the client reporting the session....")
+
 	ReportSessionCmd.Flags().StringVar(&ReportSessionFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
@@ -0,0 +1,1365 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	"github.com/googleapis/gapic-showcase/server/genrest"
+	"github.com/googleapis/gapic-showcase/server/services"
+	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
+	gmux "github.com/gorilla/mux"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// redirectHeader asks for a REST call to be answered with a redirect before the real
+	// response. Its value is a 3xx status code, optionally followed by "cross-origin" to
+	// redirect to another origin served by this same server, e.g. "307 cross-origin".
+	redirectHeader = "X-Showcase-Redirect"
+
+	// redirectedHeader reports, on the final response, the redirect status the call was
+	// sent through.
+	redirectedHeader = "X-Showcase-Redirected"
+
+	// redirectedParam marks the URL a call was redirected to, so it is served rather than
+	// redirected again. It is removed before the call reaches its handler.
+	redirectedParam = "showcaseRedirected"
+)
+
+// redirectMiddleware answers REST calls that carry redirectHeader with the requested redirect,
+// so that clients' redirect policies can be tested.
+func redirectMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			if redirected := query.Get(redirectedParam); redirected != "" {
+				query.Del(redirectedParam)
+				r.URL.RawQuery = query.Encode()
+				w.Header().Set(redirectedHeader, redirected)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			value := r.Header.Get(redirectHeader)
+			if value == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			fields := strings.Fields(value)
+			code, err := strconv.Atoi(fields[0])
+			if err != nil || !isRedirect(code) || len(fields) > 2 || (len(fields) == 2 && fields[1] != "cross-origin") {
+				rest.Error(w, http.StatusBadRequest, "invalid %s header %q: want a redirect status code, optionally followed by \"cross-origin\"", redirectHeader, value)
+				return
+			}
+
+			// The request target keeps any REST path prefix stripped before routing.
+			target := url.URL{Path: r.URL.Path}
+			if requestURL, err := url.ParseRequestURI(r.RequestURI); err == nil {
+				target.Path = requestURL.Path
+			}
+			if len(fields) == 2 {
+				target.Scheme = "http"
+				if r.TLS != nil {
+					target.Scheme = "https"
+				}
+				target.Host = otherOrigin(r.Host)
+			}
+			query.Set(redirectedParam, fields[0])
+			target.RawQuery = query.Encode()
+			http.Redirect(w, r, target.String(), code)
+		})
+	}
+}
+
+// isRedirect reports whether code is one of the redirect status codes clients need to handle.
+func isRedirect(code int) bool {
+	switch code {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	}
+	return false
+}
+
+// otherOrigin returns a host that reaches the same server as host but is a different origin,
+// by swapping between "localhost" and the loopback address.
+func otherOrigin(host string) string {
+	port := ""
+	if i := strings.LastIndex(host, ":"); i >= 0 && !strings.HasSuffix(host, "]") {
+		host, port = host[:i], host[i:]
+	}
+	if host == "localhost" {
+		return "127.0.0.1" + port
+	}
+	return "localhost" + port
+}
+
+const (
+	// requestEncodingHeader reports, on the response, the Content-Encoding the request body
+	// was decompressed from, or "identity".
+	requestEncodingHeader = "X-Showcase-Request-Encoding"
+
+	// negotiatedEncodingHeader reports, on the response, the Content-Encoding negotiated for
+	// the response body from the request's Accept-Encoding. Unlike Content-Encoding, it
+	// survives clients that decompress transparently.
+	negotiatedEncodingHeader = "X-Showcase-Negotiated-Encoding"
+)
+
+// contentEncodings are the supported content codings, in order of preference among equally
+// acceptable ones.
+var contentEncodings = []string{"gzip", "deflate", "zstd"}
+
+// defaultMaxDecodedBytes is the size of the largest decoded REST request body the server
+// accepts when --max-request-bytes sets no limit, so that small compressed bodies cannot expand
+// without bound.
+const defaultMaxDecodedBytes = 32 << 20
+
+// errDecodedTooLarge is the error decompress returns for bodies decoding to more than its limit.
+var errDecodedTooLarge = errors.New("the decoded body is too large")
+
+// compressionMiddleware decompresses REST request bodies according to their Content-Encoding,
+// rejecting those decoding to more than maxBytes, or defaultMaxDecodedBytes if maxBytes is not
+// positive, as bodyLimitMiddleware does, and compresses responses according to the
+// Accept-Encoding the client sent, or always with gzip if forceGzip is true.
+func compressionMiddleware(backend *services.Backend, forceGzip bool, maxBytes int, html bool) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	limit := int64(maxBytes)
+	if limit <= 0 {
+		limit = defaultMaxDecodedBytes
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestEncoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+			if requestEncoding == "" {
+				requestEncoding = "identity"
+			}
+			if requestEncoding != "identity" {
+				// The handlers size their reads by the Content-Length, so the body is decoded
+				// up front.
+				body, err := decompress(requestEncoding, r.Body, limit)
+				if err == errDecodedTooLarge {
+					requestTooLarge(backend, w, limit, html)
+					return
+				}
+				if err != nil {
+					rest.Error(w, http.StatusUnsupportedMediaType, "could not decode %s request body: %s", requestEncoding, err)
+					return
+				}
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+				r.ContentLength = int64(len(body))
+				r.Header.Del("Content-Encoding")
+				r.Header.Set("Content-Length", strconv.Itoa(len(body)))
+			}
+			w.Header().Set(requestEncodingHeader, requestEncoding)
+			w.Header().Add("Vary", "Accept-Encoding")
+
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+			if forceGzip {
+				encoding = "gzip"
+			}
+			w.Header().Set(negotiatedEncodingHeader, encoding)
+			if encoding == "identity" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			cw := &compressingResponseWriter{ResponseWriter: w, encoding: encoding}
+			defer cw.Close()
+			next.ServeHTTP(cw, r)
+		})
+	}
+}
+
+// decompress returns body decoded from encoding, or errDecodedTooLarge if it decodes to more
+// than limit bytes.
+func decompress(encoding string, body io.Reader, limit int64) ([]byte, error) {
+	var decoder io.Reader
+	var err error
+	switch encoding {
+	case "gzip", "x-gzip":
+		decoder, err = gzip.NewReader(body)
+	case "deflate":
+		decoder, err = zlib.NewReader(body)
+	case "zstd":
+		var zstdDecoder *zstd.Decoder
+		zstdDecoder, err = zstd.NewReader(body)
+		if err == nil {
+			defer zstdDecoder.Close()
+			decoder = zstdDecoder
+		}
+	default:
+		err = fmt.Errorf("unsupported Content-Encoding %q", encoding)
+	}
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := ioutil.ReadAll(io.LimitReader(decoder, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(decoded)) > limit {
+		return nil, errDecodedTooLarge
+	}
+	return decoded, nil
+}
+
+// negotiateEncoding returns the supported content coding most preferred by acceptEncoding, or
+// "identity".
+func negotiateEncoding(acceptEncoding string) string {
+	weights := map[string]float64{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		params := strings.Split(part, ";")
+		coding := strings.ToLower(strings.TrimSpace(params[0]))
+		if coding == "" {
+			continue
+		}
+		weight := 1.0
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					weight = q
+				}
+			}
+		}
+		weights[coding] = weight
+	}
+
+	best, bestWeight := "identity", 0.0
+	for _, coding := range contentEncodings {
+		weight, ok := weights[coding]
+		if !ok {
+			weight, ok = weights["*"]
+		}
+		if ok && weight > bestWeight {
+			best, bestWeight = coding, weight
+		}
+	}
+	return best
+}
+
+// compressingResponseWriter compresses everything written to it with encoding.
+type compressingResponseWriter struct {
+	http.ResponseWriter
+	encoding string
+	encoder  io.WriteCloser
+}
+
+func (cw *compressingResponseWriter) WriteHeader(code int) {
+	if cw.encoder == nil {
+		cw.start()
+	}
+	cw.ResponseWriter.WriteHeader(code)
+}
+
+func (cw *compressingResponseWriter) Write(data []byte) (int, error) {
+	if cw.encoder == nil {
+		cw.start()
+	}
+	return cw.encoder.Write(data)
+}
+
+func (cw *compressingResponseWriter) start() {
+	header := cw.Header()
+	header.Set("Content-Encoding", cw.encoding)
+	header.Del("Content-Length")
+	switch cw.encoding {
+	case "gzip":
+		cw.encoder = gzip.NewWriter(cw.ResponseWriter)
+	case "deflate":
+		cw.encoder = zlib.NewWriter(cw.ResponseWriter)
+	case "zstd":
+		// NewWriter only fails on invalid options.
+		cw.encoder, _ = zstd.NewWriter(cw.ResponseWriter)
+	}
+}
+
+// Close flushes the compressed body, if anything was written.
+func (cw *compressingResponseWriter) Close() error {
+	if cw.encoder == nil {
+		return nil
+	}
+	return cw.encoder.Close()
+}
+
+// framingHeader asks for a REST response body to be framed a particular way: "chunked" for
+// chunked transfer encoding, "content-length" for an explicit Content-Length, or "close" for an
+// HTTP/1.0-style body delimited by the server closing the connection.
+const framingHeader = "X-Showcase-Framing"
+
+// framingChunkSize is the size of the chunks "chunked" responses are sent in.
+const framingChunkSize = 16
+
+// framingMiddleware frames REST responses as requested by framingHeader, so that clients'
+// handling of each kind of body framing can be tested.
+func framingMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			framing := strings.ToLower(r.Header.Get(framingHeader))
+			switch framing {
+			case "":
+				next.ServeHTTP(w, r)
+				return
+			case "chunked", "content-length", "close":
+			default:
+				rest.Error(w, http.StatusBadRequest, "invalid %s header %q: want \"chunked\", \"content-length\" or \"close\"", framingHeader, framing)
+				return
+			}
+
+			bw := &bufferingResponseWriter{ResponseWriter: w, code: http.StatusOK}
+			next.ServeHTTP(bw, r)
+			body := bw.body.Bytes()
+			header := w.Header()
+			header.Set(framingHeader, framing)
+			header.Del("Content-Length")
+
+			switch framing {
+			case "content-length":
+				header.Set("Content-Length", strconv.Itoa(len(body)))
+				w.WriteHeader(bw.code)
+				w.Write(body)
+			case "chunked":
+				flusher, ok := w.(http.Flusher)
+				if !ok || r.ProtoMajor != 1 || r.ProtoMinor != 1 {
+					rest.Error(w, http.StatusHTTPVersionNotSupported, "chunked framing needs an HTTP/1.1 connection")
+					return
+				}
+				w.WriteHeader(bw.code)
+				for len(body) > 0 {
+					n := framingChunkSize
+					if n > len(body) {
+						n = len(body)
+					}
+					w.Write(body[:n])
+					flusher.Flush()
+					body = body[n:]
+				}
+			case "close":
+				writeCloseDelimited(w, bw.code, body)
+			}
+		})
+	}
+}
+
+// writeCloseDelimited writes a response whose body is delimited by closing the connection, by
+// taking over the connection from the HTTP server.
+func writeCloseDelimited(w http.ResponseWriter, code int, body []byte) {
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "close-delimited framing needs an HTTP/1.x connection", http.StatusHTTPVersionNotSupported)
+		return
+	}
+	header := w.Header().Clone()
+	conn, buf, err := hijacker.Hijack()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer conn.Close()
+
+	header.Set("Connection", "close")
+	header.Del("Transfer-Encoding")
+	fmt.Fprintf(buf, "HTTP/1.1 %d %s\r\n", code, http.StatusText(code))
+	header.Write(buf)
+	buf.WriteString("\r\n")
+	buf.Write(body)
+	buf.Flush()
+}
+
+// bufferingResponseWriter holds back the body and status written to it, sharing the header of
+// the underlying writer.
+type bufferingResponseWriter struct {
+	http.ResponseWriter
+	code int
+	body bytes.Buffer
+}
+
+func (bw *bufferingResponseWriter) WriteHeader(code int) {
+	bw.code = code
+}
+
+func (bw *bufferingResponseWriter) Write(data []byte) (int, error) {
+	return bw.body.Write(data)
+}
+
+const (
+	// dripHeader asks for a REST response body to be trickled out over the given duration,
+	// e.g. "5s", rather than written at once.
+	dripHeader = "X-Showcase-Drip"
+
+	// dripBytesHeader sets how many bytes are written at a time by dripped responses.
+	dripBytesHeader = "X-Showcase-Drip-Bytes"
+
+	// defaultDripBytes is how many bytes are written at a time if dripBytesHeader is absent.
+	defaultDripBytes = 4
+)
+
+// dripMiddleware writes REST responses a few bytes at a time, spread over the duration requested
+// by dripHeader, so that clients' read timeouts can be told apart from their overall timeouts.
+// The status and headers are sent straight away.
+func dripMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value := r.Header.Get(dripHeader)
+			if value == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			duration, err := time.ParseDuration(value)
+			if err != nil || duration < 0 {
+				rest.Error(w, http.StatusBadRequest, "invalid %s header %q: want a duration such as \"5s\"", dripHeader, value)
+				return
+			}
+			chunkSize := defaultDripBytes
+			if value := r.Header.Get(dripBytesHeader); value != "" {
+				if chunkSize, err = strconv.Atoi(value); err != nil || chunkSize <= 0 {
+					rest.Error(w, http.StatusBadRequest, "invalid %s header %q: want a positive number of bytes", dripBytesHeader, value)
+					return
+				}
+			}
+			flusher, ok := w.(http.Flusher)
+			if !ok {
+				rest.Error(w, http.StatusInternalServerError, "this connection cannot drip responses")
+				return
+			}
+
+			bw := &bufferingResponseWriter{ResponseWriter: w, code: http.StatusOK}
+			next.ServeHTTP(bw, r)
+			body := bw.body.Bytes()
+			chunks := (len(body) + chunkSize - 1) / chunkSize
+			interval := time.Duration(0)
+			if chunks > 1 {
+				interval = duration / time.Duration(chunks-1)
+			}
+
+			w.WriteHeader(bw.code)
+			flusher.Flush()
+			for len(body) > 0 {
+				n := chunkSize
+				if n > len(body) {
+					n = len(body)
+				}
+				if _, err := w.Write(body[:n]); err != nil {
+					return
+				}
+				flusher.Flush()
+				body = body[n:]
+				if len(body) == 0 {
+					break
+				}
+				select {
+				case <-time.After(interval):
+				case <-r.Context().Done():
+					return
+				}
+			}
+		})
+	}
+}
+
+// failoverMiddleware rejects REST calls outside the Failover service while this server is the
+// standby of a failover pair, mirroring what the gRPC interceptors do.
+func failoverMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, "/v1beta1/failover") {
+				if err := backend.FailoverCoordinator.Check(""); err != nil {
+					rest.Error(w, http.StatusServiceUnavailable, "%s", status.Convert(err).Message())
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// grpcHTTPStatus maps status codes to the HTTP statuses google.rpc.Code documents them as.
+var grpcHTTPStatus = map[codes.Code]int{
+	codes.Canceled:           499,
+	codes.Unknown:            http.StatusInternalServerError,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DataLoss:           http.StatusInternalServerError,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+// faultMiddleware fails the REST calls the backend's FaultInjector picks to fail, mirroring what
+// the gRPC interceptors do.
+func faultMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if server.FaultExemptPath(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if err := backend.FaultInjector.Inject(r.Method + " " + r.URL.Path); err != nil {
+				st := status.Convert(err)
+				code := grpcHTTPStatus[st.Code()]
+				backend.ServerEvents.FaultInjected("rest", r.Method+" "+routeTemplate(r), strconv.Itoa(code))
+				rest.Error(w, code, "%s", st.Message())
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// latencyMiddleware delays REST calls by the latency the ShowcaseAdmin service adds to them,
+// mirroring what the gRPC interceptors do.
+func latencyMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, "/v1beta1/admin") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if err := backend.ServerControls.Delay(r.Context()); err != nil {
+				rest.Error(w, http.StatusRequestTimeout, "%s", status.Convert(err).Message())
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// barrierHTTPStatus maps the errors of calls held at a barrier to HTTP statuses.
+var barrierHTTPStatus = map[codes.Code]int{
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.Canceled:           http.StatusRequestTimeout,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.FailedPrecondition: http.StatusBadRequest,
+}
+
+// barrierMiddleware holds REST calls that join a barrier until it is released, mirroring what
+// the gRPC interceptors do.
+func barrierMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(server.BarrierHeader)
+			if id == "" || strings.HasPrefix(r.URL.Path, "/v1beta1/barriers") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			done, err := backend.BarrierManager.Hold(r.Context(), id, r.Header.Get(server.BarrierTagHeader))
+			if err != nil {
+				st := status.Convert(err)
+				rest.Error(w, barrierHTTPStatus[st.Code()], "%s", st.Message())
+				return
+			}
+			defer done()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// authorityMiddleware records the Host each REST call was sent to, and fails the calls not sent
+// to the expected authority, mirroring what the gRPC interceptors do.
+func authorityMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method := r.Method + " " + r.URL.Path
+			exempt := strings.HasPrefix(r.URL.Path, "/v1beta1/transport")
+			if err := backend.AuthorityRecorder.Observe("rest", method, r.Host, exempt); err != nil {
+				rest.Error(w, http.StatusMisdirectedRequest, "%s", status.Convert(err).Message())
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// universeDomainHTTPStatus maps the errors of calls not meant for the universe domain to HTTP
+// statuses.
+var universeDomainHTTPStatus = map[codes.Code]int{
+	codes.FailedPrecondition: http.StatusMisdirectedRequest,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+// universeDomainMiddleware fails the REST calls not meant for the backend's universe domain,
+// when it has one, mirroring what the gRPC interceptors do.
+func universeDomainMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if backend.UniverseDomain == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			err := backend.UniverseDomain.Check(r.Host, r.Header.Values("Authorization"), r.Header.Values(server.UniverseDomainHeader))
+			if err != nil {
+				st := status.Convert(err)
+				rest.Error(w, universeDomainHTTPStatus[st.Code()], "%s", st.Message())
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// corruptionMiddleware corrupts the bodies of the successful REST responses picked by the
+// backend's PayloadCorruptor, when it has one, mirroring what its gRPC codec does.
+func corruptionMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if backend.PayloadCorruptor == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			bw := &bufferingResponseWriter{ResponseWriter: w, code: http.StatusOK}
+			next.ServeHTTP(bw, r)
+			body := bw.body.Bytes()
+			if bw.code >= 200 && bw.code < 300 {
+				body = backend.PayloadCorruptor.Corrupt(body)
+			}
+			w.Header().Del("Content-Length")
+			w.WriteHeader(bw.code)
+			w.Write(body)
+		})
+	}
+}
+
+// stickySessionMiddleware issues the session cookies of the backend's StickySessions, when it
+// has them, echoing the session and backend each REST call is served by. When sessions are
+// required, the calls without one are refused with a 428, along with the session to retry in.
+func stickySessionMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sessions := backend.StickySessions
+			if sessions == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			id := ""
+			if cookie, err := r.Cookie(server.StickySessionCookie); err == nil {
+				id = cookie.Value
+			}
+			session, assigned, issued := sessions.Assign(id)
+			if issued {
+				http.SetCookie(w, &http.Cookie{Name: server.StickySessionCookie, Value: session, Path: "/", HttpOnly: true})
+			}
+			w.Header().Set(server.StickySessionHeader, session)
+			w.Header().Set(server.StickyBackendHeader, assigned)
+			if issued && sessions.Required() {
+				rest.Error(w, http.StatusPreconditionRequired, "the call carries no %s cookie naming a session: retry with the cookie issued", server.StickySessionCookie)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// verifySignaturePath is the path of Transport.VerifySignature over REST.
+const verifySignaturePath = "/v1beta1/transport:verifySignature"
+
+// signatureMiddleware captures the parts of REST calls to Transport.VerifySignature that their
+// signature covers, as they came off the wire, before any other middleware changes them.
+func signatureMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != verifySignaturePath {
+				next.ServeHTTP(w, r)
+				return
+			}
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				rest.Error(w, http.StatusBadRequest, "could not read the request body: %s", err)
+				return
+			}
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+			// The path signed keeps any REST path prefix stripped before routing.
+			path := r.URL.RequestURI()
+			if r.RequestURI != "" {
+				path = r.RequestURI
+			}
+			header := r.Header.Clone()
+			host := r.Host
+			request := &server.SignedRequest{
+				Method: r.Method,
+				Path:   path,
+				Headers: func(name string) []string {
+					if name == "host" {
+						return []string{host}
+					}
+					return header.Values(name)
+				},
+				Body: body,
+			}
+			next.ServeHTTP(w, r.WithContext(server.WithSignedRequest(r.Context(), request)))
+		})
+	}
+}
+
+// metadataHeaders are the REST request headers passed on to the services as the incoming
+// metadata of calls, as for gRPC calls: those carrying credentials, and those the services
+// read options of calls from.
+var metadataHeaders = []string{"authorization", "x-goog-api-key", "x-goog-api-client", services.ResponseDelayHeader, services.PageTokensHeader, services.PageTokenTTLHeader}
+
+// serverTimeoutHeader is the header in which REST clients send the timeout of calls, in seconds,
+// as gRPC clients do in grpc-timeout.
+const serverTimeoutHeader = "X-Server-Timeout"
+
+// timeoutMiddleware gives REST calls sending a serverTimeoutHeader a deadline, as gRPC calls
+// sending a timeout have, so that the services observe it the same way.
+func timeoutMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value := r.Header.Get(serverTimeoutHeader)
+			if value == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			seconds, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			// Past math.MaxInt64 nanoseconds, the timeout would overflow time.Duration.
+			if err != nil || math.IsNaN(seconds) || seconds < 0 || seconds >= math.MaxInt64/float64(time.Second) {
+				rest.Error(w, http.StatusBadRequest, "the %s header must be a non-negative number of seconds, got %q", serverTimeoutHeader, value)
+				return
+			}
+			ctx, cancel := context.WithTimeout(r.Context(), time.Duration(seconds*float64(time.Second)))
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// altQueryParam is the query param in which REST clients may choose the encoding of responses
+// instead of sending an Accept header: "json", the default, or "proto" for binary protocol
+// buffers.
+const altQueryParam = "alt"
+
+// responseFormatMiddleware turns the altQueryParam of REST calls into the Accept header the
+// handlers negotiate the encoding of responses with, overriding any the calls sent, and removes
+// it from the query so that the handlers do not take it for a request field.
+func responseFormatMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			values, ok := r.URL.Query()[altQueryParam]
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(values) != 1 {
+				rest.Error(w, http.StatusBadRequest, "the %q query param must be given once, got %q", altQueryParam, values)
+				return
+			}
+			switch values[0] {
+			case "json":
+				r.Header.Set("Accept", "application/json")
+			case "proto":
+				r.Header.Set("Accept", resttools.HeaderValueContentTypeProto)
+			default:
+				rest.Error(w, http.StatusBadRequest, "the %q query param must be \"json\" or \"proto\", got %q", altQueryParam, values[0])
+				return
+			}
+			kept := []string{}
+			for _, param := range strings.Split(r.URL.RawQuery, "&") {
+				key := param
+				if idx := strings.Index(param, "="); idx >= 0 {
+					key = param[:idx]
+				}
+				if unescaped, err := url.QueryUnescape(key); err == nil && unescaped == altQueryParam {
+					continue
+				}
+				kept = append(kept, param)
+			}
+			r.URL.RawQuery = strings.Join(kept, "&")
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requestTooLargeHTML is the body of the 413 responses of front ends, which clients may be sent
+// instead of the JSON errors of servers.
+const requestTooLargeHTML = `<!DOCTYPE html>
+<html lang=en>
+<meta charset=utf-8>
+<title>Error 413 (Request Entity Too Large)!!1</title>
+<p><b>413.</b> <ins>That's an error.</ins>
+<p>Your client issued a request that was too large.
+<ins>That's all we know.</ins>
+`
+
+// bodyLimitMiddleware rejects the REST calls whose body is larger than limit bytes with 413,
+// unless limit is 0. The error is the JSON Status body of Google APIs, or the HTML page of a front
+// end if html is true.
+func bodyLimitMiddleware(backend *services.Backend, limit int, html bool) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	tooLarge := func(w http.ResponseWriter) {
+		requestTooLarge(backend, w, int64(limit), html)
+	}
+	return func(next http.Handler) http.Handler {
+		if limit <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > int64(limit) {
+				tooLarge(w)
+				return
+			}
+			if r.Body != nil && r.Body != http.NoBody {
+				body, err := ioutil.ReadAll(io.LimitReader(r.Body, int64(limit)+1))
+				r.Body.Close()
+				if err != nil {
+					rest.Error(w, http.StatusBadRequest, "error reading body content: %s", err)
+					return
+				}
+				if len(body) > limit {
+					tooLarge(w)
+					return
+				}
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requestTooLarge rejects a request whose body is larger than limit with 413, responding with
+// an HTML page, as front ends do, if html is true, and with a JSON error otherwise.
+func requestTooLarge(backend *services.Backend, w http.ResponseWriter, limit int64, html bool) {
+	message := fmt.Sprintf("the request body is larger than the limit of %d bytes", limit)
+	backend.ErrLog.Print(message)
+	if html {
+		w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		w.Write([]byte(requestTooLargeHTML))
+		return
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    http.StatusRequestEntityTooLarge,
+			"message": message,
+			"status":  "RESOURCE_EXHAUSTED",
+		},
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	w.Write(body)
+}
+
+const (
+	// retryAfterSeconds is the --retry-after-format of Retry-After headers holding a number of
+	// seconds.
+	retryAfterSeconds = "seconds"
+
+	// retryAfterHTTPDate is the --retry-after-format of Retry-After headers holding an HTTP date.
+	retryAfterHTTPDate = "http-date"
+)
+
+// retryAfterMiddleware adds a Retry-After header to the REST responses with 429 or 503 that do not
+// have one, asking clients to retry after delay, or after the pushback of the calls shed for
+// overload, unless delay is 0. The header holds a number of seconds, or an HTTP date if httpDate
+// is true.
+func retryAfterMiddleware(delay time.Duration, httpDate bool) gmux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		if delay <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(&retryAfterResponseWriter{ResponseWriter: w, delay: delay, httpDate: httpDate}, r)
+		})
+	}
+}
+
+// retryAfterResponseWriter adds a Retry-After header to the responses with 429 or 503.
+type retryAfterResponseWriter struct {
+	http.ResponseWriter
+	delay       time.Duration
+	httpDate    bool
+	wroteHeader bool
+}
+
+func (rw *retryAfterResponseWriter) WriteHeader(code int) {
+	header := rw.Header()
+	if !rw.wroteHeader && (code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable) && header.Get("Retry-After") == "" {
+		delay := rw.delay
+		if ms, err := strconv.ParseInt(header.Get(server.PushbackHeader), 10, 64); err == nil && ms >= 0 {
+			delay = time.Duration(ms) * time.Millisecond
+		}
+		if rw.httpDate {
+			header.Set("Retry-After", time.Now().Add(delay).UTC().Format(http.TimeFormat))
+		} else {
+			header.Set("Retry-After", strconv.FormatInt(int64(math.Ceil(delay.Seconds())), 10))
+		}
+	}
+	rw.wroteHeader = true
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *retryAfterResponseWriter) Write(data []byte) (int, error) {
+	rw.wroteHeader = true
+	return rw.ResponseWriter.Write(data)
+}
+
+func (rw *retryAfterResponseWriter) Flush() {
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (rw *retryAfterResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := rw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("the response writer cannot be hijacked")
+	}
+	return hijacker.Hijack()
+}
+
+// echoHeadersPath is the REST path of Echo.EchoHeaders, which is passed all the headers of its
+// calls, along with their Host.
+const echoHeadersPath = "/v1beta1/echo:headers"
+
+// metadataMiddleware passes the metadataHeaders of REST calls to the services in the incoming
+// metadata of the calls' context, so that they can tell who made them, and how, along with the
+// connection the calls were received on.
+func metadataMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			md := metadata.MD{}
+			if r.URL.Path == echoHeadersPath {
+				for name, values := range r.Header {
+					md.Append(name, values...)
+				}
+				md.Append("host", r.Host)
+			} else {
+				for _, name := range metadataHeaders {
+					if values := r.Header.Values(name); len(values) > 0 {
+						md.Append(name, values...)
+					}
+				}
+			}
+			if len(md) > 0 {
+				r = r.WithContext(metadata.NewIncomingContext(r.Context(), md))
+			}
+			next.ServeHTTP(w, r.WithContext(server.WithConnectionID(r.Context(), r.RemoteAddr)))
+		})
+	}
+}
+
+// busyWorkMiddleware does the backend's busy work before serving each REST call, mirroring what
+// the gRPC interceptors do.
+func busyWorkMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			backend.BusyWork.Do()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// serverManagedHeaders are the response headers net/http looks up by their canonical name, and
+// would add again if they were renamed.
+var serverManagedHeaders = map[string]bool{
+	"Content-Length":    true,
+	"Content-Type":      true,
+	"Date":              true,
+	"Transfer-Encoding": true,
+}
+
+// proxyMimicMiddleware makes REST calls behave as if they went through an intermediary with the
+// behaviors of the backend's ProxyMimic, mirroring what its gRPC interceptors do.
+func proxyMimicMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	proxy := backend.ProxyMimic
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if proxy.Has(server.ProxyForwardedHeaders) {
+				r.Header.Add("Via", server.ProxyVia)
+				if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+					r.Header.Add("X-Forwarded-For", host)
+				}
+				proto := "http"
+				if r.TLS != nil {
+					proto = "https"
+				}
+				r.Header.Set("X-Forwarded-Proto", proto)
+				r.Header.Set("X-Forwarded-Host", r.Host)
+				w.Header().Add("Via", server.ProxyVia)
+			}
+			pw := &proxiedResponseWriter{ResponseWriter: w, proxy: proxy}
+			next.ServeHTTP(pw, r)
+			if proxy.Has(server.ProxyStripTrailers) {
+				for name := range w.Header() {
+					if strings.HasPrefix(name, http.TrailerPrefix) {
+						delete(w.Header(), name)
+					}
+				}
+			}
+		})
+	}
+}
+
+// proxiedResponseWriter rewrites response headers the way the mimicked proxy would before they
+// are sent.
+type proxiedResponseWriter struct {
+	http.ResponseWriter
+	proxy       *server.ProxyMimic
+	wroteHeader bool
+}
+
+func (pw *proxiedResponseWriter) WriteHeader(code int) {
+	if !pw.wroteHeader {
+		pw.wroteHeader = true
+		header := pw.Header()
+		if pw.proxy.Has(server.ProxyStripTrailers) {
+			for _, declared := range header.Values("Trailer") {
+				for _, name := range strings.Split(declared, ",") {
+					header.Del(strings.TrimSpace(name))
+				}
+			}
+			header.Del("Trailer")
+		}
+		if pw.proxy.Has(server.ProxyLowercaseHeaders) {
+			for name, values := range header {
+				if serverManagedHeaders[name] || strings.HasPrefix(name, http.TrailerPrefix) {
+					continue
+				}
+				delete(header, name)
+				header[strings.ToLower(name)] = values
+			}
+		}
+		pw.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (pw *proxiedResponseWriter) Write(data []byte) (int, error) {
+	pw.WriteHeader(http.StatusOK)
+	return pw.ResponseWriter.Write(data)
+}
+
+func (pw *proxiedResponseWriter) Flush() {
+	if f, ok := pw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (pw *proxiedResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := pw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("the response writer cannot be hijacked")
+	}
+	return hijacker.Hijack()
+}
+
+// metricsMiddleware counts the REST calls served in the backend's Metrics, by the method and
+// path template of their route and the HTTP status they were answered with.
+func metricsMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method := r.Method + " " + routeTemplate(r)
+			start := time.Now()
+			mw := &meteredResponseWriter{ResponseWriter: w, code: http.StatusOK}
+			next.ServeHTTP(mw, r)
+			backend.Metrics.Observe("rest", method, strconv.Itoa(mw.code), time.Since(start))
+		})
+	}
+}
+
+// serverEventsMiddleware publishes to the backend's ServerEvents when REST calls start and
+// finish, naming them by the method and path template of their route.
+func serverEventsMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			finished := backend.ServerEvents.CallStarted("rest", r.Method+" "+routeTemplate(r))
+			mw := &meteredResponseWriter{ResponseWriter: w, code: http.StatusOK}
+			next.ServeHTTP(mw, r)
+			finished(strconv.Itoa(mw.code))
+		})
+	}
+}
+
+// routeTemplate returns the path template of the route r matched, or else its path.
+func routeTemplate(r *http.Request) string {
+	if route := gmux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return r.URL.Path
+}
+
+// meteredResponseWriter records the HTTP status a response is sent with.
+type meteredResponseWriter struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+}
+
+func (mw *meteredResponseWriter) WriteHeader(code int) {
+	if !mw.wroteHeader {
+		mw.wroteHeader = true
+		mw.code = code
+	}
+	mw.ResponseWriter.WriteHeader(code)
+}
+
+func (mw *meteredResponseWriter) Write(data []byte) (int, error) {
+	mw.wroteHeader = true
+	return mw.ResponseWriter.Write(data)
+}
+
+func (mw *meteredResponseWriter) Flush() {
+	if f, ok := mw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (mw *meteredResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := mw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("the response writer cannot be hijacked")
+	}
+	return hijacker.Hijack()
+}
+
+// cachedRESTResponse is a REST response kept in the backend's ResponseCache or IdempotencyKeys.
+type cachedRESTResponse struct {
+	header http.Header
+	body   []byte
+	code   int
+}
+
+// handlerHeaders returns the headers of after that are not in before: those the handler set, as
+// opposed to those the outer middlewares had already set for the call being served, such as its
+// trace context, session cookie and negotiated encoding.
+func handlerHeaders(before, after http.Header) http.Header {
+	written := http.Header{}
+	for name, values := range after {
+		if previous, ok := before[name]; !ok || !reflect.DeepEqual(previous, values) {
+			written[name] = append([]string(nil), values...)
+		}
+	}
+	return written
+}
+
+// responseCacheMiddleware answers the REST GETs from the backend's ResponseCache, when it has
+// one, if an identical GET succeeded within its TTL, mirroring what its gRPC interceptor does.
+func responseCacheMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if backend.ResponseCache == nil || r.Method != http.MethodGet {
+				next.ServeHTTP(w, r)
+				return
+			}
+			key := "rest GET " + r.URL.RequestURI()
+			if resttools.WantsProto(r.Header) {
+				key += " " + resttools.HeaderValueContentTypeProto
+			}
+			if cached, ok := backend.ResponseCache.Get(key); ok {
+				response := cached.(*cachedRESTResponse)
+				for name, values := range response.header {
+					w.Header()[name] = append([]string(nil), values...)
+				}
+				w.Header().Set(server.ResponseCacheHeader, server.ResponseCacheHit)
+				w.Write(response.body)
+				return
+			}
+			before := w.Header().Clone()
+			bw := &bufferingResponseWriter{ResponseWriter: w, code: http.StatusOK}
+			next.ServeHTTP(bw, r)
+			if bw.code == http.StatusOK {
+				backend.ResponseCache.Put(key, &cachedRESTResponse{
+					header: handlerHeaders(before, w.Header()),
+					body:   append([]byte(nil), bw.body.Bytes()...),
+					code:   bw.code,
+				})
+			}
+			w.Header().Set(server.ResponseCacheHeader, server.ResponseCacheMiss)
+			w.WriteHeader(bw.code)
+			w.Write(bw.body.Bytes())
+		})
+	}
+}
+
+// idempotentCreatePath matches the paths of the REST calls creating Messaging resources, whose
+// retries are deduplicated by their IdempotencyKeyHeader.
+var idempotentCreatePath = regexp.MustCompile(`^/v1beta1/(rooms|rooms/[^/]+/blurbs|users/[^/]+/profile/blurbs)$`)
+
+// idempotencyKeyMiddleware replays the response to the REST POSTs creating Messaging resources
+// that repeat the server.IdempotencyKeyHeader of an earlier one, instead of serving them again.
+// Requests repeating the key of a request still being served fail with 409, and those sending the
+// key of a different request with 422. Responses with 5xx are not remembered, so that the
+// requests may be retried.
+func idempotencyKeyMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(server.IdempotencyKeyHeader)
+			if backend.IdempotencyKeys == nil || key == "" || r.Method != http.MethodPost || !idempotentCreatePath.MatchString(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				rest.Error(w, http.StatusBadRequest, "error reading body content: %s", err)
+				return
+			}
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			fingerprint := sha256.Sum256(append([]byte(r.URL.RequestURI()+"\n"), body...))
+			replayed, finish, err := backend.IdempotencyKeys.Begin(key, string(fingerprint[:]))
+			switch {
+			case err == server.ErrIdempotencyKeyInUse:
+				rest.Error(w, http.StatusConflict, "%s %q: %s", server.IdempotencyKeyHeader, key, err)
+				return
+			case err == server.ErrIdempotencyKeyReused:
+				rest.Error(w, http.StatusUnprocessableEntity, "%s %q: %s", server.IdempotencyKeyHeader, key, err)
+				return
+			case replayed != nil:
+				response := replayed.(*cachedRESTResponse)
+				for name, values := range response.header {
+					w.Header()[name] = append([]string(nil), values...)
+				}
+				w.Header().Set(server.IdempotentReplayedHeader, "true")
+				w.WriteHeader(response.code)
+				w.Write(response.body)
+				return
+			}
+			before := w.Header().Clone()
+			bw := &bufferingResponseWriter{ResponseWriter: w, code: http.StatusOK}
+			next.ServeHTTP(bw, r)
+			if bw.code < http.StatusInternalServerError {
+				finish(&cachedRESTResponse{
+					header: handlerHeaders(before, w.Header()),
+					body:   append([]byte(nil), bw.body.Bytes()...),
+					code:   bw.code,
+				})
+			} else {
+				finish(nil)
+			}
+			w.WriteHeader(bw.code)
+			w.Write(bw.body.Bytes())
+		})
+	}
+}
+
+// tracingMiddleware traces the REST calls with the backend's Tracer, echoing the trace context
+// they carry, mirroring what its gRPC interceptors do.
+func tracingMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			traceparent := r.Header.Get(server.TraceparentHeader)
+			if traceparent != "" {
+				w.Header().Set(server.TraceparentHeader, traceparent)
+				if state := r.Header.Values(server.TracestateHeader); len(state) > 0 {
+					w.Header()[http.CanonicalHeaderKey(server.TracestateHeader)] = state
+				}
+			}
+			route := routeTemplate(r)
+			span := backend.Tracer.Start(r.Method+" "+route, traceparent)
+			span.Attributes["http.method"] = r.Method
+			span.Attributes["http.route"] = route
+			mw := &meteredResponseWriter{ResponseWriter: w, code: http.StatusOK}
+			next.ServeHTTP(mw, r)
+			span.Attributes["http.status_code"] = int64(mw.code)
+			backend.Tracer.End(span, mw.code >= 500, http.StatusText(mw.code))
+		})
+	}
+}
+
+// priorityMiddleware schedules the REST calls by the priority they send when the backend has a
+// PriorityScheduler, mirroring what its gRPC interceptors do.
+func priorityMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if backend.PriorityScheduler == nil || strings.HasPrefix(r.URL.Path, "/v1beta1/admin") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			priority, err := server.ParsePriority(r.Header.Values(server.PriorityHeader))
+			if err != nil {
+				rest.Error(w, http.StatusBadRequest, "%s", status.Convert(err).Message())
+				return
+			}
+			release, admission, err := backend.PriorityScheduler.Admit(r.Context(), priority)
+			if err != nil {
+				rest.Error(w, grpcHTTPStatus[status.Code(err)], "%s", status.Convert(err).Message())
+				return
+			}
+			defer release()
+			for key, values := range admission.Header() {
+				w.Header()[http.CanonicalHeaderKey(key)] = values
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// loadShedMiddleware sheds the REST calls beyond the limit of the backend's LoadShedder, when it
+// has one, answering them with a 503 and a PushbackHeader, mirroring what its gRPC interceptors
+// do.
+func loadShedMiddleware(backend *services.Backend) gmux.MiddlewareFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if backend.LoadShedder == nil || strings.HasPrefix(r.URL.Path, "/v1beta1/admin") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			release, err := backend.LoadShedder.Acquire(r.Method + " " + r.URL.Path)
+			if err != nil {
+				w.Header().Set(server.PushbackHeader, strconv.FormatInt(backend.LoadShedder.Pushback().Milliseconds(), 10))
+				rest.Error(w, http.StatusServiceUnavailable, "%s", status.Convert(err).Message())
+				return
+			}
+			defer release()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,1539 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"github.com/googleapis/gapic-showcase/server/services"
+	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// startRESTServer starts a REST server for tests, to be closed by the caller.
+func startRESTServer(t *testing.T, config RuntimeConfig) *httptest.Server {
+	server := httptest.NewUnstartedServer(nil)
+	server.Config = newEndpointREST(nil, config, createBackends(config)).server
+	server.Start()
+	return server
+}
+
+func TestRedirectMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+	jsonOptions := allowCompactJSON()
+	defer jsonOptions.Restore()
+
+	var redirects []*http.Request
+	client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		redirects = append(redirects, req)
+		return nil
+	}}
+
+	for _, testCase := range []struct {
+		header   string
+		wantHost string
+	}{
+		{header: "301"},
+		{header: "302"},
+		{header: "307"},
+		{header: "308"},
+		{header: "307 cross-origin", wantHost: "localhost"},
+	} {
+		redirects = nil
+		request, err := http.NewRequest("POST", server.URL+"/v1beta1/repeat:body", strings.NewReader(`{"info":{"fString":"hi"}}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		request.Header.Set(redirectHeader, testCase.header)
+
+		response, err := client.Do(request)
+		if err != nil {
+			t.Fatalf("%q: %v", testCase.header, err)
+		}
+		body, _ := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+
+		code := strings.Fields(testCase.header)[0]
+		if len(redirects) != 1 {
+			t.Errorf("%q: want one redirect, got %d", testCase.header, len(redirects))
+			continue
+		}
+		if got := response.Header.Get(redirectedHeader); got != code {
+			t.Errorf("%q: %s: got %q, want %q", testCase.header, redirectedHeader, got, code)
+		}
+		if testCase.wantHost != "" && redirects[0].URL.Hostname() != testCase.wantHost {
+			t.Errorf("%q: redirected to %s, want host %s", testCase.header, redirects[0].URL, testCase.wantHost)
+		}
+
+		// 307 and 308 preserve the method and body; 301 and 302 turn a POST into a GET, which
+		// this method does not accept.
+		preserved := code == "307" || code == "308"
+		if preserved && (response.StatusCode != http.StatusOK || !strings.Contains(string(body), `"fString":"hi"`)) {
+			t.Errorf("%q: got %d %s, want the echoed request", testCase.header, response.StatusCode, body)
+		}
+		if !preserved && redirects[0].Method != "GET" {
+			t.Errorf("%q: redirected with %s, want GET", testCase.header, redirects[0].Method)
+		}
+	}
+}
+
+func TestRedirectMiddleware_noFollow(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	errNoFollow := errors.New("redirects not followed")
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return errNoFollow }}
+
+	request, err := http.NewRequest("GET", server.URL+"/v1beta1/repeat:query?info.fString=hi", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resttools.PopulateRequestHeaders(request)
+	request.Header.Set(redirectHeader, "308 cross-origin")
+	_, err = client.Do(request)
+	urlErr := &url.Error{}
+	if !errors.As(err, &urlErr) || !errors.Is(err, errNoFollow) {
+		t.Fatalf("want the redirect to be refused, got %v", err)
+	}
+	location, _ := url.Parse(urlErr.URL)
+	if location.Hostname() != "localhost" || location.Query().Get("info.fString") != "hi" || location.Query().Get(redirectedParam) != "308" {
+		t.Errorf("unexpected redirect location %s", urlErr.URL)
+	}
+
+	request.Header.Set(redirectHeader, "200")
+	response, err := client.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusBadRequest {
+		t.Errorf("non-redirect status: got %d, want 400", response.StatusCode)
+	}
+}
+
+func compress(t *testing.T, encoding string, data []byte) []byte {
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "deflate":
+		w = zlib.NewWriter(&buf)
+	case "zstd":
+		w, _ = zstd.NewWriter(&buf)
+	default:
+		return data
+	}
+	w.Write(data)
+	w.Close()
+	return buf.Bytes()
+}
+
+func decompressBody(t *testing.T, encoding string, data []byte) []byte {
+	var r io.Reader = bytes.NewReader(data)
+	var err error
+	switch encoding {
+	case "gzip":
+		r, err = gzip.NewReader(r)
+	case "deflate":
+		r, err = zlib.NewReader(r)
+	case "zstd":
+		r, err = zstd.NewReader(r)
+	}
+	if err != nil {
+		t.Fatalf("decompressing %s: %v", encoding, err)
+	}
+	out, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("decompressing %s: %v", encoding, err)
+	}
+	return out
+}
+
+func TestCompressionMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+	jsonOptions := allowCompactJSON()
+	defer jsonOptions.Restore()
+	client := &http.Client{Transport: &http.Transport{DisableCompression: true}}
+
+	for _, testCase := range []struct {
+		contentEncoding string
+		acceptEncoding  string
+		want            string
+	}{
+		{contentEncoding: "", acceptEncoding: "", want: "identity"},
+		{contentEncoding: "gzip", acceptEncoding: "gzip", want: "gzip"},
+		{contentEncoding: "deflate", acceptEncoding: "deflate, gzip;q=0.5", want: "deflate"},
+		{contentEncoding: "zstd", acceptEncoding: "zstd;q=1.0, gzip;q=0.9", want: "zstd"},
+		{contentEncoding: "gzip", acceptEncoding: "br, *;q=0.1", want: "gzip"},
+		{contentEncoding: "identity", acceptEncoding: "gzip;q=0, br", want: "identity"},
+	} {
+		body := compress(t, testCase.contentEncoding, []byte(`{"info":{"fString":"squeezed"}}`))
+		request, err := http.NewRequest("POST", server.URL+"/v1beta1/repeat:body", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		if testCase.contentEncoding != "" {
+			request.Header.Set("Content-Encoding", testCase.contentEncoding)
+		}
+		if testCase.acceptEncoding != "" {
+			request.Header.Set("Accept-Encoding", testCase.acceptEncoding)
+		}
+
+		response, err := client.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		raw, _ := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+
+		label := testCase.contentEncoding + "/" + testCase.acceptEncoding
+		if response.StatusCode != http.StatusOK {
+			t.Errorf("%s: got status %d: %s", label, response.StatusCode, raw)
+			continue
+		}
+		wantRequestEncoding := testCase.contentEncoding
+		if wantRequestEncoding == "" {
+			wantRequestEncoding = "identity"
+		}
+		if got := response.Header.Get(requestEncodingHeader); got != wantRequestEncoding {
+			t.Errorf("%s: %s: got %q, want %q", label, requestEncodingHeader, got, wantRequestEncoding)
+		}
+		if got := response.Header.Get(negotiatedEncodingHeader); got != testCase.want {
+			t.Errorf("%s: %s: got %q, want %q", label, negotiatedEncodingHeader, got, testCase.want)
+		}
+		wantContentEncoding := testCase.want
+		if wantContentEncoding == "identity" {
+			wantContentEncoding = ""
+		}
+		if got := response.Header.Get("Content-Encoding"); got != wantContentEncoding {
+			t.Errorf("%s: Content-Encoding: got %q, want %q", label, got, wantContentEncoding)
+		}
+		if got := string(decompressBody(t, wantContentEncoding, raw)); !strings.Contains(got, `"fString":"squeezed"`) {
+			t.Errorf("%s: unexpected body %q", label, got)
+		}
+	}
+}
+
+func TestCompressionMiddleware_tooLarge(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{maxRequestBytes: 512})
+	defer server.Close()
+
+	for _, testCase := range []struct {
+		body []byte
+		want int
+	}{
+		{[]byte(`{"info":{"fString":"squeezed"}}`), http.StatusOK},
+		// Compressed, the body is well under the limit.
+		{[]byte(`{"info":{"fString":"` + strings.Repeat("0", 200000) + `"}}`), http.StatusRequestEntityTooLarge},
+	} {
+		body := compress(t, "gzip", testCase.body)
+		if len(body) > 512 {
+			t.Fatalf("the compressed body of %d bytes is over the limit", len(body))
+		}
+		request, err := http.NewRequest("POST", server.URL+"/v1beta1/repeat:body", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		request.Header.Set("Content-Encoding", "gzip")
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		raw, _ := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if response.StatusCode != testCase.want {
+			t.Errorf("body decoding to %d bytes: got status %d, want %d: %s", len(testCase.body), response.StatusCode, testCase.want, raw)
+		}
+	}
+}
+
+func TestCompressionMiddleware_unsupported(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	request, err := http.NewRequest("POST", server.URL+"/v1beta1/repeat:body", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resttools.PopulateRequestHeaders(request)
+	request.Header.Set("Content-Encoding", "br")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusUnsupportedMediaType {
+		t.Errorf("got status %d, want 415", response.StatusCode)
+	}
+}
+
+func TestCompressionMiddleware_forceGzip(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{forceGzip: true})
+	defer server.Close()
+	client := &http.Client{Transport: &http.Transport{DisableCompression: true}}
+
+	request, err := http.NewRequest("POST", server.URL+"/v1beta1/repeat:body", strings.NewReader(`{"info":{"fString":"squeezed"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resttools.PopulateRequestHeaders(request)
+	request.Header.Set("Accept-Encoding", "identity")
+	response, err := client.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, _ := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if got := response.Header.Get("Content-Encoding"); got != "gzip" {
+		t.Fatalf("Content-Encoding: got %q, want gzip", got)
+	}
+	if got := string(decompressBody(t, "gzip", raw)); !strings.Contains(got, "squeezed") {
+		t.Errorf("unexpected body %q", got)
+	}
+}
+
+func TestFramingMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+	jsonOptions := allowCompactJSON()
+	defer jsonOptions.Restore()
+
+	for _, framing := range []string{"chunked", "content-length", "close"} {
+		conn, err := net.Dial("tcp", server.Listener.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		request, err := http.NewRequest("POST", server.URL+"/v1beta1/repeat:body", strings.NewReader(`{"info":{"fString":"a body long enough to be sent in several chunks"}}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		request.Header.Set(framingHeader, framing)
+		if err := request.Write(conn); err != nil {
+			t.Fatal(err)
+		}
+
+		response, err := http.ReadResponse(bufio.NewReader(conn), request)
+		if err != nil {
+			t.Fatalf("%s: %v", framing, err)
+		}
+		body, err := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		conn.Close()
+		if err != nil {
+			t.Fatalf("%s: reading body: %v", framing, err)
+		}
+		if response.StatusCode != http.StatusOK || !strings.Contains(string(body), "several chunks") {
+			t.Errorf("%s: got %d %s", framing, response.StatusCode, body)
+		}
+
+		chunked := len(response.TransferEncoding) == 1 && response.TransferEncoding[0] == "chunked"
+		switch framing {
+		case "chunked":
+			if !chunked || response.ContentLength != -1 {
+				t.Errorf("chunked: got Transfer-Encoding %v, Content-Length %d", response.TransferEncoding, response.ContentLength)
+			}
+		case "content-length":
+			if chunked || response.ContentLength != int64(len(body)) {
+				t.Errorf("content-length: got Transfer-Encoding %v, Content-Length %d for %d bytes", response.TransferEncoding, response.ContentLength, len(body))
+			}
+		case "close":
+			if chunked || response.ContentLength != -1 || !response.Close {
+				t.Errorf("close: got Transfer-Encoding %v, Content-Length %d, Close %v", response.TransferEncoding, response.ContentLength, response.Close)
+			}
+		}
+	}
+}
+
+func TestDripMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+	jsonOptions := allowCompactJSON()
+	defer jsonOptions.Restore()
+
+	newRequest := func() *http.Request {
+		request, err := http.NewRequest("GET", server.URL+"/v1beta1/repeat:query?info.fString=trickle", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		request.Header.Set(dripHeader, "400ms")
+		request.Header.Set(dripBytesHeader, "8")
+		return request
+	}
+
+	start := time.Now()
+	response, err := http.DefaultClient.Do(newRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	headersAfter := time.Since(start)
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	bodyAfter := time.Since(start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), `"fString":"trickle"`) {
+		t.Errorf("unexpected body %s", body)
+	}
+	if headersAfter > 200*time.Millisecond {
+		t.Errorf("headers took %v, want them sent straight away", headersAfter)
+	}
+	if bodyAfter < 400*time.Millisecond {
+		t.Errorf("body took %v, want at least 400ms", bodyAfter)
+	}
+
+	// An overall timeout shorter than the drip fails even though every read is quick.
+	client := &http.Client{Timeout: 200 * time.Millisecond}
+	response, err = client.Do(newRequest())
+	if err == nil {
+		_, err = ioutil.ReadAll(response.Body)
+		response.Body.Close()
+	}
+	if err == nil {
+		t.Error("want the overall client timeout to expire")
+	}
+}
+
+func TestProxyMimicMiddleware(t *testing.T) {
+	proxy, err := server.NewProxyMimic([]string{"forwarded-headers", "strip-trailers", "lowercase-headers"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var seen http.Header
+	handler := proxyMimicMiddleware(&services.Backend{ProxyMimic: proxy})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r.Header
+		w.Header().Set("Trailer", "X-Showcase-Checksum")
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Showcase-Custom", "kept")
+		w.Write([]byte("{}"))
+		w.Header().Set("X-Showcase-Checksum", "dropped")
+		w.Header().Set(http.TrailerPrefix+"X-Showcase-Late", "dropped")
+	}))
+
+	request := httptest.NewRequest("GET", "http://localhost:7469/v1beta1/repeat:query", nil)
+	request.RemoteAddr = "192.0.2.1:5000"
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	for name, want := range map[string]string{
+		"Via":               server.ProxyVia,
+		"X-Forwarded-For":   "192.0.2.1",
+		"X-Forwarded-Proto": "http",
+		"X-Forwarded-Host":  "localhost:7469",
+	} {
+		if got := seen.Get(name); got != want {
+			t.Errorf("request header %s: got %q, want %q", name, got, want)
+		}
+	}
+
+	header := recorder.Result().Header
+	if got := header["via"]; len(got) != 1 || got[0] != server.ProxyVia {
+		t.Errorf("response header via: got %q, want %q", got, server.ProxyVia)
+	}
+	if got := header["x-showcase-custom"]; len(got) != 1 || got[0] != "kept" {
+		t.Errorf("response header x-showcase-custom: got %q", got)
+	}
+	if got := header["Content-Type"]; len(got) != 1 {
+		t.Errorf("response header Content-Type: got %q, want it left canonical", got)
+	}
+	if len(recorder.Result().Trailer) != 0 || header.Get("Trailer") != "" {
+		t.Errorf("trailers not stripped: header %v, trailer %v", header, recorder.Result().Trailer)
+	}
+}
+
+func TestBarrierMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+	jsonOptions := allowCompactJSON()
+	defer jsonOptions.Restore()
+
+	post := func(path, body string, header http.Header) (int, string) {
+		request, err := http.NewRequest("POST", server.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		for name, values := range header {
+			request.Header[name] = values
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+		got, _ := ioutil.ReadAll(response.Body)
+		return response.StatusCode, string(got)
+	}
+
+	if code, body := post("/v1beta1/echo:echo", `{"content":"hi"}`, http.Header{"X-Showcase-Barrier": {"unarmed"}}); code != http.StatusBadRequest {
+		t.Errorf("joining an unarmed barrier: want %d, got %d %s", http.StatusBadRequest, code, body)
+	}
+	if code, body := post("/v1beta1/barriers", `{"barrierId":"rest"}`, nil); code != http.StatusOK {
+		t.Fatalf("ArmBarrier: got %d %s", code, body)
+	}
+
+	done := make(chan string, 2)
+	for _, tag := range []string{"first", "second"} {
+		go func(tag string) {
+			post("/v1beta1/echo:echo", `{"content":"hi"}`, http.Header{"X-Showcase-Barrier": {"rest"}, "X-Showcase-Barrier-Tag": {tag}})
+			done <- tag
+		}(tag)
+	}
+	code, body := post("/v1beta1/barriers/rest:release", `{"order":["second","first"],"waitForCalls":2}`, nil)
+	released := &pb.ReleaseBarrierResponse{}
+	if err := protojson.Unmarshal([]byte(body), released); code != http.StatusOK || err != nil {
+		t.Fatalf("ReleaseBarrier: got %d %s", code, body)
+	}
+	if want := []string{"second", "first"}; !reflect.DeepEqual(released.GetReleased(), want) {
+		t.Errorf("ReleaseBarrier: want %v released, got %v", want, released.GetReleased())
+	}
+	for i := 0; i < 2; i++ {
+		<-done
+	}
+}
+
+func TestAuthorityMiddleware(t *testing.T) {
+	config := RuntimeConfig{}
+	backend := createBackends(config)
+	server := httptest.NewServer(newEndpointREST(nil, config, backend).server.Handler)
+	defer server.Close()
+
+	post := func(path, host, body string) int {
+		request, err := http.NewRequest("POST", server.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		request.Host = host
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		return response.StatusCode
+	}
+
+	if code := post("/v1beta1/transport/authorities:expect", "localhost", `{"authority":"us-east1.example.com"}`); code != http.StatusOK {
+		t.Fatalf("ExpectAuthority: got %d", code)
+	}
+	if code := post("/v1beta1/echo:echo", "localhost", `{"content":"hi"}`); code != http.StatusMisdirectedRequest {
+		t.Errorf("Echo to an unexpected host: want %d, got %d", http.StatusMisdirectedRequest, code)
+	}
+	if code := post("/v1beta1/echo:echo", "us-east1.example.com", `{"content":"hi"}`); code != http.StatusOK {
+		t.Errorf("Echo to the expected host: want %d, got %d", http.StatusOK, code)
+	}
+
+	records := backend.AuthorityRecorder.Records("POST /v1beta1/echo:echo")
+	if len(records) != 2 || !records[0].GetRejected() || records[1].GetAuthority() != "us-east1.example.com" || records[1].GetTransport() != "rest" {
+		t.Errorf("Records: got %v", records)
+	}
+}
+
+func TestUniverseDomainMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{universeDomain: "example.com"})
+	defer server.Close()
+
+	for _, testCase := range []struct {
+		host          string
+		authorization string
+		want          int
+	}{
+		{host: "showcase.example.com", authorization: "Bearer token", want: http.StatusOK},
+		{host: "localhost", authorization: "Bearer token", want: http.StatusMisdirectedRequest},
+		{host: "showcase.example.com", want: http.StatusUnauthorized},
+	} {
+		request, err := http.NewRequest("POST", server.URL+"/v1beta1/echo:echo", strings.NewReader(`{"content":"hi"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		request.Host = testCase.host
+		if testCase.authorization != "" {
+			request.Header.Set("Authorization", testCase.authorization)
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		if response.StatusCode != testCase.want {
+			t.Errorf("host %q, authorization %q: want %d, got %d", testCase.host, testCase.authorization, testCase.want, response.StatusCode)
+		}
+	}
+}
+
+func TestFaultMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{faultRate: 1, faultCode: "RESOURCE_EXHAUSTED"})
+	defer server.Close()
+
+	request, err := http.NewRequest("POST", server.URL+"/v1beta1/echo:echo", strings.NewReader(`{"content":"hi"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resttools.PopulateRequestHeaders(request)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("want status %d, got %d", http.StatusTooManyRequests, response.StatusCode)
+	}
+	if want := "showcase fault injected into the call to POST /v1beta1/echo:echo"; string(body) != want {
+		t.Errorf("want body %q, got %q", want, body)
+	}
+
+	// Operations are polled whatever the faults, as over gRPC.
+	request, err = http.NewRequest("POST", server.URL+"/v1beta1/operations/google.showcase.v1beta1.Echo/Wait/unknown:wait", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resttools.PopulateRequestHeaders(request)
+	response, err = http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if response.StatusCode == http.StatusTooManyRequests {
+		t.Errorf("WaitOperation: want the call not faulted, got status %d", response.StatusCode)
+	}
+}
+
+func TestShowcaseAdmin_faults(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	do := func(method, path, body string) int {
+		request, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		return response.StatusCode
+	}
+	for _, step := range []struct {
+		method, path, body string
+		wantCode           int
+	}{
+		{"PATCH", "/v1beta1/admin/config", `{"faultRate":1,"faultCode":"UNAVAILABLE"}`, http.StatusOK},
+		{"POST", "/v1beta1/echo:echo", `{"content":"hi"}`, http.StatusServiceUnavailable},
+		{"GET", "/v1beta1/admin/config", "", http.StatusOK},
+		{"POST", "/v1beta1/admin:reset", "{}", http.StatusOK},
+		{"POST", "/v1beta1/echo:echo", `{"content":"hi"}`, http.StatusOK},
+	} {
+		if got := do(step.method, step.path, step.body); got != step.wantCode {
+			t.Errorf("%s %s: want status %d, got %d", step.method, step.path, step.wantCode, got)
+		}
+	}
+}
+
+func TestCorruptionMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{corruptFraction: 1, corruptionModes: []string{"truncate"}})
+	defer server.Close()
+
+	for _, testCase := range []struct {
+		body     string
+		wantCode int
+		wantBody string
+	}{
+		{body: `{"content":"hi"}`, wantCode: http.StatusOK},
+		{body: `{"error":{"code":3,"message":"invalid"}}`, wantCode: http.StatusInternalServerError, wantBody: "showcase server error: rpc error: code = InvalidArgument desc = invalid"},
+	} {
+		request, err := http.NewRequest("POST", server.URL+"/v1beta1/echo:echo", strings.NewReader(testCase.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.StatusCode != testCase.wantCode {
+			t.Errorf("%s: want status %d, got %d", testCase.body, testCase.wantCode, response.StatusCode)
+		}
+		if testCase.wantCode == http.StatusOK && json.Valid(body) {
+			t.Errorf("%s: want a corrupted body, got %q", testCase.body, body)
+		}
+		if testCase.wantBody != "" && strings.TrimSpace(string(body)) != testCase.wantBody {
+			t.Errorf("%s: error bodies should be intact: want %q, got %q", testCase.body, testCase.wantBody, body)
+		}
+	}
+}
+
+func TestStickySessionMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{restSessions: "require", restSessionBackends: 2})
+	defer server.Close()
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Jar: jar}
+
+	echo := func(client *http.Client) *http.Response {
+		request, err := http.NewRequest("POST", server.URL+"/v1beta1/echo:echo", strings.NewReader(`{"content":"hi"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		response, err := client.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		return response
+	}
+
+	first := echo(client)
+	if first.StatusCode != http.StatusPreconditionRequired {
+		t.Errorf("calls without a session: want %d, got %d", http.StatusPreconditionRequired, first.StatusCode)
+	}
+	session := first.Header.Get("X-Showcase-Session")
+	if session == "" || first.Header.Get("X-Showcase-Session-Backend") != "backend-0" {
+		t.Errorf("calls without a session: want a session on backend-0, got headers %v", first.Header)
+	}
+	for i := 0; i < 2; i++ {
+		response := echo(client)
+		if response.StatusCode != http.StatusOK {
+			t.Errorf("call %d with the cookie: want %d, got %d", i, http.StatusOK, response.StatusCode)
+		}
+		if got := response.Header.Get("X-Showcase-Session"); got != session {
+			t.Errorf("call %d with the cookie: want session %q, got %q", i, session, got)
+		}
+		if got := response.Header.Get("X-Showcase-Session-Backend"); got != "backend-0" {
+			t.Errorf("call %d with the cookie: want it kept on backend-0, got %q", i, got)
+		}
+	}
+
+	if other := echo(http.DefaultClient); other.Header.Get("X-Showcase-Session-Backend") != "backend-1" {
+		t.Errorf("a new client: want its session on backend-1, got %q", other.Header.Get("X-Showcase-Session-Backend"))
+	}
+}
+
+func TestSignatureMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{restPathPrefix: "/api", signingKey: "key"})
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	body := `{"payload":"aGk="}`
+	digest := sha256.Sum256([]byte(body))
+	canonical := "SHOWCASE-HMAC-SHA256\n" +
+		"POST /api/v1beta1/transport:verifySignature\n" +
+		"host:" + host + "\n" +
+		hex.EncodeToString(digest[:])
+	mac := hmac.New(sha256.New, []byte("key"))
+	mac.Write([]byte(canonical))
+
+	for _, testCase := range []struct {
+		signature string
+		wantValid bool
+	}{
+		{signature: hex.EncodeToString(mac.Sum(nil)), wantValid: true},
+		{signature: "00", wantValid: false},
+	} {
+		request, err := http.NewRequest("POST", server.URL+"/api/v1beta1/transport:verifySignature", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		request.Header.Set("X-Showcase-Signed-Headers", "host")
+		request.Header.Set("X-Showcase-Signature", testCase.signature)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.StatusCode != http.StatusOK {
+			t.Fatalf("want status %d, got %d: %s", http.StatusOK, response.StatusCode, got)
+		}
+		verification := &pb.VerifySignatureResponse{}
+		if err := protojson.Unmarshal(got, verification); err != nil {
+			t.Fatal(err)
+		}
+		if verification.GetValid() != testCase.wantValid || verification.GetCanonicalRequest() != canonical {
+			t.Errorf("signature %q: want valid %v over\n%s\ngot %v", testCase.signature, testCase.wantValid, canonical, verification)
+		}
+	}
+}
+
+func TestMetadataMiddleware(t *testing.T) {
+	config := RuntimeConfig{}
+	backend := createBackends(config)
+	restServer := httptest.NewUnstartedServer(nil)
+	restServer.Config = newEndpointREST(nil, config, backend).server
+	restServer.Start()
+	defer restServer.Close()
+
+	request, err := http.NewRequest("POST", restServer.URL+"/v1beta1/users", strings.NewReader(`{"user":{"displayName":"Alice","email":"alice@example.com"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resttools.PopulateRequestHeaders(request)
+	request.Header.Set("Authorization", "Bearer alice")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("want %d creating a user, got %d", http.StatusOK, response.StatusCode)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var event *pb.AuditEvent
+	backend.AuditLog.Tail(ctx, "users/", true, func(e *pb.AuditEvent) error {
+		event = e
+		cancel()
+		return nil
+	})
+	if got := event.GetActor(); got != "bearer:alice" {
+		t.Errorf("want the REST call audited as made by %q, got %q", "bearer:alice", got)
+	}
+}
+
+func TestMetadataMiddleware_responseDelay(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	request, err := http.NewRequest("POST", server.URL+"/v1beta1/echo:echo", strings.NewReader(`{"content":"hi"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resttools.PopulateRequestHeaders(request)
+	request.Header.Set(services.ResponseDelayHeader, "30ms")
+	start := time.Now()
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if elapsed := time.Since(start); response.StatusCode != http.StatusOK || elapsed < 30*time.Millisecond {
+		t.Errorf("want a %d response after 30ms, got %d after %v", http.StatusOK, response.StatusCode, elapsed)
+	}
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	backend := createBackends(RuntimeConfig{})
+	server := httptest.NewUnstartedServer(nil)
+	server.Config = newEndpointREST(nil, RuntimeConfig{}, backend).server
+	server.Start()
+	defer server.Close()
+
+	postREST(t, server.URL+"/v1beta1/echo:echo", `{"content":"hello"}`)
+	postREST(t, server.URL+"/v1beta1/echo:echo", `{"error":{"code":5}}`)
+	getREST(t, server.URL+"/v1beta1/users/nobody")
+
+	var metrics strings.Builder
+	if err := backend.Metrics.Write(&metrics); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`showcase_rpc_calls_total{method="POST /v1beta1/echo:echo",transport="rest",code="200"} 1`,
+		`showcase_rpc_calls_total{method="POST /v1beta1/echo:echo",transport="rest",code="500"} 1`,
+		`showcase_rpc_calls_total{method="GET /v1beta1/{name:users/.+}",transport="rest",code="500"} 1`,
+		`showcase_rpc_duration_seconds_count{method="POST /v1beta1/echo:echo",transport="rest"} 2`,
+	} {
+		if !strings.Contains(metrics.String(), want) {
+			t.Errorf("metrics: want %q, got\n%s", want, metrics.String())
+		}
+	}
+}
+
+func TestServerEventsMiddleware(t *testing.T) {
+	backend := createBackends(RuntimeConfig{})
+	server := httptest.NewUnstartedServer(nil)
+	server.Config = newEndpointREST(nil, RuntimeConfig{}, backend).server
+	server.Start()
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan *pb.ServerEvent, 100)
+	go backend.ServerEvents.Tail(ctx, []pb.ServerEvent_Kind{pb.ServerEvent_CALL_STARTED, pb.ServerEvent_FAULT_INJECTED, pb.ServerEvent_STATE_MUTATED}, func(event *pb.ServerEvent) error {
+		received <- event
+		return nil
+	})
+
+	// Fail calls until the tail, once subscribed, gets the fault injected into one.
+	backend.FaultInjector.Configure(1, codes.Unavailable)
+	var fault *pb.ServerEvent
+	for fault == nil {
+		postREST(t, server.URL+"/v1beta1/echo:echo", `{"content":"hello"}`)
+		select {
+		case event := <-received:
+			if event.GetKind() == pb.ServerEvent_FAULT_INJECTED {
+				fault = event
+			}
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	want := &pb.ServerEvent{Kind: pb.ServerEvent_FAULT_INJECTED, Transport: "rest", Method: "POST /v1beta1/echo:echo", Status: "503"}
+	if fault.GetTransport() != want.GetTransport() || fault.GetMethod() != want.GetMethod() || fault.GetStatus() != want.GetStatus() {
+		t.Errorf("want event %v, got %v", want, fault)
+	}
+
+	backend.FaultInjector.Configure(0, codes.Unavailable)
+	if code, body := postREST(t, server.URL+"/v1beta1/rooms", `{"room":{"displayName":"events"}}`); code != http.StatusOK {
+		t.Fatalf("CreateRoom: got %d %s", code, body)
+	}
+	var started bool
+	for event := range received {
+		if event.GetKind() == pb.ServerEvent_CALL_STARTED && event.GetMethod() == "POST /v1beta1/rooms" {
+			started = true
+		}
+		if event.GetKind() == pb.ServerEvent_STATE_MUTATED {
+			if !started || event.GetMethod() != "/google.showcase.v1beta1.Messaging/CreateRoom" || !strings.HasPrefix(event.GetResource(), "rooms/") {
+				t.Errorf("want the start of the CreateRoom call, then the room created; got %v", event)
+			}
+			break
+		}
+	}
+}
+
+func TestResponseCacheMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{responseCacheTTL: time.Minute})
+	defer server.Close()
+
+	code, body := postREST(t, server.URL+"/v1beta1/users", `{"user":{"displayName":"cached","email":"cached@example.com"}}`)
+	if code != http.StatusOK {
+		t.Fatalf("CreateUser: got %d %s", code, body)
+	}
+	var user struct{ Name string }
+	if err := json.Unmarshal(body, &user); err != nil {
+		t.Fatal(err)
+	}
+
+	var bodies []string
+	for _, want := range []string{"MISS", "HIT"} {
+		request, err := http.NewRequest("GET", server.URL+"/v1beta1/"+user.Name, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := response.Header.Get("X-Showcase-Cache"); response.StatusCode != http.StatusOK || got != want {
+			t.Errorf("GetUser: want 200 with cache status %s, got %d with %q", want, response.StatusCode, got)
+		}
+		bodies = append(bodies, string(data))
+	}
+	if bodies[0] != bodies[1] {
+		t.Errorf("GetUser: want the cached response %s, got %s", bodies[0], bodies[1])
+	}
+
+	// Repeating a CreateUser serves it again, failing for the email already being taken.
+	code, body = postREST(t, server.URL+"/v1beta1/users", `{"user":{"displayName":"cached","email":"cached@example.com"}}`)
+	if code == http.StatusOK || !strings.Contains(string(body), "AlreadyExists") {
+		t.Errorf("repeated CreateUser: want AlreadyExists, got %d %s", code, body)
+	}
+}
+
+func TestResponseCacheMiddleware_callHeaders(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{responseCacheTTL: time.Minute, restSessions: "issue", restSessionBackends: 2})
+	defer server.Close()
+
+	code, body := postREST(t, server.URL+"/v1beta1/users", `{"user":{"displayName":"cached","email":"cached@example.com"}}`)
+	if code != http.StatusOK {
+		t.Fatalf("CreateUser: got %d %s", code, body)
+	}
+	var user struct{ Name string }
+	if err := json.Unmarshal(body, &user); err != nil {
+		t.Fatal(err)
+	}
+
+	// Two clients, each with a trace and a session of its own, get the same cached user.
+	sessions := map[string]bool{}
+	for _, testCase := range []struct {
+		traceparent, cache string
+	}{
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "MISS"},
+		{"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", "HIT"},
+	} {
+		request, err := http.NewRequest("GET", server.URL+"/v1beta1/"+user.Name, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		request.Header.Set("traceparent", testCase.traceparent)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		if got := response.Header.Get("X-Showcase-Cache"); got != testCase.cache {
+			t.Errorf("GetUser: want cache status %s, got %q", testCase.cache, got)
+		}
+		if got := response.Header.Get("traceparent"); got != testCase.traceparent {
+			t.Errorf("GetUser (%s): want its own traceparent %s echoed, got %q", testCase.cache, testCase.traceparent, got)
+		}
+		session := response.Header.Get("X-Showcase-Session")
+		cookies := response.Cookies()
+		if len(cookies) != 1 || cookies[0].Value != session {
+			t.Errorf("GetUser (%s): want the cookie of the session %s issued, got %v", testCase.cache, session, cookies)
+		}
+		sessions[session] = true
+	}
+	if len(sessions) != 2 {
+		t.Errorf("GetUser: want each client issued a session of its own, got %v", sessions)
+	}
+}
+
+func TestTracingMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	for _, sent := range []string{traceparent, ""} {
+		request, err := http.NewRequest("POST", server.URL+"/v1beta1/echo:echo", strings.NewReader(`{"content":"traced"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		if sent != "" {
+			request.Header.Set("traceparent", sent)
+			request.Header.Set("tracestate", "vendor=value")
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		if got := response.Header.Get("traceparent"); got != sent {
+			t.Errorf("traceparent %q: got %q echoed", sent, got)
+		}
+		if got, want := response.Header.Get("tracestate"), map[bool]string{true: "vendor=value"}[sent != ""]; got != want {
+			t.Errorf("traceparent %q: got tracestate %q echoed, want %q", sent, got, want)
+		}
+	}
+}
+
+func TestLoadShedMiddleware(t *testing.T) {
+	backend := createBackends(RuntimeConfig{loadShedLimit: 1, loadShedPushback: 2 * time.Second})
+	started, release := make(chan struct{}), make(chan struct{})
+	handler := loadShedMiddleware(backend)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/v1beta1/admin") {
+			started <- struct{}{}
+			<-release
+		}
+	}))
+
+	served := make(chan int)
+	go func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/v1beta1/users", nil))
+		served <- recorder.Code
+	}()
+	<-started
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/v1beta1/users", nil))
+	if recorder.Code != http.StatusServiceUnavailable || recorder.Header().Get("Grpc-Retry-Pushback-Ms") != "2000" {
+		t.Errorf("call beyond the limit: want 503 with a 2000ms pushback, got %d with %q", recorder.Code, recorder.Header().Get("Grpc-Retry-Pushback-Ms"))
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/v1beta1/admin/config", nil))
+	if recorder.Code != http.StatusOK {
+		t.Errorf("admin call beyond the limit: want it served, got %d", recorder.Code)
+	}
+
+	close(release)
+	if code := <-served; code != http.StatusOK {
+		t.Errorf("call within the limit: want 200, got %d", code)
+	}
+}
+
+func TestPriorityMiddleware(t *testing.T) {
+	backend := createBackends(RuntimeConfig{prioritySlots: 1})
+	started, release := make(chan struct{}), make(chan struct{})
+	handler := priorityMiddleware(backend)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("block") != "" {
+			started <- struct{}{}
+			<-release
+		}
+	}))
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest("GET", "/v1beta1/users", nil)
+	request.Header.Set(server.PriorityHeader, "high")
+	handler.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("call with an invalid priority: want 400, got %d", recorder.Code)
+	}
+
+	go handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/v1beta1/users?block=true", nil))
+	<-started
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/v1beta1/admin/config", nil))
+	if recorder.Code != http.StatusOK || recorder.Header().Get(server.QueueWaitHeader) != "" {
+		t.Errorf("admin call: want it served unqueued, got %d with headers %v", recorder.Code, recorder.Header())
+	}
+
+	type served struct {
+		name   string
+		header http.Header
+	}
+	done := make(chan served)
+	call := func(name, priority string) {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest("GET", "/v1beta1/users", nil)
+		request.Header.Set(server.PriorityHeader, priority)
+		handler.ServeHTTP(recorder, request)
+		done <- served{name, recorder.Header()}
+	}
+	go call("background", "7")
+	for backend.PriorityScheduler.Queued() < 1 {
+		time.Sleep(time.Millisecond)
+	}
+	go call("urgent", "1")
+	for backend.PriorityScheduler.Queued() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+
+	want := map[string]string{"urgent": "0", "background": "1"}
+	for range want {
+		got := <-done
+		if overtaken := got.header.Get(server.OvertakenHeader); overtaken != want[got.name] {
+			t.Errorf("%s: got %s %q, want %s", got.name, server.OvertakenHeader, overtaken, want[got.name])
+		}
+	}
+}
+
+func TestMetadataMiddleware_echoHeaders(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	request, err := http.NewRequest("POST", server.URL+"/v1beta1/echo:headers", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resttools.PopulateRequestHeaders(request)
+	request.Header.Set("X-Goog-Request-Params", "name=users%2F1")
+	request.Header.Set("User-Agent", "showcase-test/1.0")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("want 200, got %d: %s", response.StatusCode, data)
+	}
+	echoed := &pb.EchoHeadersResponse{}
+	if err := protojson.Unmarshal(data, echoed); err != nil {
+		t.Fatal(err)
+	}
+	got := map[string][]string{}
+	for _, header := range echoed.GetHeaders() {
+		got[header.GetName()] = header.GetValues()
+	}
+	for name, want := range map[string]string{
+		"x-goog-request-params": "name=users%2F1",
+		"user-agent":            "showcase-test/1.0",
+		"host":                  server.Listener.Addr().String(),
+	} {
+		if values := got[name]; len(values) != 1 || values[0] != want {
+			t.Errorf("%s: got %q, want %q", name, values, want)
+		}
+	}
+}
+
+func TestTimeoutMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	probe := func(timeout string) (int, []byte) {
+		request, err := http.NewRequest("POST", server.URL+"/v1beta1/echo:probeDeadline", strings.NewReader(`{}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		if timeout != "" {
+			request.Header.Set(serverTimeoutHeader, timeout)
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+		data, _ := ioutil.ReadAll(response.Body)
+		return response.StatusCode, data
+	}
+
+	code, data := probe("2.5")
+	resp := &pb.ProbeDeadlineResponse{}
+	if err := protojson.Unmarshal(data, resp); code != http.StatusOK || err != nil {
+		t.Fatalf("want 200, got %d: %s", code, data)
+	}
+	if remaining := resp.GetRemaining().AsDuration(); !resp.GetHasDeadline() || remaining <= 0 || remaining > 2500*time.Millisecond {
+		t.Errorf("ProbeDeadline with a 2.5s timeout: got %v", resp)
+	}
+
+	code, data = probe("")
+	resp = &pb.ProbeDeadlineResponse{}
+	if err := protojson.Unmarshal(data, resp); code != http.StatusOK || err != nil || resp.GetHasDeadline() {
+		t.Errorf("ProbeDeadline without a timeout: want no deadline, got %d: %s", code, data)
+	}
+
+	for _, timeout := range []string{"soon", "-1", "NaN", "Inf", "9223372037", "1e300"} {
+		if code, data := probe(timeout); code != http.StatusBadRequest {
+			t.Errorf("ProbeDeadline with a timeout of %q: want 400, got %d: %s", timeout, code, data)
+		}
+	}
+}
+
+func TestResponseFormatMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{responseCacheTTL: time.Minute})
+	defer server.Close()
+
+	call := func(method, url, body, accept string) (int, string, []byte) {
+		var reader io.Reader
+		if body != "" {
+			reader = strings.NewReader(body)
+		}
+		request, err := http.NewRequest(method, server.URL+url, reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		if accept != "" {
+			request.Header.Set("Accept", accept)
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+		data, _ := ioutil.ReadAll(response.Body)
+		return response.StatusCode, response.Header.Get("Content-Type"), data
+	}
+
+	for _, testCase := range []struct {
+		url, accept string
+		wantProto   bool
+	}{
+		{url: "/v1beta1/echo:echo", wantProto: false},
+		{url: "/v1beta1/echo:echo", accept: "application/x-protobuf", wantProto: true},
+		{url: "/v1beta1/echo:echo?alt=proto", wantProto: true},
+		{url: "/v1beta1/echo:echo?alt=json", accept: "application/x-protobuf", wantProto: false},
+	} {
+		code, contentType, data := call("POST", testCase.url, `{"content":"negotiated"}`, testCase.accept)
+		if code != http.StatusOK {
+			t.Errorf("Echo to %s with Accept %q: want 200, got %d: %s", testCase.url, testCase.accept, code, data)
+			continue
+		}
+		got := &pb.EchoResponse{}
+		if testCase.wantProto {
+			err := proto.Unmarshal(data, got)
+			if contentType != resttools.HeaderValueContentTypeProto || err != nil {
+				t.Errorf("Echo to %s with Accept %q: want a proto body, got %q: %v", testCase.url, testCase.accept, contentType, err)
+			}
+		} else {
+			err := protojson.Unmarshal(data, got)
+			if contentType != "application/json" || err != nil {
+				t.Errorf("Echo to %s with Accept %q: want a JSON body, got %q: %v", testCase.url, testCase.accept, contentType, err)
+			}
+		}
+		if got.GetContent() != "negotiated" {
+			t.Errorf("Echo to %s with Accept %q: got %v", testCase.url, testCase.accept, got)
+		}
+	}
+
+	if code, _, data := call("POST", "/v1beta1/echo:echo?alt=xml", `{"content":"negotiated"}`, ""); code != http.StatusBadRequest {
+		t.Errorf("Echo with alt=xml: want 400, got %d: %s", code, data)
+	}
+
+	// The cached JSON response to a GET is not served to the same GET asking for proto.
+	code, _, data := call("POST", "/v1beta1/users", `{"user":{"displayName":"formatted","email":"formatted@example.com"}}`, "")
+	user := &pb.User{}
+	if err := protojson.Unmarshal(data, user); code != http.StatusOK || err != nil {
+		t.Fatalf("CreateUser: got %d %s", code, data)
+	}
+	if code, contentType, data := call("GET", "/v1beta1/"+user.GetName(), "", ""); code != http.StatusOK || contentType != "application/json" {
+		t.Errorf("GetUser: want 200 with JSON, got %d %q: %s", code, contentType, data)
+	}
+	code, contentType, data := call("GET", "/v1beta1/"+user.GetName()+"?alt=proto", "", "")
+	got := &pb.User{}
+	if err := proto.Unmarshal(data, got); code != http.StatusOK || contentType != resttools.HeaderValueContentTypeProto || err != nil {
+		t.Fatalf("GetUser with alt=proto: want 200 with proto, got %d %q: %v", code, contentType, err)
+	}
+	if !proto.Equal(got, user) {
+		t.Errorf("GetUser with alt=proto: got %v, want %v", got, user)
+	}
+}
+
+func TestBodyLimitMiddleware(t *testing.T) {
+	small := `{"content":"small"}`
+	large := `{"content":"` + strings.Repeat("large ", 20) + `"}`
+	for _, html := range []bool{false, true} {
+		server := startRESTServer(t, RuntimeConfig{maxRequestBytes: 64, maxRequestBytesHTML: html})
+
+		if code, data := postREST(t, server.URL+"/v1beta1/echo:echo", small); code != http.StatusOK {
+			t.Errorf("html %v: Echo of %d bytes: want 200, got %d: %s", html, len(small), code, data)
+		}
+
+		// Without a Content-Length, the body is only found too large once read.
+		for _, reader := range []io.Reader{strings.NewReader(large), io.MultiReader(strings.NewReader(large))} {
+			request, err := http.NewRequest("POST", server.URL+"/v1beta1/echo:echo", reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			request.Header.Set("Content-Type", "application/json")
+			response, err := http.DefaultClient.Do(request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, _ := ioutil.ReadAll(response.Body)
+			response.Body.Close()
+			if response.StatusCode != http.StatusRequestEntityTooLarge {
+				t.Errorf("html %v: Echo of %d bytes: want 413, got %d: %s", html, len(large), response.StatusCode, data)
+				continue
+			}
+			contentType := response.Header.Get("Content-Type")
+			if html {
+				if !strings.HasPrefix(contentType, "text/html") || !strings.Contains(string(data), "Error 413") {
+					t.Errorf("html %v: want an HTML error page, got %q: %s", html, contentType, data)
+				}
+				continue
+			}
+			status := struct {
+				Error struct {
+					Code    int
+					Message string
+					Status  string
+				}
+			}{}
+			if err := json.Unmarshal(data, &status); err != nil || contentType != "application/json" {
+				t.Errorf("html %v: want a JSON error, got %q: %s", html, contentType, data)
+			}
+			if status.Error.Code != http.StatusRequestEntityTooLarge || status.Error.Status != "RESOURCE_EXHAUSTED" || status.Error.Message == "" {
+				t.Errorf("html %v: got error %+v", html, status.Error)
+			}
+		}
+		server.Close()
+	}
+}
+
+func TestRetryAfterMiddleware(t *testing.T) {
+	for _, format := range []string{retryAfterSeconds, retryAfterHTTPDate} {
+		server := startRESTServer(t, RuntimeConfig{retryAfter: 2500 * time.Millisecond, retryAfterFormat: format})
+
+		do := func(method, path, body string) (int, string) {
+			request, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resttools.PopulateRequestHeaders(request)
+			response, err := http.DefaultClient.Do(request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ioutil.ReadAll(response.Body)
+			response.Body.Close()
+			return response.StatusCode, response.Header.Get("Retry-After")
+		}
+
+		if code, retryAfter := do("POST", "/v1beta1/echo:echo", `{"content":"hi"}`); code != http.StatusOK || retryAfter != "" {
+			t.Errorf("%s: Echo: want 200 without Retry-After, got %d with %q", format, code, retryAfter)
+		}
+		for _, fault := range []struct {
+			code     string
+			wantCode int
+		}{
+			{"UNAVAILABLE", http.StatusServiceUnavailable},
+			{"RESOURCE_EXHAUSTED", http.StatusTooManyRequests},
+		} {
+			do("PATCH", "/v1beta1/admin/config", `{"faultRate":1,"faultCode":"`+fault.code+`"}`)
+			sent := time.Now()
+			code, retryAfter := do("POST", "/v1beta1/echo:echo", `{"content":"hi"}`)
+			if code != fault.wantCode {
+				t.Errorf("%s: Echo failing with %s: want %d, got %d", format, fault.code, fault.wantCode, code)
+			}
+			if format == retryAfterSeconds {
+				if retryAfter != "3" {
+					t.Errorf("%s: Echo failing with %s: want Retry-After 3, got %q", format, fault.code, retryAfter)
+				}
+				continue
+			}
+			date, err := http.ParseTime(retryAfter)
+			if err != nil || date.Before(sent.Add(time.Second)) || date.After(sent.Add(4*time.Second)) {
+				t.Errorf("%s: Echo failing with %s: want a Retry-After date 2.5s after %v, got %q", format, fault.code, sent, retryAfter)
+			}
+		}
+		server.Close()
+	}
+}
+
+func TestRetryAfterMiddleware_pushback(t *testing.T) {
+	handler := retryAfterMiddleware(time.Second, false)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(server.PushbackHeader, "4200")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/v1beta1/users", nil))
+	if got := recorder.Header().Get("Retry-After"); got != "5" {
+		t.Errorf("shed call with a 4200ms pushback: want Retry-After 5, got %q", got)
+	}
+}
+
+func TestIdempotencyKeyMiddleware(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	create := func(path, key, body string) (int, string, []byte) {
+		request, err := http.NewRequest("POST", server.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		if key != "" {
+			request.Header.Set("Idempotency-Key", key)
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+		data, _ := ioutil.ReadAll(response.Body)
+		return response.StatusCode, response.Header.Get("Idempotent-Replayed"), data
+	}
+
+	roomBody := `{"room":{"displayName":"idempotent"}}`
+	code, replayed, first := create("/v1beta1/rooms", "room-key", roomBody)
+	if code != http.StatusOK || replayed != "" {
+		t.Fatalf("CreateRoom: want 200 served, got %d replayed %q: %s", code, replayed, first)
+	}
+	code, replayed, second := create("/v1beta1/rooms", "room-key", roomBody)
+	if code != http.StatusOK || replayed != "true" || string(second) != string(first) {
+		t.Errorf("CreateRoom retried: want the first response replayed, got %d replayed %q: %s", code, replayed, second)
+	}
+	if code, _, data := create("/v1beta1/rooms", "room-key", `{"room":{"displayName":"other"}}`); code != http.StatusUnprocessableEntity {
+		t.Errorf("CreateRoom of another room with the key: want 422, got %d: %s", code, data)
+	}
+	if code, _, data := create("/v1beta1/rooms", "", roomBody); code == http.StatusOK {
+		t.Errorf("CreateRoom retried without the key: want it served again and failing, got %d: %s", code, data)
+	}
+
+	room := &pb.Room{}
+	if err := protojson.Unmarshal(first, room); err != nil {
+		t.Fatal(err)
+	}
+	blurbBody := `{"blurb":{"user":"users/someone","text":"once"}}`
+	code, _, first = create("/v1beta1/"+room.GetName()+"/blurbs", "blurb-key", blurbBody)
+	if code != http.StatusOK {
+		t.Fatalf("CreateBlurb: want 200, got %d: %s", code, first)
+	}
+	code, replayed, second = create("/v1beta1/"+room.GetName()+"/blurbs", "blurb-key", blurbBody)
+	if code != http.StatusOK || replayed != "true" || string(second) != string(first) {
+		t.Errorf("CreateBlurb retried: want the first response replayed, got %d replayed %q: %s", code, replayed, second)
+	}
+}
+
+func TestIdempotencyKeyMiddleware_callHeaders(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{restSessions: "issue", restSessionBackends: 2})
+	defer server.Close()
+
+	// A retry carries a trace of its own, and no session yet, like a fresh client.
+	sessions := map[string]bool{}
+	for _, testCase := range []struct {
+		traceparent, replayed string
+	}{
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", ""},
+		{"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", "true"},
+	} {
+		request, err := http.NewRequest("POST", server.URL+"/v1beta1/rooms", strings.NewReader(`{"room":{"displayName":"idempotent"}}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resttools.PopulateRequestHeaders(request)
+		request.Header.Set("Idempotency-Key", "room-key")
+		request.Header.Set("traceparent", testCase.traceparent)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+		if got := response.Header.Get("Idempotent-Replayed"); response.StatusCode != http.StatusOK || got != testCase.replayed {
+			t.Errorf("CreateRoom: want 200 replayed %q, got %d replayed %q", testCase.replayed, response.StatusCode, got)
+		}
+		if got := response.Header.Get("traceparent"); got != testCase.traceparent {
+			t.Errorf("CreateRoom (replayed %q): want its own traceparent %s echoed, got %q", testCase.replayed, testCase.traceparent, got)
+		}
+		sessions[response.Header.Get("X-Showcase-Session")] = true
+	}
+	if len(sessions) != 2 {
+		t.Errorf("CreateRoom: want each attempt issued a session of its own, got %v", sessions)
+	}
+}
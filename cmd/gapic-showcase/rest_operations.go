@@ -0,0 +1,104 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server/genrest"
+	"github.com/googleapis/gapic-showcase/server/services"
+	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
+	gmux "github.com/gorilla/mux"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/status"
+)
+
+// defaultOperationWait is how long a REST call waiting on an operation without a timeout waits
+// for it to complete.
+const defaultOperationWait = 30 * time.Second
+
+// operationPollInterval is how often an operation being waited on is polled.
+const operationPollInterval = 10 * time.Millisecond
+
+// registerOperationHandlers registers the REST handlers of the google.longrunning.Operations
+// methods, which the generated handlers do not serve.
+func registerOperationHandlers(router *gmux.Router, backend *services.Backend) {
+	router.HandleFunc("/v1beta1/{name:operations/.+}:wait", waitOperationHandler(backend)).Methods("POST")
+}
+
+// waitOperationHandler serves Operations.WaitOperation, polling the operation named in the path
+// until it is done or the timeout query param, a duration such as "1.5s", elapses. An operation
+// still pending at the timeout is returned with the 504 Gateway Timeout status, so that clients
+// can tell it from a completed one and wait again.
+func waitOperationHandler(backend *services.Backend) http.HandlerFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := gmux.Vars(r)["name"]
+		timeout := defaultOperationWait
+		for key, values := range r.URL.Query() {
+			if key != "timeout" {
+				rest.Error(w, http.StatusBadRequest, "encountered unexpected query param %q", key)
+				return
+			}
+			d, err := time.ParseDuration(values[0])
+			if err != nil || d < 0 {
+				rest.Error(w, http.StatusBadRequest, "the timeout query param must be a non-negative duration such as \"1.5s\", got %q", values[0])
+				return
+			}
+			timeout = d
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		ticker := time.NewTicker(operationPollInterval)
+		defer ticker.Stop()
+		for {
+			op, err := backend.OperationsServer.GetOperation(ctx, &lropb.GetOperationRequest{Name: name})
+			if err != nil {
+				st := status.Convert(err)
+				rest.Error(w, grpcHTTPStatus[st.Code()], "%s", st.Message())
+				return
+			}
+			if op.GetDone() {
+				writeOperation(rest, w, r, http.StatusOK, op)
+				return
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				if r.Context().Err() != nil {
+					return
+				}
+				writeOperation(rest, w, r, http.StatusGatewayTimeout, op)
+				return
+			}
+		}
+	}
+}
+
+// writeOperation writes op as the body of the response to r with the given status, encoded as r
+// asks for.
+func writeOperation(rest *genrest.RESTBackend, w http.ResponseWriter, r *http.Request, code int, op *lropb.Operation) {
+	body, contentType, err := resttools.MarshalResponse(r.Header, resttools.ToJSON(), op)
+	if err != nil {
+		rest.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(code)
+	w.Write(body)
+}
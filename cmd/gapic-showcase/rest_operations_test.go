@@ -0,0 +1,117 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+)
+
+// postREST posts body to path on server, returning the status and body of the response.
+func postREST(t *testing.T, url, body string) (int, []byte) {
+	request, err := http.NewRequest("POST", url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resttools.PopulateRequestHeaders(request)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return response.StatusCode, data
+}
+
+// startWait starts an Echo.Wait operation over REST, returning its name.
+func startWait(t *testing.T, url, body string) string {
+	code, data := postREST(t, url+"/v1beta1/echo:wait", body)
+	op := &lropb.Operation{}
+	if code != http.StatusOK {
+		t.Fatalf("Wait: want status 200, got %d: %s", code, data)
+	}
+	if err := resttools.FromJSON().Unmarshal(data, op); err != nil {
+		t.Fatal(err)
+	}
+	return op.GetName()
+}
+
+func TestWaitOperationREST(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	name := startWait(t, server.URL, `{"ttl":"0.1s","success":{"content":"done"}}`)
+	code, data := postREST(t, server.URL+"/v1beta1/"+name+":wait?timeout=10s", "")
+	op := &lropb.Operation{}
+	if err := resttools.FromJSON().Unmarshal(data, op); err != nil {
+		t.Fatalf("%s: %s", err, data)
+	}
+	if code != http.StatusOK || !op.GetDone() || op.GetResponse() == nil {
+		t.Errorf("want the operation done with status 200, got %d: %s", code, data)
+	}
+}
+
+func TestWaitOperationREST_timeout(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	name := startWait(t, server.URL, `{"ttl":"3600s","success":{"content":"done"}}`)
+	code, data := postREST(t, server.URL+"/v1beta1/"+name+":wait?timeout=0.05s", "")
+	op := &lropb.Operation{}
+	if err := resttools.FromJSON().Unmarshal(data, op); err != nil {
+		t.Fatalf("%s: %s", err, data)
+	}
+	if code != http.StatusGatewayTimeout || op.GetDone() || op.GetName() != name {
+		t.Errorf("want the pending operation %q with status 504, got %d: %s", name, code, data)
+	}
+}
+
+func TestWaitOperationREST_invalid(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	name := startWait(t, server.URL, `{"ttl":"0s"}`)
+	for _, testCase := range []struct {
+		path string
+		want int
+	}{
+		{"/v1beta1/operations/missing:wait", http.StatusNotFound},
+		{"/v1beta1/" + name + ":wait?timeout=soon", http.StatusBadRequest},
+		{"/v1beta1/" + name + ":wait?deadline=1s", http.StatusBadRequest},
+	} {
+		if code, data := postREST(t, server.URL+testCase.path, ""); code != testCase.want {
+			t.Errorf("%s: want status %d, got %d: %s", testCase.path, testCase.want, code, data)
+		}
+	}
+}
+
+func TestWaitOperationREST_uncleanName(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	// The names of operations encode their request in base64, which may hold "//".
+	code, data := postREST(t, server.URL+"/v1beta1/operations/google.showcase.v1beta1.Echo/Wait/a//b:wait", "")
+	if strings.Contains(string(data), "unrecognized request") {
+		t.Errorf("WaitOperation of a name holding \"//\": want it routed, got %d: %s", code, data)
+	}
+}
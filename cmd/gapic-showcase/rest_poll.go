@@ -0,0 +1,109 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"github.com/googleapis/gapic-showcase/server/genrest"
+	"github.com/googleapis/gapic-showcase/server/services"
+	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
+	gmux "github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// defaultPollWait is how long a long-poll REST call without a timeout is held open for.
+const defaultPollWait = 30 * time.Second
+
+// registerPollHandlers registers the long-poll REST handlers, "hanging GETs" that answer once
+// an event occurs, which the generated handlers, lacking server streaming, cannot serve.
+func registerPollHandlers(router *gmux.Router, backend *services.Backend) {
+	router.HandleFunc("/v1beta1/users:poll", pollUsersHandler(backend)).Methods("GET")
+}
+
+// pollUsersHandler holds the call open until a user changes, answering with the change as a
+// WatchUsersResponse, or until the timeout query param, a duration such as "1.5s", elapses,
+// answering with the 204 No Content status. The resumeToken query param, as in
+// WatchUsersRequest, answers with the change following the one it was received with, so that
+// clients polling again miss none.
+func pollUsersHandler(backend *services.Backend) http.HandlerFunc {
+	rest := (*genrest.RESTBackend)(backend)
+	return func(w http.ResponseWriter, r *http.Request) {
+		request := &pb.WatchUsersRequest{}
+		timeout := defaultPollWait
+		for key, values := range r.URL.Query() {
+			switch key {
+			case "resumeToken":
+				request.ResumeToken = values[0]
+			case "timeout":
+				d, err := time.ParseDuration(values[0])
+				if err != nil || d < 0 {
+					rest.Error(w, http.StatusBadRequest, "the timeout query param must be a non-negative duration such as \"1.5s\", got %q", values[0])
+					return
+				}
+				timeout = d
+			default:
+				rest.Error(w, http.StatusBadRequest, "encountered unexpected query param %q", key)
+				return
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		stream := &pollStream{ctx: ctx, cancel: cancel}
+		err := backend.IdentityServer.WatchUsers(request, stream)
+		switch {
+		case stream.response != nil:
+			body, contentType, err := resttools.MarshalResponse(r.Header, resttools.ToJSON(), stream.response)
+			if err != nil {
+				rest.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
+				return
+			}
+			w.Header().Set("Content-Type", contentType)
+			w.Write(body)
+		case r.Context().Err() != nil:
+		case ctx.Err() != nil || err == nil:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			st := status.Convert(err)
+			rest.Error(w, grpcHTTPStatus[st.Code()], "%s", st.Message())
+		}
+	}
+}
+
+// pollStream is the stream of a WatchUsers call serving a long poll, which ends with the first
+// change sent.
+type pollStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	cancel   context.CancelFunc
+	response *pb.WatchUsersResponse
+}
+
+func (s *pollStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *pollStream) Send(response *pb.WatchUsersResponse) error {
+	if s.response == nil {
+		s.response = response
+	}
+	s.cancel()
+	return s.ctx.Err()
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
+)
+
+// getREST gets url, returning the status and body of the response.
+func getREST(t *testing.T, url string) (int, []byte) {
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resttools.PopulateRequestHeaders(request)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return response.StatusCode, data
+}
+
+func TestPollUsersREST(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	type result struct {
+		code int
+		data []byte
+		err  error
+	}
+	polled := make(chan result)
+	go func() {
+		request, _ := http.NewRequest("GET", server.URL+"/v1beta1/users:poll?timeout=10s", nil)
+		resttools.PopulateRequestHeaders(request)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			polled <- result{err: err}
+			return
+		}
+		defer response.Body.Close()
+		data, err := ioutil.ReadAll(response.Body)
+		polled <- result{response.StatusCode, data, err}
+	}()
+	time.Sleep(50 * time.Millisecond)
+	if code, data := postREST(t, server.URL+"/v1beta1/users", `{"user":{"displayName":"Rumble","email":"rumble@goodboi.com"}}`); code != http.StatusOK {
+		t.Fatalf("CreateUser: want status 200, got %d: %s", code, data)
+	}
+
+	got := <-polled
+	if got.err != nil {
+		t.Fatal(got.err)
+	}
+	change := &pb.WatchUsersResponse{}
+	if err := resttools.FromJSON().Unmarshal(got.data, change); err != nil {
+		t.Fatalf("%s: %s", err, got.data)
+	}
+	if got.code != http.StatusOK || change.GetAction() != pb.WatchUsersResponse_CREATE || change.GetUser().GetDisplayName() != "Rumble" {
+		t.Errorf("want the user created with status 200, got %d: %s", got.code, got.data)
+	}
+
+	code, data := getREST(t, server.URL+"/v1beta1/users:poll?timeout=0.05s&resumeToken="+change.GetResumeToken())
+	if code != http.StatusNoContent || len(data) != 0 {
+		t.Errorf("want status 204 without a body once no user changes, got %d: %s", code, data)
+	}
+}
+
+func TestPollUsersREST_invalid(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{})
+	defer server.Close()
+
+	for _, query := range []string{"timeout=soon", "timeout=-1s", "expireTime=2019-04-01T00:00:00Z"} {
+		if code, data := getREST(t, server.URL+"/v1beta1/users:poll?"+query); code != http.StatusBadRequest {
+			t.Errorf("%s: want status 400, got %d: %s", query, code, data)
+		}
+	}
+	if code, data := getREST(t, server.URL+"/v1beta1/users:poll?timeout=1s&resumeToken=stale"); code == http.StatusOK || code == http.StatusNoContent {
+		t.Errorf("an invalid resume token: want an error, got %d: %s", code, data)
+	}
+}
@@ -15,10 +15,13 @@
 package main
 
 import (
+	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/googleapis/gapic-showcase/server"
 	"github.com/spf13/cobra"
 )
 
@@ -35,6 +38,13 @@ func init() {
 		Use:   "run",
 		Short: "Runs the showcase server",
 		Run: func(cmd *cobra.Command, args []string) {
+			if err := configureLogging(config.logFormat); err != nil {
+				log.Fatalf("Invalid log format: %v", err)
+			}
+			if config.retryAfterFormat != retryAfterSeconds && config.retryAfterFormat != retryAfterHTTPDate {
+				log.Fatalf("Invalid --retry-after-format %q: it must be %q or %q", config.retryAfterFormat, retryAfterSeconds, retryAfterHTTPDate)
+			}
+			applyMTLSDir(&config)
 			cmuxServer := CreateAllEndpoints(config)
 
 			done := make(chan os.Signal, 2)
@@ -82,4 +92,259 @@ func init() {
 		"mtls-key",
 		"",
 		"The server private key path for custom mutual TLS channel.")
+	runCmd.Flags().StringVar(
+		&config.mtlsDir,
+		"mtls-dir",
+		"",
+		"The directory of the certificates generated by `gapic-showcase certs generate`, which --mtls-ca-cert, --mtls-cert and --mtls-key default to.")
+	runCmd.Flags().BoolVar(
+		&config.verifyRoutingHeaders,
+		"verify-routing-headers",
+		false,
+		"Reject gRPC calls whose x-goog-request-params header does not match the routing annotations of the method.")
+	runCmd.Flags().StringVar(
+		&config.conformanceSummaryFile,
+		"conformance-summary-file",
+		"",
+		"The file the conformance summary of reported sessions is exported to as JSON.")
+	runCmd.Flags().StringVar(
+		&config.callLogFile,
+		"call-log-file",
+		"",
+		"The file calls are appended to as Cloud Logging JSON entries.")
+	runCmd.Flags().StringVar(
+		&config.callLogDir,
+		"call-log-dir",
+		"",
+		"The directory calls are exported to as hourly Cloud Logging JSON files, laid out like a Cloud Storage log export.")
+	runCmd.Flags().StringVar(
+		&config.failoverRole,
+		"failover-role",
+		"",
+		"The role of this server in a failover pair: \"leader\" or \"standby\". A standby rejects calls until the leader fails over to it.")
+	runCmd.Flags().StringVar(
+		&config.failoverPeer,
+		"failover-peer",
+		"",
+		"The gRPC address of the other server of the failover pair.")
+	runCmd.Flags().StringVar(
+		&config.dnsPort,
+		"dns-port",
+		"",
+		"The UDP port a DNS server for name-resolution tests is served on. No DNS server is run if empty.")
+	runCmd.Flags().StringVar(
+		&config.dnsRecordsFile,
+		"dns-records",
+		"",
+		"The JSON file listing the A, AAAA, SRV, TXT and GRPC_CONFIG records the DNS server answers with.")
+	runCmd.Flags().Uint32Var(
+		&config.maxConcurrentStreams,
+		"max-concurrent-streams",
+		0,
+		"The maximum number of concurrent streams gRPC clients may open per connection. Unlimited if 0.")
+	runCmd.Flags().IntVar(
+		&config.maxConnectionsPerClient,
+		"max-connections-per-client",
+		0,
+		"The maximum number of gRPC connections each client may hold, clients being identified by their certificate subject under mutual TLS and by their host otherwise. Connections over the quota are sent a GOAWAY and closed. Unlimited if 0.")
+	runCmd.Flags().DurationVar(
+		&config.keepaliveMinTime,
+		"keepalive-min-time",
+		5*time.Minute,
+		"The shortest interval at which gRPC clients may send keepalive pings. Clients pinging more often are disconnected with a \"too_many_pings\" GOAWAY.")
+	runCmd.Flags().BoolVar(
+		&config.keepalivePermitWithoutStream,
+		"keepalive-permit-without-stream",
+		false,
+		"Allow gRPC clients to send keepalive pings on connections without active streams.")
+	runCmd.Flags().Int64Var(
+		&config.streamSeed,
+		"stream-seed",
+		0,
+		"The seed the order blurb events are delivered in across the streams watching them is derived from, making cross-stream interleaving reproducible. Events are delivered in the order streams were opened if 0.")
+	runCmd.Flags().StringVar(
+		&config.reflectionVersion,
+		"reflection",
+		"all",
+		"The versions of the gRPC reflection protocol served: \"v1\", \"v1alpha\", \"all\" for both, or \"none\" to not serve reflection.")
+	runCmd.Flags().StringSliceVar(
+		&config.proxyBehaviors,
+		"proxy-mimic",
+		nil,
+		"The behaviors of intermediaries the server mimics on every call: any of \"forwarded-headers\", \"strip-trailers\" and \"lowercase-headers\".")
+	runCmd.Flags().StringSliceVar(
+		&config.binaryLogMethods,
+		"binary-log-methods",
+		nil,
+		"The gRPC methods whose calls are captured in the binary log: \"*\", \"<service>/*\" or \"<service>/<method>\", using full service names. Nothing is captured if empty.")
+	runCmd.Flags().StringVar(
+		&config.binaryLogFile,
+		"binary-log-file",
+		"",
+		"The file captured binary log entries are appended to, each a GrpcLogEntry proto preceded by its length as 4 big-endian bytes.")
+	runCmd.Flags().StringVar(
+		&config.tlsKeyLogFile,
+		"tls-key-log-file",
+		os.Getenv("SSLKEYLOGFILE"),
+		"The file the secrets of TLS connections are appended to, for packet analyzers to decrypt captured traffic. Defaults to $SSLKEYLOGFILE.")
+	runCmd.Flags().StringVar(
+		&config.adminPort,
+		"admin-port",
+		"",
+		"The port the net/http/pprof profiling and runtime trace endpoints are served on, under /debug/pprof/, along with Prometheus metrics under /metrics. They are not served if empty.")
+	runCmd.Flags().IntVar(
+		&config.maxProcs,
+		"gomaxprocs",
+		0,
+		"The number of CPUs the server may use at once, as GOMAXPROCS. Left to the Go runtime if 0.")
+	runCmd.Flags().DurationVar(
+		&config.busyWork,
+		"busy-work",
+		0,
+		"The CPU time spent on busy work before serving each call, measured on an idle core. Calls competing for the CPUs the server may use take longer.")
+	runCmd.Flags().StringVar(
+		&config.restPathPrefix,
+		"rest-path-prefix",
+		"",
+		"The path the REST surface is served under, e.g. \"/api\" to serve it at \"/api/v1beta1/...\". Served at the root if empty.")
+	runCmd.Flags().StringVar(
+		&config.universeDomain,
+		"universe-domain",
+		"",
+		"The universe domain the server simulates being in, e.g. \"example.com\". When set, calls must be sent to a host in that domain with bearer credentials, and fail otherwise.")
+	runCmd.Flags().Float64Var(
+		&config.corruptFraction,
+		"corrupt-responses",
+		0,
+		"The fraction of successful responses, from 0 to 1, whose serialized payload is corrupted so that clients cannot parse it. No response is corrupted if 0.")
+	runCmd.Flags().StringSliceVar(
+		&config.corruptionModes,
+		"corruption-modes",
+		[]string{"bit-flip", "truncate"},
+		"The ways corrupted responses are corrupted, one picked at random per response: any of \"bit-flip\" and \"truncate\".")
+	runCmd.Flags().BoolVar(
+		&config.deadlineRace,
+		"deadline-race",
+		false,
+		"Complete gRPC calls that have a deadline right at it, as set by --deadline-race-margin, so that clients' races between the response and the deadline can be reproduced.")
+	runCmd.Flags().DurationVar(
+		&config.deadlineRaceMargin,
+		"deadline-race-margin",
+		2*time.Millisecond,
+		"How long before their deadline --deadline-race completes calls, or after it if negative.")
+	runCmd.Flags().StringVar(
+		&config.restSessions,
+		"rest-sessions",
+		"",
+		"Issue REST clients sticky session cookies, each bound to a simulated backend echoed in responses: \"issue\" to serve calls without a session, \"require\" to refuse them. No cookies are issued if empty.")
+	runCmd.Flags().IntVar(
+		&config.restSessionBackends,
+		"rest-session-backends",
+		3,
+		"The number of simulated backends --rest-sessions binds sessions to, in turn.")
+	runCmd.Flags().StringVar(
+		&config.signingKey,
+		"signing-key",
+		server.DefaultSigningKey,
+		"The shared key whose HMAC-SHA256 signatures of calls Transport.VerifySignature verifies.")
+	runCmd.Flags().Float64Var(
+		&config.faultRate,
+		"fault-rate",
+		0,
+		"The fraction of calls to the Showcase API, from 0 to 1, failed at random with --fault-code before they are served. No call is failed if 0.")
+	runCmd.Flags().StringVar(
+		&config.faultCode,
+		"fault-code",
+		"UNAVAILABLE",
+		"The status code, such as \"UNAVAILABLE\" or \"RESOURCE_EXHAUSTED\", of the calls --fault-rate fails.")
+	runCmd.Flags().StringVar(
+		&config.storage,
+		"storage",
+		"",
+		"The file users, rooms and blurbs are persisted to, and restored from when the server starts, instead of only being kept in memory.")
+	runCmd.Flags().StringSliceVar(
+		&config.notServing,
+		"not-serving",
+		nil,
+		"The full names of the services, such as \"google.showcase.v1beta1.Echo\", the standard gRPC health service reports as NOT_SERVING.")
+	runCmd.Flags().StringSliceVar(
+		&config.services,
+		"services",
+		nil,
+		"The only Showcase API services served, named in lowercase without any \"Service\" suffix, such as \"echo,messaging,sequence\". The calls to the others fail with UNIMPLEMENTED. All are served if empty.")
+	runCmd.Flags().DurationVar(
+		&config.responseCacheTTL,
+		"response-cache-ttl",
+		0,
+		"How long the successful responses of idempotent methods, those bound to HTTP GETs, are cached for, answering identical calls meanwhile with an x-showcase-cache: HIT header. Nothing is cached if 0.")
+	runCmd.Flags().StringVar(
+		&config.otlpEndpoint,
+		"otlp-endpoint",
+		"",
+		"The OpenTelemetry collector, such as \"http://localhost:4318\", the spans of the calls served are sent to over OTLP/HTTP. Spans are not exported if empty, though incoming traceparent headers are always echoed.")
+	runCmd.Flags().IntVar(
+		&config.loadShedLimit,
+		"shed-load-above",
+		0,
+		"How many calls to the Showcase API the server serves at once, failing the others with UNAVAILABLE and a grpc-retry-pushback-ms trailer asking to retry after --shed-pushback. No call is shed if 0.")
+	runCmd.Flags().DurationVar(
+		&config.loadShedPushback,
+		"shed-pushback",
+		time.Second,
+		"How long the calls shed by --shed-load-above are asked to wait before retrying.")
+	runCmd.Flags().IntVar(
+		&config.prioritySlots,
+		"priority-slots",
+		0,
+		"If not 0, how many calls the server serves at once, queuing the others and admitting the most urgent first, by the 0 to 7 urgency of their x-showcase-priority header. Calls report how long they waited and how many later calls overtook them in the x-showcase-queue-wait-ms and x-showcase-overtaken response headers.")
+	runCmd.Flags().StringVar(
+		&config.logFormat,
+		"log-format",
+		"text",
+		"The format of the server's logs: \"text\", or \"json\" to log an object per entry, with the method, messages and status of each call as fields.")
+	runCmd.Flags().BoolVar(
+		&config.forceGzip,
+		"force-gzip",
+		false,
+		"Compress every gRPC and REST response with gzip, whatever the compression of the request or the client's Accept-Encoding.")
+	runCmd.Flags().BoolVar(
+		&config.lintRequests,
+		"lint-requests",
+		false,
+		"Check the messages of gRPC calls for problems of the clients' serialization the server tolerates, such as unknown fields, strings that are not valid UTF-8 and messages nested too deeply for some protobuf runtimes, reporting each in an x-showcase-lint-warning trailer.")
+	runCmd.Flags().IntVar(
+		&config.maxRequestBytes,
+		"max-request-bytes",
+		0,
+		"If not 0, the size in bytes of the largest gRPC message and REST body the server accepts. Larger gRPC messages fail with RESOURCE_EXHAUSTED, while larger REST bodies are rejected with 413 and a JSON error.")
+	runCmd.Flags().BoolVar(
+		&config.maxRequestBytesHTML,
+		"max-request-bytes-html",
+		false,
+		"Reject the REST bodies larger than --max-request-bytes with the HTML error page of a front end rather than a JSON error, as clients behind one may be sent.")
+	runCmd.Flags().DurationVar(
+		&config.retryAfter,
+		"retry-after",
+		0,
+		"If not 0, how long REST responses with 429 or 503, such as those of shed or faulted calls, ask clients to wait before retrying in a Retry-After header. Shed calls ask for their pushback instead.")
+	runCmd.Flags().StringVar(
+		&config.retryAfterFormat,
+		"retry-after-format",
+		retryAfterSeconds,
+		"The form of the Retry-After headers of --retry-after: \"seconds\", for a number of seconds, or \"http-date\", for the date after which to retry.")
+	runCmd.Flags().StringVar(
+		&config.responseHooksFile,
+		"response-hooks",
+		"",
+		"The JSON file listing the response hooks, written in a subset of CEL, that fail unary gRPC calls or change their responses depending on their requests.")
+	runCmd.Flags().StringVar(
+		&config.brownout,
+		"brownout",
+		"",
+		"The curve the fraction of calls failed with --fault-code follows instead of --fault-rate, as comma-separated points each joining how long after start the point is reached and the fraction failed then with \"=\", such as \"0s=0,1m=0.5,2m=0.5,3m=0\". The fraction changes linearly between points.")
+	runCmd.Flags().BoolVar(
+		&config.brownoutRepeat,
+		"brownout-repeat",
+		false,
+		"Start the curve of --brownout over once its last point is reached.")
 }
@@ -0,0 +1,198 @@
+// Copyright 2018 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"github.com/spf13/cobra"
+)
+
+// sampleRegionTag marks the code of a sample in the files sample generators write.
+const sampleRegionTag = "[START "
+
+// defaultSampleRunners are the commands samples are run with, by file extension.
+var defaultSampleRunners = map[string]string{
+	".go":  "go run",
+	".js":  "node",
+	".php": "php",
+	".py":  "python3",
+	".rb":  "ruby",
+	".sh":  "sh",
+}
+
+// sampleResult is the outcome of running one sample.
+type sampleResult struct {
+	err     error
+	output  []byte
+	elapsed time.Duration
+}
+
+func init() {
+	config := RuntimeConfig{reflectionVersion: "all"}
+	var runnerFlags map[string]string
+	var timeout time.Duration
+	samplesCmd := &cobra.Command{
+		Use:   "samples",
+		Short: "Works with code samples of the showcase API",
+	}
+	samplesRunCmd := &cobra.Command{
+		Use:   "run [file or directory]...",
+		Short: "Runs generated code samples against a fresh showcase server",
+		Long: "Runs the code samples found in the given files and directories against a fresh showcase server, " +
+			"resetting the server state before each sample, and reports which samples failed. " +
+			"Samples are the files with a region tag whose extension has a runner. " +
+			"The address of the server is in the GAPIC_SHOWCASE_ADDRESS and " + emulatorHostEnv + " environment variables of the samples.",
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runners := map[string]string{}
+			for ext, runner := range defaultSampleRunners {
+				runners[ext] = runner
+			}
+			for ext, runner := range runnerFlags {
+				runners[ext] = runner
+			}
+			samples, err := findSamples(args, runners)
+			if err != nil {
+				log.Fatalf("Failed to find samples: %v", err)
+			}
+			if len(samples) == 0 {
+				log.Fatalf("No samples found in %s", strings.Join(args, ", "))
+			}
+
+			if !strings.HasPrefix(config.port, ":") {
+				config.port = ":" + config.port
+			}
+			lis, err := net.Listen("tcp", config.port)
+			if err != nil {
+				log.Fatalf("Showcase failed to listen on port '%s': %v", config.port, err)
+			}
+			backend := createBackends(config)
+			server := newEndpoints(lis, config, backend)
+			go server.Serve()
+			defer server.Shutdown()
+
+			env := append(os.Environ(), "GAPIC_SHOWCASE_ADDRESS=localhost"+config.port, emulatorHostEnv+"=localhost"+config.port)
+			reset := func() {
+				backend.FixturesServer.ResetState(context.Background(), &pb.ResetStateRequest{})
+			}
+			if failed := runSamples(cmd.OutOrStdout(), samples, runners, env, timeout, reset); failed > 0 {
+				server.Shutdown()
+				os.Exit(1)
+			}
+		},
+	}
+	rootCmd.AddCommand(samplesCmd)
+	samplesCmd.AddCommand(samplesRunCmd)
+	samplesRunCmd.Flags().StringVarP(
+		&config.port,
+		"port",
+		"p",
+		":7469",
+		"The port the showcase server the samples call is served on.")
+	samplesRunCmd.Flags().StringVarP(
+		&config.fallbackPort,
+		"fallback-port",
+		"f",
+		":1337",
+		"The port the fallback-proxy of the showcase server is served on.")
+	samplesRunCmd.Flags().StringToStringVar(
+		&runnerFlags,
+		"runner",
+		nil,
+		"The command samples are run with by file extension, such as \".py=python3\", in addition to or instead of the default ones for .go, .js, .php, .py, .rb and .sh files. Files with an empty command are not run.")
+	samplesRunCmd.Flags().DurationVar(
+		&timeout,
+		"timeout",
+		time.Minute,
+		"How long each sample may run.")
+}
+
+// findSamples returns the samples in paths, which are files or directories searched
+// recursively, sorted.
+func findSamples(paths []string, runners map[string]string) ([]string, error) {
+	samples := []string{}
+	for _, root := range paths {
+		err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			if strings.TrimSpace(runners[filepath.Ext(path)]) == "" {
+				return nil
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if bytes.Contains(content, []byte(sampleRegionTag)) {
+				samples = append(samples, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(samples)
+	return samples, nil
+}
+
+// runSamples runs samples one at a time with their runner and env, calling reset before each,
+// reports their results to w, and returns how many failed.
+func runSamples(w io.Writer, samples []string, runners map[string]string, env []string, timeout time.Duration, reset func()) int {
+	failed := 0
+	for _, path := range samples {
+		reset()
+		result := runSample(path, strings.Fields(runners[filepath.Ext(path)]), env, timeout)
+		if result.err == nil {
+			fmt.Fprintf(w, "PASS %s (%.2fs)\n", path, result.elapsed.Seconds())
+			continue
+		}
+		failed++
+		fmt.Fprintf(w, "FAIL %s (%.2fs): %v\n", path, result.elapsed.Seconds(), result.err)
+		for _, line := range strings.Split(strings.TrimRight(string(result.output), "\n"), "\n") {
+			fmt.Fprintf(w, "    %s\n", line)
+		}
+	}
+	fmt.Fprintf(w, "%d samples: %d passed, %d failed\n", len(samples), len(samples)-failed, failed)
+	return failed
+}
+
+// runSample runs the sample at path with runner from the directory of the sample.
+func runSample(path string, runner []string, env []string, timeout time.Duration) sampleResult {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, runner[0], append(runner[1:], filepath.Base(path))...)
+	cmd.Dir = filepath.Dir(path)
+	cmd.Env = env
+	start := time.Now()
+	output, err := cmd.CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("timed out after %v", timeout)
+	}
+	return sampleResult{err: err, output: output, elapsed: time.Since(start)}
+}
@@ -0,0 +1,98 @@
+// Copyright 2018 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeSamples(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "samples")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestFindSamples(t *testing.T) {
+	dir := writeSamples(t, map[string]string{
+		"echo/echo_sync.sh":   "# [START showcase_v1beta1_generated_Echo_Echo_sync]\n",
+		"echo/README.md":      "# [START not a sample]\n",
+		"echo/helpers.sh":     "# no region tag\n",
+		"identity/create.py":  "# [START showcase_v1beta1_generated_Identity_CreateUser_sync]\n",
+		"identity/create.rb":  "# [START showcase_v1beta1_generated_Identity_CreateUser_sync]\n",
+		"messaging/create.sh": "# [START showcase_v1beta1_generated_Messaging_CreateRoom_sync]\n",
+	})
+	defer os.RemoveAll(dir)
+
+	got, err := findSamples([]string{filepath.Join(dir, "echo"), filepath.Join(dir, "identity")}, map[string]string{".sh": "sh", ".py": "python3", ".rb": ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{filepath.Join(dir, "echo", "echo_sync.sh"), filepath.Join(dir, "identity", "create.py")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("findSamples: want %v, got %v", want, got)
+	}
+}
+
+func TestRunSamples(t *testing.T) {
+	dir := writeSamples(t, map[string]string{
+		"fail.sh":  "# [START fail]\necho broken\nexit 3\n",
+		"pass.sh":  "# [START pass]\n[ \"$GAPIC_SHOWCASE_ADDRESS\" = localhost:7469 ]\n",
+		"sleep.sh": "# [START sleep]\nexec sleep 5\n",
+	})
+	defer os.RemoveAll(dir)
+	runners := map[string]string{".sh": "sh"}
+	samples, err := findSamples([]string{dir}, runners)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resets := 0
+	out := &bytes.Buffer{}
+	env := append(os.Environ(), "GAPIC_SHOWCASE_ADDRESS=localhost:7469")
+	failed := runSamples(out, samples, runners, env, 200*time.Millisecond, func() { resets++ })
+	if failed != 2 || resets != 3 {
+		t.Errorf("want 2 failed samples and 3 resets, got %d and %d:\n%s", failed, resets, out)
+	}
+	for _, want := range []string{
+		"FAIL " + filepath.Join(dir, "fail.sh"),
+		"    broken\n",
+		"PASS " + filepath.Join(dir, "pass.sh"),
+		"FAIL " + filepath.Join(dir, "sleep.sh"),
+		"timed out after 200ms",
+		"3 samples: 1 passed, 2 failed",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("want %q in the report, got:\n%s", want, out)
+		}
+	}
+}
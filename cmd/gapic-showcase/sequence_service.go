@@ -11,7 +11,7 @@ import (
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
 
-	gapic "github.com/googleapis/gapic-showcase/client"
+	gapic "github.com/googleapis/gapic-showcase/client/apiv1beta1"
 )
 
 var SequenceConfig *viper.Viper
@@ -0,0 +1,189 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"github.com/googleapis/gapic-showcase/server/services"
+	"google.golang.org/grpc"
+)
+
+// showcaseService is a service of the Showcase API the server can be restricted to serving with
+// the --services flag.
+type showcaseService struct {
+	// name is how the --services flag names the service: its name, lowercased, without any
+	// "Service" suffix.
+	name string
+
+	// register registers the service of backend on a gRPC server.
+	register func(s *grpc.Server, backend *services.Backend)
+
+	// disable replaces the service in backend with one failing every call with UNIMPLEMENTED,
+	// for the REST handlers, which are all registered regardless, to call.
+	disable func(backend *services.Backend)
+}
+
+// showcaseServices are the services of the Showcase API, sorted by name. The mixin services,
+// such as google.longrunning.Operations, other services rely on are always served.
+var showcaseServices = []showcaseService{
+	{
+		name:     "auditlog",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterAuditLogServer(s, b.AuditLogServer) },
+		disable:  func(b *services.Backend) { b.AuditLogServer = &pb.UnimplementedAuditLogServer{} },
+	},
+	{
+		name:     "barrier",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterBarrierServer(s, b.BarrierServer) },
+		disable:  func(b *services.Backend) { b.BarrierServer = &pb.UnimplementedBarrierServer{} },
+	},
+	{
+		name:     "clock",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterClockServer(s, b.ClockServer) },
+		disable:  func(b *services.Backend) { b.ClockServer = &pb.UnimplementedClockServer{} },
+	},
+	{
+		name:     "compliance",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterComplianceServer(s, b.ComplianceServer) },
+		disable:  func(b *services.Backend) { b.ComplianceServer = &pb.UnimplementedComplianceServer{} },
+	},
+	{
+		name:     "crypto",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterCryptoServer(s, b.CryptoServer) },
+		disable:  func(b *services.Backend) { b.CryptoServer = &pb.UnimplementedCryptoServer{} },
+	},
+	{
+		name:     "debug",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterDebugServer(s, b.DebugServer) },
+		disable:  func(b *services.Backend) { b.DebugServer = &pb.UnimplementedDebugServer{} },
+	},
+	{
+		name:     "echo",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterEchoServer(s, b.EchoServer) },
+		disable:  func(b *services.Backend) { b.EchoServer = &pb.UnimplementedEchoServer{} },
+	},
+	{
+		name:     "failover",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterFailoverServer(s, b.FailoverServer) },
+		disable:  func(b *services.Backend) { b.FailoverServer = &pb.UnimplementedFailoverServer{} },
+	},
+	{
+		name:     "fixtures",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterFixturesServer(s, b.FixturesServer) },
+		disable:  func(b *services.Backend) { b.FixturesServer = &pb.UnimplementedFixturesServer{} },
+	},
+	{
+		name:     "identity",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterIdentityServer(s, b.IdentityServer) },
+		disable:  func(b *services.Backend) { b.IdentityServer = &pb.UnimplementedIdentityServer{} },
+	},
+	{
+		name:     "matrix",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterMatrixServer(s, b.MatrixServer) },
+		disable:  func(b *services.Backend) { b.MatrixServer = &pb.UnimplementedMatrixServer{} },
+	},
+	{
+		name:     "messaging",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterMessagingServer(s, b.MessagingServer) },
+		disable:  func(b *services.Backend) { b.MessagingServer = &pb.UnimplementedMessagingServer{} },
+	},
+	{
+		name:     "rollout",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterRolloutServer(s, b.RolloutServer) },
+		disable:  func(b *services.Backend) { b.RolloutServer = &pb.UnimplementedRolloutServer{} },
+	},
+	{
+		name:     "routing",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterRoutingServer(s, b.RoutingServer) },
+		disable:  func(b *services.Backend) { b.RoutingServer = &pb.UnimplementedRoutingServer{} },
+	},
+	{
+		name: "sequence",
+		register: func(s *grpc.Server, b *services.Backend) {
+			pb.RegisterSequenceServiceServer(s, b.SequenceServiceServer)
+		},
+		disable: func(b *services.Backend) { b.SequenceServiceServer = &pb.UnimplementedSequenceServiceServer{} },
+	},
+	{
+		name:     "showcaseadmin",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterShowcaseAdminServer(s, b.ShowcaseAdminServer) },
+		disable:  func(b *services.Backend) { b.ShowcaseAdminServer = &pb.UnimplementedShowcaseAdminServer{} },
+	},
+	{
+		name:     "testing",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterTestingServer(s, b.TestingServer) },
+		disable:  func(b *services.Backend) { b.TestingServer = &pb.UnimplementedTestingServer{} },
+	},
+	{
+		name:     "transport",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterTransportServer(s, b.TransportServer) },
+		disable:  func(b *services.Backend) { b.TransportServer = &pb.UnimplementedTransportServer{} },
+	},
+	{
+		name:     "webhook",
+		register: func(s *grpc.Server, b *services.Backend) { pb.RegisterWebhookServiceServer(s, b.WebhookServiceServer) },
+		disable:  func(b *services.Backend) { b.WebhookServiceServer = &pb.UnimplementedWebhookServiceServer{} },
+	},
+}
+
+// selectServices returns the services named in names, or all of them if names is empty.
+func selectServices(names []string) ([]showcaseService, error) {
+	if len(names) == 0 {
+		return showcaseServices, nil
+	}
+	selected := map[string]bool{}
+	for _, name := range names {
+		selected[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+	chosen := []showcaseService{}
+	known := []string{}
+	for _, service := range showcaseServices {
+		known = append(known, service.name)
+		if selected[service.name] {
+			chosen = append(chosen, service)
+			delete(selected, service.name)
+		}
+	}
+	if len(selected) > 0 {
+		unknown := []string{}
+		for name := range selected {
+			unknown = append(unknown, fmt.Sprintf("%q", name))
+		}
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown services %s: must be among %s", strings.Join(unknown, ", "), strings.Join(known, ", "))
+	}
+	return chosen, nil
+}
+
+// restrictServices disables the services of backend not named in names, unless names is empty.
+func restrictServices(backend *services.Backend, names []string) error {
+	selected, err := selectServices(names)
+	if err != nil {
+		return err
+	}
+	enabled := map[string]bool{}
+	for _, service := range selected {
+		enabled[service.name] = true
+	}
+	for _, service := range showcaseServices {
+		if !enabled[service.name] {
+			service.disable(backend)
+		}
+	}
+	return nil
+}
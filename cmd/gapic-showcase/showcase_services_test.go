@@ -0,0 +1,75 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSelectServices(t *testing.T) {
+	all, err := selectServices(nil)
+	if err != nil || len(all) != len(showcaseServices) {
+		t.Errorf("selectServices(nil): want all %d services, got %d, %v", len(showcaseServices), len(all), err)
+	}
+	selected, err := selectServices([]string{"Messaging", " echo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 2 || selected[0].name != "echo" || selected[1].name != "messaging" {
+		t.Errorf("selectServices: want echo and messaging, got %v", selected)
+	}
+	if _, err := selectServices([]string{"echo", "sequenceservice"}); err == nil {
+		t.Error("selectServices with an unknown service: want an error")
+	}
+}
+
+func TestServicesGRPC(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	config := RuntimeConfig{services: []string{"echo"}, reflectionVersion: "none"}
+	endpoint := newEndpointGRPC(lis, config, createBackends(config)).(*endpointGRPC)
+
+	info := endpoint.server.GetServiceInfo()
+	for _, name := range []string{"google.showcase.v1beta1.Echo", "google.longrunning.Operations", "grpc.health.v1.Health"} {
+		if _, ok := info[name]; !ok {
+			t.Errorf("want %s served", name)
+		}
+	}
+	for _, name := range []string{"google.showcase.v1beta1.Identity", "grpc.reflection.v1.ServerReflection", "grpc.reflection.v1alpha.ServerReflection"} {
+		if _, ok := info[name]; ok {
+			t.Errorf("want %s not served", name)
+		}
+	}
+}
+
+func TestServicesREST(t *testing.T) {
+	server := startRESTServer(t, RuntimeConfig{services: []string{"echo"}})
+	defer server.Close()
+
+	if code, data := postREST(t, server.URL+"/v1beta1/echo:echo", `{"content":"hi"}`); code != http.StatusOK {
+		t.Errorf("Echo: want status 200, got %d: %s", code, data)
+	}
+	// The generated handlers answer every error of the services with a 500.
+	if code, data := postREST(t, server.URL+"/v1beta1/users", `{"user":{"displayName":"Rumble","email":"rumble@goodboi.com"}}`); code != http.StatusInternalServerError ||
+		!strings.Contains(string(data), "Unimplemented") {
+		t.Errorf("CreateUser: want status 500 for an Unimplemented error, got %d: %s", code, data)
+	}
+}
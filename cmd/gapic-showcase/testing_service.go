@@ -11,7 +11,7 @@ import (
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
 
-	gapic "github.com/googleapis/gapic-showcase/client"
+	gapic "github.com/googleapis/gapic-showcase/client/apiv1beta1"
 )
 
 var TestingConfig *viper.Viper
@@ -22,9 +22,13 @@ var TestingSubCommands []string = []string{
 	"list-sessions",
 	"delete-session",
 	"report-session",
+	"get-conformance-summary",
 	"list-tests",
 	"delete-test",
 	"verify-test",
+	"get-scratchpad-entry",
+	"set-scratchpad-entry",
+	"delete-scratchpad-entry",
 }
 
 func init() {
@@ -33,6 +33,8 @@ func init() {
 
 	UpdateRoomCmd.Flags().StringVar(&UpdateRoomInput.Room.Description, "room.description", "", "The description of the chat room.")
 
+	UpdateRoomCmd.Flags().StringVar(&UpdateRoomInput.Room.Etag, "room.etag", "", "A checksum of the room's state, changing every...")
+
 	UpdateRoomCmd.Flags().StringSliceVar(&UpdateRoomInput.UpdateMask.Paths, "update_mask.paths", []string{}, "The set of field mask paths.")
 
 	UpdateRoomCmd.Flags().StringVar(&UpdateRoomFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
@@ -49,6 +49,8 @@ func init() {
 
 	UpdateUserCmd.Flags().BoolVar(&updateUserInputUserEnableNotifications, "user.enable_notifications", false, "Enables the receiving of notifications. The...")
 
+	UpdateUserCmd.Flags().StringVar(&UpdateUserInput.User.Etag, "user.etag", "", "A checksum of the user's state, changing every...")
+
 	UpdateUserCmd.Flags().StringSliceVar(&UpdateUserInput.UpdateMask.Paths, "update_mask.paths", []string{}, "The set of field mask paths.")
 
 	UpdateUserCmd.Flags().StringVar(&UpdateUserFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
@@ -19,6 +19,8 @@ import (
 
 	statuspb "google.golang.org/genproto/googleapis/rpc/status"
 
+	"strings"
+
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -44,6 +46,10 @@ var WaitInputResponseSuccess genprotopb.WaitRequest_Success
 
 var WaitInputResponseErrorDetails []string
 
+var WaitInputMetadataVariant string
+
+var waitInputPendingPolls int32
+
 func init() {
 	EchoServiceCmd.AddCommand(WaitCmd)
 
@@ -55,6 +61,10 @@ func init() {
 
 	WaitInputResponseSuccess.Success = new(genprotopb.WaitResponse)
 
+	WaitInput.ProgressInterval = new(durationpb.Duration)
+
+	WaitInput.StartTime = new(timestamppb.Timestamp)
+
 	WaitCmd.Flags().Int64Var(&WaitInputEndEndTime.EndTime.Seconds, "end.end_time.seconds", 0, "Represents seconds of UTC time since Unix epoch ...")
 
 	WaitCmd.Flags().Int32Var(&WaitInputEndEndTime.EndTime.Nanos, "end.end_time.nanos", 0, "Non-negative fractions of a second at nanosecond...")
@@ -71,6 +81,18 @@ func init() {
 
 	WaitCmd.Flags().StringVar(&WaitInputResponseSuccess.Success.Content, "response.success.content", "", "This content of the result.")
 
+	WaitCmd.Flags().StringVar(&WaitInputMetadataVariant, "metadata_variant", "", "The metadata the operation carries while pending.")
+
+	WaitCmd.Flags().Int64Var(&WaitInput.ProgressInterval.Seconds, "progress_interval.seconds", 0, "Signed seconds of the span of time. Must be from...")
+
+	WaitCmd.Flags().Int32Var(&WaitInput.ProgressInterval.Nanos, "progress_interval.nanos", 0, "Signed fractions of a second at nanosecond...")
+
+	WaitCmd.Flags().Int64Var(&WaitInput.StartTime.Seconds, "start_time.seconds", 0, "Represents seconds of UTC time since Unix epoch ...")
+
+	WaitCmd.Flags().Int32Var(&WaitInput.StartTime.Nanos, "start_time.nanos", 0, "Non-negative fractions of a second at nanosecond...")
+
+	WaitCmd.Flags().Int32Var(&waitInputPendingPolls, "pending_polls", 0, "If set, the operation is not done for this many...")
+
 	WaitCmd.Flags().StringVar(&WaitInputEnd, "end", "", "Choices: end_time, ttl")
 
 	WaitCmd.Flags().StringVar(&WaitInputResponse, "response", "", "Choices: error, success")
@@ -92,7 +114,7 @@ func init() {
 var WaitCmd = &cobra.Command{
 	Use:   "wait",
 	Short: "This method will wait for the requested amount of...",
-	Long:  "This method will wait for the requested amount of time and then return.  This method showcases how a client handles a request timeout.",
+	Long:  "This method will wait for the requested amount of time and then return.  This method showcases how a client handles a request timeout. Cancelling ...",
 	PreRun: func(cmd *cobra.Command, args []string) {
 
 		if WaitFromFile == "" {
@@ -145,6 +167,12 @@ var WaitCmd = &cobra.Command{
 				return fmt.Errorf("Missing oneof choice for response")
 			}
 
+			WaitInput.MetadataVariant = genprotopb.WaitRequest_MetadataVariant(genprotopb.WaitRequest_MetadataVariant_value[strings.ToUpper(WaitInputMetadataVariant)])
+
+			if cmd.Flags().Changed("pending_polls") {
+				WaitInput.PendingPolls = &waitInputPendingPolls
+			}
+
 		}
 
 		// unmarshal JSON strings into slice of structs
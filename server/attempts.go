@@ -0,0 +1,74 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// AttemptCountToken prefixes the token of the x-goog-api-client header in which generated
+	// clients report the number of the attempt of a call, starting at 1.
+	AttemptCountToken = "gccl-attempt-count/"
+
+	// PreviousAttemptsHeader is the header in which gRPC clients retrying a call report the
+	// number of attempts made before.
+	PreviousAttemptsHeader = "grpc-previous-rpc-attempts"
+)
+
+type connectionIDKey struct{}
+
+// WithConnectionID returns a copy of ctx identifying the connection of its call, such as a REST
+// call, by id.
+func WithConnectionID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, connectionIDKey{}, id)
+}
+
+// ConnectionID identifies the connection of the call ctx belongs to by the address of the
+// client's end of it, or returns "" if it is not known.
+func ConnectionID(ctx context.Context) string {
+	if id, ok := ctx.Value(connectionIDKey{}).(string); ok {
+		return id
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// ClientAttempt returns the number of the attempt of a call according to the client, starting
+// at 1, from the AttemptCountToken of the x-goog-api-client header in md or else from its
+// PreviousAttemptsHeader. It returns 0 if the client reported neither.
+func ClientAttempt(md metadata.MD) int32 {
+	for _, token := range strings.Fields(strings.Join(md.Get("x-goog-api-client"), " ")) {
+		if !strings.HasPrefix(token, AttemptCountToken) {
+			continue
+		}
+		if n, err := strconv.ParseInt(strings.TrimPrefix(token, AttemptCountToken), 10, 32); err == nil && n > 0 {
+			return int32(n)
+		}
+	}
+	if values := md.Get(PreviousAttemptsHeader); len(values) > 0 {
+		if n, err := strconv.ParseInt(values[0], 10, 32); err == nil && n >= 0 {
+			return int32(n) + 1
+		}
+	}
+	return 0
+}
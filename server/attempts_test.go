@@ -0,0 +1,59 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientAttempt(t *testing.T) {
+	tests := []struct {
+		md   metadata.MD
+		want int32
+	}{
+		{metadata.Pairs("x-goog-api-client", "gl-go/1.16.0 gccl-attempt-count/3 gapic/0.1.0"), 3},
+		{metadata.Pairs("x-goog-api-client", "gl-go/1.16.0 gccl-attempt-count/2", PreviousAttemptsHeader, "4"), 2},
+		{metadata.Pairs(PreviousAttemptsHeader, "1"), 2},
+		{metadata.Pairs(PreviousAttemptsHeader, "0"), 1},
+		{metadata.Pairs("x-goog-api-client", "gccl-attempt-count/many"), 0},
+		{metadata.Pairs(PreviousAttemptsHeader, "-1"), 0},
+		{metadata.MD{}, 0},
+	}
+	for _, tt := range tests {
+		if got := ClientAttempt(tt.md); got != tt.want {
+			t.Errorf("ClientAttempt(%v): got %d, want %d", tt.md, got, tt.want)
+		}
+	}
+}
+
+func TestConnectionID(t *testing.T) {
+	if got := ConnectionID(context.Background()); got != "" {
+		t.Errorf("ConnectionID without a connection: got %q, want \"\"", got)
+	}
+
+	addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 5000}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	if got := ConnectionID(ctx); got != "127.0.0.1:5000" {
+		t.Errorf("ConnectionID of a gRPC call: got %q, want %q", got, "127.0.0.1:5000")
+	}
+	if got := ConnectionID(WithConnectionID(ctx, "127.0.0.1:6000")); got != "127.0.0.1:6000" {
+		t.Errorf("ConnectionID of a REST call: got %q, want %q", got, "127.0.0.1:6000")
+	}
+}
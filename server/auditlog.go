@@ -0,0 +1,217 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxAuditEvents is the number of most recent events an AuditLog keeps for replay.
+	maxAuditEvents = 1000
+
+	// auditTailBuffer is the number of events a tail may fall behind by before it is
+	// dropped.
+	auditTailBuffer = 100
+)
+
+// AuditLog logs the calls creating, updating and deleting resources, and streams them to the
+// tails subscribed, so that tests can assert the side effects of writes as they happen.
+type AuditLog struct {
+	mu      sync.Mutex
+	events  []*pb.AuditEvent
+	seq     int64
+	tails   map[*auditTail]bool
+	nowF    func() time.Time
+	publish *ServerEvents
+}
+
+// auditTail is a subscriber to an AuditLog.
+type auditTail struct {
+	prefix  string
+	events  chan *pb.AuditEvent
+	dropped bool
+}
+
+// NewAuditLog creates an empty AuditLog.
+func NewAuditLog() *AuditLog {
+	return &AuditLog{tails: map[*auditTail]bool{}, nowF: time.Now}
+}
+
+// PublishTo makes the log publish a STATE_MUTATED event to events for each call it records.
+func (l *AuditLog) PublishTo(events *ServerEvents) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.publish = events
+}
+
+// Record logs a call to method, made with the credentials in ctx, doing action to resource,
+// which went from before to after. Either of before and after may be nil.
+func (l *AuditLog) Record(ctx context.Context, method string, action pb.AuditEvent_Action, resource string, before, after proto.Message) {
+	event := &pb.AuditEvent{
+		Method:   method,
+		Action:   action,
+		Actor:    auditActor(ctx),
+		Resource: resource,
+		Changes:  diffFields(before, after),
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.seq++
+	event.Sequence = l.seq
+	event.Time = timestamppb.New(l.nowF())
+	l.events = append(l.events, event)
+	if len(l.events) > maxAuditEvents {
+		l.events = l.events[len(l.events)-maxAuditEvents:]
+	}
+	l.publish.StateMutated(method, resource)
+	for tail := range l.tails {
+		if !strings.HasPrefix(resource, tail.prefix) {
+			continue
+		}
+		select {
+		case tail.events <- event:
+		default:
+			tail.dropped = true
+			close(tail.events)
+			delete(l.tails, tail)
+		}
+	}
+}
+
+// Tail sends the events about the resources whose name starts with prefix, logged from now on,
+// until ctx is done or send fails. If replay is true, the events kept from before are sent
+// first. Tails falling too far behind fail with ResourceExhausted.
+func (l *AuditLog) Tail(ctx context.Context, prefix string, replay bool, send func(*pb.AuditEvent) error) error {
+	tail := &auditTail{prefix: prefix, events: make(chan *pb.AuditEvent, auditTailBuffer)}
+	l.mu.Lock()
+	backlog := []*pb.AuditEvent{}
+	if replay {
+		for _, event := range l.events {
+			if strings.HasPrefix(event.GetResource(), prefix) {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+	l.tails[tail] = true
+	l.mu.Unlock()
+	defer func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.tails, tail)
+	}()
+
+	for _, event := range backlog {
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case event, ok := <-tail.events:
+			if !ok {
+				return status.Errorf(codes.ResourceExhausted, "the tail fell more than %d events behind the audit log", auditTailBuffer)
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// auditActor names who made the call in ctx by its credentials.
+func auditActor(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		if len(value) > len("bearer ") && strings.EqualFold(value[:len("bearer ")], "bearer ") {
+			return "bearer:" + strings.TrimSpace(value[len("bearer "):])
+		}
+	}
+	if keys := md.Get("x-goog-api-key"); len(keys) > 0 {
+		return "api-key:" + keys[0]
+	}
+	return "anonymous"
+}
+
+// diffFields returns the fields that differ between the JSON representations of before and
+// after, sorted by path.
+func diffFields(before, after proto.Message) []*pb.AuditEvent_FieldChange {
+	beforeFields, afterFields := map[string]string{}, map[string]string{}
+	flattenJSON(before, beforeFields)
+	flattenJSON(after, afterFields)
+
+	paths := []string{}
+	for path, value := range beforeFields {
+		if afterFields[path] != value {
+			paths = append(paths, path)
+		}
+	}
+	for path := range afterFields {
+		if _, ok := beforeFields[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	changes := []*pb.AuditEvent_FieldChange{}
+	for _, path := range paths {
+		changes = append(changes, &pb.AuditEvent_FieldChange{Path: path, Before: beforeFields[path], After: afterFields[path]})
+	}
+	return changes
+}
+
+// flattenJSON adds to fields the JSON representation of each leaf field of message, keyed by
+// its path. The fields whose JSON representation is not an object, such as repeated fields and
+// timestamps, are leaves.
+func flattenJSON(message proto.Message, fields map[string]string) {
+	if message == nil {
+		return
+	}
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return
+	}
+	flattenObject("", object, fields)
+}
+
+func flattenObject(prefix string, object map[string]interface{}, fields map[string]string) {
+	for name, value := range object {
+		if nested, ok := value.(map[string]interface{}); ok {
+			flattenObject(prefix+name+".", nested, fields)
+			continue
+		}
+		data, _ := json.Marshal(value)
+		fields[prefix+name] = string(data)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAuditActor(t *testing.T) {
+	for _, testCase := range []struct {
+		md   metadata.MD
+		want string
+	}{
+		{nil, "anonymous"},
+		{metadata.Pairs("authorization", "Bearer alice-token"), "bearer:alice-token"},
+		{metadata.Pairs("authorization", "Basic YWxpY2U="), "anonymous"},
+		{metadata.Pairs("x-goog-api-key", "key"), "api-key:key"},
+		{metadata.Pairs("x-goog-api-key", "key", "authorization", "bearer t"), "bearer:t"},
+	} {
+		ctx := metadata.NewIncomingContext(context.Background(), testCase.md)
+		if got := auditActor(ctx); got != testCase.want {
+			t.Errorf("%v: want %q, got %q", testCase.md, testCase.want, got)
+		}
+	}
+}
+
+func TestDiffFields(t *testing.T) {
+	before := &pb.User{Name: "users/0", DisplayName: "Alice", Email: "alice@example.com", CreateTime: &timestamppb.Timestamp{Seconds: 1}}
+	after := &pb.User{Name: "users/0", DisplayName: "Alicia", CreateTime: &timestamppb.Timestamp{Seconds: 1}, Age: proto.Int32(30)}
+	want := []*pb.AuditEvent_FieldChange{
+		{Path: "age", After: "30"},
+		{Path: "displayName", Before: `"Alice"`, After: `"Alicia"`},
+		{Path: "email", Before: `"alice@example.com"`},
+	}
+	got := diffFields(before, after)
+	if len(got) != len(want) {
+		t.Fatalf("want %v, got %v", want, got)
+	}
+	for i := range want {
+		if !proto.Equal(got[i], want[i]) {
+			t.Errorf("change %d: want %v, got %v", i, want[i], got[i])
+		}
+	}
+
+	blurb := &pb.Blurb{Name: "rooms/0/blurbs/0", Content: &pb.Blurb_Text{Text: "hi"}}
+	paths := []string{}
+	for _, change := range diffFields(nil, blurb) {
+		paths = append(paths, change.GetPath())
+	}
+	if want := []string{"name", "text"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("creation: want paths %v, got %v", want, paths)
+	}
+}
+
+func TestAuditLog_tail(t *testing.T) {
+	log := NewAuditLog()
+	ctx := context.Background()
+	log.Record(ctx, "google.showcase.v1beta1.Identity/CreateUser", pb.AuditEvent_CREATE, "users/0", nil, &pb.User{Name: "users/0"})
+	log.Record(ctx, "google.showcase.v1beta1.Messaging/CreateRoom", pb.AuditEvent_CREATE, "rooms/0", nil, &pb.Room{Name: "rooms/0"})
+
+	tailCtx, cancel := context.WithCancel(ctx)
+	events := make(chan *pb.AuditEvent, 10)
+	done := make(chan error)
+	go func() {
+		done <- log.Tail(tailCtx, "rooms/", true, func(event *pb.AuditEvent) error {
+			events <- event
+			return nil
+		})
+	}()
+
+	if event := <-events; event.GetResource() != "rooms/0" || event.GetSequence() != 2 {
+		t.Errorf("replay: want the rooms/0 event, got %v", event)
+	}
+	// Wait for the tail to subscribe before logging more events.
+	for {
+		log.mu.Lock()
+		subscribed := len(log.tails) == 1
+		log.mu.Unlock()
+		if subscribed {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	log.Record(ctx, "google.showcase.v1beta1.Identity/DeleteUser", pb.AuditEvent_DELETE, "users/0", &pb.User{Name: "users/0"}, nil)
+	log.Record(ctx, "google.showcase.v1beta1.Messaging/DeleteRoom", pb.AuditEvent_DELETE, "rooms/0", &pb.Room{Name: "rooms/0"}, nil)
+	if event := <-events; event.GetResource() != "rooms/0" || event.GetAction() != pb.AuditEvent_DELETE || event.GetSequence() != 4 {
+		t.Errorf("tail: want the deletion of rooms/0, got %v", event)
+	}
+
+	cancel()
+	if err := <-done; status.Code(err) != codes.Canceled {
+		t.Errorf("want Canceled once the call is cancelled, got %v", err)
+	}
+}
+
+func TestAuditLog_fallingBehind(t *testing.T) {
+	log := NewAuditLog()
+	block := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- log.Tail(context.Background(), "", false, func(*pb.AuditEvent) error {
+			<-block
+			return nil
+		})
+	}()
+	for {
+		log.mu.Lock()
+		subscribed := len(log.tails) == 1
+		log.mu.Unlock()
+		if subscribed {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	for i := 0; i < auditTailBuffer+2; i++ {
+		log.Record(context.Background(), "m", pb.AuditEvent_CREATE, "users/0", nil, nil)
+	}
+	close(block)
+	if err := <-done; status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("want ResourceExhausted for a tail falling behind, got %v", err)
+	}
+}
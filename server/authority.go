@@ -0,0 +1,146 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxAuthorityRecords is the number of most recent calls an AuthorityRecorder keeps.
+const maxAuthorityRecords = 1000
+
+// authorityExemptPrefix is the gRPC method prefix of the calls that are never failed for being
+// sent to an unexpected authority.
+const authorityExemptPrefix = "/google.showcase.v1beta1.Transport/"
+
+// AuthorityRecorder records the authority each call was sent to and, once an authority is
+// expected, fails the calls sent to any other, so that clients' endpoint selection can be
+// asserted server-side.
+type AuthorityRecorder struct {
+	mu       sync.Mutex
+	records  []*pb.AuthorityRecord
+	expected string
+	nowF     func() time.Time
+}
+
+// NewAuthorityRecorder creates an AuthorityRecorder expecting no particular authority.
+func NewAuthorityRecorder() *AuthorityRecorder {
+	return &AuthorityRecorder{nowF: time.Now}
+}
+
+// Expect makes the recorder fail the calls not sent to authority, or lifts the expectation if
+// authority is empty. It returns the authority previously expected.
+func (r *AuthorityRecorder) Expect(authority string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	previous := r.expected
+	r.expected = authority
+	return previous
+}
+
+// Records returns the calls recorded, oldest first. If method is not empty, only the calls to
+// that method are returned.
+func (r *AuthorityRecorder) Records(method string) []*pb.AuthorityRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	records := []*pb.AuthorityRecord{}
+	for _, record := range r.records {
+		if method == "" || record.GetMethod() == method {
+			records = append(records, proto.Clone(record).(*pb.AuthorityRecord))
+		}
+	}
+	return records
+}
+
+// Observe records a call to method over transport sent to authority. Unless exempt is true, it
+// returns a FailedPrecondition error if the call was not sent to the expected authority.
+func (r *AuthorityRecorder) Observe(transport, method, authority string, exempt bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record := &pb.AuthorityRecord{
+		Method:    method,
+		Transport: transport,
+		Authority: authority,
+		Time:      timestamppb.New(r.nowF()),
+		Rejected:  !exempt && !authorityMatches(authority, r.expected),
+	}
+	r.records = append(r.records, record)
+	if len(r.records) > maxAuthorityRecords {
+		r.records = r.records[len(r.records)-maxAuthorityRecords:]
+	}
+	if record.GetRejected() {
+		return status.Errorf(codes.FailedPrecondition, "call sent to authority %q, but the server expects %q", authority, r.expected)
+	}
+	return nil
+}
+
+// authorityMatches returns whether authority is expected. An expected authority without a port
+// matches any port.
+func authorityMatches(authority, expected string) bool {
+	if expected == "" || strings.EqualFold(authority, expected) {
+		return true
+	}
+	if _, _, err := net.SplitHostPort(expected); err == nil {
+		return false
+	}
+	host, _, err := net.SplitHostPort(authority)
+	return err == nil && strings.EqualFold(host, expected)
+}
+
+// observe records a gRPC call to method.
+func (r *AuthorityRecorder) observe(ctx context.Context, method string) error {
+	authority := ""
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(":authority"); len(values) > 0 {
+		authority = values[0]
+	}
+	return r.Observe("grpc", method, authority, strings.HasPrefix(method, authorityExemptPrefix))
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, recording the authority of calls.
+func (r *AuthorityRecorder) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := r.observe(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, recording the authority of calls.
+func (r *AuthorityRecorder) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := r.observe(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorityMatches(t *testing.T) {
+	for _, testCase := range []struct {
+		authority, expected string
+		want                bool
+	}{
+		{"localhost:7469", "", true},
+		{"localhost:7469", "localhost:7469", true},
+		{"LOCALHOST:7469", "localhost:7469", true},
+		{"localhost:7469", "localhost", true},
+		{"localhost:7469", "localhost:443", false},
+		{"us-east1-showcase.example.com", "showcase.example.com", false},
+		{"", "localhost", false},
+	} {
+		if got := authorityMatches(testCase.authority, testCase.expected); got != testCase.want {
+			t.Errorf("authorityMatches(%q, %q): got %v, want %v", testCase.authority, testCase.expected, got, testCase.want)
+		}
+	}
+}
+
+func TestAuthorityRecorder(t *testing.T) {
+	r := NewAuthorityRecorder()
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	call := func(method, authority string) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(":authority", authority))
+		_, err := r.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	if err := call("/google.showcase.v1beta1.Echo/Echo", "localhost:7469"); err != nil {
+		t.Errorf("Echo: unexpected err %v", err)
+	}
+	if previous := r.Expect("us-east1.example.com"); previous != "" {
+		t.Errorf("Expect: want no previous authority, got %q", previous)
+	}
+	if err := call("/google.showcase.v1beta1.Echo/Echo", "localhost:7469"); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Echo: want FailedPrecondition for an unexpected authority, got %v", err)
+	}
+	if err := call("/google.showcase.v1beta1.Echo/Echo", "us-east1.example.com:443"); err != nil {
+		t.Errorf("Echo: unexpected err %v", err)
+	}
+	if err := call("/google.showcase.v1beta1.Transport/ExpectAuthority", "localhost:7469"); err != nil {
+		t.Errorf("ExpectAuthority: calls to the Transport service should not be failed, got %v", err)
+	}
+
+	records := r.Records("/google.showcase.v1beta1.Echo/Echo")
+	if len(records) != 3 {
+		t.Fatalf("Records: want 3 Echo calls, got %d", len(records))
+	}
+	for idx, want := range []struct {
+		authority string
+		rejected  bool
+	}{
+		{"localhost:7469", false},
+		{"localhost:7469", true},
+		{"us-east1.example.com:443", false},
+	} {
+		if got := records[idx]; got.GetAuthority() != want.authority || got.GetRejected() != want.rejected || got.GetTransport() != "grpc" {
+			t.Errorf("Records[%d]: got %v, want authority %q rejected %v", idx, got, want.authority, want.rejected)
+		}
+	}
+	if got := len(r.Records("")); got != 4 {
+		t.Errorf("Records: want 4 calls in total, got %d", got)
+	}
+
+	for i := 0; i < maxAuthorityRecords; i++ {
+		r.Observe("rest", "GET /hello", "localhost", true)
+	}
+	if got := len(r.Records("")); got != maxAuthorityRecords {
+		t.Errorf("Records: want the %d most recent calls, got %d", maxAuthorityRecords, got)
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// BarrierHeader is the header naming the barrier a call joins.
+	BarrierHeader = "x-showcase-barrier"
+
+	// BarrierTagHeader is the header naming a call held at a barrier.
+	BarrierTagHeader = "x-showcase-barrier-tag"
+
+	// defaultBarrierTimeout is how long calls are held at a barrier armed without a timeout.
+	defaultBarrierTimeout = 30 * time.Second
+)
+
+// barrierExemptPrefix is the gRPC method prefix of the calls that never wait at a barrier.
+const barrierExemptPrefix = "/google.showcase.v1beta1.Barrier/"
+
+// heldCall is a call waiting at a barrier.
+type heldCall struct {
+	tag     string
+	release chan struct{}
+	done    chan struct{}
+}
+
+type barrier struct {
+	timeout time.Duration
+	held    []*heldCall
+	arrived int
+
+	// changed is closed and replaced whenever a call arrives.
+	changed chan struct{}
+}
+
+// BarrierManager holds calls joining an armed barrier until the barrier is released, so that
+// tests can decide the order in which concurrent calls complete.
+type BarrierManager struct {
+	mu       sync.Mutex
+	barriers map[string]*barrier
+}
+
+// NewBarrierManager creates a BarrierManager without any barriers armed.
+func NewBarrierManager() *BarrierManager {
+	return &BarrierManager{barriers: map[string]*barrier{}}
+}
+
+// Arm arms the barrier id, holding each call joining it for at most timeout, or
+// defaultBarrierTimeout if timeout is 0.
+func (m *BarrierManager) Arm(id string, timeout time.Duration) (*pb.BarrierState, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "barrier_id must be set")
+	}
+	if timeout < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "timeout must not be negative, got %v", timeout)
+	}
+	if timeout == 0 {
+		timeout = defaultBarrierTimeout
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.barriers[id]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "barrier %q is already armed", id)
+	}
+	m.barriers[id] = &barrier{timeout: timeout, changed: make(chan struct{})}
+	return &pb.BarrierState{BarrierId: id}, nil
+}
+
+// Hold makes the calling RPC wait at the barrier id under tag, or under the order it arrived in
+// if tag is empty. It returns once the call is released, along with a function to call when the
+// held RPC has been served.
+func (m *BarrierManager) Hold(ctx context.Context, id, tag string) (func(), error) {
+	m.mu.Lock()
+	b, ok := m.barriers[id]
+	if !ok {
+		m.mu.Unlock()
+		return nil, status.Errorf(codes.FailedPrecondition, "barrier %q is not armed", id)
+	}
+	b.arrived++
+	if tag == "" {
+		tag = strconv.Itoa(b.arrived)
+	}
+	for _, call := range b.held {
+		if call.tag == tag {
+			m.mu.Unlock()
+			return nil, status.Errorf(codes.AlreadyExists, "a call tagged %q is already held at barrier %q", tag, id)
+		}
+	}
+	call := &heldCall{tag: tag, release: make(chan struct{}), done: make(chan struct{})}
+	b.held = append(b.held, call)
+	close(b.changed)
+	b.changed = make(chan struct{})
+	timeout := b.timeout
+	m.mu.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-call.release:
+		return func() { close(call.done) }, nil
+	case <-timer.C:
+		if m.drop(id, call) {
+			return nil, status.Errorf(codes.Aborted, "barrier %q was not released within %v", id, timeout)
+		}
+	case <-ctx.Done():
+		if m.drop(id, call) {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	// The call was released while it was giving up, so it is served anyway.
+	<-call.release
+	return func() { close(call.done) }, nil
+}
+
+// drop stops holding call at the barrier id, reporting whether it was still held.
+func (m *BarrierManager) drop(id string, call *heldCall) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	b, ok := m.barriers[id]
+	if !ok {
+		return false
+	}
+	for i, held := range b.held {
+		if held == call {
+			b.held = append(b.held[:i], b.held[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Release waits until waitFor calls are held at the barrier id, then disarms it and releases
+// the calls held one at a time, each once the one before it has been served: first the calls
+// tagged in order, then the remaining calls in the order they arrived.
+func (m *BarrierManager) Release(ctx context.Context, id string, order []string, waitFor int) ([]string, error) {
+	for {
+		m.mu.Lock()
+		b, ok := m.barriers[id]
+		if !ok {
+			m.mu.Unlock()
+			return nil, status.Errorf(codes.NotFound, "barrier %q is not armed", id)
+		}
+		if len(b.held) >= waitFor {
+			break
+		}
+		changed, held := b.changed, len(b.held)
+		m.mu.Unlock()
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, status.Errorf(status.FromContextError(ctx.Err()).Code(), "%d of %d calls arrived at barrier %q", held, waitFor, id)
+		}
+	}
+	calls, err := releaseOrder(m.barriers[id].held, order)
+	if err != nil {
+		m.mu.Unlock()
+		return nil, err
+	}
+	delete(m.barriers, id)
+	m.mu.Unlock()
+
+	released := []string{}
+	for i, call := range calls {
+		close(call.release)
+		released = append(released, call.tag)
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			// Let the remaining calls go, in order, without waiting on them.
+			for _, rest := range calls[i+1:] {
+				close(rest.release)
+			}
+			return nil, status.Errorf(status.FromContextError(ctx.Err()).Code(), "releasing barrier %q: call %q was not served in time", id, call.tag)
+		}
+	}
+	return released, nil
+}
+
+// releaseOrder returns held reordered so that the calls tagged in order come first.
+func releaseOrder(held []*heldCall, order []string) ([]*heldCall, error) {
+	byTag := map[string]*heldCall{}
+	for _, call := range held {
+		byTag[call.tag] = call
+	}
+	calls := []*heldCall{}
+	listed := map[string]bool{}
+	for _, tag := range order {
+		call, ok := byTag[tag]
+		if !ok {
+			tags := []string{}
+			for _, call := range held {
+				tags = append(tags, call.tag)
+			}
+			return nil, status.Errorf(codes.FailedPrecondition, "no call tagged %q is held; held calls: [%s]", tag, strings.Join(tags, ", "))
+		}
+		if listed[tag] {
+			return nil, status.Errorf(codes.InvalidArgument, "call %q is listed twice in order", tag)
+		}
+		listed[tag] = true
+		calls = append(calls, call)
+	}
+	for _, call := range held {
+		if !listed[call.tag] {
+			calls = append(calls, call)
+		}
+	}
+	return calls, nil
+}
+
+// hold holds a gRPC call if its metadata names a barrier.
+func (m *BarrierManager) hold(ctx context.Context, method string) (func(), error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ids := md.Get(BarrierHeader)
+	if len(ids) == 0 || strings.HasPrefix(method, barrierExemptPrefix) {
+		return func() {}, nil
+	}
+	if len(ids) > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "a call may join one barrier, got %d", len(ids))
+	}
+	tag := ""
+	if tags := md.Get(BarrierTagHeader); len(tags) > 0 {
+		tag = tags[0]
+	}
+	return m.Hold(ctx, ids[0], tag)
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, holding calls that join a barrier.
+func (m *BarrierManager) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	done, err := m.hold(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, holding calls that join a barrier
+// before their handler starts.
+func (m *BarrierManager) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	done, err := m.hold(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer done()
+	return handler(srv, ss)
+}
@@ -0,0 +1,118 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestBarrierManager_releaseOrder(t *testing.T) {
+	m := NewBarrierManager()
+	if _, err := m.Arm("race", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	var mu sync.Mutex
+	completed := []string{}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		completed = append(completed, req.(string))
+		return req, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+	var wg sync.WaitGroup
+	for _, tag := range []string{"a", "b", "c"} {
+		wg.Add(1)
+		go func(tag string) {
+			defer wg.Done()
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(BarrierHeader, "race", BarrierTagHeader, tag))
+			if _, err := m.UnaryInterceptor(ctx, tag, info, handler); err != nil {
+				t.Errorf("call %q: %v", tag, err)
+			}
+		}(tag)
+	}
+
+	released, err := m.Release(context.Background(), "race", []string{"c", "a"}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+	want := []string{"c", "a", "b"}
+	if !reflect.DeepEqual(released, want) {
+		t.Errorf("released: want %v, got %v", want, released)
+	}
+	if !reflect.DeepEqual(completed, want) {
+		t.Errorf("completed: want %v, got %v", want, completed)
+	}
+
+	if _, err := m.Release(context.Background(), "race", nil, 0); status.Code(err) != codes.NotFound {
+		t.Errorf("releasing a released barrier: want NotFound, got %v", err)
+	}
+}
+
+func TestBarrierManager_errors(t *testing.T) {
+	m := NewBarrierManager()
+	if _, err := m.Arm("", 0); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Arm without an ID: want InvalidArgument, got %v", err)
+	}
+	if _, err := m.Arm("b", 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Arm("b", 0); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("arming twice: want AlreadyExists, got %v", err)
+	}
+	if _, err := m.Hold(context.Background(), "unarmed", ""); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("joining an unarmed barrier: want FailedPrecondition, got %v", err)
+	}
+	if _, err := m.Hold(context.Background(), "b", ""); status.Code(err) != codes.Aborted {
+		t.Errorf("held past the timeout: want Aborted, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := m.Release(ctx, "b", nil, 1); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("waiting for calls that never arrive: want DeadlineExceeded, got %v", err)
+	}
+	if _, err := m.Release(context.Background(), "b", []string{"missing"}, 0); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("releasing a call not held: want FailedPrecondition, got %v", err)
+	}
+}
+
+func TestBarrierManager_passThrough(t *testing.T) {
+	m := NewBarrierManager()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	for _, tt := range []struct {
+		method string
+		md     metadata.MD
+	}{
+		{"/google.showcase.v1beta1.Echo/Echo", metadata.MD{}},
+		{"/google.showcase.v1beta1.Barrier/ReleaseBarrier", metadata.Pairs(BarrierHeader, "unarmed")},
+	} {
+		ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+		if _, err := m.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler); err != nil {
+			t.Errorf("%s with %v: unexpected err %v", tt.method, tt.md, err)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	binlogpb "google.golang.org/grpc/binarylog/grpc_binarylog_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxBinaryLogEntries is how many of the most recent binary log entries a BinaryLogger keeps.
+const maxBinaryLogEntries = 10000
+
+// binaryLogExemptMethods are never logged, so that reading the binary log does not add to it.
+var binaryLogExemptMethods = map[string]bool{
+	"/google.showcase.v1beta1.Transport/ListBinaryLogEntries": true,
+}
+
+type binaryLogCallKey struct{}
+
+// binaryLogCall is the state of a call being logged.
+type binaryLogCall struct {
+	id      uint64
+	timeout time.Duration
+
+	mu      sync.Mutex
+	seq     uint64
+	trailer metadata.MD
+}
+
+// BinaryLogger is a grpc stats.Handler recording the calls to the server as gRPC binary log
+// entries, with the messages as they were on the wire. The most recent entries are kept in
+// memory, and every entry can also be written to a file in the format of the binary log sinks
+// of grpc-go: each entry is a serialized grpc.binarylog.v1.GrpcLogEntry preceded by its length
+// as four big-endian bytes.
+type BinaryLogger struct {
+	methods []string
+
+	mu      sync.Mutex
+	nextID  uint64
+	entries []*binlogpb.GrpcLogEntry
+	out     io.Writer
+}
+
+// NewBinaryLogger creates a BinaryLogger for the calls to methods, each "*" for every method,
+// "<service>/*" for every method of a service, or "<service>/<method>", where <service> is the
+// full name of the service. Entries are also written to out, unless it is nil.
+func NewBinaryLogger(methods []string, out io.Writer) (*BinaryLogger, error) {
+	for _, m := range methods {
+		if m != "*" && strings.Count(m, "/") != 1 {
+			return nil, fmt.Errorf("binary log method %q must be \"*\", \"<service>/*\" or \"<service>/<method>\"", m)
+		}
+	}
+	return &BinaryLogger{methods: methods, nextID: 1, out: out}, nil
+}
+
+func (l *BinaryLogger) logs(fullMethod string) bool {
+	if binaryLogExemptMethods[fullMethod] {
+		return false
+	}
+	method := strings.TrimPrefix(fullMethod, "/")
+	for _, m := range l.methods {
+		if m == "*" || m == method || (strings.HasSuffix(m, "/*") && strings.HasPrefix(method, strings.TrimSuffix(m, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// TagConn implements stats.Handler.
+func (l *BinaryLogger) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn implements stats.Handler.
+func (l *BinaryLogger) HandleConn(context.Context, stats.ConnStats) {}
+
+// TagRPC implements stats.Handler.
+func (l *BinaryLogger) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	if !l.logs(info.FullMethodName) {
+		return ctx
+	}
+	l.mu.Lock()
+	call := &binaryLogCall{id: l.nextID}
+	l.nextID++
+	l.mu.Unlock()
+	if deadline, ok := ctx.Deadline(); ok {
+		call.timeout = time.Until(deadline)
+	}
+	return context.WithValue(ctx, binaryLogCallKey{}, call)
+}
+
+// HandleRPC implements stats.Handler.
+func (l *BinaryLogger) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	call, ok := ctx.Value(binaryLogCallKey{}).(*binaryLogCall)
+	if !ok || s.IsClient() {
+		return
+	}
+	entry := &binlogpb.GrpcLogEntry{Logger: binlogpb.GrpcLogEntry_LOGGER_SERVER}
+	switch s := s.(type) {
+	case *stats.InHeader:
+		header := &binlogpb.ClientHeader{
+			Metadata:   binaryLogMetadata(s.Header),
+			MethodName: s.FullMethod,
+		}
+		if authority := s.Header.Get(":authority"); len(authority) > 0 {
+			header.Authority = authority[0]
+		}
+		if call.timeout > 0 {
+			header.Timeout = durationpb.New(call.timeout)
+		}
+		entry.Type = binlogpb.GrpcLogEntry_EVENT_TYPE_CLIENT_HEADER
+		entry.Payload = &binlogpb.GrpcLogEntry_ClientHeader{ClientHeader: header}
+		entry.Peer = binaryLogAddress(s.RemoteAddr)
+	case *stats.InPayload:
+		entry.Type = binlogpb.GrpcLogEntry_EVENT_TYPE_CLIENT_MESSAGE
+		entry.Payload = &binlogpb.GrpcLogEntry_Message{Message: &binlogpb.Message{Length: uint32(len(s.Data)), Data: s.Data}}
+	case *stats.OutHeader:
+		entry.Type = binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_HEADER
+		entry.Payload = &binlogpb.GrpcLogEntry_ServerHeader{ServerHeader: &binlogpb.ServerHeader{Metadata: binaryLogMetadata(s.Header)}}
+	case *stats.OutPayload:
+		entry.Type = binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_MESSAGE
+		entry.Payload = &binlogpb.GrpcLogEntry_Message{Message: &binlogpb.Message{Length: uint32(len(s.Data)), Data: s.Data}}
+	case *stats.OutTrailer:
+		// The trailer is logged with the status once the call ends.
+		call.mu.Lock()
+		call.trailer = s.Trailer
+		call.mu.Unlock()
+		return
+	case *stats.End:
+		call.mu.Lock()
+		trailer := call.trailer
+		call.mu.Unlock()
+		st := status.Convert(s.Error)
+		var details []byte
+		if len(st.Proto().GetDetails()) > 0 {
+			details, _ = proto.Marshal(st.Proto())
+		}
+		entry.Type = binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_TRAILER
+		entry.Payload = &binlogpb.GrpcLogEntry_Trailer{Trailer: &binlogpb.Trailer{
+			Metadata:      binaryLogMetadata(trailer),
+			StatusCode:    uint32(st.Code()),
+			StatusMessage: st.Message(),
+			StatusDetails: details,
+		}}
+	default:
+		return
+	}
+
+	call.mu.Lock()
+	call.seq++
+	entry.CallId = call.id
+	entry.SequenceIdWithinCall = call.seq
+	call.mu.Unlock()
+	entry.Timestamp = timestamppb.Now()
+	l.record(entry)
+}
+
+func (l *BinaryLogger) record(entry *binlogpb.GrpcLogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+	if len(l.entries) > maxBinaryLogEntries {
+		l.entries = l.entries[len(l.entries)-maxBinaryLogEntries:]
+	}
+	if l.out == nil {
+		return
+	}
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		return
+	}
+	length := make([]byte, 4)
+	binary.BigEndian.PutUint32(length, uint32(len(data)))
+	l.out.Write(append(length, data...))
+}
+
+// Entries returns the entries kept in memory for the calls matching the given criteria. An
+// empty method matches every method, a 0 callID every call and an empty host every peer.
+func (l *BinaryLogger) Entries(method string, callID uint64, host string) []*binlogpb.GrpcLogEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Only the client header of a call has its method and peer.
+	matchingCalls := map[uint64]bool{}
+	for _, e := range l.entries {
+		header := e.GetClientHeader()
+		if header == nil {
+			continue
+		}
+		if (method == "" || header.GetMethodName() == method) &&
+			(callID == 0 || e.GetCallId() == callID) &&
+			(host == "" || e.GetPeer().GetAddress() == host) {
+			matchingCalls[e.GetCallId()] = true
+		}
+	}
+	entries := []*binlogpb.GrpcLogEntry{}
+	for _, e := range l.entries {
+		if matchingCalls[e.GetCallId()] {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+func binaryLogMetadata(md metadata.MD) *binlogpb.Metadata {
+	entries := []*binlogpb.MetadataEntry{}
+	for key, values := range md {
+		// Pseudo-headers and grpc- metadata other than grpc-trace-bin are left out of
+		// binary logs.
+		if strings.HasPrefix(key, ":") || (strings.HasPrefix(key, "grpc-") && key != "grpc-trace-bin") {
+			continue
+		}
+		for _, v := range values {
+			entries = append(entries, &binlogpb.MetadataEntry{Key: key, Value: []byte(v)})
+		}
+	}
+	return &binlogpb.Metadata{Entry: entries}
+}
+
+func binaryLogAddress(addr net.Addr) *binlogpb.Address {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return &binlogpb.Address{Type: binlogpb.Address_TYPE_UNKNOWN}
+	}
+	addrType := binlogpb.Address_TYPE_IPV6
+	if tcpAddr.IP.To4() != nil {
+		addrType = binlogpb.Address_TYPE_IPV4
+	}
+	return &binlogpb.Address{Type: addrType, Address: tcpAddr.IP.String(), IpPort: uint32(tcpAddr.Port)}
+}
+
+// MultiStatsHandler returns a stats.Handler passing everything to each of handlers in turn.
+func MultiStatsHandler(handlers ...stats.Handler) stats.Handler {
+	return multiStatsHandler(handlers)
+}
+
+type multiStatsHandler []stats.Handler
+
+func (m multiStatsHandler) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	for _, h := range m {
+		ctx = h.TagConn(ctx, info)
+	}
+	return ctx
+}
+
+func (m multiStatsHandler) HandleConn(ctx context.Context, s stats.ConnStats) {
+	for _, h := range m {
+		h.HandleConn(ctx, s)
+	}
+}
+
+func (m multiStatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	for _, h := range m {
+		ctx = h.TagRPC(ctx, info)
+	}
+	return ctx
+}
+
+func (m multiStatsHandler) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	for _, h := range m {
+		h.HandleRPC(ctx, s)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	binlogpb "google.golang.org/grpc/binarylog/grpc_binarylog_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNewBinaryLogger_invalid(t *testing.T) {
+	if _, err := NewBinaryLogger([]string{"Echo"}, nil); err == nil {
+		t.Error("NewBinaryLogger with a method without a service: want an error")
+	}
+}
+
+func TestBinaryLogger_logs(t *testing.T) {
+	for _, test := range []struct {
+		methods []string
+		method  string
+		want    bool
+	}{
+		{[]string{"*"}, "/google.showcase.v1beta1.Echo/Echo", true},
+		{[]string{"*"}, "/google.showcase.v1beta1.Transport/ListBinaryLogEntries", false},
+		{[]string{"google.showcase.v1beta1.Echo/*"}, "/google.showcase.v1beta1.Echo/Chat", true},
+		{[]string{"google.showcase.v1beta1.Echo/*"}, "/google.showcase.v1beta1.EchoMore/Chat", false},
+		{[]string{"google.showcase.v1beta1.Echo/Echo"}, "/google.showcase.v1beta1.Echo/Echo", true},
+		{[]string{"google.showcase.v1beta1.Echo/Echo"}, "/google.showcase.v1beta1.Echo/Expand", false},
+		{nil, "/google.showcase.v1beta1.Echo/Echo", false},
+	} {
+		l, err := NewBinaryLogger(test.methods, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := l.logs(test.method); got != test.want {
+			t.Errorf("%v logs %s: got %v, want %v", test.methods, test.method, got, test.want)
+		}
+	}
+}
+
+func TestBinaryLogger(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger, err := NewBinaryLogger([]string{"google.showcase.v1beta1.Echo/Echo"}, out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer(grpc.StatsHandler(MultiStatsHandler(NewTransportMonitor(0), logger)))
+	pb.RegisterEchoServer(s, forwardedEcho{&pb.UnimplementedEchoServer{}})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewEchoClient(conn)
+
+	ctx, cancel := context.WithTimeout(metadata.AppendToOutgoingContext(context.Background(), "x-foo", "bar"), time.Minute)
+	defer cancel()
+	if _, err := client.Echo(ctx, &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "logged"}}); err != nil {
+		t.Fatal(err)
+	}
+	stream, err := client.Chat(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream.CloseSend()
+	stream.Recv()
+
+	wantTypes := []binlogpb.GrpcLogEntry_EventType{
+		binlogpb.GrpcLogEntry_EVENT_TYPE_CLIENT_HEADER,
+		binlogpb.GrpcLogEntry_EVENT_TYPE_CLIENT_MESSAGE,
+		binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_HEADER,
+		binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_MESSAGE,
+		binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_TRAILER,
+	}
+	// The trailer is logged once the server is done with the call, which may be after the
+	// client has the response.
+	var entries []*binlogpb.GrpcLogEntry
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		if entries = logger.Entries("/google.showcase.v1beta1.Echo/Echo", 0, "127.0.0.1"); len(entries) >= len(wantTypes) {
+			break
+		}
+	}
+	if len(entries) != len(wantTypes) {
+		t.Fatalf("got %d entries, want %d: %v", len(entries), len(wantTypes), entries)
+	}
+	for i, e := range entries {
+		if e.GetType() != wantTypes[i] || e.GetSequenceIdWithinCall() != uint64(i+1) || e.GetCallId() != entries[0].GetCallId() {
+			t.Errorf("entry %d: unexpected %v", i, e)
+		}
+	}
+
+	header := entries[0].GetClientHeader()
+	if header.GetMethodName() != "/google.showcase.v1beta1.Echo/Echo" || header.GetTimeout().AsDuration() <= 0 {
+		t.Errorf("unexpected client header %v", header)
+	}
+	foundFoo := false
+	for _, md := range header.GetMetadata().GetEntry() {
+		foundFoo = foundFoo || (md.GetKey() == "x-foo" && string(md.GetValue()) == "bar")
+	}
+	if !foundFoo {
+		t.Errorf("client header metadata is missing x-foo: %v", header.GetMetadata())
+	}
+	req := &pb.EchoRequest{}
+	if err := proto.Unmarshal(entries[1].GetMessage().GetData(), req); err != nil || req.GetContent() != "logged" {
+		t.Errorf("client message: got %v, %v", req, err)
+	}
+	trailer := entries[4].GetTrailer()
+	if trailer.GetStatusCode() != 0 || len(trailer.GetMetadata().GetEntry()) != 1 || trailer.GetMetadata().GetEntry()[0].GetKey() != "showcase-trailer" {
+		t.Errorf("unexpected trailer %v", trailer)
+	}
+
+	if others := logger.Entries("/google.showcase.v1beta1.Echo/Chat", 0, ""); len(others) != 0 {
+		t.Errorf("Chat was logged: %v", others)
+	}
+	if others := logger.Entries("", 0, "192.0.2.1"); len(others) != 0 {
+		t.Errorf("calls from another host: got %v", others)
+	}
+
+	for i := range wantTypes {
+		if out.Len() < 4 {
+			t.Fatalf("file entry %d: missing", i)
+		}
+		length := binary.BigEndian.Uint32(out.Next(4))
+		e := &binlogpb.GrpcLogEntry{}
+		if err := proto.Unmarshal(out.Next(int(length)), e); err != nil || e.GetType() != wantTypes[i] {
+			t.Errorf("file entry %d: got %v, %v", i, e, err)
+		}
+	}
+}
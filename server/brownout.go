@@ -0,0 +1,105 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BrownoutPoint is a point of a Brownout curve.
+type BrownoutPoint struct {
+	// Offset is how long after the curve starts the point is reached.
+	Offset time.Duration
+
+	// Rate is the fraction of calls failed, from 0 to 1, at the point.
+	Rate float64
+}
+
+// Brownout is a curve the fraction of calls a FaultInjector fails follows over time, changing
+// linearly between its points, so that failures build up and recede gradually.
+type Brownout struct {
+	// Points are the points of the curve, by increasing offset.
+	Points []BrownoutPoint
+
+	// Repeat is whether the curve starts over once its last point is reached.
+	Repeat bool
+}
+
+// ParseBrownout parses a curve written as comma-separated points, each an offset and a rate
+// joined by "=", such as "0s=0,1m=0.5,2m=0.5,3m=0".
+func ParseBrownout(spec string, repeat bool) (*Brownout, error) {
+	b := &Brownout{Repeat: repeat}
+	for _, point := range strings.Split(spec, ",") {
+		parts := strings.SplitN(strings.TrimSpace(point), "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid brownout point %q: want an offset, \"=\" and a rate", point)
+		}
+		offset, err := time.ParseDuration(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid brownout point %q: %v", point, err)
+		}
+		rate, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid brownout point %q: %v", point, err)
+		}
+		b.Points = append(b.Points, BrownoutPoint{Offset: offset, Rate: rate})
+	}
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Validate returns an error unless the curve has points, by increasing offset, whose rates are
+// between 0 and 1.
+func (b *Brownout) Validate() error {
+	if len(b.Points) == 0 {
+		return fmt.Errorf("a brownout needs points")
+	}
+	for i, point := range b.Points {
+		if point.Rate < 0 || point.Rate > 1 {
+			return fmt.Errorf("invalid brownout rate %v: must be between 0 and 1", point.Rate)
+		}
+		if point.Offset < 0 || (i > 0 && point.Offset <= b.Points[i-1].Offset) {
+			return fmt.Errorf("invalid brownout offset %v: offsets must be non-negative and increasing", point.Offset)
+		}
+	}
+	if b.Repeat && b.Points[len(b.Points)-1].Offset == 0 {
+		return fmt.Errorf("a repeating brownout needs its last point after the start")
+	}
+	return nil
+}
+
+// Rate returns the fraction of calls failed elapsed after the curve started.
+func (b *Brownout) Rate(elapsed time.Duration) float64 {
+	last := b.Points[len(b.Points)-1]
+	if b.Repeat && elapsed >= last.Offset {
+		elapsed %= last.Offset
+	}
+	if elapsed <= b.Points[0].Offset {
+		return b.Points[0].Rate
+	}
+	for i := 1; i < len(b.Points); i++ {
+		from, to := b.Points[i-1], b.Points[i]
+		if elapsed <= to.Offset {
+			progress := float64(elapsed-from.Offset) / float64(to.Offset-from.Offset)
+			return from.Rate + (to.Rate-from.Rate)*progress
+		}
+	}
+	return last.Rate
+}
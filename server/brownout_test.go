@@ -0,0 +1,61 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBrownout(t *testing.T) {
+	b, err := ParseBrownout("0s=0, 1m=0.5,2m=0.5,3m=0", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b.Points) != 4 || !b.Repeat || b.Points[1] != (BrownoutPoint{Offset: time.Minute, Rate: 0.5}) {
+		t.Errorf("ParseBrownout: unexpected curve %+v", b)
+	}
+	for _, spec := range []string{"", "1m", "soon=0.5", "1m=half", "0s=0,1m=1.5", "0s=0,-1m=0.5", "1m=0,1m=0.5", "2m=0,1m=0.5"} {
+		if _, err := ParseBrownout(spec, false); err == nil {
+			t.Errorf("ParseBrownout(%q): want an error", spec)
+		}
+	}
+	if _, err := ParseBrownout("0s=0.5", true); err == nil {
+		t.Error("ParseBrownout of a repeating curve without a duration: want an error")
+	}
+}
+
+func TestBrownout_Rate(t *testing.T) {
+	curve := []BrownoutPoint{{10 * time.Second, 0.2}, {20 * time.Second, 1}, {40 * time.Second, 0}}
+	for _, test := range []struct {
+		repeat  bool
+		elapsed time.Duration
+		want    float64
+	}{
+		{false, 0, 0.2},
+		{false, 10 * time.Second, 0.2},
+		{false, 15 * time.Second, 0.6},
+		{false, 20 * time.Second, 1},
+		{false, 30 * time.Second, 0.5},
+		{false, time.Hour, 0},
+		{true, 55 * time.Second, 0.6},
+		{true, 70 * time.Second, 0.5},
+	} {
+		b := &Brownout{Points: curve, Repeat: test.repeat}
+		if got := b.Rate(test.elapsed); got < test.want-1e-9 || got > test.want+1e-9 {
+			t.Errorf("Rate(%v) with repeat=%v: want %v, got %v", test.elapsed, test.repeat, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// busyWorkCalibrationRounds is how many rounds of hashing NewBusyWork times to find out how fast
+// this machine hashes.
+const busyWorkCalibrationRounds = 20000
+
+// BusyWork is CPU-bound work done before serving each call, making the server as slow as one
+// starved of CPU. The work takes about the given duration on an idle core, and longer when
+// calls compete for the cores the server may use.
+type BusyWork struct {
+	rounds int
+}
+
+// NewBusyWork creates a BusyWork taking about d on an idle core, as measured on this machine.
+func NewBusyWork(d time.Duration) *BusyWork {
+	start := time.Now()
+	spin(busyWorkCalibrationRounds)
+	elapsed := time.Since(start)
+	if elapsed <= 0 {
+		elapsed = time.Nanosecond
+	}
+	return &BusyWork{rounds: int(float64(busyWorkCalibrationRounds) * float64(d) / float64(elapsed))}
+}
+
+// Do does the work. A nil BusyWork does nothing.
+func (w *BusyWork) Do() {
+	if w == nil {
+		return
+	}
+	spin(w.rounds)
+}
+
+// spin hashes its own output rounds times.
+func spin(rounds int) [sha256.Size]byte {
+	var sum [sha256.Size]byte
+	for i := 0; i < rounds; i++ {
+		sum = sha256.Sum256(sum[:])
+	}
+	return sum
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, doing the work before each call.
+func (w *BusyWork) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	w.Do()
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, doing the work before each call.
+func (w *BusyWork) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	w.Do()
+	return handler(srv, ss)
+}
@@ -0,0 +1,37 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusyWork(t *testing.T) {
+	var none *BusyWork
+	none.Do()
+
+	d := 20 * time.Millisecond
+	w := NewBusyWork(d)
+	if w.rounds <= 0 {
+		t.Fatalf("want busy work to be calibrated, got %d rounds", w.rounds)
+	}
+	start := time.Now()
+	w.Do()
+	// The machine may turn out faster than it was while calibrating, but not by much.
+	if elapsed := time.Since(start); elapsed < d/4 {
+		t.Errorf("busy work of %v took only %v", d, elapsed)
+	}
+}
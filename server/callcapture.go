@@ -0,0 +1,278 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxCapturedCalls is how many of the most recent calls to each method a CallCapture keeps.
+	maxCapturedCalls = 100
+
+	// maxCapturedMessages is how many of the messages each way of a call a CallCapture keeps.
+	maxCapturedMessages = 100
+
+	// listCapturedCallsMethod is the method listing the calls captured, whose own calls are not.
+	listCapturedCallsMethod = "/google.showcase.v1beta1.Transport/ListCapturedCalls"
+)
+
+// CallCapture captures the gRPC calls to the server as they hit the wire: their metadata, the
+// messages exchanged and the status they end with, so that tests can assert on exactly what
+// clients sent.
+type CallCapture struct {
+	mu    sync.Mutex
+	calls map[string][]*pb.CapturedCall
+	nowF  func() time.Time
+}
+
+// NewCallCapture creates a CallCapture having captured no call.
+func NewCallCapture() *CallCapture {
+	return &CallCapture{calls: map[string][]*pb.CapturedCall{}, nowF: time.Now}
+}
+
+// Calls returns the count most recent calls to method captured, or all of those kept if count
+// is 0, oldest first.
+func (c *CallCapture) Calls(method string, count int) []*pb.CapturedCall {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	calls := c.calls[method]
+	if count > 0 && count < len(calls) {
+		calls = calls[len(calls)-count:]
+	}
+	captured := make([]*pb.CapturedCall, 0, len(calls))
+	for _, call := range calls {
+		captured = append(captured, proto.Clone(call).(*pb.CapturedCall))
+	}
+	return captured
+}
+
+func (c *CallCapture) record(call *pb.CapturedCall) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	calls := append(c.calls[call.GetMethod()], call)
+	if len(calls) > maxCapturedCalls {
+		calls = calls[len(calls)-maxCapturedCalls:]
+	}
+	c.calls[call.GetMethod()] = calls
+}
+
+// capturedCall is a call being captured.
+type capturedCall struct {
+	mu   sync.Mutex
+	call *pb.CapturedCall
+
+	header, trailer metadata.MD
+}
+
+func (c *CallCapture) start(ctx context.Context, method string) *capturedCall {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return &capturedCall{
+		call: &pb.CapturedCall{
+			Method:         method,
+			StartTime:      timestamppb.New(c.nowF()),
+			RequestHeaders: capturedHeaders(md),
+		},
+		header:  metadata.MD{},
+		trailer: metadata.MD{},
+	}
+}
+
+func (c *CallCapture) end(captured *capturedCall, err error) {
+	captured.mu.Lock()
+	defer captured.mu.Unlock()
+	call := captured.call
+	call.EndTime = timestamppb.New(c.nowF())
+	call.ResponseHeaders = capturedHeaders(captured.header)
+	call.ResponseTrailers = capturedHeaders(captured.trailer)
+	call.Status = status.Convert(err).Proto()
+	c.record(call)
+}
+
+// message captures a message received, or else sent.
+func (captured *capturedCall) message(msg interface{}, received bool) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return
+	}
+	captured.mu.Lock()
+	defer captured.mu.Unlock()
+	messages := &captured.call.Responses
+	if received {
+		messages = &captured.call.Requests
+	}
+	if len(*messages) >= maxCapturedMessages {
+		return
+	}
+	if a, err := anypb.New(m); err == nil {
+		*messages = append(*messages, a)
+	}
+}
+
+// metadata captures response metadata sent as headers, or else trailers.
+func (captured *capturedCall) metadata(md metadata.MD, header bool) {
+	captured.mu.Lock()
+	defer captured.mu.Unlock()
+	target := captured.trailer
+	if header {
+		target = captured.header
+	}
+	for key, values := range md {
+		target[key] = append(target[key], values...)
+	}
+}
+
+// capturedHeaders returns md sorted by key.
+func capturedHeaders(md metadata.MD) []*pb.CapturedCall_Header {
+	keys := make([]string, 0, len(md))
+	for key := range md {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	headers := make([]*pb.CapturedCall_Header, 0, len(keys))
+	for _, key := range keys {
+		headers = append(headers, &pb.CapturedCall_Header{Key: key, Values: append([]string(nil), md[key]...)})
+	}
+	return headers
+}
+
+// capturingTransportStream captures the response metadata set through a transport stream.
+type capturingTransportStream struct {
+	grpc.ServerTransportStream
+	captured *capturedCall
+}
+
+func (s capturingTransportStream) SetHeader(md metadata.MD) error {
+	err := s.ServerTransportStream.SetHeader(md)
+	if err == nil {
+		s.captured.metadata(md, true)
+	}
+	return err
+}
+
+func (s capturingTransportStream) SendHeader(md metadata.MD) error {
+	err := s.ServerTransportStream.SendHeader(md)
+	if err == nil {
+		s.captured.metadata(md, true)
+	}
+	return err
+}
+
+func (s capturingTransportStream) SetTrailer(md metadata.MD) error {
+	err := s.ServerTransportStream.SetTrailer(md)
+	if err == nil {
+		s.captured.metadata(md, false)
+	}
+	return err
+}
+
+// capturingContext returns ctx capturing the response metadata set through its transport
+// stream.
+func capturingContext(ctx context.Context, captured *capturedCall) context.Context {
+	if sts := grpc.ServerTransportStreamFromContext(ctx); sts != nil {
+		return grpc.NewContextWithServerTransportStream(ctx, capturingTransportStream{sts, captured})
+	}
+	return ctx
+}
+
+// capturingServerStream captures the messages and metadata of a streaming call.
+type capturingServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	captured *capturedCall
+}
+
+func (s *capturingServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *capturingServerStream) SetHeader(md metadata.MD) error {
+	err := s.ServerStream.SetHeader(md)
+	if err == nil {
+		s.captured.metadata(md, true)
+	}
+	return err
+}
+
+func (s *capturingServerStream) SendHeader(md metadata.MD) error {
+	err := s.ServerStream.SendHeader(md)
+	if err == nil {
+		s.captured.metadata(md, true)
+	}
+	return err
+}
+
+func (s *capturingServerStream) SetTrailer(md metadata.MD) {
+	s.ServerStream.SetTrailer(md)
+	s.captured.metadata(md, false)
+}
+
+func (s *capturingServerStream) SendMsg(msg interface{}) error {
+	err := s.ServerStream.SendMsg(msg)
+	if err == nil {
+		s.captured.message(msg, false)
+	}
+	return err
+}
+
+func (s *capturingServerStream) RecvMsg(msg interface{}) error {
+	err := s.ServerStream.RecvMsg(msg)
+	if err == nil {
+		s.captured.message(msg, true)
+	}
+	return err
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, capturing calls.
+func (c *CallCapture) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if info.FullMethod == listCapturedCallsMethod {
+		return handler(ctx, req)
+	}
+	captured := c.start(ctx, info.FullMethod)
+	captured.message(req, true)
+	resp, err := handler(capturingContext(ctx, captured), req)
+	if err == nil {
+		captured.message(resp, false)
+	}
+	c.end(captured, err)
+	return resp, err
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, capturing calls.
+func (c *CallCapture) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	captured := c.start(ss.Context(), info.FullMethod)
+	err := handler(srv, &capturingServerStream{ServerStream: ss, ctx: capturingContext(ss.Context(), captured), captured: captured})
+	c.end(captured, err)
+	return err
+}
@@ -0,0 +1,143 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// capturedHeader returns the values of the captured header key.
+func capturedHeader(headers []*pb.CapturedCall_Header, key string) string {
+	for _, header := range headers {
+		if header.GetKey() == key {
+			return strings.Join(header.GetValues(), ",")
+		}
+	}
+	return ""
+}
+
+// chattyEcho answers each Chat request, setting a trailer.
+type chattyEcho struct {
+	forwardedEcho
+}
+
+func (chattyEcho) Chat(stream pb.Echo_ChatServer) error {
+	stream.SetTrailer(metadata.Pairs("showcase-trailer", "stream"))
+	for {
+		in, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		if err := stream.Send(&pb.EchoResponse{Content: in.GetContent()}); err != nil {
+			return err
+		}
+	}
+}
+
+func TestCallCapture(t *testing.T) {
+	capture := NewCallCapture()
+	s := grpc.NewServer(grpc.UnaryInterceptor(capture.UnaryInterceptor), grpc.StreamInterceptor(capture.StreamInterceptor))
+	pb.RegisterEchoServer(s, chattyEcho{forwardedEcho{&pb.UnimplementedEchoServer{}}})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewEchoClient(conn)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-test", "captured")
+	if _, err := client.Echo(ctx, &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hello"}}); err != nil {
+		t.Fatal(err)
+	}
+	stream, err := client.Chat(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := stream.Send(&pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: fmt.Sprint(i)}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stream.CloseSend()
+	for {
+		if _, err := stream.Recv(); err != nil {
+			break
+		}
+	}
+	if _, err := client.Block(ctx, &pb.BlockRequest{}); err == nil {
+		t.Fatal("Block: want an error from the unimplemented method")
+	}
+
+	calls := capture.Calls("/google.showcase.v1beta1.Echo/Echo", 0)
+	if len(calls) != 1 {
+		t.Fatalf("Echo: want 1 call captured, got %d", len(calls))
+	}
+	echo := calls[0]
+	request := &pb.EchoRequest{}
+	if len(echo.GetRequests()) != 1 || echo.GetRequests()[0].UnmarshalTo(request) != nil || request.GetContent() != "hello" {
+		t.Errorf("Echo: want the request captured, got %v", echo.GetRequests())
+	}
+	if len(echo.GetResponses()) != 1 || capturedHeader(echo.GetRequestHeaders(), "x-test") != "captured" ||
+		capturedHeader(echo.GetResponseTrailers(), "showcase-trailer") != "unary" || echo.GetStatus().GetCode() != 0 {
+		t.Errorf("Echo: unexpected call captured: %v", echo)
+	}
+
+	calls = capture.Calls("/google.showcase.v1beta1.Echo/Chat", 0)
+	if len(calls) != 1 {
+		t.Fatalf("Chat: want 1 call captured, got %d", len(calls))
+	}
+	if chat := calls[0]; len(chat.GetRequests()) != 2 || len(chat.GetResponses()) != 2 ||
+		capturedHeader(chat.GetResponseTrailers(), "showcase-trailer") != "stream" {
+		t.Errorf("Chat: unexpected call captured: %v", chat)
+	}
+
+	calls = capture.Calls("/google.showcase.v1beta1.Echo/Block", 0)
+	if len(calls) != 1 || calls[0].GetStatus().GetCode() != int32(codes.Unimplemented) || len(calls[0].GetResponses()) != 0 {
+		t.Errorf("Block: want the failed call captured, got %v", calls)
+	}
+}
+
+func TestCallCapture_Calls(t *testing.T) {
+	capture := NewCallCapture()
+	for i := 0; i < maxCapturedCalls+5; i++ {
+		capture.record(&pb.CapturedCall{Method: "/m", RequestHeaders: []*pb.CapturedCall_Header{{Key: "i", Values: []string{fmt.Sprint(i)}}}})
+	}
+	calls := capture.Calls("/m", 0)
+	if len(calls) != maxCapturedCalls || capturedHeader(calls[0].GetRequestHeaders(), "i") != "5" {
+		t.Errorf("Calls: want the last %d calls, got %d starting with %v", maxCapturedCalls, len(calls), calls[0])
+	}
+	calls = capture.Calls("/m", 2)
+	if len(calls) != 2 || capturedHeader(calls[1].GetRequestHeaders(), "i") != fmt.Sprint(maxCapturedCalls+4) {
+		t.Errorf("Calls(2): want the last 2 calls, got %v", calls)
+	}
+	if calls := capture.Calls("/other", 0); len(calls) != 0 {
+		t.Errorf("Calls(other method): want none, got %v", calls)
+	}
+}
@@ -0,0 +1,316 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// CallLogProject is the project call log entries are attributed to.
+	CallLogProject = "gapic-showcase"
+
+	// CallLogID is the log ID call log entries are written under.
+	CallLogID = "showcase-calls"
+)
+
+// CallLogSink stores call log entries, each serialized as a single line of JSON.
+type CallLogSink interface {
+	// WriteEntry stores the serialized entry for a call observed at timestamp.
+	WriteEntry(timestamp time.Time, line []byte) error
+
+	// Close releases the resources held by the sink.
+	Close() error
+}
+
+// NewFileCallLogSink returns a CallLogSink appending entries, one per line, to the file at path.
+func NewFileCallLogSink(path string) (CallLogSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileCallLogSink{file: f}, nil
+}
+
+type fileCallLogSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func (s *fileCallLogSink) WriteEntry(_ time.Time, line []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.file.Write(append(line, '\n'))
+	return err
+}
+
+func (s *fileCallLogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// NewDirectoryCallLogSink returns a CallLogSink that shards entries under dir the way Cloud
+// Logging exports logs to Cloud Storage: one file per hour, at
+// LOG_ID/YYYY/MM/DD/HH:00:00_HH:59:59_S0.json.
+func NewDirectoryCallLogSink(dir string) CallLogSink {
+	return &directoryCallLogSink{dir: dir}
+}
+
+type directoryCallLogSink struct {
+	mu   sync.Mutex
+	dir  string
+	path string
+	file *os.File
+}
+
+func (s *directoryCallLogSink) WriteEntry(timestamp time.Time, line []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := timestamp.UTC()
+	path := filepath.Join(s.dir, CallLogID, t.Format("2006"), t.Format("01"), t.Format("02"),
+		fmt.Sprintf("%02d:00:00_%02d:59:59_S0.json", t.Hour(), t.Hour()))
+	if path != s.path {
+		if s.file != nil {
+			s.file.Close()
+			s.file = nil
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		s.path, s.file = path, f
+	}
+	_, err := s.file.Write(append(line, '\n'))
+	return err
+}
+
+func (s *directoryCallLogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.path, s.file = "", nil
+	return err
+}
+
+// CallLogExporter is an observer writing every observed call to its CallLogSinks as a Cloud
+// Logging LogEntry, so existing log-analysis tooling can be used on showcase test runs.
+type CallLogExporter struct {
+	sinks  []CallLogSink
+	errLog *log.Logger
+	nowF   func() time.Time
+
+	mu       sync.Mutex
+	insertID int64
+}
+
+// NewCallLogExporter creates a CallLogExporter writing to sinks. Failures to write entries are
+// reported to errLog.
+func NewCallLogExporter(errLog *log.Logger, sinks ...CallLogSink) *CallLogExporter {
+	return &CallLogExporter{sinks: sinks, errLog: errLog, nowF: time.Now}
+}
+
+// callLogEntry is the subset of the Cloud Logging LogEntry the exporter populates.
+type callLogEntry struct {
+	InsertID    string              `json:"insertId"`
+	LogName     string              `json:"logName"`
+	Resource    callLogResource     `json:"resource"`
+	Timestamp   string              `json:"timestamp"`
+	Severity    string              `json:"severity"`
+	Labels      map[string]string   `json:"labels,omitempty"`
+	JSONPayload callLogEntryPayload `json:"jsonPayload"`
+}
+
+type callLogResource struct {
+	Type   string            `json:"type"`
+	Labels map[string]string `json:"labels"`
+}
+
+type callLogEntryPayload struct {
+	Method   string            `json:"method"`
+	Kind     string            `json:"kind"`
+	Headers  map[string]string `json:"headers,omitempty"`
+	Request  json.RawMessage   `json:"request,omitempty"`
+	Response json.RawMessage   `json:"response,omitempty"`
+	Status   *callLogStatus    `json:"status,omitempty"`
+}
+
+type callLogStatus struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// GetName returns the name of this observer.
+func (e *CallLogExporter) GetName() string { return "callLogExporter" }
+
+// ObserveUnary logs a completed unary call.
+func (e *CallLogExporter) ObserveUnary(
+	ctx context.Context,
+	req interface{},
+	resp interface{},
+	info *grpc.UnaryServerInfo,
+	err error) {
+	payload := callLogEntryPayload{
+		Method:  info.FullMethod,
+		Kind:    "unary",
+		Headers: incomingHeaders(ctx),
+		Request: marshalCallLogMessage(req),
+		Status:  callStatus(err),
+	}
+	if err == nil {
+		payload.Response = marshalCallLogMessage(resp)
+	}
+	e.write(payload, err)
+}
+
+// ObserveStreamRequest logs a message received on a stream.
+func (e *CallLogExporter) ObserveStreamRequest(
+	ctx context.Context,
+	req interface{},
+	info *grpc.StreamServerInfo,
+	err error) {
+	if err == io.EOF {
+		return
+	}
+	payload := callLogEntryPayload{
+		Method:  info.FullMethod,
+		Kind:    "stream-request",
+		Headers: incomingHeaders(ctx),
+		Status:  callStatus(err),
+	}
+	if err == nil {
+		payload.Request = marshalCallLogMessage(req)
+	}
+	e.write(payload, err)
+}
+
+// ObserveStreamResponse logs a message sent on a stream.
+func (e *CallLogExporter) ObserveStreamResponse(
+	_ context.Context,
+	resp interface{},
+	info *grpc.StreamServerInfo,
+	err error) {
+	e.write(callLogEntryPayload{
+		Method:   info.FullMethod,
+		Kind:     "stream-response",
+		Response: marshalCallLogMessage(resp),
+		Status:   callStatus(err),
+	}, err)
+}
+
+// Close closes the underlying sinks.
+func (e *CallLogExporter) Close() error {
+	var err error
+	for _, sink := range e.sinks {
+		if sinkErr := sink.Close(); err == nil {
+			err = sinkErr
+		}
+	}
+	return err
+}
+
+func (e *CallLogExporter) write(payload callLogEntryPayload, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.insertID++
+	now := e.nowF()
+	severity := "INFO"
+	if err != nil {
+		severity = "ERROR"
+	}
+	entry := callLogEntry{
+		InsertID: fmt.Sprintf("%d-%d", now.UnixNano(), e.insertID),
+		LogName:  fmt.Sprintf("projects/%s/logs/%s", CallLogProject, CallLogID),
+		Resource: callLogResource{
+			Type:   "global",
+			Labels: map[string]string{"project_id": CallLogProject},
+		},
+		Timestamp:   now.UTC().Format(time.RFC3339Nano),
+		Severity:    severity,
+		Labels:      map[string]string{"method": payload.Method, "kind": payload.Kind},
+		JSONPayload: payload,
+	}
+	line, jsonErr := json.Marshal(entry)
+	if jsonErr != nil {
+		e.logError(payload.Method, jsonErr)
+		return
+	}
+	for _, sink := range e.sinks {
+		if err := sink.WriteEntry(now, line); err != nil {
+			e.logError(payload.Method, err)
+		}
+	}
+}
+
+func (e *CallLogExporter) logError(method string, err error) {
+	if e.errLog != nil {
+		e.errLog.Printf("Failed to export call log entry for %s: %v", method, err)
+	}
+}
+
+// marshalCallLogMessage renders a request or response as JSON, falling back to a JSON string for
+// values that are not proto messages.
+func marshalCallLogMessage(m interface{}) json.RawMessage {
+	if m == nil {
+		return nil
+	}
+	if msg, ok := m.(proto.Message); ok {
+		if data, err := protojson.Marshal(msg); err == nil {
+			return data
+		}
+	}
+	data, _ := json.Marshal(fmt.Sprintf("%+v", m))
+	return data
+}
+
+func callStatus(err error) *callLogStatus {
+	st := status.Convert(err)
+	return &callLogStatus{Code: st.Code().String(), Message: st.Message()}
+}
+
+func incomingHeaders(ctx context.Context) map[string]string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md) == 0 {
+		return nil
+	}
+	headers := map[string]string{}
+	for key, values := range md {
+		headers[key] = strings.Join(values, ",")
+	}
+	return headers
+}
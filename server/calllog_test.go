@@ -0,0 +1,119 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func readCallLog(t *testing.T, path string) []map[string]interface{} {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening call log: %v", err)
+	}
+	defer f.Close()
+
+	entries := []map[string]interface{}{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		entry := map[string]interface{}{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("parsing call log line %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestCallLogExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "calllog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "calls.json")
+	fileSink, err := NewFileCallLogSink(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exporter := NewCallLogExporter(nil, fileSink, NewDirectoryCallLogSink(dir))
+	now := time.Date(2021, 8, 3, 14, 30, 0, 0, time.UTC)
+	exporter.nowF = func() time.Time { return now }
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-goog-api-client", "gl-go/1.16.0"))
+	unary := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+	stream := &grpc.StreamServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Collect"}
+	exporter.ObserveUnary(ctx, &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hi"}}, &pb.EchoResponse{Content: "hi"}, unary, nil)
+	exporter.ObserveUnary(ctx, &pb.EchoRequest{}, nil, unary, status.Error(codes.Aborted, "boom"))
+	exporter.ObserveStreamRequest(ctx, &pb.EchoRequest{}, stream, io.EOF)
+	exporter.ObserveStreamResponse(ctx, &pb.EchoResponse{Content: "streamed"}, stream, nil)
+	if err := exporter.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	entries := readCallLog(t, path)
+	if len(entries) != 3 {
+		t.Fatalf("want 3 entries, got %d: %v", len(entries), entries)
+	}
+
+	first := entries[0]
+	if got := first["logName"]; got != "projects/gapic-showcase/logs/showcase-calls" {
+		t.Errorf("logName: got %v", got)
+	}
+	if got := first["timestamp"]; got != "2021-08-03T14:30:00Z" {
+		t.Errorf("timestamp: got %v", got)
+	}
+	if got := first["severity"]; got != "INFO" {
+		t.Errorf("severity: got %v", got)
+	}
+	payload := first["jsonPayload"].(map[string]interface{})
+	if got := payload["response"].(map[string]interface{})["content"]; got != "hi" {
+		t.Errorf("response content: got %v", got)
+	}
+	if got := payload["headers"].(map[string]interface{})["x-goog-api-client"]; got != "gl-go/1.16.0" {
+		t.Errorf("headers: got %v", got)
+	}
+
+	second := entries[1]
+	if got := second["severity"]; got != "ERROR" {
+		t.Errorf("severity of failed call: got %v", got)
+	}
+	if got := second["jsonPayload"].(map[string]interface{})["status"].(map[string]interface{})["code"]; got != "Aborted" {
+		t.Errorf("status code of failed call: got %v", got)
+	}
+	if got := entries[2]["labels"].(map[string]interface{})["kind"]; got != "stream-response" {
+		t.Errorf("kind of stream response: got %v", got)
+	}
+
+	sharded := readCallLog(t, filepath.Join(dir, CallLogID, "2021", "08", "03", "14:00:00_14:59:59_S0.json"))
+	if len(sharded) != len(entries) {
+		t.Errorf("directory sink: want %d entries, got %d", len(entries), len(sharded))
+	}
+}
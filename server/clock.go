@@ -0,0 +1,77 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var clockSingleton = NewClock(time.Now)
+
+// GetClockInstance returns the clock singleton, which the waiter, operation expirations, and
+// resource timestamps tell time by.
+func GetClockInstance() *Clock {
+	return clockSingleton
+}
+
+// Clock is a virtual clock. It runs at the pace of real time but can be moved ahead, so that
+// tests of operations and expirations need not wait in real time.
+type Clock struct {
+	nowF func() time.Time
+
+	mu     sync.Mutex
+	offset time.Duration
+}
+
+// NewClock creates a Clock following nowF.
+func NewClock(nowF func() time.Time) *Clock {
+	return &Clock{nowF: nowF}
+}
+
+// Now returns the time on the clock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.nowF().Add(c.offset)
+}
+
+// Timestamp returns the time on the clock as a Timestamp proto.
+func (c *Clock) Timestamp() *timestamppb.Timestamp {
+	return timestamppb.New(c.Now())
+}
+
+// Offset returns how far the clock is ahead of real time.
+func (c *Clock) Offset() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.offset
+}
+
+// Advance moves the clock ahead by d.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset += d
+}
+
+// Reset moves the clock back to real time.
+func (c *Clock) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset = 0
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetClockInstance(t *testing.T) {
+	if GetClockInstance() != clockSingleton {
+		t.Error("GetClockInstance: Expected to get clock singleton.")
+	}
+}
+
+func TestClock(t *testing.T) {
+	real := time.Unix(1000, 0)
+	clock := NewClock(func() time.Time { return real })
+
+	if got := clock.Now(); !got.Equal(real) {
+		t.Errorf("Now before advancing: got %v, want %v", got, real)
+	}
+	clock.Advance(time.Hour)
+	clock.Advance(30 * time.Minute)
+	if got, want := clock.Now(), real.Add(90*time.Minute); !got.Equal(want) {
+		t.Errorf("Now after advancing: got %v, want %v", got, want)
+	}
+	if got := clock.Offset(); got != 90*time.Minute {
+		t.Errorf("Offset: got %v", got)
+	}
+	if got := clock.Timestamp().AsTime(); !got.Equal(real.Add(90 * time.Minute)) {
+		t.Errorf("Timestamp: got %v", got)
+	}
+
+	real = real.Add(time.Second)
+	if got, want := clock.Now(), real.Add(90*time.Minute); !got.Equal(want) {
+		t.Errorf("Now as real time passes: got %v, want %v", got, want)
+	}
+
+	clock.Reset()
+	if got := clock.Now(); !got.Equal(real) {
+		t.Errorf("Now after reset: got %v, want %v", got, real)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// RequestCompressionHeader is the response header in which calls report the compression
+// algorithm their request messages were sent with, or "identity" if uncompressed.
+const RequestCompressionHeader = "x-showcase-request-compression"
+
+// requestCompressionKey is the context key of the compression algorithm of a call's requests.
+type requestCompressionKey struct{}
+
+// RequestCompression returns the compression algorithm, as named in the grpc-encoding header,
+// the client compressed the requests of the call in ctx with, or "identity" if it did not
+// compress them. Calls are only known to be compressed when they went through the compression
+// interceptors, since wrapping the transport stream hides the grpc-encoding it received.
+func RequestCompression(ctx context.Context) string {
+	if compression, ok := ctx.Value(requestCompressionKey{}).(string); ok && compression != "" {
+		return compression
+	}
+	return "identity"
+}
+
+// withRequestCompression returns ctx annotated with the compression algorithm its transport
+// stream received the call's requests with.
+func withRequestCompression(ctx context.Context) context.Context {
+	stream, ok := grpc.ServerTransportStreamFromContext(ctx).(interface{ RecvCompress() string })
+	if !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, requestCompressionKey{}, stream.RecvCompress())
+}
+
+// CompressionUnaryInterceptor implements grpc.UnaryServerInterceptor, recording the compression
+// of the requests for RequestCompression. It must run before any interceptor that wraps the
+// transport stream.
+func CompressionUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(withRequestCompression(ctx), req)
+}
+
+// CompressionStreamInterceptor implements grpc.StreamServerInterceptor, recording the
+// compression of the requests for RequestCompression.
+func CompressionStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	return handler(srv, &compressionServerStream{ServerStream: ss, ctx: withRequestCompression(ss.Context())})
+}
+
+// compressionServerStream is a stream whose context records the compression of its requests.
+type compressionServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *compressionServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+// compressionEcho echoes the compression its calls' requests were sent with.
+type compressionEcho struct {
+	*pb.UnimplementedEchoServer
+}
+
+func (compressionEcho) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+	return &pb.EchoResponse{Content: RequestCompression(ctx)}, nil
+}
+
+func (compressionEcho) Expand(in *pb.ExpandRequest, stream pb.Echo_ExpandServer) error {
+	return stream.Send(&pb.EchoResponse{Content: RequestCompression(stream.Context())})
+}
+
+func TestRequestCompression(t *testing.T) {
+	capture := NewCallCapture()
+	s := grpc.NewServer(
+		// The call capture wraps the transport stream, hiding its encoding from later
+		// interceptors and handlers.
+		grpc.ChainUnaryInterceptor(CompressionUnaryInterceptor, capture.UnaryInterceptor),
+		grpc.ChainStreamInterceptor(CompressionStreamInterceptor, capture.StreamInterceptor))
+	pb.RegisterEchoServer(s, compressionEcho{&pb.UnimplementedEchoServer{}})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewEchoClient(conn)
+
+	for _, testCase := range []struct {
+		opts []grpc.CallOption
+		want string
+	}{
+		{want: "identity"},
+		{opts: []grpc.CallOption{grpc.UseCompressor(gzip.Name)}, want: "gzip"},
+	} {
+		response, err := client.Echo(context.Background(), &pb.EchoRequest{}, testCase.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := response.GetContent(); got != testCase.want {
+			t.Errorf("Echo: got compression %q, want %q", got, testCase.want)
+		}
+
+		stream, err := client.Expand(context.Background(), &pb.ExpandRequest{}, testCase.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response, err = stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := response.GetContent(); got != testCase.want {
+			t.Errorf("Expand: got compression %q, want %q", got, testCase.want)
+		}
+	}
+}
+
+func TestRequestCompression_noInterceptor(t *testing.T) {
+	if got := RequestCompression(context.Background()); got != "identity" {
+		t.Errorf("got %q, want identity", got)
+	}
+}
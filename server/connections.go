@@ -0,0 +1,467 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// refusedConnectionLinger is how long a connection sent a GOAWAY with an error is kept open,
+// draining what the client sends, so that the client reads the GOAWAY before the connection
+// closes.
+const refusedConnectionLinger = time.Second
+
+// ConnectionManager keeps track of the gRPC connections handshaken through its transport
+// credentials. It limits the number of connections each client may hold, clients being
+// identified by the subject of their certificate under mutual TLS and by their host otherwise:
+// the connections of a client over its quota are sent a GOAWAY with ENHANCE_YOUR_CALM and debug
+// data explaining the quota, and closed. It can also send a GOAWAY on the connection of a
+// particular call once the call completes.
+type ConnectionManager struct {
+	quota int
+
+	mu          sync.Mutex
+	clients     map[string]int
+	connections map[string]*trackedConn
+}
+
+// NewConnectionManager creates a ConnectionManager allowing each client at most quota
+// connections. A quota of 0 leaves the number of connections unlimited.
+func NewConnectionManager(quota int) *ConnectionManager {
+	return &ConnectionManager{
+		quota:       quota,
+		clients:     map[string]int{},
+		connections: map[string]*trackedConn{},
+	}
+}
+
+// Credentials wraps creds, so that the connections handshaken with them are managed by m. The
+// connections are followed above the security protocol, so that the HTTP/2 frames are seen and
+// sent in the clear. Nil creds serve plaintext connections.
+func (m *ConnectionManager) Credentials(creds credentials.TransportCredentials) credentials.TransportCredentials {
+	return &connectionCredentials{creds: creds, manager: m}
+}
+
+// Connections returns the number of connections open from client, a host or the subject of a
+// client certificate.
+func (m *ConnectionManager) Connections(client string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.clients[client]
+}
+
+// ScheduleGoAway arranges for a GOAWAY with code and debugData to be sent on the connection of
+// the gRPC call ctx belongs to, right after the call's response. A GOAWAY with an error code is
+// followed by the connection being closed.
+func (m *ConnectionManager) ScheduleGoAway(ctx context.Context, code http2.ErrCode, debugData string) error {
+	p, ok := peer.FromContext(ctx)
+	method, hasMethod := grpc.Method(ctx)
+	if !ok || !hasMethod {
+		return status.Error(codes.FailedPrecondition, "a GOAWAY can only be sent on a gRPC connection")
+	}
+	m.mu.Lock()
+	conn, ok := m.connections[p.Addr.String()]
+	m.mu.Unlock()
+	if !ok {
+		return status.Errorf(codes.FailedPrecondition, "the connection from %s is not managed by this server", p.Addr)
+	}
+	return conn.scheduleGoAway(method, code, debugData)
+}
+
+// acquire counts a new connection from client, if it is within quota. It returns the number of
+// connections client already had open.
+func (m *ConnectionManager) acquire(client string) (int, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	open := m.clients[client]
+	if m.quota > 0 && open >= m.quota {
+		return open, false
+	}
+	m.clients[client] = open + 1
+	return open, true
+}
+
+func (m *ConnectionManager) register(conn *trackedConn) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.connections[conn.RemoteAddr().String()] = conn
+}
+
+func (m *ConnectionManager) release(conn *trackedConn) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.connections, conn.RemoteAddr().String())
+	if m.clients[conn.client]--; m.clients[conn.client] <= 0 {
+		delete(m.clients, conn.client)
+	}
+}
+
+// connectionCredentials accepts the connections within their client's quota, once the
+// underlying credentials have handshaken them.
+type connectionCredentials struct {
+	creds   credentials.TransportCredentials
+	manager *ConnectionManager
+}
+
+func (c *connectionCredentials) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	conn, info := rawConn, credentials.AuthInfo(nil)
+	if c.creds != nil {
+		var err error
+		if conn, info, err = c.creds.ServerHandshake(rawConn); err != nil {
+			return nil, nil, err
+		}
+	}
+	client := clientOf(conn, info)
+	if open, ok := c.manager.acquire(client); !ok {
+		// The connection is refused on its own, without gRPC closing it first.
+		go refuseConnection(conn, http2.ErrCodeEnhanceYourCalm,
+			fmt.Sprintf("too_many_connections: client %s already holds %d of at most %d connections", client, open, c.manager.quota))
+		return nil, nil, credentials.ErrConnDispatched
+	}
+	tracked := newTrackedConn(conn, client, c.manager)
+	c.manager.register(tracked)
+	return tracked, info, nil
+}
+
+func (c *connectionCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if c.creds == nil {
+		return conn, nil, nil
+	}
+	return c.creds.ClientHandshake(ctx, authority, conn)
+}
+
+func (c *connectionCredentials) Info() credentials.ProtocolInfo {
+	if c.creds == nil {
+		return credentials.ProtocolInfo{SecurityProtocol: "insecure"}
+	}
+	return c.creds.Info()
+}
+
+func (c *connectionCredentials) Clone() credentials.TransportCredentials {
+	clone := &connectionCredentials{manager: c.manager}
+	if c.creds != nil {
+		clone.creds = c.creds.Clone()
+	}
+	return clone
+}
+
+func (c *connectionCredentials) OverrideServerName(name string) error {
+	if c.creds == nil {
+		return nil
+	}
+	return c.creds.OverrideServerName(name)
+}
+
+// clientOf returns the client conn was opened by: the subject of its certificate if it
+// presented one in the handshake that produced info, or else the host it connected from.
+func clientOf(conn net.Conn, info credentials.AuthInfo) string {
+	if tlsInfo, ok := info.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+		return tlsInfo.State.PeerCertificates[0].Subject.String()
+	}
+	addr := conn.RemoteAddr().String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// refuseConnection opens an HTTP/2 session on conn only to send a GOAWAY with code and
+// debugData, then closes conn.
+func refuseConnection(conn net.Conn, code http2.ErrCode, debugData string) {
+	defer conn.Close()
+	framer := http2.NewFramer(conn, nil)
+	if err := framer.WriteSettings(); err != nil {
+		return
+	}
+	if err := framer.WriteGoAway(0, code, []byte(debugData)); err != nil {
+		return
+	}
+	lingerAndDrain(conn)
+}
+
+// lingerAndDrain discards what the client sends on conn for a little while, then closes conn.
+// It closes conn on a timer rather than by a deadline, which gRPC clears once it hands off a
+// refused connection.
+func lingerAndDrain(conn net.Conn) {
+	linger := time.AfterFunc(refusedConnectionLinger, func() { conn.Close() })
+	defer linger.Stop()
+	io.Copy(ioutil.Discard, conn)
+}
+
+// trackedConn is a managed connection. It follows the HTTP/2 frames going each way, so that it
+// knows which stream each call arrived on and can slip a GOAWAY in between two frames the
+// server sends.
+type trackedConn struct {
+	net.Conn
+	client  string
+	manager *ConnectionManager
+	once    sync.Once
+
+	// in is only used by Read and out only by Write, which gRPC calls from a single goroutine
+	// each.
+	in      frameParser
+	decoder *hpack.Decoder
+	block   []byte
+	out     frameParser
+
+	// trailersEnd is set while the server is sending trailers split across CONTINUATION frames.
+	trailersEnd bool
+
+	mu            sync.Mutex
+	lastStream    map[string]uint32
+	maxStreamID   uint32
+	pendingStream uint32
+	pendingCode   http2.ErrCode
+	pendingDebug  string
+}
+
+func newTrackedConn(conn net.Conn, client string, manager *ConnectionManager) *trackedConn {
+	return &trackedConn{
+		Conn:       conn,
+		client:     client,
+		manager:    manager,
+		in:         frameParser{skip: len(http2.ClientPreface), keep: keepHeaderBlocks},
+		decoder:    hpack.NewDecoder(4096, nil),
+		out:        frameParser{},
+		lastStream: map[string]uint32{},
+	}
+}
+
+func (c *trackedConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	for data := p[:n]; len(data) > 0; {
+		consumed, frame := c.in.next(data)
+		data = data[consumed:]
+		if frame != nil {
+			c.receivedFrame(frame)
+		}
+	}
+	return n, err
+}
+
+func (c *trackedConn) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		consumed, frame := c.out.next(p)
+		n, err := c.Conn.Write(p[:consumed])
+		written += n
+		if err != nil {
+			return written, err
+		}
+		p = p[consumed:]
+		if frame != nil {
+			c.sentFrame(frame)
+		}
+	}
+	return written, nil
+}
+
+func (c *trackedConn) Close() error {
+	c.once.Do(func() { c.manager.release(c) })
+	return c.Conn.Close()
+}
+
+// receivedFrame records the method each stream the client opens is for.
+func (c *trackedConn) receivedFrame(f *frame) {
+	switch f.Type {
+	case http2.FrameHeaders:
+		payload := f.Payload
+		if f.Flags.Has(http2.FlagHeadersPadded) && len(payload) > 0 {
+			padding := int(payload[0])
+			payload = payload[1:]
+			if padding > len(payload) {
+				return
+			}
+			payload = payload[:len(payload)-padding]
+		}
+		if f.Flags.Has(http2.FlagHeadersPriority) {
+			if len(payload) < 5 {
+				return
+			}
+			payload = payload[5:]
+		}
+		c.block = append(c.block[:0], payload...)
+	case http2.FrameContinuation:
+		c.block = append(c.block, f.Payload...)
+	default:
+		return
+	}
+	if !f.Flags.Has(http2.FlagHeadersEndHeaders) {
+		return
+	}
+
+	// Every header block has to be decoded to keep the HPACK state in step with the client.
+	fields, err := c.decoder.DecodeFull(c.block)
+	if err != nil {
+		return
+	}
+	for _, field := range fields {
+		if field.Name == ":path" {
+			c.mu.Lock()
+			c.lastStream[field.Value] = f.StreamID
+			if f.StreamID > c.maxStreamID {
+				c.maxStreamID = f.StreamID
+			}
+			c.mu.Unlock()
+		}
+	}
+}
+
+// sentFrame sends the scheduled GOAWAY once the stream it waits for has ended.
+func (c *trackedConn) sentFrame(f *frame) {
+	c.mu.Lock()
+	stream := c.pendingStream
+	c.mu.Unlock()
+	if stream == 0 || f.StreamID != stream {
+		return
+	}
+
+	switch {
+	case f.Type == http2.FrameRSTStream:
+	case f.Type == http2.FrameHeaders && f.Flags.Has(http2.FlagHeadersEndStream):
+		c.trailersEnd = !f.Flags.Has(http2.FlagHeadersEndHeaders)
+		if c.trailersEnd {
+			return
+		}
+	case f.Type == http2.FrameContinuation && c.trailersEnd:
+		c.trailersEnd = !f.Flags.Has(http2.FlagContinuationEndHeaders)
+		if c.trailersEnd {
+			return
+		}
+	default:
+		return
+	}
+
+	c.mu.Lock()
+	lastStreamID, code, debugData := c.maxStreamID, c.pendingCode, c.pendingDebug
+	c.pendingStream = 0
+	c.mu.Unlock()
+	if err := http2.NewFramer(c.Conn, nil).WriteGoAway(lastStreamID, code, []byte(debugData)); err != nil {
+		return
+	}
+	if code != http2.ErrCodeNo {
+		go func() {
+			lingerAndDrain(c.Conn)
+			c.Close()
+		}()
+	}
+}
+
+func (c *trackedConn) scheduleGoAway(method string, code http2.ErrCode, debugData string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stream, ok := c.lastStream[method]
+	if !ok {
+		return status.Errorf(codes.Internal, "no stream for %s was seen on the connection", method)
+	}
+	if c.pendingStream != 0 {
+		return status.Error(codes.FailedPrecondition, "a GOAWAY is already scheduled on this connection")
+	}
+	c.pendingStream, c.pendingCode, c.pendingDebug = stream, code, debugData
+	return nil
+}
+
+// frame is an HTTP/2 frame as seen by a frameParser.
+type frame struct {
+	Type     http2.FrameType
+	Flags    http2.Flags
+	StreamID uint32
+
+	// Payload is only filled in for the frame types the parser keeps.
+	Payload []byte
+}
+
+// keepHeaderBlocks keeps the payloads of the frames carrying header blocks.
+func keepHeaderBlocks(t http2.FrameType) bool {
+	return t == http2.FrameHeaders || t == http2.FrameContinuation
+}
+
+// frameParser splits a byte stream into HTTP/2 frames, however the bytes are split between
+// calls to next.
+type frameParser struct {
+	// skip is the number of bytes to skip before the first frame, such as a connection preface.
+	skip int
+
+	// keep reports which frame types to keep the payload of.
+	keep func(http2.FrameType) bool
+
+	header    [9]byte
+	headerLen int
+	remaining int
+	current   frame
+}
+
+// next consumes data up to the end of the frame in progress, returning the number of bytes
+// consumed and the frame if it was completed.
+func (p *frameParser) next(data []byte) (int, *frame) {
+	if p.skip > 0 {
+		n := min(p.skip, len(data))
+		p.skip -= n
+		return n, nil
+	}
+
+	consumed := 0
+	if p.headerLen < len(p.header) {
+		n := copy(p.header[p.headerLen:], data)
+		p.headerLen += n
+		consumed = n
+		if p.headerLen < len(p.header) {
+			return consumed, nil
+		}
+		h := p.header
+		p.remaining = int(h[0])<<16 | int(h[1])<<8 | int(h[2])
+		p.current = frame{
+			Type:     http2.FrameType(h[3]),
+			Flags:    http2.Flags(h[4]),
+			StreamID: (uint32(h[5])<<24 | uint32(h[6])<<16 | uint32(h[7])<<8 | uint32(h[8])) & (1<<31 - 1),
+		}
+		data = data[n:]
+	}
+
+	n := min(p.remaining, len(data))
+	if p.keep != nil && p.keep(p.current.Type) {
+		p.current.Payload = append(p.current.Payload, data[:n]...)
+	}
+	p.remaining -= n
+	consumed += n
+	if p.remaining > 0 {
+		return consumed, nil
+	}
+	f := p.current
+	p.current = frame{}
+	p.headerLen = 0
+	return consumed, &f
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,345 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/proto"
+)
+
+// testPKI is a certificate authority issuing the certificates of the servers and clients of
+// mutual TLS tests.
+type testPKI struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "showcase test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &testPKI{cert: cert, key: key, pool: pool}
+}
+
+// issue returns a certificate for commonName, valid for usage and for 127.0.0.1.
+func (p *testPKI) issue(t *testing.T, commonName string, usage x509.ExtKeyUsage) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, p.cert, &key.PublicKey, p.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// server returns the credentials of a server requiring client certificates issued by p.
+func (p *testPKI) server(t *testing.T) credentials.TransportCredentials {
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{p.issue(t, "server", x509.ExtKeyUsageServerAuth)},
+		ClientCAs:    p.pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})
+}
+
+// client returns the TLS configuration of a client named commonName.
+func (p *testPKI) client(t *testing.T, commonName string) *tls.Config {
+	return &tls.Config{
+		Certificates: []tls.Certificate{p.issue(t, commonName, x509.ExtKeyUsageClientAuth)},
+		RootCAs:      p.pool,
+		NextProtos:   []string{"h2"},
+	}
+}
+
+// dialer opens the raw connections of a test, in plaintext or over TLS.
+type dialer func(addr string) (net.Conn, error)
+
+func plainDialer(addr string) (net.Conn, error) {
+	return net.Dial("tcp", addr)
+}
+
+func tlsDialer(config *tls.Config) dialer {
+	return func(addr string) (net.Conn, error) {
+		return tls.Dial("tcp", addr, config)
+	}
+}
+
+// readGoAway opens a raw HTTP/2 connection to addr and returns the GOAWAY frame the server
+// sends on it, if any.
+func readGoAway(t *testing.T, dial dialer, addr string) *http2.GoAwayFrame {
+	conn, err := dial(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	if _, err := conn.Write([]byte(http2.ClientPreface)); err != nil {
+		t.Fatal(err)
+	}
+	framer := http2.NewFramer(conn, conn)
+	if err := framer.WriteSettings(); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		frame, err := framer.ReadFrame()
+		if err != nil {
+			return nil
+		}
+		if goAway, ok := frame.(*http2.GoAwayFrame); ok {
+			return goAway
+		}
+	}
+}
+
+func TestConnectionManager_quota(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager := NewConnectionManager(1)
+	s := grpc.NewServer(grpc.Creds(manager.Credentials(nil)))
+	pb.RegisterEchoServer(s, slowEcho{&pb.UnimplementedEchoServer{}})
+	go s.Serve(lis)
+	defer s.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pb.NewEchoClient(conn).Echo(context.Background(), &pb.EchoRequest{}); err != nil {
+		t.Fatalf("Echo within quota: %v", err)
+	}
+	if got := manager.Connections("127.0.0.1"); got != 1 {
+		t.Errorf("want 1 connection counted, got %d", got)
+	}
+
+	goAway := readGoAway(t, plainDialer, lis.Addr().String())
+	if goAway == nil {
+		t.Fatal("want a GOAWAY on the connection over quota")
+	}
+	if goAway.ErrCode != http2.ErrCodeEnhanceYourCalm || goAway.LastStreamID != 0 {
+		t.Errorf("unexpected GOAWAY: %v", goAway)
+	}
+	if debug := string(goAway.DebugData()); !strings.HasPrefix(debug, "too_many_connections") {
+		t.Errorf("unexpected GOAWAY debug data %q", debug)
+	}
+
+	// Closing a connection frees its slot.
+	conn.Close()
+	deadline := time.Now().Add(5 * time.Second)
+	for manager.Connections("127.0.0.1") != 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := manager.Connections("127.0.0.1"); got != 0 {
+		t.Fatalf("want the closed connection released, got %d open", got)
+	}
+	conn, err = grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := pb.NewEchoClient(conn).Echo(context.Background(), &pb.EchoRequest{}); err != nil {
+		t.Errorf("Echo after a connection was released: %v", err)
+	}
+}
+
+// goAwayEcho schedules a GOAWAY on the connection of every call to Echo.
+type goAwayEcho struct {
+	*pb.UnimplementedEchoServer
+	manager *ConnectionManager
+}
+
+func (e goAwayEcho) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+	if err := e.manager.ScheduleGoAway(ctx, http2.ErrCodeEnhanceYourCalm, in.GetContent()); err != nil {
+		return nil, err
+	}
+	return &pb.EchoResponse{Content: in.GetContent()}, nil
+}
+
+func TestConnectionManager_scheduleGoAway(t *testing.T) {
+	testScheduleGoAway(t, nil, plainDialer, "http")
+}
+
+func TestConnectionManager_scheduleGoAwayTLS(t *testing.T) {
+	pki := newTestPKI(t)
+	testScheduleGoAway(t, pki.server(t), tlsDialer(pki.client(t, "alice")), "https")
+}
+
+// testScheduleGoAway checks that a GOAWAY scheduled by a call follows its response, on a
+// server with creds reached by dial.
+func testScheduleGoAway(t *testing.T, creds credentials.TransportCredentials, dial dialer, scheme string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager := NewConnectionManager(0)
+	s := grpc.NewServer(grpc.Creds(manager.Credentials(creds)))
+	pb.RegisterEchoServer(s, goAwayEcho{&pb.UnimplementedEchoServer{}, manager})
+	go s.Serve(lis)
+	defer s.Stop()
+
+	conn, err := dial(lis.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	if _, err := conn.Write([]byte(http2.ClientPreface)); err != nil {
+		t.Fatal(err)
+	}
+	framer := http2.NewFramer(conn, conn)
+	framer.WriteSettings()
+
+	// Make a gRPC call by hand, to see exactly which frames the server sends.
+	var headers bytes.Buffer
+	encoder := hpack.NewEncoder(&headers)
+	for _, field := range [][2]string{
+		{":method", "POST"},
+		{":scheme", scheme},
+		{":path", "/google.showcase.v1beta1.Echo/Echo"},
+		{":authority", "localhost"},
+		{"content-type", "application/grpc"},
+		{"te", "trailers"},
+	} {
+		encoder.WriteField(hpack.HeaderField{Name: field[0], Value: field[1]})
+	}
+	framer.WriteHeaders(http2.HeadersFrameParam{StreamID: 1, BlockFragment: headers.Bytes(), EndHeaders: true})
+	message, _ := proto.Marshal(&pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "going away"}})
+	data := make([]byte, 5+len(message))
+	binary.BigEndian.PutUint32(data[1:], uint32(len(message)))
+	copy(data[5:], message)
+	framer.WriteData(1, true, data)
+
+	sawTrailers := false
+	for {
+		f, err := framer.ReadFrame()
+		if err != nil {
+			t.Fatalf("want a GOAWAY, got %v", err)
+		}
+		switch f := f.(type) {
+		case *http2.SettingsFrame:
+			if !f.IsAck() {
+				framer.WriteSettingsAck()
+			}
+		case *http2.HeadersFrame:
+			if f.StreamEnded() {
+				sawTrailers = true
+			}
+		case *http2.GoAwayFrame:
+			if !sawTrailers {
+				t.Error("GOAWAY was sent before the response ended")
+			}
+			if f.ErrCode != http2.ErrCodeEnhanceYourCalm || f.LastStreamID != 1 || string(f.DebugData()) != "going away" {
+				t.Errorf("unexpected GOAWAY: %v %q", f, f.DebugData())
+			}
+			return
+		}
+	}
+}
+
+func TestConnectionManager_quotaTLS(t *testing.T) {
+	pki := newTestPKI(t)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager := NewConnectionManager(1)
+	s := grpc.NewServer(grpc.Creds(manager.Credentials(pki.server(t))))
+	pb.RegisterEchoServer(s, slowEcho{&pb.UnimplementedEchoServer{}})
+	go s.Serve(lis)
+	defer s.Stop()
+
+	alice := pki.client(t, "alice")
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(alice)), grpc.WithBlock())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := pb.NewEchoClient(conn).Echo(context.Background(), &pb.EchoRequest{}); err != nil {
+		t.Fatalf("Echo within quota: %v", err)
+	}
+	if got := manager.Connections("CN=alice"); got != 1 {
+		t.Errorf("want 1 connection counted for alice's certificate, got %d", got)
+	}
+
+	// The refusal is sent over TLS, where the client can read it.
+	goAway := readGoAway(t, tlsDialer(alice), lis.Addr().String())
+	if goAway == nil {
+		t.Fatal("want a GOAWAY on the connection over quota")
+	}
+	if goAway.ErrCode != http2.ErrCodeEnhanceYourCalm || !strings.HasPrefix(string(goAway.DebugData()), "too_many_connections: client CN=alice") {
+		t.Errorf("unexpected GOAWAY: %v %q", goAway, goAway.DebugData())
+	}
+
+	// Another certificate is another client, even from the same host.
+	other, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(pki.client(t, "bob"))), grpc.WithBlock())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+	if _, err := pb.NewEchoClient(other).Echo(context.Background(), &pb.EchoRequest{}); err != nil {
+		t.Errorf("Echo from another certificate: %v", err)
+	}
+}
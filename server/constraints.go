@@ -0,0 +1,228 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// constraintPatterns caches the compiled patterns of the field constraints, by their source.
+var constraintPatterns sync.Map
+
+// CheckFieldConstraints returns nil if m, and the messages set within it, satisfy the
+// google.showcase.v1beta1.constraints annotations of their fields. Otherwise, it returns an
+// InvalidArgument error with a google.rpc.BadRequest detail listing each violation, naming the
+// field by its path from m.
+func CheckFieldConstraints(m proto.Message) error {
+	violations := FieldConstraintViolations(m.ProtoReflect())
+	if len(violations) == 0 {
+		return nil
+	}
+	descriptions := []string{}
+	for _, violation := range violations {
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s", violation.GetField(), violation.GetDescription()))
+	}
+	st := status.Newf(codes.InvalidArgument, "the request violates field constraints: %s", strings.Join(descriptions, "; "))
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// FieldConstraintViolations returns the violations of the field constraints in m and in the
+// messages set within it, naming each field by its path from m.
+func FieldConstraintViolations(m protoreflect.Message) []*errdetails.BadRequest_FieldViolation {
+	return fieldConstraintViolations(m, "")
+}
+
+// fieldConstraintViolations returns the violations of the field constraints in m, whose fields'
+// paths are prefixed by prefix.
+func fieldConstraintViolations(m protoreflect.Message, prefix string) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+	fields := m.Descriptor().Fields()
+	for idx := 0; idx < fields.Len(); idx++ {
+		field := fields.Get(idx)
+		path := prefix + string(field.Name())
+		if field.HasPresence() && !m.Has(field) {
+			continue
+		}
+		constraints := fieldConstraints(field)
+		switch {
+		case field.IsList():
+			list := m.Get(field).List()
+			if constraints != nil {
+				violations = append(violations, itemCountViolations(constraints, path, list.Len())...)
+			}
+			for elem := 0; elem < list.Len(); elem++ {
+				violations = append(violations, valueViolations(field, constraints, fmt.Sprintf("%s[%d]", path, elem), list.Get(elem))...)
+			}
+		case field.IsMap():
+			// Map values are not constrained.
+		default:
+			violations = append(violations, valueViolations(field, constraints, path, m.Get(field))...)
+		}
+	}
+	return violations
+}
+
+// fieldConstraints returns the constraints field is annotated with, or nil.
+func fieldConstraints(field protoreflect.FieldDescriptor) *pb.FieldConstraints {
+	options := field.Options()
+	if options == nil || !proto.HasExtension(options, pb.E_Constraints) {
+		return nil
+	}
+	return proto.GetExtension(options, pb.E_Constraints).(*pb.FieldConstraints)
+}
+
+// itemCountViolations returns the violations of constraints by a repeated field at path holding
+// count elements.
+func itemCountViolations(constraints *pb.FieldConstraints, path string, count int) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+	if constraints.MinItems != nil && uint64(count) < constraints.GetMinItems() {
+		violations = append(violations, violation(path, "must hold at least %d elements, but holds %d", constraints.GetMinItems(), count))
+	}
+	if constraints.MaxItems != nil && uint64(count) > constraints.GetMaxItems() {
+		violations = append(violations, violation(path, "must hold at most %d elements, but holds %d", constraints.GetMaxItems(), count))
+	}
+	return violations
+}
+
+// valueViolations returns the violations of constraints, which may be nil, by value, a single
+// value of field at path, or of the constraints of the message it is.
+func valueViolations(field protoreflect.FieldDescriptor, constraints *pb.FieldConstraints, path string, value protoreflect.Value) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return fieldConstraintViolations(value.Message(), path+".")
+	case protoreflect.StringKind:
+		if constraints == nil {
+			break
+		}
+		length := uint64(utf8.RuneCountInString(value.String()))
+		if constraints.MinLen != nil && length < constraints.GetMinLen() {
+			violations = append(violations, violation(path, "must be at least %d characters long, but is %d", constraints.GetMinLen(), length))
+		}
+		if constraints.MaxLen != nil && length > constraints.GetMaxLen() {
+			violations = append(violations, violation(path, "must be at most %d characters long, but is %d", constraints.GetMaxLen(), length))
+		}
+		if pattern := constraints.GetPattern(); pattern != "" && value.String() != "" {
+			re, err := constraintPattern(pattern)
+			if err != nil {
+				violations = append(violations, violation(path, "cannot be checked against the invalid pattern %q", pattern))
+			} else if !re.MatchString(value.String()) {
+				violations = append(violations, violation(path, "must match the pattern %q", pattern))
+			}
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if constraints != nil {
+			violations = append(violations, rangeViolations(constraints, path, value.Int())...)
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if constraints != nil {
+			n := value.Uint()
+			if n > math.MaxInt64 {
+				// Larger than any bound.
+				n = math.MaxInt64
+			}
+			violations = append(violations, rangeViolations(constraints, path, int64(n))...)
+		}
+	}
+	return violations
+}
+
+// rangeViolations returns the violations of constraints by n, the integer at path.
+func rangeViolations(constraints *pb.FieldConstraints, path string, n int64) []*errdetails.BadRequest_FieldViolation {
+	violations := []*errdetails.BadRequest_FieldViolation{}
+	if constraints.Gte != nil && n < constraints.GetGte() {
+		violations = append(violations, violation(path, "must be at least %d, but is %d", constraints.GetGte(), n))
+	}
+	if constraints.Lte != nil && n > constraints.GetLte() {
+		violations = append(violations, violation(path, "must be at most %d, but is %d", constraints.GetLte(), n))
+	}
+	return violations
+}
+
+// constraintPattern returns the compiled pattern.
+func constraintPattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := constraintPatterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	constraintPatterns.Store(pattern, re)
+	return re, nil
+}
+
+func violation(field, format string, args ...interface{}) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: fmt.Sprintf(format, args...)}
+}
+
+// ConstraintUnaryInterceptor implements grpc.UnaryServerInterceptor, failing the calls whose
+// request violates field constraints, as CheckFieldConstraints reports them.
+func ConstraintUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if m, ok := req.(proto.Message); ok {
+		if err := CheckFieldConstraints(m); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+// ConstraintStreamInterceptor implements grpc.StreamServerInterceptor, failing the calls any
+// message of which violates field constraints, as CheckFieldConstraints reports them.
+func ConstraintStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	return handler(srv, &constrainedServerStream{ServerStream: ss})
+}
+
+// constrainedServerStream is a stream checking the field constraints of the messages received
+// on it.
+type constrainedServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *constrainedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if message, ok := m.(proto.Message); ok {
+		return CheckFieldConstraints(message)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCheckFieldConstraints(t *testing.T) {
+	for _, in := range []proto.Message{
+		&pb.FanOutRequest{TaskCount: 10, Concurrency: 2},
+		&pb.CreateUserRequest{User: &pb.User{DisplayName: strings.Repeat("é", 256), Email: "é@example.com"}},
+		&pb.UpdateUserRequest{User: &pb.User{Nickname: proto.String("jonas")}},
+	} {
+		if err := CheckFieldConstraints(in); err != nil {
+			t.Errorf("CheckFieldConstraints(%v): %v", in, err)
+		}
+	}
+
+	failures := make([]*pb.FanOutRequest_Failure, 10001)
+	for idx := range failures {
+		failures[idx] = &pb.FanOutRequest_Failure{}
+	}
+	err := CheckFieldConstraints(&pb.FanOutRequest{TaskCount: -5, Concurrency: -1, Failures: failures})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("CheckFieldConstraints of an invalid request: want InvalidArgument, got %v", err)
+	}
+	want := map[string]string{
+		"task_count":  "must be at least 0, but is -5",
+		"concurrency": "must be at least 0, but is -1",
+		"failures":    "must hold at most 10000 elements, but holds 10001",
+	}
+	got := map[string]string{}
+	for _, detail := range status.Convert(err).Details() {
+		if request, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range request.GetFieldViolations() {
+				got[violation.GetField()] = violation.GetDescription()
+			}
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("CheckFieldConstraints: want violations %v, got %v", want, got)
+	}
+	for field, description := range want {
+		if got[field] != description {
+			t.Errorf("CheckFieldConstraints: want %s %q, got %q", field, description, got[field])
+		}
+	}
+
+	err = CheckFieldConstraints(&pb.CreateUserRequest{User: &pb.User{DisplayName: strings.Repeat("x", 257), Email: "x@y"}})
+	if !strings.Contains(status.Convert(err).Message(), "user.display_name: must be at most 256 characters long, but is 257") {
+		t.Errorf("CheckFieldConstraints of a long display name: got %v", err)
+	}
+}
+
+// constrainedEcho generates payloads.
+type constrainedEcho struct {
+	*pb.UnimplementedEchoServer
+}
+
+func (constrainedEcho) GeneratePayload(ctx context.Context, in *pb.GeneratePayloadRequest) (*pb.GeneratePayloadResponse, error) {
+	return &pb.GeneratePayloadResponse{Size: in.GetSize()}, nil
+}
+
+func TestConstraintInterceptors(t *testing.T) {
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(ConstraintUnaryInterceptor),
+		grpc.StreamInterceptor(ConstraintStreamInterceptor))
+	pb.RegisterEchoServer(s, constrainedEcho{})
+	pb.RegisterIdentityServer(s, &pb.UnimplementedIdentityServer{})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewEchoClient(conn)
+
+	if _, err := client.GeneratePayload(context.Background(), &pb.GeneratePayloadRequest{Size: 10}); err != nil {
+		t.Errorf("GeneratePayload of a valid request: %v", err)
+	}
+	_, err = client.GeneratePayload(context.Background(), &pb.GeneratePayloadRequest{Size: -1})
+	if status.Code(err) != codes.InvalidArgument || len(status.Convert(err).Details()) != 1 {
+		t.Errorf("GeneratePayload of a negative size: want InvalidArgument with a BadRequest, got %v", err)
+	}
+	_, err = pb.NewIdentityClient(conn).CreateUser(context.Background(), &pb.CreateUserRequest{User: &pb.User{Email: "nobody"}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateUser with an invalid email: want InvalidArgument before the handler, got %v", err)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// adminPrefix is the gRPC method prefix of the calls to the ShowcaseAdmin service, which are
+// never delayed nor failed by the behaviors it controls.
+const adminPrefix = "/google.showcase.v1beta1.ShowcaseAdmin/"
+
+// ServerControls holds the behaviors of the server the ShowcaseAdmin service changes at runtime
+// that no other component owns: the latency added to every call and the feature flags turned
+// on.
+type ServerControls struct {
+	mu      sync.Mutex
+	latency time.Duration
+	flags   map[string]bool
+}
+
+// NewServerControls creates ServerControls adding no latency, with no feature flag on.
+func NewServerControls() *ServerControls {
+	return &ServerControls{flags: map[string]bool{}}
+}
+
+// Latency returns how long the server waits before serving each call to the Showcase API.
+func (c *ServerControls) Latency() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.latency
+}
+
+// SetLatency makes the server wait for latency before serving each call to the Showcase API.
+func (c *ServerControls) SetLatency(latency time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.latency = latency
+}
+
+// FeatureFlags returns the names of the feature flags turned on, sorted.
+func (c *ServerControls) FeatureFlags() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	flags := []string{}
+	for flag := range c.flags {
+		flags = append(flags, flag)
+	}
+	sort.Strings(flags)
+	return flags
+}
+
+// SetFeatureFlags turns on the feature flags named flags, and off all others.
+func (c *ServerControls) SetFeatureFlags(flags []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.flags = map[string]bool{}
+	for _, flag := range flags {
+		c.flags[flag] = true
+	}
+}
+
+// Enabled returns whether the feature flag named flag is on.
+func (c *ServerControls) Enabled(flag string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.flags[flag]
+}
+
+// Delay waits for the latency added to calls, failing with the status of ctx's error if it is
+// done first.
+func (c *ServerControls) Delay(ctx context.Context) error {
+	latency := c.Latency()
+	if latency <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(latency)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// delay delays a gRPC call to method.
+func (c *ServerControls) delay(ctx context.Context, method string) error {
+	if !strings.HasPrefix(method, faultedPrefix) || strings.HasPrefix(method, adminPrefix) {
+		return nil
+	}
+	return c.Delay(ctx)
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, delaying calls by the latency added
+// to them.
+func (c *ServerControls) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := c.delay(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, delaying calls by the latency
+// added to them before their handler starts.
+func (c *ServerControls) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := c.delay(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServerControls_featureFlags(t *testing.T) {
+	c := NewServerControls()
+	c.SetFeatureFlags([]string{"b", "a"})
+	if got := c.FeatureFlags(); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("FeatureFlags(): want [a b], got %v", got)
+	}
+	if !c.Enabled("a") || c.Enabled("c") {
+		t.Errorf("Enabled: want a on and c off, got %v and %v", c.Enabled("a"), c.Enabled("c"))
+	}
+	c.SetFeatureFlags(nil)
+	if got := c.FeatureFlags(); len(got) != 0 {
+		t.Errorf("FeatureFlags() after turning all off: want none, got %v", got)
+	}
+}
+
+func TestServerControls_Interceptors(t *testing.T) {
+	c := NewServerControls()
+	c.SetLatency(20 * time.Millisecond)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "served", nil }
+	for _, testCase := range []struct {
+		method  string
+		delayed bool
+	}{
+		{"/google.showcase.v1beta1.Echo/Echo", true},
+		{"/google.showcase.v1beta1.ShowcaseAdmin/UpdateServerConfig", false},
+		{"/grpc.health.v1.Health/Check", false},
+	} {
+		start := time.Now()
+		if _, err := c.UnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testCase.method}, handler); err != nil {
+			t.Fatal(err)
+		}
+		if delayed := time.Since(start) >= 20*time.Millisecond; delayed != testCase.delayed {
+			t.Errorf("%s: want delayed=%v, got %v", testCase.method, testCase.delayed, delayed)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	c.SetLatency(time.Hour)
+	if err := c.Delay(ctx); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Delay past the deadline: want DeadlineExceeded, got %v", err)
+	}
+}
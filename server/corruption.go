@@ -0,0 +1,125 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CorruptionMode is a way a serialized response can be corrupted.
+type CorruptionMode string
+
+const (
+	// CorruptBitFlip turns on the low three bits of the first byte of the response. In a
+	// protobuf encoding they become the invalid wire type 7 of the first field's tag; in a JSON
+	// one, the opening brace becomes a DEL character.
+	CorruptBitFlip CorruptionMode = "bit-flip"
+
+	// CorruptTruncate drops the last byte of the response, so that its last field, or its
+	// closing brace in JSON, is incomplete.
+	CorruptTruncate CorruptionMode = "truncate"
+)
+
+var corruptionModes = []CorruptionMode{CorruptBitFlip, CorruptTruncate}
+
+// corruptedPackagePrefix is the prefix of the full names of the gRPC response messages that may
+// be corrupted. Responses of the reflection and health services are left intact.
+const corruptedPackagePrefix = "google.showcase.v1beta1."
+
+// PayloadCorruptor corrupts a fraction of the serialized responses, beyond what clients can
+// parse, so that their handling of unparseable messages can be exercised.
+type PayloadCorruptor struct {
+	fraction float64
+	modes    []CorruptionMode
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewPayloadCorruptor creates a PayloadCorruptor corrupting the given fraction of responses,
+// from 0 to 1, each in one of modes picked at random.
+func NewPayloadCorruptor(fraction float64, modes []string) (*PayloadCorruptor, error) {
+	if fraction < 0 || fraction > 1 {
+		return nil, fmt.Errorf("invalid fraction %v: must be between 0 and 1", fraction)
+	}
+	if len(modes) == 0 {
+		return nil, fmt.Errorf("no corruption modes: must be some of %v", corruptionModes)
+	}
+	c := &PayloadCorruptor{fraction: fraction, rand: rand.New(rand.NewSource(time.Now().UnixNano()))}
+	for _, m := range modes {
+		known := false
+		for _, k := range corruptionModes {
+			known = known || CorruptionMode(m) == k
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown corruption mode %q: must be one of %v", m, corruptionModes)
+		}
+		c.modes = append(c.modes, CorruptionMode(m))
+	}
+	return c, nil
+}
+
+// Corrupt returns data, corrupted in place if data was picked to be. Empty responses are never
+// corrupted. A nil PayloadCorruptor corrupts nothing.
+func (c *PayloadCorruptor) Corrupt(data []byte) []byte {
+	if c == nil || len(data) == 0 {
+		return data
+	}
+	c.mu.Lock()
+	picked := c.rand.Float64() < c.fraction
+	mode := c.modes[c.rand.Intn(len(c.modes))]
+	c.mu.Unlock()
+	if !picked {
+		return data
+	}
+	switch mode {
+	case CorruptBitFlip:
+		data[0] |= 0x07
+	case CorruptTruncate:
+		data = data[:len(data)-1]
+	}
+	return data
+}
+
+// Codec returns the gRPC codec serializing messages as protobuf, corrupting the Showcase API
+// responses picked to be.
+func (c *PayloadCorruptor) Codec() encoding.Codec {
+	return &corruptingCodec{Codec: encoding.GetCodec("proto"), corruptor: c}
+}
+
+// corruptingCodec corrupts the protobuf encoding of the messages it marshals. It is only used
+// by the server, which marshals responses and unmarshals requests.
+type corruptingCodec struct {
+	encoding.Codec
+	corruptor *PayloadCorruptor
+}
+
+func (c *corruptingCodec) Marshal(v interface{}) ([]byte, error) {
+	data, err := c.Codec.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if m, ok := v.(protoreflect.ProtoMessage); ok && strings.HasPrefix(string(m.ProtoReflect().Descriptor().FullName()), corruptedPackagePrefix) {
+		data = c.corruptor.Corrupt(data)
+	}
+	return data, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestNewPayloadCorruptor_invalid(t *testing.T) {
+	for _, testCase := range []struct {
+		fraction float64
+		modes    []string
+	}{
+		{-0.1, []string{"bit-flip"}},
+		{1.5, []string{"bit-flip"}},
+		{0.5, nil},
+		{0.5, []string{"scramble"}},
+	} {
+		if _, err := NewPayloadCorruptor(testCase.fraction, testCase.modes); err == nil {
+			t.Errorf("NewPayloadCorruptor(%v, %v): want an error", testCase.fraction, testCase.modes)
+		}
+	}
+}
+
+func TestPayloadCorruptor_modes(t *testing.T) {
+	response := &pb.EchoResponse{Content: "hello", Severity: pb.Severity_CRITICAL}
+	for _, mode := range []string{"bit-flip", "truncate"} {
+		corruptor, err := NewPayloadCorruptor(1, []string{mode})
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := corruptor.Codec().Marshal(response)
+		if err != nil {
+			t.Fatalf("%s: Marshal: unexpected err %v", mode, err)
+		}
+		if err := proto.Unmarshal(data, &pb.EchoResponse{}); err == nil {
+			t.Errorf("%s: the corrupted protobuf encoding still parses", mode)
+		}
+		if data := corruptor.Corrupt([]byte(`{"content":"hello"}`)); json.Valid(data) {
+			t.Errorf("%s: the corrupted JSON encoding %q still parses", mode, data)
+		}
+	}
+}
+
+func TestPayloadCorruptor_spared(t *testing.T) {
+	corruptor, err := NewPayloadCorruptor(1, []string{"bit-flip", "truncate"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	codec := corruptor.Codec()
+	data, err := codec.Marshal(&emptypb.Empty{})
+	if err != nil || len(data) != 0 {
+		t.Errorf("Marshal(Empty): want an intact empty encoding, got %v, %v", data, err)
+	}
+
+	never, err := NewPayloadCorruptor(0, []string{"truncate"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = never.Codec().Marshal(&pb.EchoResponse{Content: "hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(data, &pb.EchoResponse{}); err != nil {
+		t.Errorf("no response should be corrupted with a fraction of 0, got %v", err)
+	}
+
+	var nilCorruptor *PayloadCorruptor
+	if got := nilCorruptor.Corrupt([]byte("{}")); string(got) != "{}" {
+		t.Errorf("a nil PayloadCorruptor should corrupt nothing, got %q", got)
+	}
+}
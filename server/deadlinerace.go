@@ -0,0 +1,73 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DeadlineRace holds back the completion of calls until just before, or just after, their
+// deadline, so that clients' handling of responses racing their deadline can be reproduced
+// reliably rather than by chance.
+type DeadlineRace struct {
+	margin time.Duration
+	sleepF func(time.Duration)
+	nowF   func() time.Time
+}
+
+// NewDeadlineRace creates a DeadlineRace completing calls margin before their deadline, or
+// -margin after it if margin is negative.
+func NewDeadlineRace(margin time.Duration) *DeadlineRace {
+	return &DeadlineRace{margin: margin, sleepF: time.Sleep, nowF: time.Now}
+}
+
+// Wait blocks until margin before the deadline of ctx. It returns straight away if ctx has no
+// deadline, or if that time has already passed.
+func (d *DeadlineRace) Wait(ctx context.Context) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return
+	}
+	if wait := deadline.Add(-d.margin).Sub(d.nowF()); wait > 0 {
+		d.sleepF(wait)
+	}
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, holding back the response of calls
+// with a deadline until margin before it.
+func (d *DeadlineRace) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	d.Wait(ctx)
+	return resp, err
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, holding back the status of calls
+// with a deadline until margin before it.
+func (d *DeadlineRace) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	err := handler(srv, ss)
+	d.Wait(ss.Context())
+	return err
+}
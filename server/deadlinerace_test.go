@@ -0,0 +1,74 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+func TestDeadlineRace_wait(t *testing.T) {
+	now := time.Unix(1000, 0)
+	for _, testCase := range []struct {
+		margin   time.Duration
+		deadline time.Duration
+		want     time.Duration
+	}{
+		{margin: 2 * time.Millisecond, deadline: time.Second, want: 998 * time.Millisecond},
+		{margin: -2 * time.Millisecond, deadline: time.Second, want: 1002 * time.Millisecond},
+		{margin: 0, deadline: time.Second, want: time.Second},
+		{margin: 2 * time.Second, deadline: time.Second, want: 0},
+	} {
+		var slept time.Duration
+		race := &DeadlineRace{
+			margin: testCase.margin,
+			sleepF: func(d time.Duration) { slept += d },
+			nowF:   func() time.Time { return now },
+		}
+		ctx, cancel := context.WithDeadline(context.Background(), now.Add(testCase.deadline))
+		race.Wait(ctx)
+		cancel()
+		if slept != testCase.want {
+			t.Errorf("margin %v, deadline in %v: want to wait %v, waited %v", testCase.margin, testCase.deadline, testCase.want, slept)
+		}
+	}
+}
+
+func TestDeadlineRace_interceptor(t *testing.T) {
+	race := NewDeadlineRace(5 * time.Millisecond)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "done", nil }
+
+	start := time.Now()
+	resp, err := race.UnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	if err != nil || resp != "done" {
+		t.Fatalf("want the handler's response, got %v, %v", resp, err)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("calls without a deadline should not wait, waited %v", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+	if _, err := race.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+		t.Fatal(err)
+	}
+	if early := time.Until(deadline); early > 5*time.Millisecond {
+		t.Errorf("want the call to complete within 5ms of its deadline, completed %v before it", early)
+	}
+}
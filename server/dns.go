@@ -0,0 +1,293 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// maxTXTStringLength is the longest character-string a TXT record can hold; longer values are
+// split across several strings.
+const maxTXTStringLength = 255
+
+// DNSRecord is a resource record served by a DNSServer.
+type DNSRecord struct {
+	// Name is the domain name of the record. A trailing dot is optional.
+	Name string `json:"name"`
+
+	// Type is one of "A", "AAAA", "SRV", "TXT" or "GRPC_CONFIG". A GRPC_CONFIG record is a
+	// TXT record for "_grpc_config.<Name>" holding Value, a gRPC service config, in the form
+	// the gRPC DNS resolver looks up.
+	Type string `json:"type"`
+
+	// TTL is the time to live of the record, in seconds.
+	TTL uint32 `json:"ttl"`
+
+	// Value is the address of A and AAAA records, the text of TXT records, the target of SRV
+	// records and the service config of GRPC_CONFIG records.
+	Value string `json:"value"`
+
+	// Port, Priority and Weight are the remaining fields of SRV records.
+	Port     uint16 `json:"port"`
+	Priority uint16 `json:"priority"`
+	Weight   uint16 `json:"weight"`
+}
+
+// LoadDNSRecords reads a JSON list of DNSRecords from a file.
+func LoadDNSRecords(path string) ([]DNSRecord, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	records := []DNSRecord{}
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("parsing DNS records in %s: %v", path, err)
+	}
+	return records, nil
+}
+
+// DNSServer is a minimal authoritative DNS server answering queries from a fixed set of
+// records, so that client name resolution, including service configs delivered via DNS, can be
+// tested hermetically.
+type DNSServer struct {
+	mu      sync.Mutex
+	records map[string][]dnsmessage.Resource
+	conn    net.PacketConn
+}
+
+// NewDNSServer creates a DNSServer serving records.
+func NewDNSServer(records []DNSRecord) (*DNSServer, error) {
+	s := &DNSServer{}
+	if err := s.SetRecords(records); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// SetRecords replaces the records served.
+func (s *DNSServer) SetRecords(records []DNSRecord) error {
+	resources := map[string][]dnsmessage.Resource{}
+	for _, record := range records {
+		resource, err := record.resource()
+		if err != nil {
+			return err
+		}
+		key := strings.ToLower(resource.Header.Name.String())
+		resources[key] = append(resources[key], resource)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = resources
+	return nil
+}
+
+func (r DNSRecord) resource() (dnsmessage.Resource, error) {
+	name := r.Name
+	if r.Type == "GRPC_CONFIG" {
+		name = "_grpc_config." + name
+	}
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	dnsName, err := dnsmessage.NewName(name)
+	if err != nil {
+		return dnsmessage.Resource{}, fmt.Errorf("DNS record %q: %v", r.Name, err)
+	}
+	header := dnsmessage.ResourceHeader{Name: dnsName, Class: dnsmessage.ClassINET, TTL: r.TTL}
+
+	switch r.Type {
+	case "A":
+		ip := net.ParseIP(r.Value).To4()
+		if ip == nil {
+			return dnsmessage.Resource{}, fmt.Errorf("DNS record %q: %q is not an IPv4 address", r.Name, r.Value)
+		}
+		a := &dnsmessage.AResource{}
+		copy(a.A[:], ip)
+		header.Type = dnsmessage.TypeA
+		return dnsmessage.Resource{Header: header, Body: a}, nil
+	case "AAAA":
+		ip := net.ParseIP(r.Value)
+		if ip == nil || ip.To4() != nil {
+			return dnsmessage.Resource{}, fmt.Errorf("DNS record %q: %q is not an IPv6 address", r.Name, r.Value)
+		}
+		aaaa := &dnsmessage.AAAAResource{}
+		copy(aaaa.AAAA[:], ip)
+		header.Type = dnsmessage.TypeAAAA
+		return dnsmessage.Resource{Header: header, Body: aaaa}, nil
+	case "SRV":
+		target := r.Value
+		if !strings.HasSuffix(target, ".") {
+			target += "."
+		}
+		targetName, err := dnsmessage.NewName(target)
+		if err != nil {
+			return dnsmessage.Resource{}, fmt.Errorf("DNS record %q: %v", r.Name, err)
+		}
+		header.Type = dnsmessage.TypeSRV
+		return dnsmessage.Resource{Header: header, Body: &dnsmessage.SRVResource{
+			Priority: r.Priority,
+			Weight:   r.Weight,
+			Port:     r.Port,
+			Target:   targetName,
+		}}, nil
+	case "TXT":
+		header.Type = dnsmessage.TypeTXT
+		return dnsmessage.Resource{Header: header, Body: &dnsmessage.TXTResource{TXT: splitTXT(r.Value)}}, nil
+	case "GRPC_CONFIG":
+		value := fmt.Sprintf(`grpc_config=[{"serviceConfig":%s}]`, r.Value)
+		header.Type = dnsmessage.TypeTXT
+		return dnsmessage.Resource{Header: header, Body: &dnsmessage.TXTResource{TXT: splitTXT(value)}}, nil
+	}
+	return dnsmessage.Resource{}, fmt.Errorf("DNS record %q: unsupported type %q", r.Name, r.Type)
+}
+
+// splitTXT splits value into character-strings short enough for a TXT record.
+func splitTXT(value string) []string {
+	parts := []string{}
+	for len(value) > maxTXTStringLength {
+		parts = append(parts, value[:maxTXTStringLength])
+		value = value[maxTXTStringLength:]
+	}
+	return append(parts, value)
+}
+
+// Handle returns the response to a DNS query message.
+func (s *DNSServer) Handle(query []byte) ([]byte, error) {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(query)
+	if err != nil {
+		return nil, err
+	}
+	questions, err := parser.AllQuestions()
+	if err != nil {
+		return nil, err
+	}
+
+	builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{
+		ID:                 header.ID,
+		Response:           true,
+		OpCode:             header.OpCode,
+		Authoritative:      true,
+		RecursionDesired:   header.RecursionDesired,
+		RecursionAvailable: false,
+		RCode:              s.rcode(header, questions),
+	})
+	builder.EnableCompression()
+	if err := builder.StartQuestions(); err != nil {
+		return nil, err
+	}
+	for _, q := range questions {
+		if err := builder.Question(q); err != nil {
+			return nil, err
+		}
+	}
+	if err := builder.StartAnswers(); err != nil {
+		return nil, err
+	}
+	for _, q := range questions {
+		for _, answer := range s.answers(q) {
+			if err := appendResource(&builder, answer); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return builder.Finish()
+}
+
+// rcode returns the response code for a query: NXDOMAIN if no records exist for any of the
+// names asked about.
+func (s *DNSServer) rcode(header dnsmessage.Header, questions []dnsmessage.Question) dnsmessage.RCode {
+	if header.OpCode != 0 {
+		return dnsmessage.RCodeNotImplemented
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, q := range questions {
+		if _, ok := s.records[strings.ToLower(q.Name.String())]; !ok {
+			return dnsmessage.RCodeNameError
+		}
+	}
+	return dnsmessage.RCodeSuccess
+}
+
+func (s *DNSServer) answers(q dnsmessage.Question) []dnsmessage.Resource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	answers := []dnsmessage.Resource{}
+	for _, r := range s.records[strings.ToLower(q.Name.String())] {
+		if q.Type == dnsmessage.TypeALL || r.Header.Type == q.Type {
+			r.Header.Name = q.Name
+			answers = append(answers, r)
+		}
+	}
+	return answers
+}
+
+func appendResource(builder *dnsmessage.Builder, r dnsmessage.Resource) error {
+	switch body := r.Body.(type) {
+	case *dnsmessage.AResource:
+		return builder.AResource(r.Header, *body)
+	case *dnsmessage.AAAAResource:
+		return builder.AAAAResource(r.Header, *body)
+	case *dnsmessage.SRVResource:
+		return builder.SRVResource(r.Header, *body)
+	case *dnsmessage.TXTResource:
+		return builder.TXTResource(r.Header, *body)
+	}
+	return fmt.Errorf("unsupported resource type %v", r.Header.Type)
+}
+
+// Serve answers queries arriving on conn until it is closed.
+func (s *DNSServer) Serve(conn net.PacketConn) error {
+	s.mu.Lock()
+	s.conn = conn
+	s.mu.Unlock()
+
+	buf := make([]byte, 512)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+		response, err := s.Handle(buf[:n])
+		if err != nil {
+			// Malformed queries are dropped, as most DNS servers do.
+			continue
+		}
+		if _, err := conn.WriteTo(response, addr); err != nil {
+			return err
+		}
+	}
+}
+
+// Shutdown closes the connection the server is serving on.
+func (s *DNSServer) Shutdown() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
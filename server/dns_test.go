@@ -0,0 +1,134 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func TestDNSServer(t *testing.T) {
+	serviceConfig := `{"loadBalancingConfig":[{"round_robin":{}}],"methodConfig":[{"name":[{"service":"google.showcase.v1beta1.Echo"}],"timeout":"5s"}` + strings.Repeat(" ", 300) + `]}`
+	s, err := NewDNSServer([]DNSRecord{
+		{Name: "showcase.test", Type: "A", Value: "127.0.0.1", TTL: 30},
+		{Name: "showcase.test", Type: "A", Value: "127.0.0.2", TTL: 30},
+		{Name: "_grpclb._tcp.showcase.test.", Type: "SRV", Value: "lb.showcase.test", Port: 7469, Priority: 1, Weight: 5},
+		{Name: "showcase.test", Type: "GRPC_CONFIG", Value: serviceConfig},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(conn)
+	defer s.Shutdown()
+
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "udp", conn.LocalAddr().String())
+		},
+	}
+	ctx := context.Background()
+
+	addrs, err := resolver.LookupHost(ctx, "showcase.test")
+	if err != nil {
+		t.Fatalf("LookupHost: %v", err)
+	}
+	if len(addrs) != 2 || addrs[0] != "127.0.0.1" || addrs[1] != "127.0.0.2" {
+		t.Errorf("LookupHost: got %v", addrs)
+	}
+
+	_, srvs, err := resolver.LookupSRV(ctx, "grpclb", "tcp", "showcase.test")
+	if err != nil {
+		t.Fatalf("LookupSRV: %v", err)
+	}
+	if len(srvs) != 1 || srvs[0].Target != "lb.showcase.test." || srvs[0].Port != 7469 || srvs[0].Weight != 5 {
+		t.Errorf("LookupSRV: got %+v", srvs)
+	}
+
+	txts, err := resolver.LookupTXT(ctx, "_grpc_config.showcase.test")
+	if err != nil {
+		t.Fatalf("LookupTXT: %v", err)
+	}
+	if got, want := strings.Join(txts, ""), `grpc_config=[{"serviceConfig":`+serviceConfig+`}]`; got != want {
+		t.Errorf("LookupTXT: got %q, want %q", got, want)
+	}
+
+	if _, err := resolver.LookupHost(ctx, "unknown.test"); err == nil {
+		t.Error("LookupHost of an unknown name: want an error")
+	}
+}
+
+func TestDNSServer_rcodes(t *testing.T) {
+	s, err := NewDNSServer([]DNSRecord{{Name: "showcase.test", Type: "A", Value: "127.0.0.1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name  string
+		qtype dnsmessage.Type
+		want  dnsmessage.RCode
+		count int
+	}{
+		{"showcase.test.", dnsmessage.TypeA, dnsmessage.RCodeSuccess, 1},
+		{"SHOWCASE.test.", dnsmessage.TypeA, dnsmessage.RCodeSuccess, 1},
+		{"showcase.test.", dnsmessage.TypeTXT, dnsmessage.RCodeSuccess, 0},
+		{"other.test.", dnsmessage.TypeA, dnsmessage.RCodeNameError, 0},
+	} {
+		query := dnsmessage.Message{
+			Header:    dnsmessage.Header{ID: 42, RecursionDesired: true},
+			Questions: []dnsmessage.Question{{Name: dnsmessage.MustNewName(tc.name), Type: tc.qtype, Class: dnsmessage.ClassINET}},
+		}
+		packed, err := query.Pack()
+		if err != nil {
+			t.Fatal(err)
+		}
+		raw, err := s.Handle(packed)
+		if err != nil {
+			t.Fatalf("%s %v: %v", tc.name, tc.qtype, err)
+		}
+		response := dnsmessage.Message{}
+		if err := response.Unpack(raw); err != nil {
+			t.Fatalf("%s %v: %v", tc.name, tc.qtype, err)
+		}
+		if response.ID != 42 || !response.Response || !response.Authoritative {
+			t.Errorf("%s %v: unexpected header %+v", tc.name, tc.qtype, response.Header)
+		}
+		if response.RCode != tc.want || len(response.Answers) != tc.count {
+			t.Errorf("%s %v: got %v with %d answers, want %v with %d", tc.name, tc.qtype, response.RCode, len(response.Answers), tc.want, tc.count)
+		}
+	}
+}
+
+func TestDNSServer_invalidRecords(t *testing.T) {
+	for _, record := range []DNSRecord{
+		{Name: "showcase.test", Type: "A", Value: "::1"},
+		{Name: "showcase.test", Type: "AAAA", Value: "127.0.0.1"},
+		{Name: "showcase.test", Type: "MX", Value: "mail.showcase.test"},
+	} {
+		if _, err := NewDNSServer([]DNSRecord{record}); err == nil {
+			t.Errorf("%+v: want an error", record)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// eventTailBuffer is the number of events a tail may fall behind by before it is dropped.
+const eventTailBuffer = 100
+
+// ServerEvents streams the events happening in the server, such as calls starting and finishing,
+// to the tails subscribed, so that test orchestrators can react to them as they happen.
+type ServerEvents struct {
+	mu    sync.Mutex
+	seq   int64
+	tails map[*eventTail]bool
+	nowF  func() time.Time
+}
+
+// eventTail is a subscriber to ServerEvents.
+type eventTail struct {
+	kinds  map[pb.ServerEvent_Kind]bool
+	events chan *pb.ServerEvent
+}
+
+// NewServerEvents creates ServerEvents with no tail subscribed.
+func NewServerEvents() *ServerEvents {
+	return &ServerEvents{tails: map[*eventTail]bool{}, nowF: time.Now}
+}
+
+// Publish sends event, numbered and timed, to the tails subscribed to its kind. A nil
+// ServerEvents publishes nothing.
+func (e *ServerEvents) Publish(event *pb.ServerEvent) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.seq++
+	event.Sequence = e.seq
+	event.Time = timestamppb.New(e.nowF())
+	for tail := range e.tails {
+		if len(tail.kinds) > 0 && !tail.kinds[event.GetKind()] {
+			continue
+		}
+		select {
+		case tail.events <- event:
+		default:
+			close(tail.events)
+			delete(e.tails, tail)
+		}
+	}
+}
+
+// Tail sends the events of the given kinds, or of all kinds if none is given, published from
+// now on, until ctx is done or send fails. Tails falling too far behind fail with
+// ResourceExhausted.
+func (e *ServerEvents) Tail(ctx context.Context, kinds []pb.ServerEvent_Kind, send func(*pb.ServerEvent) error) error {
+	tail := &eventTail{kinds: map[pb.ServerEvent_Kind]bool{}, events: make(chan *pb.ServerEvent, eventTailBuffer)}
+	for _, kind := range kinds {
+		tail.kinds[kind] = true
+	}
+	e.mu.Lock()
+	e.tails[tail] = true
+	e.mu.Unlock()
+	defer func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		delete(e.tails, tail)
+	}()
+
+	for {
+		select {
+		case event, ok := <-tail.events:
+			if !ok {
+				return status.Errorf(codes.ResourceExhausted, "the tail fell more than %d events behind the server", eventTailBuffer)
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// CallStarted publishes that a call to method over transport started, returning the function
+// publishing that it finished with the given status.
+func (e *ServerEvents) CallStarted(transport, method string) func(status string) {
+	if e == nil {
+		return func(string) {}
+	}
+	start := e.nowF()
+	e.Publish(&pb.ServerEvent{Kind: pb.ServerEvent_CALL_STARTED, Transport: transport, Method: method})
+	return func(status string) {
+		e.Publish(&pb.ServerEvent{
+			Kind:      pb.ServerEvent_CALL_FINISHED,
+			Transport: transport,
+			Method:    method,
+			Status:    status,
+			Duration:  durationpb.New(e.nowF().Sub(start)),
+		})
+	}
+}
+
+// FaultInjected publishes that a call to method over transport was failed with status by a
+// fault injected.
+func (e *ServerEvents) FaultInjected(transport, method, status string) {
+	e.Publish(&pb.ServerEvent{Kind: pb.ServerEvent_FAULT_INJECTED, Transport: transport, Method: method, Status: status})
+}
+
+// StateMutated publishes that a call to method, named as in the AuditLog, changed resource.
+func (e *ServerEvents) StateMutated(method, resource string) {
+	e.Publish(&pb.ServerEvent{Kind: pb.ServerEvent_STATE_MUTATED, Method: "/" + method, Resource: resource})
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, publishing when calls start and
+// finish.
+func (e *ServerEvents) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	finished := e.CallStarted("grpc", info.FullMethod)
+	resp, err := handler(ctx, req)
+	e.finish(info.FullMethod, err, finished)
+	return resp, err
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, publishing when calls start and
+// finish.
+func (e *ServerEvents) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	finished := e.CallStarted("grpc", info.FullMethod)
+	err := handler(srv, ss)
+	e.finish(info.FullMethod, err, finished)
+	return err
+}
+
+// finish publishes that a gRPC call to method finished with err, and before that that a fault
+// was injected into it if err is a FaultInjector's.
+func (e *ServerEvents) finish(method string, err error, finished func(string)) {
+	code := CodeName(status.Code(err))
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == InjectedFaultReason {
+			e.FaultInjected("grpc", method, code)
+			break
+		}
+	}
+	finished(code)
+}
@@ -0,0 +1,114 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// waitForEventTails waits until n tails subscribed to events.
+func waitForEventTails(events *ServerEvents, n int) {
+	for {
+		events.mu.Lock()
+		subscribed := len(events.tails) == n
+		events.mu.Unlock()
+		if subscribed {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestServerEvents_tail(t *testing.T) {
+	events := NewServerEvents()
+	tailCtx, cancel := context.WithCancel(context.Background())
+	received := make(chan *pb.ServerEvent, 10)
+	done := make(chan error)
+	go func() {
+		kinds := []pb.ServerEvent_Kind{pb.ServerEvent_CALL_FINISHED, pb.ServerEvent_FAULT_INJECTED, pb.ServerEvent_STATE_MUTATED}
+		done <- events.Tail(tailCtx, kinds, func(event *pb.ServerEvent) error {
+			received <- event
+			return nil
+		})
+	}()
+	waitForEventTails(events, 1)
+
+	faults, _ := NewFaultInjector(1, "UNAVAILABLE")
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+	events.UnaryInterceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	events.UnaryInterceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, faults.Inject(info.FullMethod)
+	})
+	log := NewAuditLog()
+	log.PublishTo(events)
+	log.Record(context.Background(), "google.showcase.v1beta1.Messaging/CreateRoom", pb.AuditEvent_CREATE, "rooms/0", nil, &pb.Room{Name: "rooms/0"})
+
+	for _, want := range []*pb.ServerEvent{
+		{Kind: pb.ServerEvent_CALL_FINISHED, Transport: "grpc", Method: info.FullMethod, Status: "OK"},
+		{Kind: pb.ServerEvent_FAULT_INJECTED, Transport: "grpc", Method: info.FullMethod, Status: "UNAVAILABLE"},
+		{Kind: pb.ServerEvent_CALL_FINISHED, Transport: "grpc", Method: info.FullMethod, Status: "UNAVAILABLE"},
+		{Kind: pb.ServerEvent_STATE_MUTATED, Method: "/google.showcase.v1beta1.Messaging/CreateRoom", Resource: "rooms/0"},
+	} {
+		got := <-received
+		if got.GetKind() != want.GetKind() || got.GetTransport() != want.GetTransport() || got.GetMethod() != want.GetMethod() ||
+			got.GetStatus() != want.GetStatus() || got.GetResource() != want.GetResource() {
+			t.Errorf("want event %v, got %v", want, got)
+		}
+	}
+	// The CALL_STARTED events, which the tail does not want, are numbered too.
+	if got := events.seq; got != 6 {
+		t.Errorf("want 6 events published, got %d", got)
+	}
+
+	cancel()
+	if err := <-done; status.Code(err) != codes.Canceled {
+		t.Errorf("want Canceled once the call is cancelled, got %v", err)
+	}
+}
+
+func TestServerEvents_fallingBehind(t *testing.T) {
+	events := NewServerEvents()
+	block := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- events.Tail(context.Background(), nil, func(*pb.ServerEvent) error {
+			<-block
+			return nil
+		})
+	}()
+	waitForEventTails(events, 1)
+	for i := 0; i < eventTailBuffer+2; i++ {
+		events.StateMutated("m", "users/0")
+	}
+	close(block)
+	if err := <-done; status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("want ResourceExhausted for a tail falling behind, got %v", err)
+	}
+}
+
+func TestServerEvents_nil(t *testing.T) {
+	var events *ServerEvents
+	events.CallStarted("grpc", "/m")("OK")
+	events.StateMutated("m", "users/0")
+}
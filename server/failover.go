@@ -0,0 +1,131 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// failoverExemptPrefixes are the gRPC method prefixes a standby still serves.
+var failoverExemptPrefixes = []string{
+	"/google.showcase.v1beta1.Failover/",
+	"/grpc.reflection.",
+}
+
+// FailoverCoordinator tracks the role of this server in a leader/standby pair. While the server
+// is the standby, its interceptors reject every call outside the Failover service as
+// UNAVAILABLE.
+type FailoverCoordinator struct {
+	mu    sync.Mutex
+	state *pb.FailoverState
+}
+
+// NewFailoverCoordinator creates a FailoverCoordinator starting in role, paired with the server
+// at peer. A server without a peer is always the leader.
+func NewFailoverCoordinator(role pb.FailoverState_Role, peer string) *FailoverCoordinator {
+	if peer == "" || role == pb.FailoverState_ROLE_UNSPECIFIED {
+		role = pb.FailoverState_LEADER
+	}
+	return &FailoverCoordinator{state: &pb.FailoverState{Role: role, Peer: peer}}
+}
+
+// State returns a snapshot of the failover state.
+func (c *FailoverCoordinator) State() *pb.FailoverState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return proto.Clone(c.state).(*pb.FailoverState)
+}
+
+// BeginFailover checks that this server is a leader with a peer to fail over to, and returns
+// the peer and the term it should lead in.
+func (c *FailoverCoordinator) BeginFailover() (peer string, term int64, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state.GetPeer() == "" {
+		return "", 0, status.Error(codes.FailedPrecondition, "no failover peer is configured")
+	}
+	if c.state.GetRole() != pb.FailoverState_LEADER {
+		return "", 0, status.Errorf(codes.FailedPrecondition, "this server is not the leader; the leader is at %s", c.state.GetPeer())
+	}
+	return c.state.GetPeer(), c.state.GetTerm() + 1, nil
+}
+
+// Demote makes this server the standby as of term.
+func (c *FailoverCoordinator) Demote(term int64) *pb.FailoverState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.state.Role = pb.FailoverState_STANDBY
+	c.state.Term = term
+	return proto.Clone(c.state).(*pb.FailoverState)
+}
+
+// Promote makes this server the leader as of term, which must be greater than the current term.
+func (c *FailoverCoordinator) Promote(term int64) (*pb.FailoverState, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if term <= c.state.GetTerm() {
+		return nil, status.Errorf(codes.FailedPrecondition, "stale handoff: term %d is not after current term %d", term, c.state.GetTerm())
+	}
+	c.state.Role = pb.FailoverState_LEADER
+	c.state.Term = term
+	return proto.Clone(c.state).(*pb.FailoverState), nil
+}
+
+// Check returns an UNAVAILABLE error if this server is the standby and method is not exempt.
+func (c *FailoverCoordinator) Check(method string) error {
+	for _, prefix := range failoverExemptPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return nil
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state.GetRole() == pb.FailoverState_STANDBY {
+		return status.Errorf(codes.Unavailable, "this server is the standby; the leader is at %s", c.state.GetPeer())
+	}
+	return nil
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, rejecting calls while on standby.
+func (c *FailoverCoordinator) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := c.Check(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, rejecting calls while on standby.
+func (c *FailoverCoordinator) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := c.Check(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFailoverCoordinator_withoutPeer(t *testing.T) {
+	c := NewFailoverCoordinator(pb.FailoverState_STANDBY, "")
+	if got := c.State().GetRole(); got != pb.FailoverState_LEADER {
+		t.Errorf("a server without a peer should lead, got role %v", got)
+	}
+	if _, _, err := c.BeginFailover(); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("BeginFailover without a peer: want FailedPrecondition, got %v", err)
+	}
+}
+
+func TestFailoverCoordinator_Interceptors(t *testing.T) {
+	c := NewFailoverCoordinator(pb.FailoverState_STANDBY, "localhost:7470")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	tests := []struct {
+		method string
+		code   codes.Code
+	}{
+		{"/google.showcase.v1beta1.Echo/Echo", codes.Unavailable},
+		{"/google.showcase.v1beta1.Failover/GetFailoverState", codes.OK},
+		{"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo", codes.OK},
+	}
+	for _, tt := range tests {
+		_, err := c.UnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+		if got := status.Code(err); got != tt.code {
+			t.Errorf("standby %s: want %v, got %v", tt.method, tt.code, err)
+		}
+		err = c.StreamInterceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: tt.method}, func(interface{}, grpc.ServerStream) error { return nil })
+		if got := status.Code(err); got != tt.code {
+			t.Errorf("standby stream %s: want %v, got %v", tt.method, tt.code, err)
+		}
+	}
+
+	if _, _, err := c.BeginFailover(); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("BeginFailover on standby: want FailedPrecondition, got %v", err)
+	}
+	if _, err := c.Promote(0); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Promote to a stale term: want FailedPrecondition, got %v", err)
+	}
+	if _, err := c.Promote(1); err != nil {
+		t.Fatalf("Promote: unexpected err %v", err)
+	}
+	if _, err := c.UnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: tests[0].method}, handler); err != nil {
+		t.Errorf("leader %s: unexpected err %v", tests[0].method, err)
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InjectedFaultReason is the ErrorInfo reason of the errors a FaultInjector fails calls with, so
+// that tests can tell injected faults from genuine errors.
+const InjectedFaultReason = "INJECTED_FAULT"
+
+// faultedPrefix is the gRPC method prefix of the calls that may be failed, and faultedPath the
+// REST path prefix. Calls to other services, such as the health and reflection services, are
+// always served.
+const (
+	faultedPrefix = "/google.showcase.v1beta1."
+	faultedPath   = "/v1beta1/"
+)
+
+// faultExemptions are the calls of the Showcase API that are never failed either, by gRPC method
+// prefix and matching REST path, so that tests can keep reconfiguring the server and polling
+// operations while faults are injected.
+var faultExemptions = []struct {
+	method string
+	path   *regexp.Regexp
+}{
+	{adminPrefix, regexp.MustCompile(`^/v1beta1/admin([/:]|$)`)},
+	{"/google.longrunning.Operations/", regexp.MustCompile(`^/v1beta1/operations([/:]|$)`)},
+	{"/google.cloud.location.Locations/", regexp.MustCompile(`^/v1beta1/projects/[^/]+/locations(/|$)`)},
+	{"/google.iam.v1.IAMPolicy/", regexp.MustCompile(`:(setIamPolicy|getIamPolicy|testIamPermissions)$`)},
+}
+
+// FaultExempt returns whether the gRPC calls to method, a full method name, are never failed by
+// a FaultInjector.
+func FaultExempt(method string) bool {
+	for _, exemption := range faultExemptions {
+		if strings.HasPrefix(method, exemption.method) {
+			return true
+		}
+	}
+	return !strings.HasPrefix(method, faultedPrefix)
+}
+
+// FaultExemptPath returns whether the REST calls to path are never failed by a FaultInjector, as
+// the gRPC calls to the same methods are not.
+func FaultExemptPath(path string) bool {
+	for _, exemption := range faultExemptions {
+		if exemption.path.MatchString(path) {
+			return true
+		}
+	}
+	return !strings.HasPrefix(path, faultedPath)
+}
+
+// FaultInjector fails a fraction of the calls to the Showcase API, picked at random, with a
+// chosen status code, so that clients' retry policies can be tested statistically without
+// setting the error of every request. The fraction is either fixed or follows a Brownout.
+type FaultInjector struct {
+	mu       sync.Mutex
+	fraction float64
+	code     codes.Code
+	rand     *rand.Rand
+
+	brownout      *Brownout
+	brownoutStart time.Time
+	nowF          func() time.Time
+}
+
+// NewFaultInjector creates a FaultInjector failing the given fraction of calls, from 0 to 1,
+// with the status code named code, such as "UNAVAILABLE", or Unavailable if code is empty.
+func NewFaultInjector(fraction float64, code string) (*FaultInjector, error) {
+	c := codes.Unavailable
+	if code != "" {
+		var err error
+		if c, err = ParseCode(code); err != nil {
+			return nil, err
+		}
+	}
+	f := &FaultInjector{rand: rand.New(rand.NewSource(time.Now().UnixNano())), nowF: time.Now}
+	if err := f.Configure(fraction, c); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// ParseCode returns the status code named name, such as "UNAVAILABLE", in any case.
+func ParseCode(name string) (codes.Code, error) {
+	var c codes.Code
+	if err := c.UnmarshalJSON([]byte(strconv.Quote(strings.ToUpper(name)))); err != nil {
+		return 0, fmt.Errorf("unknown status code %q", name)
+	}
+	return c, nil
+}
+
+// CodeName returns the name of code as in google.rpc.Code, such as "RESOURCE_EXHAUSTED".
+func CodeName(code codes.Code) string {
+	if code == codes.Canceled {
+		return "CANCELLED"
+	}
+	var b strings.Builder
+	name := code.String()
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' && name[i-1] >= 'a' && name[i-1] <= 'z' {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToUpper(b.String())
+}
+
+// Configure makes the injector fail the given fraction of calls, from 0 to 1, with code.
+func (f *FaultInjector) Configure(fraction float64, code codes.Code) error {
+	if fraction < 0 || fraction > 1 {
+		return fmt.Errorf("invalid fraction %v: must be between 0 and 1", fraction)
+	}
+	if code == codes.OK {
+		return fmt.Errorf("invalid status code %v: faults must fail calls", code)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.fraction = fraction
+	f.code = code
+	return nil
+}
+
+// Config returns the fraction of calls the injector fails and the status code it fails them
+// with. The fraction is the one Configure set, even while a brownout overrides it.
+func (f *FaultInjector) Config() (float64, codes.Code) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.fraction, f.code
+}
+
+// ConfigureBrownout makes the fraction of calls the injector fails follow b from now on, rather
+// than the fraction Configure set, or clears the brownout if b is nil.
+func (f *FaultInjector) ConfigureBrownout(b *Brownout) error {
+	if b != nil {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.brownout = b
+	f.brownoutStart = f.nowF()
+	return nil
+}
+
+// Brownout returns the brownout the injector follows, or nil if there is none, along with the
+// fraction of calls it currently fails.
+func (f *FaultInjector) Brownout() (*Brownout, float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.brownout, f.currentFraction()
+}
+
+// currentFraction returns the fraction of calls failed now. f.mu must be held.
+func (f *FaultInjector) currentFraction() float64 {
+	if f.brownout == nil {
+		return f.fraction
+	}
+	return f.brownout.Rate(f.nowF().Sub(f.brownoutStart))
+}
+
+// Inject returns an error if the call to method, as named in error details, was picked to fail.
+// A nil FaultInjector fails nothing.
+func (f *FaultInjector) Inject(method string) error {
+	if f == nil {
+		return nil
+	}
+	f.mu.Lock()
+	fraction := f.currentFraction()
+	picked := fraction > 0 && f.rand.Float64() < fraction
+	code := f.code
+	f.mu.Unlock()
+	if !picked {
+		return nil
+	}
+	message := fmt.Sprintf("fault injected into the call to %s", method)
+	st, err := status.New(code, message).WithDetails(&errdetails.ErrorInfo{
+		Reason:   InjectedFaultReason,
+		Domain:   "showcase.googleapis.com",
+		Metadata: map[string]string{"method": method},
+	})
+	if err != nil {
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
+
+// inject injects faults into a gRPC call to method.
+func (f *FaultInjector) inject(method string) error {
+	if FaultExempt(method) {
+		return nil
+	}
+	return f.Inject(method)
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, failing the calls picked to fail.
+func (f *FaultInjector) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := f.inject(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, failing the calls picked to fail
+// before their handler starts.
+func (f *FaultInjector) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := f.inject(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewFaultInjector_invalid(t *testing.T) {
+	for _, testCase := range []struct {
+		fraction float64
+		code     string
+	}{
+		{-0.1, "UNAVAILABLE"},
+		{1.5, "UNAVAILABLE"},
+		{0.5, "OK"},
+		{0.5, "FLAKY"},
+	} {
+		if _, err := NewFaultInjector(testCase.fraction, testCase.code); err == nil {
+			t.Errorf("NewFaultInjector(%v, %q): want an error", testCase.fraction, testCase.code)
+		}
+	}
+}
+
+func TestFaultInjector_Interceptors(t *testing.T) {
+	f, err := NewFaultInjector(1, "resource_exhausted")
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "served", nil }
+	for _, testCase := range []struct {
+		method string
+		want   codes.Code
+	}{
+		{"/google.showcase.v1beta1.Echo/Echo", codes.ResourceExhausted},
+		{"/google.longrunning.Operations/GetOperation", codes.OK},
+		{"/google.showcase.v1beta1.ShowcaseAdmin/GetServerConfig", codes.OK},
+		{"/grpc.health.v1.Health/Check", codes.OK},
+	} {
+		_, err := f.UnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testCase.method}, handler)
+		if got := status.Code(err); got != testCase.want {
+			t.Errorf("%s: want %v, got %v", testCase.method, testCase.want, err)
+		}
+		if err == nil {
+			continue
+		}
+		details := status.Convert(err).Details()
+		if len(details) != 1 || details[0].(*errdetails.ErrorInfo).GetReason() != InjectedFaultReason {
+			t.Errorf("%s: want an ErrorInfo with reason %s, got %v", testCase.method, InjectedFaultReason, details)
+		}
+	}
+}
+
+func TestFaultExempt(t *testing.T) {
+	for _, testCase := range []struct {
+		method, path string
+		want         bool
+	}{
+		{"/google.showcase.v1beta1.Echo/Echo", "/v1beta1/echo:echo", false},
+		{"/google.showcase.v1beta1.Identity/GetUser", "/v1beta1/users/admin", false},
+		{"/google.showcase.v1beta1.ShowcaseAdmin/GetServerConfig", "/v1beta1/admin/config", true},
+		{"/google.showcase.v1beta1.ShowcaseAdmin/ResetServer", "/v1beta1/admin:reset", true},
+		{"/google.longrunning.Operations/GetOperation", "/v1beta1/operations/google.showcase.v1beta1.Echo/Wait/abc", true},
+		{"/google.longrunning.Operations/WaitOperation", "/v1beta1/operations/google.showcase.v1beta1.Echo/Wait/abc:wait", true},
+		{"/google.longrunning.Operations/ListOperations", "/v1beta1/operations", true},
+		{"/google.cloud.location.Locations/ListLocations", "/v1beta1/projects/showcase/locations", true},
+		{"/google.iam.v1.IAMPolicy/GetIamPolicy", "/v1beta1/users/admin:getIamPolicy", true},
+		{"/grpc.health.v1.Health/Check", "/hello", true},
+	} {
+		if got := FaultExempt(testCase.method); got != testCase.want {
+			t.Errorf("FaultExempt(%q): want %v, got %v", testCase.method, testCase.want, got)
+		}
+		if got := FaultExemptPath(testCase.path); got != testCase.want {
+			t.Errorf("FaultExemptPath(%q): want %v, got %v", testCase.path, testCase.want, got)
+		}
+	}
+	for _, path := range []string{"/v1beta1/administrators", "/v1beta1/operationsAudit"} {
+		if FaultExemptPath(path) {
+			t.Errorf("FaultExemptPath(%q): want the path faulted", path)
+		}
+	}
+}
+
+func TestFaultInjector_fraction(t *testing.T) {
+	f, err := NewFaultInjector(0.5, "UNAVAILABLE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	failed := 0
+	for i := 0; i < 1000; i++ {
+		if f.Inject("POST /v1beta1/echo:echo") != nil {
+			failed++
+		}
+	}
+	if failed < 400 || failed > 600 {
+		t.Errorf("want about half of 1000 calls failed, got %d", failed)
+	}
+
+	if err := f.Configure(0, codes.Internal); err != nil {
+		t.Fatal(err)
+	}
+	if fraction, code := f.Config(); fraction != 0 || code != codes.Internal {
+		t.Errorf("Config(): want 0, Internal, got %v, %v", fraction, code)
+	}
+	if err := f.Inject("POST /v1beta1/echo:echo"); err != nil {
+		t.Errorf("no call should be failed with a fraction of 0, got %v", err)
+	}
+	if err := f.Configure(0.5, codes.OK); err == nil {
+		t.Error("Configure(0.5, OK): want an error")
+	}
+
+	var nilInjector *FaultInjector
+	if err := nilInjector.Inject("POST /v1beta1/echo:echo"); err != nil {
+		t.Errorf("a nil FaultInjector should fail nothing, got %v", err)
+	}
+}
+
+func TestFaultInjector_brownout(t *testing.T) {
+	f, err := NewFaultInjector(0.5, "UNAVAILABLE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(100, 0)
+	f.nowF = func() time.Time { return now }
+	if err := f.ConfigureBrownout(&Brownout{Points: []BrownoutPoint{{0, 0}, {time.Minute, 1}, {2 * time.Minute, 0}}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		elapsed time.Duration
+		want    float64
+	}{
+		{0, 0},
+		{time.Minute, 1},
+		{90 * time.Second, 0.5},
+		{time.Hour, 0},
+	} {
+		now = time.Unix(100, 0).Add(test.elapsed)
+		if _, current := f.Brownout(); current != test.want {
+			t.Errorf("after %v: want a fraction of %v failed, got %v", test.elapsed, test.want, current)
+		}
+		failed := 0
+		for i := 0; i < 100; i++ {
+			if f.Inject("POST /v1beta1/echo:echo") != nil {
+				failed++
+			}
+		}
+		if (test.want == 0 && failed != 0) || (test.want == 1 && failed != 100) {
+			t.Errorf("after %v: want a fraction of %v failed, got %d of 100 calls", test.elapsed, test.want, failed)
+		}
+	}
+	if fraction, _ := f.Config(); fraction != 0.5 {
+		t.Errorf("Config(): want the fraction configured kept during the brownout, got %v", fraction)
+	}
+
+	if err := f.ConfigureBrownout(&Brownout{}); err == nil {
+		t.Error("ConfigureBrownout of a curve without points: want an error")
+	}
+	if err := f.ConfigureBrownout(nil); err != nil {
+		t.Fatal(err)
+	}
+	if b, current := f.Brownout(); b != nil || current != 0.5 {
+		t.Errorf("after clearing the brownout: want the configured fraction, got %v, %v", b, current)
+	}
+}
+
+func TestCodeName(t *testing.T) {
+	for code, want := range map[codes.Code]string{
+		codes.OK:                "OK",
+		codes.Canceled:          "CANCELLED",
+		codes.ResourceExhausted: "RESOURCE_EXHAUSTED",
+		codes.DataLoss:          "DATA_LOSS",
+	} {
+		if got := CodeName(code); got != want {
+			t.Errorf("CodeName(%v): want %q, got %q", code, want, got)
+		}
+		if parsed, err := ParseCode(want); err != nil || parsed != code {
+			t.Errorf("ParseCode(%q): want %v, got %v, %v", want, code, parsed, err)
+		}
+	}
+}
@@ -0,0 +1,887 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.15.0
+// source: google/showcase/v1beta1/admin.proto
+
+package genproto
+
+import (
+	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The configuration of the server that can change while it runs.
+type ServerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The fraction of the calls to the Showcase API, from 0 to 1, failed at
+	// random with fault_code, as the `--fault-rate` flag does.
+	FaultRate float64 `protobuf:"fixed64,1,opt,name=fault_rate,json=faultRate,proto3" json:"fault_rate,omitempty"`
+	// The name of the status code, such as "UNAVAILABLE", of the calls
+	// fault_rate fails.
+	FaultCode string `protobuf:"bytes,2,opt,name=fault_code,json=faultCode,proto3" json:"fault_code,omitempty"`
+	// How long the server waits before serving each call to the Showcase API
+	// other than those to this service.
+	Latency *durationpb.Duration `protobuf:"bytes,3,opt,name=latency,proto3" json:"latency,omitempty"`
+	// The names of the feature flags turned on. They need not be known to the
+	// server, so that test suites may also use them to share the scenario they
+	// run.
+	FeatureFlags []string `protobuf:"bytes,4,rep,name=feature_flags,json=featureFlags,proto3" json:"feature_flags,omitempty"`
+	// The full names of the services, such as "google.showcase.v1beta1.Echo",
+	// the standard grpc.health.v1.Health service reports as NOT_SERVING, as the
+	// `--not-serving` flag does. The empty name stands for the server as a
+	// whole.
+	NotServingServices []string `protobuf:"bytes,5,rep,name=not_serving_services,json=notServingServices,proto3" json:"not_serving_services,omitempty"`
+	// Ramps the fraction of calls failed with fault_code up and down over time,
+	// as the `--brownout` flag does, rather than keep it at fault_rate. The
+	// curve starts over whenever it is updated.
+	Brownout *Brownout `protobuf:"bytes,6,opt,name=brownout,proto3" json:"brownout,omitempty"`
+}
+
+func (x *ServerConfig) Reset() {
+	*x = ServerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConfig) ProtoMessage() {}
+
+func (x *ServerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConfig.ProtoReflect.Descriptor instead.
+func (*ServerConfig) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServerConfig) GetFaultRate() float64 {
+	if x != nil {
+		return x.FaultRate
+	}
+	return 0
+}
+
+func (x *ServerConfig) GetFaultCode() string {
+	if x != nil {
+		return x.FaultCode
+	}
+	return ""
+}
+
+func (x *ServerConfig) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *ServerConfig) GetFeatureFlags() []string {
+	if x != nil {
+		return x.FeatureFlags
+	}
+	return nil
+}
+
+func (x *ServerConfig) GetNotServingServices() []string {
+	if x != nil {
+		return x.NotServingServices
+	}
+	return nil
+}
+
+func (x *ServerConfig) GetBrownout() *Brownout {
+	if x != nil {
+		return x.Brownout
+	}
+	return nil
+}
+
+// A gradual degradation of the server: the fraction of calls failed follows a
+// curve over time, so that clients' adaptive throttling and circuit breakers
+// can be tested against failures that build up and recede rather than start
+// and stop at once.
+type Brownout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The points of the curve, by increasing offset. Before the first point,
+	// calls fail as at the first point, and after the last, as at the last
+	// unless the curve repeats.
+	Points []*Brownout_Point `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	// Whether the curve starts over once its last point is reached.
+	Repeat bool `protobuf:"varint,2,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	// The fraction of calls being failed at the time the config was read.
+	CurrentFaultRate float64 `protobuf:"fixed64,3,opt,name=current_fault_rate,json=currentFaultRate,proto3" json:"current_fault_rate,omitempty"`
+}
+
+func (x *Brownout) Reset() {
+	*x = Brownout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Brownout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Brownout) ProtoMessage() {}
+
+func (x *Brownout) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Brownout.ProtoReflect.Descriptor instead.
+func (*Brownout) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Brownout) GetPoints() []*Brownout_Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *Brownout) GetRepeat() bool {
+	if x != nil {
+		return x.Repeat
+	}
+	return false
+}
+
+func (x *Brownout) GetCurrentFaultRate() float64 {
+	if x != nil {
+		return x.CurrentFaultRate
+	}
+	return 0
+}
+
+// The request message for the GetServerConfig method.
+type GetServerConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetServerConfigRequest) Reset() {
+	*x = GetServerConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerConfigRequest) ProtoMessage() {}
+
+func (x *GetServerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetServerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+// The request message for the UpdateServerConfig method.
+type UpdateServerConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The configuration to update the server to.
+	Config *ServerConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// The fields of config to update. All of them are updated if unset.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateServerConfigRequest) Reset() {
+	*x = UpdateServerConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateServerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServerConfigRequest) ProtoMessage() {}
+
+func (x *UpdateServerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServerConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateServerConfigRequest) GetConfig() *ServerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *UpdateServerConfigRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// The request message for the ResetServer method.
+type ResetServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResetServerRequest) Reset() {
+	*x = ResetServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetServerRequest) ProtoMessage() {}
+
+func (x *ResetServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetServerRequest.ProtoReflect.Descriptor instead.
+func (*ResetServerRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+// The response message for the ResetServer method.
+type ResetServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The configuration the server was reset to.
+	Config *ServerConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ResetServerResponse) Reset() {
+	*x = ResetServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetServerResponse) ProtoMessage() {}
+
+func (x *ResetServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetServerResponse.ProtoReflect.Descriptor instead.
+func (*ResetServerResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResetServerResponse) GetConfig() *ServerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// A point of the curve.
+type Brownout_Point struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How long after the curve starts the point is reached.
+	Offset *durationpb.Duration `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The fraction of calls failed, from 0 to 1, at the point. The fraction
+	// changes linearly between consecutive points.
+	FaultRate float64 `protobuf:"fixed64,2,opt,name=fault_rate,json=faultRate,proto3" json:"fault_rate,omitempty"`
+}
+
+func (x *Brownout_Point) Reset() {
+	*x = Brownout_Point{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Brownout_Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Brownout_Point) ProtoMessage() {}
+
+func (x *Brownout_Point) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_admin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Brownout_Point.ProtoReflect.Descriptor instead.
+func (*Brownout_Point) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_admin_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Brownout_Point) GetOffset() *durationpb.Duration {
+	if x != nil {
+		return x.Offset
+	}
+	return nil
+}
+
+func (x *Brownout_Point) GetFaultRate() float64 {
+	if x != nil {
+		return x.FaultRate
+	}
+	return 0
+}
+
+var File_google_showcase_v1beta1_admin_proto protoreflect.FileDescriptor
+
+var file_google_showcase_v1beta1_admin_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67,
+	0x73, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x6f, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x12, 0x6e, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x62, 0x72, 0x6f, 0x77, 0x6e, 0x6f, 0x75, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x42, 0x72, 0x6f, 0x77, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x62, 0x72, 0x6f, 0x77, 0x6e, 0x6f,
+	0x75, 0x74, 0x22, 0xf1, 0x01, 0x0a, 0x08, 0x42, 0x72, 0x6f, 0x77, 0x6e, 0x6f, 0x75, 0x74, 0x12,
+	0x3f, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x77, 0x6e, 0x6f,
+	0x75, 0x74, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x61, 0x74, 0x65, 0x1a, 0x59, 0x0a, 0x05, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x9c, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22,
+	0x14, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x32, 0xd2, 0x03, 0x0a, 0x0d,
+	0x53, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x88, 0x01,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x2f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x96, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x32, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1f, 0x32, 0x15, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x89, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x3a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0xca,
+	0x41, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x37, 0x34, 0x36, 0x39,
+	0x42, 0x71, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x50,
+	0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x61, 0x70, 0x69, 0x63, 0x2d, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67,
+	0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xea, 0x02, 0x19, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x3a, 0x3a, 0x53, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x42, 0x65,
+	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_google_showcase_v1beta1_admin_proto_rawDescOnce sync.Once
+	file_google_showcase_v1beta1_admin_proto_rawDescData = file_google_showcase_v1beta1_admin_proto_rawDesc
+)
+
+func file_google_showcase_v1beta1_admin_proto_rawDescGZIP() []byte {
+	file_google_showcase_v1beta1_admin_proto_rawDescOnce.Do(func() {
+		file_google_showcase_v1beta1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_google_showcase_v1beta1_admin_proto_rawDescData)
+	})
+	return file_google_showcase_v1beta1_admin_proto_rawDescData
+}
+
+var file_google_showcase_v1beta1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_google_showcase_v1beta1_admin_proto_goTypes = []interface{}{
+	(*ServerConfig)(nil),              // 0: google.showcase.v1beta1.ServerConfig
+	(*Brownout)(nil),                  // 1: google.showcase.v1beta1.Brownout
+	(*GetServerConfigRequest)(nil),    // 2: google.showcase.v1beta1.GetServerConfigRequest
+	(*UpdateServerConfigRequest)(nil), // 3: google.showcase.v1beta1.UpdateServerConfigRequest
+	(*ResetServerRequest)(nil),        // 4: google.showcase.v1beta1.ResetServerRequest
+	(*ResetServerResponse)(nil),       // 5: google.showcase.v1beta1.ResetServerResponse
+	(*Brownout_Point)(nil),            // 6: google.showcase.v1beta1.Brownout.Point
+	(*durationpb.Duration)(nil),       // 7: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),     // 8: google.protobuf.FieldMask
+}
+var file_google_showcase_v1beta1_admin_proto_depIdxs = []int32{
+	7,  // 0: google.showcase.v1beta1.ServerConfig.latency:type_name -> google.protobuf.Duration
+	1,  // 1: google.showcase.v1beta1.ServerConfig.brownout:type_name -> google.showcase.v1beta1.Brownout
+	6,  // 2: google.showcase.v1beta1.Brownout.points:type_name -> google.showcase.v1beta1.Brownout.Point
+	0,  // 3: google.showcase.v1beta1.UpdateServerConfigRequest.config:type_name -> google.showcase.v1beta1.ServerConfig
+	8,  // 4: google.showcase.v1beta1.UpdateServerConfigRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 5: google.showcase.v1beta1.ResetServerResponse.config:type_name -> google.showcase.v1beta1.ServerConfig
+	7,  // 6: google.showcase.v1beta1.Brownout.Point.offset:type_name -> google.protobuf.Duration
+	2,  // 7: google.showcase.v1beta1.ShowcaseAdmin.GetServerConfig:input_type -> google.showcase.v1beta1.GetServerConfigRequest
+	3,  // 8: google.showcase.v1beta1.ShowcaseAdmin.UpdateServerConfig:input_type -> google.showcase.v1beta1.UpdateServerConfigRequest
+	4,  // 9: google.showcase.v1beta1.ShowcaseAdmin.ResetServer:input_type -> google.showcase.v1beta1.ResetServerRequest
+	0,  // 10: google.showcase.v1beta1.ShowcaseAdmin.GetServerConfig:output_type -> google.showcase.v1beta1.ServerConfig
+	0,  // 11: google.showcase.v1beta1.ShowcaseAdmin.UpdateServerConfig:output_type -> google.showcase.v1beta1.ServerConfig
+	5,  // 12: google.showcase.v1beta1.ShowcaseAdmin.ResetServer:output_type -> google.showcase.v1beta1.ResetServerResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_google_showcase_v1beta1_admin_proto_init() }
+func file_google_showcase_v1beta1_admin_proto_init() {
+	if File_google_showcase_v1beta1_admin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_google_showcase_v1beta1_admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Brownout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_admin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_admin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateServerConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetServerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Brownout_Point); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_google_showcase_v1beta1_admin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_google_showcase_v1beta1_admin_proto_goTypes,
+		DependencyIndexes: file_google_showcase_v1beta1_admin_proto_depIdxs,
+		MessageInfos:      file_google_showcase_v1beta1_admin_proto_msgTypes,
+	}.Build()
+	File_google_showcase_v1beta1_admin_proto = out.File
+	file_google_showcase_v1beta1_admin_proto_rawDesc = nil
+	file_google_showcase_v1beta1_admin_proto_goTypes = nil
+	file_google_showcase_v1beta1_admin_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ShowcaseAdminClient is the client API for ShowcaseAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ShowcaseAdminClient interface {
+	// Retrieves the runtime configuration of the server.
+	GetServerConfig(ctx context.Context, in *GetServerConfigRequest, opts ...grpc.CallOption) (*ServerConfig, error)
+	// Updates the runtime configuration of the server. The calls already being
+	// served are not affected.
+	UpdateServerConfig(ctx context.Context, in *UpdateServerConfigRequest, opts ...grpc.CallOption) (*ServerConfig, error)
+	// Restores the runtime configuration the server started with, and resets
+	// all of its state, as Fixtures.ResetState does.
+	ResetServer(ctx context.Context, in *ResetServerRequest, opts ...grpc.CallOption) (*ResetServerResponse, error)
+}
+
+type showcaseAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShowcaseAdminClient(cc grpc.ClientConnInterface) ShowcaseAdminClient {
+	return &showcaseAdminClient{cc}
+}
+
+func (c *showcaseAdminClient) GetServerConfig(ctx context.Context, in *GetServerConfigRequest, opts ...grpc.CallOption) (*ServerConfig, error) {
+	out := new(ServerConfig)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.ShowcaseAdmin/GetServerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *showcaseAdminClient) UpdateServerConfig(ctx context.Context, in *UpdateServerConfigRequest, opts ...grpc.CallOption) (*ServerConfig, error) {
+	out := new(ServerConfig)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.ShowcaseAdmin/UpdateServerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *showcaseAdminClient) ResetServer(ctx context.Context, in *ResetServerRequest, opts ...grpc.CallOption) (*ResetServerResponse, error) {
+	out := new(ResetServerResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.ShowcaseAdmin/ResetServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShowcaseAdminServer is the server API for ShowcaseAdmin service.
+type ShowcaseAdminServer interface {
+	// Retrieves the runtime configuration of the server.
+	GetServerConfig(context.Context, *GetServerConfigRequest) (*ServerConfig, error)
+	// Updates the runtime configuration of the server. The calls already being
+	// served are not affected.
+	UpdateServerConfig(context.Context, *UpdateServerConfigRequest) (*ServerConfig, error)
+	// Restores the runtime configuration the server started with, and resets
+	// all of its state, as Fixtures.ResetState does.
+	ResetServer(context.Context, *ResetServerRequest) (*ResetServerResponse, error)
+}
+
+// UnimplementedShowcaseAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedShowcaseAdminServer struct {
+}
+
+func (*UnimplementedShowcaseAdminServer) GetServerConfig(context.Context, *GetServerConfigRequest) (*ServerConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerConfig not implemented")
+}
+func (*UnimplementedShowcaseAdminServer) UpdateServerConfig(context.Context, *UpdateServerConfigRequest) (*ServerConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServerConfig not implemented")
+}
+func (*UnimplementedShowcaseAdminServer) ResetServer(context.Context, *ResetServerRequest) (*ResetServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetServer not implemented")
+}
+
+func RegisterShowcaseAdminServer(s *grpc.Server, srv ShowcaseAdminServer) {
+	s.RegisterService(&_ShowcaseAdmin_serviceDesc, srv)
+}
+
+func _ShowcaseAdmin_GetServerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShowcaseAdminServer).GetServerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.ShowcaseAdmin/GetServerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShowcaseAdminServer).GetServerConfig(ctx, req.(*GetServerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShowcaseAdmin_UpdateServerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShowcaseAdminServer).UpdateServerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.ShowcaseAdmin/UpdateServerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShowcaseAdminServer).UpdateServerConfig(ctx, req.(*UpdateServerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShowcaseAdmin_ResetServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShowcaseAdminServer).ResetServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.ShowcaseAdmin/ResetServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShowcaseAdminServer).ResetServer(ctx, req.(*ResetServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ShowcaseAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "google.showcase.v1beta1.ShowcaseAdmin",
+	HandlerType: (*ShowcaseAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServerConfig",
+			Handler:    _ShowcaseAdmin_GetServerConfig_Handler,
+		},
+		{
+			MethodName: "UpdateServerConfig",
+			Handler:    _ShowcaseAdmin_UpdateServerConfig_Handler,
+		},
+		{
+			MethodName: "ResetServer",
+			Handler:    _ShowcaseAdmin_ResetServer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "google/showcase/v1beta1/admin.proto",
+}
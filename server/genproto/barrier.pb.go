@@ -0,0 +1,572 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.15.0
+// source: google/showcase/v1beta1/barrier.proto
+
+package genproto
+
+import (
+	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The state of a barrier.
+type BarrierState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the barrier.
+	BarrierId string `protobuf:"bytes,1,opt,name=barrier_id,json=barrierId,proto3" json:"barrier_id,omitempty"`
+	// The tags of the calls held at the barrier, in the order they arrived.
+	HeldCalls []string `protobuf:"bytes,2,rep,name=held_calls,json=heldCalls,proto3" json:"held_calls,omitempty"`
+}
+
+func (x *BarrierState) Reset() {
+	*x = BarrierState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_barrier_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BarrierState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BarrierState) ProtoMessage() {}
+
+func (x *BarrierState) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_barrier_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BarrierState.ProtoReflect.Descriptor instead.
+func (*BarrierState) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_barrier_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BarrierState) GetBarrierId() string {
+	if x != nil {
+		return x.BarrierId
+	}
+	return ""
+}
+
+func (x *BarrierState) GetHeldCalls() []string {
+	if x != nil {
+		return x.HeldCalls
+	}
+	return nil
+}
+
+// The request message for the ArmBarrier method.
+type ArmBarrierRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the barrier to arm. It must not already be armed.
+	BarrierId string `protobuf:"bytes,1,opt,name=barrier_id,json=barrierId,proto3" json:"barrier_id,omitempty"`
+	// How long a call may be held before it fails with ABORTED. Defaults to 30
+	// seconds.
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *ArmBarrierRequest) Reset() {
+	*x = ArmBarrierRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_barrier_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArmBarrierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmBarrierRequest) ProtoMessage() {}
+
+func (x *ArmBarrierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_barrier_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmBarrierRequest.ProtoReflect.Descriptor instead.
+func (*ArmBarrierRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_barrier_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ArmBarrierRequest) GetBarrierId() string {
+	if x != nil {
+		return x.BarrierId
+	}
+	return ""
+}
+
+func (x *ArmBarrierRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// The request message for the ReleaseBarrier method.
+type ReleaseBarrierRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the barrier to release.
+	BarrierId string `protobuf:"bytes,1,opt,name=barrier_id,json=barrierId,proto3" json:"barrier_id,omitempty"`
+	// The tags of the calls to release first, in order. The calls not listed are
+	// released afterwards, in the order they arrived. Every tag listed must be
+	// held by the time the release starts.
+	Order []string `protobuf:"bytes,2,rep,name=order,proto3" json:"order,omitempty"`
+	// How many calls must be held before the release starts. The release waits
+	// for them to arrive, for as long as the ReleaseBarrier call may run.
+	WaitForCalls int32 `protobuf:"varint,3,opt,name=wait_for_calls,json=waitForCalls,proto3" json:"wait_for_calls,omitempty"`
+}
+
+func (x *ReleaseBarrierRequest) Reset() {
+	*x = ReleaseBarrierRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_barrier_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseBarrierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseBarrierRequest) ProtoMessage() {}
+
+func (x *ReleaseBarrierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_barrier_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseBarrierRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseBarrierRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_barrier_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReleaseBarrierRequest) GetBarrierId() string {
+	if x != nil {
+		return x.BarrierId
+	}
+	return ""
+}
+
+func (x *ReleaseBarrierRequest) GetOrder() []string {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ReleaseBarrierRequest) GetWaitForCalls() int32 {
+	if x != nil {
+		return x.WaitForCalls
+	}
+	return 0
+}
+
+// The response message for the ReleaseBarrier method.
+type ReleaseBarrierResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The tags of the calls released, in the order they were released.
+	Released []string `protobuf:"bytes,1,rep,name=released,proto3" json:"released,omitempty"`
+}
+
+func (x *ReleaseBarrierResponse) Reset() {
+	*x = ReleaseBarrierResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_barrier_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseBarrierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseBarrierResponse) ProtoMessage() {}
+
+func (x *ReleaseBarrierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_barrier_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseBarrierResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseBarrierResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_barrier_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReleaseBarrierResponse) GetReleased() []string {
+	if x != nil {
+		return x.Released
+	}
+	return nil
+}
+
+var File_google_showcase_v1beta1_barrier_proto protoreflect.FileDescriptor
+
+var file_google_showcase_v1beta1_barrier_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x62, 0x61, 0x72, 0x72, 0x69, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4c, 0x0a, 0x0c, 0x42, 0x61, 0x72, 0x72, 0x69,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x72, 0x72, 0x69,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x65, 0x6c, 0x64,
+	0x43, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x67, 0x0a, 0x11, 0x41, 0x72, 0x6d, 0x42, 0x61, 0x72, 0x72,
+	0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61,
+	0x72, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x72,
+	0x0a, 0x15, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x42, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x72, 0x72, 0x69,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e,
+	0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x43, 0x61, 0x6c,
+	0x6c, 0x73, 0x22, 0x34, 0x0a, 0x16, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x42, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x32, 0xc2, 0x02, 0x0a, 0x07, 0x42, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x12, 0x7d, 0x0a, 0x0a, 0x41, 0x72, 0x6d, 0x42, 0x61, 0x72, 0x72, 0x69,
+	0x65, 0x72, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x72, 0x6d,
+	0x42, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x62, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x73,
+	0x3a, 0x01, 0x2a, 0x12, 0xa4, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x42,
+	0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x42, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x42, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22,
+	0x26, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x62, 0x61, 0x72, 0x72, 0x69, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x62, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x3a,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0xca, 0x41, 0x0e, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x37, 0x34, 0x36, 0x39, 0x42, 0x71, 0x0a,
+	0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x50, 0x01, 0x5a, 0x34,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x61, 0x70, 0x69, 0x63, 0x2d, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0xea, 0x02, 0x19, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x3a, 0x3a, 0x53,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x42, 0x65, 0x74, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_google_showcase_v1beta1_barrier_proto_rawDescOnce sync.Once
+	file_google_showcase_v1beta1_barrier_proto_rawDescData = file_google_showcase_v1beta1_barrier_proto_rawDesc
+)
+
+func file_google_showcase_v1beta1_barrier_proto_rawDescGZIP() []byte {
+	file_google_showcase_v1beta1_barrier_proto_rawDescOnce.Do(func() {
+		file_google_showcase_v1beta1_barrier_proto_rawDescData = protoimpl.X.CompressGZIP(file_google_showcase_v1beta1_barrier_proto_rawDescData)
+	})
+	return file_google_showcase_v1beta1_barrier_proto_rawDescData
+}
+
+var file_google_showcase_v1beta1_barrier_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_google_showcase_v1beta1_barrier_proto_goTypes = []interface{}{
+	(*BarrierState)(nil),           // 0: google.showcase.v1beta1.BarrierState
+	(*ArmBarrierRequest)(nil),      // 1: google.showcase.v1beta1.ArmBarrierRequest
+	(*ReleaseBarrierRequest)(nil),  // 2: google.showcase.v1beta1.ReleaseBarrierRequest
+	(*ReleaseBarrierResponse)(nil), // 3: google.showcase.v1beta1.ReleaseBarrierResponse
+	(*durationpb.Duration)(nil),    // 4: google.protobuf.Duration
+}
+var file_google_showcase_v1beta1_barrier_proto_depIdxs = []int32{
+	4, // 0: google.showcase.v1beta1.ArmBarrierRequest.timeout:type_name -> google.protobuf.Duration
+	1, // 1: google.showcase.v1beta1.Barrier.ArmBarrier:input_type -> google.showcase.v1beta1.ArmBarrierRequest
+	2, // 2: google.showcase.v1beta1.Barrier.ReleaseBarrier:input_type -> google.showcase.v1beta1.ReleaseBarrierRequest
+	0, // 3: google.showcase.v1beta1.Barrier.ArmBarrier:output_type -> google.showcase.v1beta1.BarrierState
+	3, // 4: google.showcase.v1beta1.Barrier.ReleaseBarrier:output_type -> google.showcase.v1beta1.ReleaseBarrierResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_google_showcase_v1beta1_barrier_proto_init() }
+func file_google_showcase_v1beta1_barrier_proto_init() {
+	if File_google_showcase_v1beta1_barrier_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_google_showcase_v1beta1_barrier_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BarrierState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_barrier_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArmBarrierRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_barrier_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseBarrierRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_barrier_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseBarrierResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_google_showcase_v1beta1_barrier_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_google_showcase_v1beta1_barrier_proto_goTypes,
+		DependencyIndexes: file_google_showcase_v1beta1_barrier_proto_depIdxs,
+		MessageInfos:      file_google_showcase_v1beta1_barrier_proto_msgTypes,
+	}.Build()
+	File_google_showcase_v1beta1_barrier_proto = out.File
+	file_google_showcase_v1beta1_barrier_proto_rawDesc = nil
+	file_google_showcase_v1beta1_barrier_proto_goTypes = nil
+	file_google_showcase_v1beta1_barrier_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// BarrierClient is the client API for Barrier service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BarrierClient interface {
+	// Arms a barrier, so that calls joining it are held.
+	ArmBarrier(ctx context.Context, in *ArmBarrierRequest, opts ...grpc.CallOption) (*BarrierState, error)
+	// Releases the calls held at a barrier one at a time, each after the one
+	// before it has been served, and disarms the barrier.
+	ReleaseBarrier(ctx context.Context, in *ReleaseBarrierRequest, opts ...grpc.CallOption) (*ReleaseBarrierResponse, error)
+}
+
+type barrierClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBarrierClient(cc grpc.ClientConnInterface) BarrierClient {
+	return &barrierClient{cc}
+}
+
+func (c *barrierClient) ArmBarrier(ctx context.Context, in *ArmBarrierRequest, opts ...grpc.CallOption) (*BarrierState, error) {
+	out := new(BarrierState)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Barrier/ArmBarrier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *barrierClient) ReleaseBarrier(ctx context.Context, in *ReleaseBarrierRequest, opts ...grpc.CallOption) (*ReleaseBarrierResponse, error) {
+	out := new(ReleaseBarrierResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Barrier/ReleaseBarrier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BarrierServer is the server API for Barrier service.
+type BarrierServer interface {
+	// Arms a barrier, so that calls joining it are held.
+	ArmBarrier(context.Context, *ArmBarrierRequest) (*BarrierState, error)
+	// Releases the calls held at a barrier one at a time, each after the one
+	// before it has been served, and disarms the barrier.
+	ReleaseBarrier(context.Context, *ReleaseBarrierRequest) (*ReleaseBarrierResponse, error)
+}
+
+// UnimplementedBarrierServer can be embedded to have forward compatible implementations.
+type UnimplementedBarrierServer struct {
+}
+
+func (*UnimplementedBarrierServer) ArmBarrier(context.Context, *ArmBarrierRequest) (*BarrierState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArmBarrier not implemented")
+}
+func (*UnimplementedBarrierServer) ReleaseBarrier(context.Context, *ReleaseBarrierRequest) (*ReleaseBarrierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseBarrier not implemented")
+}
+
+func RegisterBarrierServer(s *grpc.Server, srv BarrierServer) {
+	s.RegisterService(&_Barrier_serviceDesc, srv)
+}
+
+func _Barrier_ArmBarrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmBarrierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BarrierServer).ArmBarrier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Barrier/ArmBarrier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BarrierServer).ArmBarrier(ctx, req.(*ArmBarrierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Barrier_ReleaseBarrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseBarrierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BarrierServer).ReleaseBarrier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Barrier/ReleaseBarrier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BarrierServer).ReleaseBarrier(ctx, req.(*ReleaseBarrierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Barrier_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "google.showcase.v1beta1.Barrier",
+	HandlerType: (*BarrierServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ArmBarrier",
+			Handler:    _Barrier_ArmBarrier_Handler,
+		},
+		{
+			MethodName: "ReleaseBarrier",
+			Handler:    _Barrier_ReleaseBarrier_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "google/showcase/v1beta1/barrier.proto",
+}
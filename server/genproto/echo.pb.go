@@ -96,6 +96,69 @@ func (Severity) EnumDescriptor() ([]byte, []int) {
 	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{0}
 }
 
+// The metadata a pending operation carries in place of a WaitMetadata, so
+// that clients can test how they handle metadata they cannot unpack.
+type WaitRequest_MetadataVariant int32
+
+const (
+	// The metadata is a WaitMetadata.
+	WaitRequest_METADATA_VARIANT_UNSPECIFIED WaitRequest_MetadataVariant = 0
+	// The operation carries no metadata.
+	WaitRequest_METADATA_MISSING WaitRequest_MetadataVariant = 1
+	// The metadata is a WaitResponse rather than a WaitMetadata.
+	WaitRequest_METADATA_WRONG_TYPE WaitRequest_MetadataVariant = 2
+	// The metadata's type URL names a message type that does not exist.
+	WaitRequest_METADATA_UNKNOWN_TYPE WaitRequest_MetadataVariant = 3
+	// The metadata's type URL names WaitMetadata, but its value cannot be
+	// decoded.
+	WaitRequest_METADATA_MALFORMED WaitRequest_MetadataVariant = 4
+)
+
+// Enum value maps for WaitRequest_MetadataVariant.
+var (
+	WaitRequest_MetadataVariant_name = map[int32]string{
+		0: "METADATA_VARIANT_UNSPECIFIED",
+		1: "METADATA_MISSING",
+		2: "METADATA_WRONG_TYPE",
+		3: "METADATA_UNKNOWN_TYPE",
+		4: "METADATA_MALFORMED",
+	}
+	WaitRequest_MetadataVariant_value = map[string]int32{
+		"METADATA_VARIANT_UNSPECIFIED": 0,
+		"METADATA_MISSING":             1,
+		"METADATA_WRONG_TYPE":          2,
+		"METADATA_UNKNOWN_TYPE":        3,
+		"METADATA_MALFORMED":           4,
+	}
+)
+
+func (x WaitRequest_MetadataVariant) Enum() *WaitRequest_MetadataVariant {
+	p := new(WaitRequest_MetadataVariant)
+	*p = x
+	return p
+}
+
+func (x WaitRequest_MetadataVariant) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitRequest_MetadataVariant) Descriptor() protoreflect.EnumDescriptor {
+	return file_google_showcase_v1beta1_echo_proto_enumTypes[1].Descriptor()
+}
+
+func (WaitRequest_MetadataVariant) Type() protoreflect.EnumType {
+	return &file_google_showcase_v1beta1_echo_proto_enumTypes[1]
+}
+
+func (x WaitRequest_MetadataVariant) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitRequest_MetadataVariant.Descriptor instead.
+func (WaitRequest_MetadataVariant) EnumDescriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{9, 0}
+}
+
 // The request message used for the Echo, Collect and Chat methods.
 // If content or opt are set in this message then the request will succeed.
 // If status is set in this message then the status will be returned as an
@@ -111,6 +174,48 @@ type EchoRequest struct {
 	Response isEchoRequest_Response `protobuf_oneof:"response"`
 	// The severity to be echoed by the server.
 	Severity Severity `protobuf:"varint,3,opt,name=severity,proto3,enum=google.showcase.v1beta1.Severity" json:"severity,omitempty"`
+	// A template the server renders into the content of the response, in place
+	// of the content of the request, so that clients can check which request
+	// data the server saw. The template may contain these placeholders:
+	//
+	// * `{content}`: the content of the request.
+	// * `{severity}`: the name of the severity of the request.
+	// * `{header:<name>}`: the values of the gRPC request metadata `<name>`,
+	//   joined with commas. Empty if the metadata is absent.
+	// * `{seq}`: the position of the request, starting at 1, among the templated
+	//   requests the server received on this stream, or, for Echo, across all
+	//   templated calls to Echo.
+	//
+	// `{{` and `}}` stand for literal braces. Templates with unknown placeholders
+	// are rejected with INVALID_ARGUMENT.
+	ResponseTemplate string `protobuf:"bytes,4,opt,name=response_template,json=responseTemplate,proto3" json:"response_template,omitempty"`
+	// A label to be echoed by the server. This field is the one added by the
+	// schema change the Rollout service simulates: while the server serves the
+	// BLUE version of the schema, it ignores the label.
+	Label string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	// How long the server waits before sending the response, so that clients'
+	// timeouts and deadlines can be tested with the RPC shape under test.
+	// Calls may also send the delay, as a duration such as "1.5s", in the
+	// `x-showcase-delay` header. This field takes precedence over the header.
+	// When the deadline of the call expires first, the call fails with
+	// DEADLINE_EXCEEDED.
+	ResponseDelay *durationpb.Duration `protobuf:"bytes,6,opt,name=response_delay,json=responseDelay,proto3" json:"response_delay,omitempty"`
+	// Makes a Collect call fail once the server has received a number of
+	// requests, so that clients can test failures mid-upload knowing exactly
+	// what the server got: the requests it received are in the call log, and
+	// in the calls Transport.ListCapturedCalls returns. Only the first request
+	// of a Collect call may set it, and other methods ignore it.
+	FailAfter *CollectFailure `protobuf:"bytes,7,opt,name=fail_after,json=failAfter,proto3" json:"fail_after,omitempty"`
+	// Makes a Chat call fail once the server has echoed a number of requests,
+	// not counting the messages it pushes, so that clients can test handling
+	// the partial results of streams. Only the first request of a Chat call may set
+	// it, and other methods ignore it.
+	FailAfterResponses *StreamFailure `protobuf:"bytes,8,opt,name=fail_after_responses,json=failAfterResponses,proto3" json:"fail_after_responses,omitempty"`
+	// Makes a Collect call read its requests slowly: the server waits this long
+	// after each request before reading the next, so that clients can exercise
+	// send-side flow control and write deadlines. Only the first request of a
+	// Collect call may set it, and other methods ignore it.
+	ReadDelay *durationpb.Duration `protobuf:"bytes,9,opt,name=read_delay,json=readDelay,proto3" json:"read_delay,omitempty"`
 }
 
 func (x *EchoRequest) Reset() {
@@ -173,6 +278,48 @@ func (x *EchoRequest) GetSeverity() Severity {
 	return Severity_UNNECESSARY
 }
 
+func (x *EchoRequest) GetResponseTemplate() string {
+	if x != nil {
+		return x.ResponseTemplate
+	}
+	return ""
+}
+
+func (x *EchoRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *EchoRequest) GetResponseDelay() *durationpb.Duration {
+	if x != nil {
+		return x.ResponseDelay
+	}
+	return nil
+}
+
+func (x *EchoRequest) GetFailAfter() *CollectFailure {
+	if x != nil {
+		return x.FailAfter
+	}
+	return nil
+}
+
+func (x *EchoRequest) GetFailAfterResponses() *StreamFailure {
+	if x != nil {
+		return x.FailAfterResponses
+	}
+	return nil
+}
+
+func (x *EchoRequest) GetReadDelay() *durationpb.Duration {
+	if x != nil {
+		return x.ReadDelay
+	}
+	return nil
+}
+
 type isEchoRequest_Response interface {
 	isEchoRequest_Response()
 }
@@ -191,20 +338,21 @@ func (*EchoRequest_Content) isEchoRequest_Response() {}
 
 func (*EchoRequest_Error) isEchoRequest_Response() {}
 
-// The response message for the Echo methods.
-type EchoResponse struct {
+// How a Collect call fails after receiving a number of requests.
+type CollectFailure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The content specified in the request.
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	// The severity specified in the request.
-	Severity Severity `protobuf:"varint,2,opt,name=severity,proto3,enum=google.showcase.v1beta1.Severity" json:"severity,omitempty"`
+	// The number of requests, including the first, after which the call fails.
+	// Must be positive.
+	RequestCount int32 `protobuf:"varint,1,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	// The error the call fails with. Must not be OK.
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *EchoResponse) Reset() {
-	*x = EchoResponse{}
+func (x *CollectFailure) Reset() {
+	*x = CollectFailure{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -212,13 +360,13 @@ func (x *EchoResponse) Reset() {
 	}
 }
 
-func (x *EchoResponse) String() string {
+func (x *CollectFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EchoResponse) ProtoMessage() {}
+func (*CollectFailure) ProtoMessage() {}
 
-func (x *EchoResponse) ProtoReflect() protoreflect.Message {
+func (x *CollectFailure) ProtoReflect() protoreflect.Message {
 	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -230,39 +378,40 @@ func (x *EchoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EchoResponse.ProtoReflect.Descriptor instead.
-func (*EchoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectFailure.ProtoReflect.Descriptor instead.
+func (*CollectFailure) Descriptor() ([]byte, []int) {
 	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *EchoResponse) GetContent() string {
+func (x *CollectFailure) GetRequestCount() int32 {
 	if x != nil {
-		return x.Content
+		return x.RequestCount
 	}
-	return ""
+	return 0
 }
 
-func (x *EchoResponse) GetSeverity() Severity {
+func (x *CollectFailure) GetError() *status.Status {
 	if x != nil {
-		return x.Severity
+		return x.Error
 	}
-	return Severity_UNNECESSARY
+	return nil
 }
 
-// The request message for the Expand method.
-type ExpandRequest struct {
+// How a streaming call fails after sending a number of responses.
+type StreamFailure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The content that will be split into words and returned on the stream.
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	// The error that is thrown after all words are sent on the stream.
+	// The number of responses the server sends before the call fails. May be 0
+	// to fail before the first.
+	ResponseCount int32 `protobuf:"varint,1,opt,name=response_count,json=responseCount,proto3" json:"response_count,omitempty"`
+	// The error the call fails with. Must not be OK.
 	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ExpandRequest) Reset() {
-	*x = ExpandRequest{}
+func (x *StreamFailure) Reset() {
+	*x = StreamFailure{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -270,13 +419,13 @@ func (x *ExpandRequest) Reset() {
 	}
 }
 
-func (x *ExpandRequest) String() string {
+func (x *StreamFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpandRequest) ProtoMessage() {}
+func (*StreamFailure) ProtoMessage() {}
 
-func (x *ExpandRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamFailure) ProtoReflect() protoreflect.Message {
 	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -288,41 +437,52 @@ func (x *ExpandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpandRequest.ProtoReflect.Descriptor instead.
-func (*ExpandRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamFailure.ProtoReflect.Descriptor instead.
+func (*StreamFailure) Descriptor() ([]byte, []int) {
 	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ExpandRequest) GetContent() string {
+func (x *StreamFailure) GetResponseCount() int32 {
 	if x != nil {
-		return x.Content
+		return x.ResponseCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ExpandRequest) GetError() *status.Status {
+func (x *StreamFailure) GetError() *status.Status {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-// The request for the PagedExpand method.
-type PagedExpandRequest struct {
+// The response message for the Echo methods.
+type EchoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The string to expand.
+	// The content specified in the request.
 	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	// The number of words to returned in each page.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// The position of the page to be returned.
-	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The severity specified in the request.
+	Severity Severity `protobuf:"varint,2,opt,name=severity,proto3,enum=google.showcase.v1beta1.Severity" json:"severity,omitempty"`
+	// The label specified in the request. Never set while the server serves the
+	// BLUE version of the schema.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// Set on the words of the Expand method: the token resuming the stream,
+	// sent as the `stream_resume_token` of a new Expand call with the same
+	// content, from the word after this one.
+	StreamResumeToken string `protobuf:"bytes,4,opt,name=stream_resume_token,json=streamResumeToken,proto3" json:"stream_resume_token,omitempty"`
+	// Set on the response of the Collect method: the number of requests the
+	// server received.
+	ReceivedMessages int32 `protobuf:"varint,5,opt,name=received_messages,json=receivedMessages,proto3" json:"received_messages,omitempty"`
+	// Set on the response of the Collect method: the total size, serialized, of
+	// the requests the server received.
+	ReceivedBytes int64 `protobuf:"varint,6,opt,name=received_bytes,json=receivedBytes,proto3" json:"received_bytes,omitempty"`
 }
 
-func (x *PagedExpandRequest) Reset() {
-	*x = PagedExpandRequest{}
+func (x *EchoResponse) Reset() {
+	*x = EchoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -330,13 +490,13 @@ func (x *PagedExpandRequest) Reset() {
 	}
 }
 
-func (x *PagedExpandRequest) String() string {
+func (x *EchoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PagedExpandRequest) ProtoMessage() {}
+func (*EchoResponse) ProtoMessage() {}
 
-func (x *PagedExpandRequest) ProtoReflect() protoreflect.Message {
+func (x *EchoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -348,52 +508,86 @@ func (x *PagedExpandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PagedExpandRequest.ProtoReflect.Descriptor instead.
-func (*PagedExpandRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EchoResponse.ProtoReflect.Descriptor instead.
+func (*EchoResponse) Descriptor() ([]byte, []int) {
 	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *PagedExpandRequest) GetContent() string {
+func (x *EchoResponse) GetContent() string {
 	if x != nil {
 		return x.Content
 	}
 	return ""
 }
 
-func (x *PagedExpandRequest) GetPageSize() int32 {
+func (x *EchoResponse) GetSeverity() Severity {
 	if x != nil {
-		return x.PageSize
+		return x.Severity
 	}
-	return 0
+	return Severity_UNNECESSARY
 }
 
-func (x *PagedExpandRequest) GetPageToken() string {
+func (x *EchoResponse) GetLabel() string {
 	if x != nil {
-		return x.PageToken
+		return x.Label
 	}
 	return ""
 }
 
-// The request for the PagedExpandLegacy method.  This is a pattern used by some legacy APIs. New
-// APIs should NOT use this pattern, but rather something like PagedExpandRequest which conforms to
-// aip.dev/158.
-type PagedExpandLegacyRequest struct {
+func (x *EchoResponse) GetStreamResumeToken() string {
+	if x != nil {
+		return x.StreamResumeToken
+	}
+	return ""
+}
+
+func (x *EchoResponse) GetReceivedMessages() int32 {
+	if x != nil {
+		return x.ReceivedMessages
+	}
+	return 0
+}
+
+func (x *EchoResponse) GetReceivedBytes() int64 {
+	if x != nil {
+		return x.ReceivedBytes
+	}
+	return 0
+}
+
+// The request message for the Expand method.
+type ExpandRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The string to expand.
+	// The content that will be split into words and returned on the stream.
 	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	// The number of words to returned in each page.
-	// (-- aip.dev/not-precedent: This is a legacy, non-standard pattern that
-	//     violates aip.dev/158. Ordinarily, this should be page_size. --)
-	MaxResults int32 `protobuf:"varint,2,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
-	// The position of the page to be returned.
-	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The error that is thrown after all words are sent on the stream.
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// How long the server waits before sending each word on the stream. Calls
+	// may also send the delay in the `x-showcase-delay` header, as for the
+	// EchoRequest.response_delay field.
+	ResponseDelay *durationpb.Duration `protobuf:"bytes,3,opt,name=response_delay,json=responseDelay,proto3" json:"response_delay,omitempty"`
+	// The most the server adds at random to the response delay of each word,
+	// so that words arrive at uneven intervals.
+	Jitter *durationpb.Duration `protobuf:"bytes,4,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// The seed of the random jitter. Calls with the same non-zero seed see the
+	// same delays; otherwise the server picks a seed.
+	JitterSeed int64 `protobuf:"varint,5,opt,name=jitter_seed,json=jitterSeed,proto3" json:"jitter_seed,omitempty"`
+	// The `stream_resume_token` of the last word received on a previous call
+	// with the same content, whose stream dropped, to resume from the word
+	// after it rather than from the first.
+	StreamResumeToken string `protobuf:"bytes,6,opt,name=stream_resume_token,json=streamResumeToken,proto3" json:"stream_resume_token,omitempty"`
+	// Makes the call fail once the server has sent a number of words, counting
+	// from the first word sent on this call, or after all words if there are
+	// fewer, rather than with `error` after all words, so that clients can test
+	// handling the partial results of streams.
+	FailAfter *StreamFailure `protobuf:"bytes,7,opt,name=fail_after,json=failAfter,proto3" json:"fail_after,omitempty"`
 }
 
-func (x *PagedExpandLegacyRequest) Reset() {
-	*x = PagedExpandLegacyRequest{}
+func (x *ExpandRequest) Reset() {
+	*x = ExpandRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -401,13 +595,13 @@ func (x *PagedExpandLegacyRequest) Reset() {
 	}
 }
 
-func (x *PagedExpandLegacyRequest) String() string {
+func (x *ExpandRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PagedExpandLegacyRequest) ProtoMessage() {}
+func (*ExpandRequest) ProtoMessage() {}
 
-func (x *PagedExpandLegacyRequest) ProtoReflect() protoreflect.Message {
+func (x *ExpandRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -419,46 +613,85 @@ func (x *PagedExpandLegacyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PagedExpandLegacyRequest.ProtoReflect.Descriptor instead.
-func (*PagedExpandLegacyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExpandRequest.ProtoReflect.Descriptor instead.
+func (*ExpandRequest) Descriptor() ([]byte, []int) {
 	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PagedExpandLegacyRequest) GetContent() string {
+func (x *ExpandRequest) GetContent() string {
 	if x != nil {
 		return x.Content
 	}
 	return ""
 }
 
-func (x *PagedExpandLegacyRequest) GetMaxResults() int32 {
+func (x *ExpandRequest) GetError() *status.Status {
 	if x != nil {
-		return x.MaxResults
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ExpandRequest) GetResponseDelay() *durationpb.Duration {
+	if x != nil {
+		return x.ResponseDelay
+	}
+	return nil
+}
+
+func (x *ExpandRequest) GetJitter() *durationpb.Duration {
+	if x != nil {
+		return x.Jitter
+	}
+	return nil
+}
+
+func (x *ExpandRequest) GetJitterSeed() int64 {
+	if x != nil {
+		return x.JitterSeed
 	}
 	return 0
 }
 
-func (x *PagedExpandLegacyRequest) GetPageToken() string {
+func (x *ExpandRequest) GetStreamResumeToken() string {
 	if x != nil {
-		return x.PageToken
+		return x.StreamResumeToken
 	}
 	return ""
 }
 
-// The response for the PagedExpand method.
-type PagedExpandResponse struct {
+func (x *ExpandRequest) GetFailAfter() *StreamFailure {
+	if x != nil {
+		return x.FailAfter
+	}
+	return nil
+}
+
+// The request for the PagedExpand method.
+type PagedExpandRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The words that were expanded.
-	Responses []*EchoResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
-	// The next page token.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The string to expand.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The number of words to returned in each page.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The position of the page to be returned.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The number of words to skip before the first word of the page, counted
+	// from the position of the page token if set. As described in AIP-158, the
+	// page starts with the word after those skipped, and the next page token
+	// resumes from the page's end: each page requested with a skip skips anew.
+	Skip int32 `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`
+	// Whether to expand the words in reverse order, from the last one to the
+	// first. Page tokens are only valid for listings in the direction they
+	// were issued for.
+	Reverse bool `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
 }
 
-func (x *PagedExpandResponse) Reset() {
-	*x = PagedExpandResponse{}
+func (x *PagedExpandRequest) Reset() {
+	*x = PagedExpandRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -466,13 +699,13 @@ func (x *PagedExpandResponse) Reset() {
 	}
 }
 
-func (x *PagedExpandResponse) String() string {
+func (x *PagedExpandRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PagedExpandResponse) ProtoMessage() {}
+func (*PagedExpandRequest) ProtoMessage() {}
 
-func (x *PagedExpandResponse) ProtoReflect() protoreflect.Message {
+func (x *PagedExpandRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -484,30 +717,249 @@ func (x *PagedExpandResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PagedExpandResponse.ProtoReflect.Descriptor instead.
-func (*PagedExpandResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PagedExpandRequest.ProtoReflect.Descriptor instead.
+func (*PagedExpandRequest) Descriptor() ([]byte, []int) {
 	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PagedExpandResponse) GetResponses() []*EchoResponse {
+func (x *PagedExpandRequest) GetContent() string {
 	if x != nil {
-		return x.Responses
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-func (x *PagedExpandResponse) GetNextPageToken() string {
+func (x *PagedExpandRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-// The request for Wait method.
-type WaitRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *PagedExpandRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *PagedExpandRequest) GetSkip() int32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *PagedExpandRequest) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+// The request for the LongRunningPagedExpand method.
+type LongRunningPagedExpandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The words to expand and the size of the first page, as for the
+	// PagedExpand method.
+	Request *PagedExpandRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// The time that the operation will complete. Takes precedence over ttl when
+	// both are set.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The duration of the operation.
+	Ttl *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *LongRunningPagedExpandRequest) Reset() {
+	*x = LongRunningPagedExpandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LongRunningPagedExpandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LongRunningPagedExpandRequest) ProtoMessage() {}
+
+func (x *LongRunningPagedExpandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LongRunningPagedExpandRequest.ProtoReflect.Descriptor instead.
+func (*LongRunningPagedExpandRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LongRunningPagedExpandRequest) GetRequest() *PagedExpandRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *LongRunningPagedExpandRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *LongRunningPagedExpandRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// The request for the PagedExpandLegacy method.  This is a pattern used by some legacy APIs. New
+// APIs should NOT use this pattern, but rather something like PagedExpandRequest which conforms to
+// aip.dev/158.
+type PagedExpandLegacyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The string to expand.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The number of words to returned in each page.
+	// (-- aip.dev/not-precedent: This is a legacy, non-standard pattern that
+	//     violates aip.dev/158. Ordinarily, this should be page_size. --)
+	MaxResults int32 `protobuf:"varint,2,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	// The position of the page to be returned.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *PagedExpandLegacyRequest) Reset() {
+	*x = PagedExpandLegacyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PagedExpandLegacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagedExpandLegacyRequest) ProtoMessage() {}
+
+func (x *PagedExpandLegacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagedExpandLegacyRequest.ProtoReflect.Descriptor instead.
+func (*PagedExpandLegacyRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PagedExpandLegacyRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PagedExpandLegacyRequest) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *PagedExpandLegacyRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// The response for the PagedExpand method.
+type PagedExpandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The words that were expanded.
+	Responses []*EchoResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	// The next page token.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *PagedExpandResponse) Reset() {
+	*x = PagedExpandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PagedExpandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagedExpandResponse) ProtoMessage() {}
+
+func (x *PagedExpandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagedExpandResponse.ProtoReflect.Descriptor instead.
+func (*PagedExpandResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PagedExpandResponse) GetResponses() []*EchoResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *PagedExpandResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// The request for Wait method.
+type WaitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to End:
 	//	*WaitRequest_EndTime
@@ -517,12 +969,26 @@ type WaitRequest struct {
 	//	*WaitRequest_Error
 	//	*WaitRequest_Success
 	Response isWaitRequest_Response `protobuf_oneof:"response"`
+	// The metadata the operation carries while pending.
+	MetadataVariant WaitRequest_MetadataVariant `protobuf:"varint,5,opt,name=metadata_variant,json=metadataVariant,proto3,enum=google.showcase.v1beta1.WaitRequest_MetadataVariant" json:"metadata_variant,omitempty"`
+	// If set, the WaitMetadata of the pending operation reports its progress,
+	// updated once every interval, so that pollers see it change between polls.
+	// It must be positive.
+	ProgressInterval *durationpb.Duration `protobuf:"bytes,6,opt,name=progress_interval,json=progressInterval,proto3" json:"progress_interval,omitempty"`
+	// The time the operation started, which its progress is reported from. The
+	// server sets it in the operations reporting their progress.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// If set, the operation is not done for this many calls to
+	// Operations.GetOperation, whatever its end time, and completes at the
+	// next, so that tests of pollers do not depend on the wall clock. It must
+	// not be negative.
+	PendingPolls *int32 `protobuf:"varint,8,opt,name=pending_polls,json=pendingPolls,proto3,oneof" json:"pending_polls,omitempty"`
 }
 
 func (x *WaitRequest) Reset() {
 	*x = WaitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[6]
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -535,7 +1001,7 @@ func (x *WaitRequest) String() string {
 func (*WaitRequest) ProtoMessage() {}
 
 func (x *WaitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[6]
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +1014,7 @@ func (x *WaitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitRequest.ProtoReflect.Descriptor instead.
 func (*WaitRequest) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{6}
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{9}
 }
 
 func (m *WaitRequest) GetEnd() isWaitRequest_End {
@@ -593,6 +1059,34 @@ func (x *WaitRequest) GetSuccess() *WaitResponse {
 	return nil
 }
 
+func (x *WaitRequest) GetMetadataVariant() WaitRequest_MetadataVariant {
+	if x != nil {
+		return x.MetadataVariant
+	}
+	return WaitRequest_METADATA_VARIANT_UNSPECIFIED
+}
+
+func (x *WaitRequest) GetProgressInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ProgressInterval
+	}
+	return nil
+}
+
+func (x *WaitRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *WaitRequest) GetPendingPolls() int32 {
+	if x != nil && x.PendingPolls != nil {
+		return *x.PendingPolls
+	}
+	return 0
+}
+
 type isWaitRequest_End interface {
 	isWaitRequest_End()
 }
@@ -643,7 +1137,7 @@ type WaitResponse struct {
 func (x *WaitResponse) Reset() {
 	*x = WaitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[7]
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -656,7 +1150,7 @@ func (x *WaitResponse) String() string {
 func (*WaitResponse) ProtoMessage() {}
 
 func (x *WaitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[7]
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +1163,7 @@ func (x *WaitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitResponse.ProtoReflect.Descriptor instead.
 func (*WaitResponse) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{7}
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *WaitResponse) GetContent() string {
@@ -687,12 +1181,21 @@ type WaitMetadata struct {
 
 	// The time that this operation will complete.
 	EndTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// How much of the operation was done at update_time, from 0 to 100. Only
+	// reported for operations with a progress_interval.
+	ProgressPercent int32 `protobuf:"varint,2,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
+	// How long the operation had left to run at update_time. Only reported for
+	// operations with a progress_interval.
+	TimeRemaining *durationpb.Duration `protobuf:"bytes,3,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"`
+	// The time the progress was last updated at. Only reported for operations
+	// with a progress_interval.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 }
 
 func (x *WaitMetadata) Reset() {
 	*x = WaitMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[8]
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -705,7 +1208,7 @@ func (x *WaitMetadata) String() string {
 func (*WaitMetadata) ProtoMessage() {}
 
 func (x *WaitMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[8]
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +1221,7 @@ func (x *WaitMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitMetadata.ProtoReflect.Descriptor instead.
 func (*WaitMetadata) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{8}
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *WaitMetadata) GetEndTime() *timestamppb.Timestamp {
@@ -728,37 +1231,177 @@ func (x *WaitMetadata) GetEndTime() *timestamppb.Timestamp {
 	return nil
 }
 
-// The request for Block method.
-type BlockRequest struct {
+func (x *WaitMetadata) GetProgressPercent() int32 {
+	if x != nil {
+		return x.ProgressPercent
+	}
+	return 0
+}
+
+func (x *WaitMetadata) GetTimeRemaining() *durationpb.Duration {
+	if x != nil {
+		return x.TimeRemaining
+	}
+	return nil
+}
+
+func (x *WaitMetadata) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// The request for NestedWait method.
+type NestedWaitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The amount of time to block before returning a response.
-	ResponseDelay *durationpb.Duration `protobuf:"bytes,1,opt,name=response_delay,json=responseDelay,proto3" json:"response_delay,omitempty"`
-	// Types that are assignable to Response:
-	//	*BlockRequest_Error
-	//	*BlockRequest_Success
-	Response isBlockRequest_Response `protobuf_oneof:"response"`
+	// The time that the first operation will complete. Takes precedence over
+	// ttl when both are set.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The duration of the first operation.
+	Ttl *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// The request of the second operation, as for the Wait method. Its ttl, if
+	// set, starts when the first operation completes.
+	Next *WaitRequest `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
 }
 
-func (x *BlockRequest) Reset() {
-	*x = BlockRequest{}
+func (x *NestedWaitRequest) Reset() {
+	*x = NestedWaitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[9]
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockRequest) String() string {
+func (x *NestedWaitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockRequest) ProtoMessage() {}
+func (*NestedWaitRequest) ProtoMessage() {}
 
-func (x *BlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[9]
+func (x *NestedWaitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedWaitRequest.ProtoReflect.Descriptor instead.
+func (*NestedWaitRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NestedWaitRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *NestedWaitRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *NestedWaitRequest) GetNext() *WaitRequest {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+// The result of the NestedWait operation.
+type NestedWaitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The second operation, as it was when the first completed. Poll it with
+	// google.longrunning.Operations.GetOperation for the final result.
+	Operation *longrunning.Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+}
+
+func (x *NestedWaitResponse) Reset() {
+	*x = NestedWaitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NestedWaitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedWaitResponse) ProtoMessage() {}
+
+func (x *NestedWaitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedWaitResponse.ProtoReflect.Descriptor instead.
+func (*NestedWaitResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NestedWaitResponse) GetOperation() *longrunning.Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+// The request for Block method.
+type BlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The amount of time to block before returning a response.
+	ResponseDelay *durationpb.Duration `protobuf:"bytes,1,opt,name=response_delay,json=responseDelay,proto3" json:"response_delay,omitempty"`
+	// Types that are assignable to Response:
+	//	*BlockRequest_Error
+	//	*BlockRequest_Success
+	Response isBlockRequest_Response `protobuf_oneof:"response"`
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,7 +1414,7 @@ func (x *BlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
 func (*BlockRequest) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{9}
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *BlockRequest) GetResponseDelay() *durationpb.Duration {
@@ -835,7 +1478,7 @@ type BlockResponse struct {
 func (x *BlockResponse) Reset() {
 	*x = BlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[10]
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -848,291 +1491,1522 @@ func (x *BlockResponse) String() string {
 func (*BlockResponse) ProtoMessage() {}
 
 func (x *BlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[10]
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BlockResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// The request for the EchoHeaders method.
+type EchoHeadersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The names of the headers to be returned, case-insensitively. If empty,
+	// all headers are returned.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *EchoHeadersRequest) Reset() {
+	*x = EchoHeadersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EchoHeadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EchoHeadersRequest) ProtoMessage() {}
+
+func (x *EchoHeadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EchoHeadersRequest.ProtoReflect.Descriptor instead.
+func (*EchoHeadersRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EchoHeadersRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// The response for the EchoHeaders method.
+type EchoHeadersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The headers received, sorted by name.
+	Headers []*EchoHeadersResponse_Header `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (x *EchoHeadersResponse) Reset() {
+	*x = EchoHeadersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EchoHeadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EchoHeadersResponse) ProtoMessage() {}
+
+func (x *EchoHeadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EchoHeadersResponse.ProtoReflect.Descriptor instead.
+func (*EchoHeadersResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EchoHeadersResponse) GetHeaders() []*EchoHeadersResponse_Header {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// The request for the ProbeDeadline method.
+type ProbeDeadlineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How long the server waits before responding. Calls whose deadline passes
+	// meanwhile fail with DEADLINE_EXCEEDED.
+	ResponseDelay *durationpb.Duration `protobuf:"bytes,1,opt,name=response_delay,json=responseDelay,proto3" json:"response_delay,omitempty"`
+}
+
+func (x *ProbeDeadlineRequest) Reset() {
+	*x = ProbeDeadlineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeDeadlineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeDeadlineRequest) ProtoMessage() {}
+
+func (x *ProbeDeadlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeDeadlineRequest.ProtoReflect.Descriptor instead.
+func (*ProbeDeadlineRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ProbeDeadlineRequest) GetResponseDelay() *durationpb.Duration {
+	if x != nil {
+		return x.ResponseDelay
+	}
+	return nil
+}
+
+// The response for the ProbeDeadline method.
+type ProbeDeadlineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the call had a deadline.
+	HasDeadline bool `protobuf:"varint,1,opt,name=has_deadline,json=hasDeadline,proto3" json:"has_deadline,omitempty"`
+	// The deadline of the call, if any.
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// How long was left until the deadline when the server received the call.
+	Remaining *durationpb.Duration `protobuf:"bytes,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (x *ProbeDeadlineResponse) Reset() {
+	*x = ProbeDeadlineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeDeadlineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeDeadlineResponse) ProtoMessage() {}
+
+func (x *ProbeDeadlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
-func (*BlockResponse) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *BlockResponse) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
-}
-
-var File_google_showcase_v1beta1_echo_proto protoreflect.FileDescriptor
-
-var file_google_showcase_v1beta1_echo_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
-	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
-	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1c, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x6c, 0x6f,
-	0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x01, 0x0a, 0x0b, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x08,
-	0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
-	0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x0c, 0x45, 0x63, 0x68, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
-	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65,
-	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
-	0x22, 0x53, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6f, 0x0a, 0x12, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78,
-	0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
-	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x79, 0x0a, 0x18, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45,
-	0x78, 0x70, 0x61, 0x6e, 0x64, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x26,
-	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf7, 0x01, 0x0a, 0x0b, 0x57, 0x61, 0x69, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x2d, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x2a,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x48, 0x01, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x48, 0x01, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x05, 0x0a,
-	0x03, 0x65, 0x6e, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x28, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x0c, 0x57, 0x61,
-	0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
-	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
-	0x65, 0x22, 0xcc, 0x01, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64,
-	0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
-	0x65, 0x6c, 0x61, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x42, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x29, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2a, 0x44, 0x0a, 0x08, 0x53,
-	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x4e, 0x45, 0x43,
-	0x45, 0x53, 0x53, 0x41, 0x52, 0x59, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x45, 0x43, 0x45,
-	0x53, 0x53, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x52, 0x47, 0x45, 0x4e,
-	0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10,
-	0x03, 0x32, 0xa7, 0x08, 0x0a, 0x04, 0x45, 0x63, 0x68, 0x6f, 0x12, 0x72, 0x0a, 0x04, 0x45, 0x63,
-	0x68, 0x6f, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
-	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x01, 0x2a, 0x12, 0x8a,
-	0x01, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
-	0x22, 0x14, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a,
-	0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x3a, 0x01, 0x2a, 0xda, 0x41, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x2c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x30, 0x01, 0x12, 0x7a, 0x0a, 0x07, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x3a, 0x01, 0x2a, 0x28, 0x01, 0x12, 0x57, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12,
-	0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
-	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01,
-	0x12, 0x8e, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64,
-	0x12, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x64,
-	0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70,
-	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63,
-	0x68, 0x6f, 0x3a, 0x70, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x3a, 0x01,
-	0x2a, 0x12, 0xa0, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e,
-	0x64, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x12, 0x31, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x4c, 0x65, 0x67,
-	0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
-	0x22, 0x1f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a,
-	0x70, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x3a, 0x01, 0x2a, 0x12, 0x89, 0x01, 0x0a, 0x04, 0x57, 0x61, 0x69, 0x74, 0x12, 0x24, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e,
-	0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x77, 0x61, 0x69, 0x74, 0x3a, 0x01,
-	0x2a, 0xca, 0x41, 0x1c, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x76, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
-	0x22, 0x13, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0xca, 0x41, 0x0e, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x37, 0x34, 0x36, 0x39, 0x42, 0x71, 0x0a, 0x1b, 0x63,
-	0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61,
-	0x70, 0x69, 0x73, 0x2f, 0x67, 0x61, 0x70, 0x69, 0x63, 0x2d, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
-	0x73, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0xea, 0x02, 0x19, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x68, 0x6f,
-	0x77, 0x63, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x42, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_google_showcase_v1beta1_echo_proto_rawDescOnce sync.Once
-	file_google_showcase_v1beta1_echo_proto_rawDescData = file_google_showcase_v1beta1_echo_proto_rawDesc
-)
-
-func file_google_showcase_v1beta1_echo_proto_rawDescGZIP() []byte {
-	file_google_showcase_v1beta1_echo_proto_rawDescOnce.Do(func() {
-		file_google_showcase_v1beta1_echo_proto_rawDescData = protoimpl.X.CompressGZIP(file_google_showcase_v1beta1_echo_proto_rawDescData)
-	})
-	return file_google_showcase_v1beta1_echo_proto_rawDescData
-}
-
-var file_google_showcase_v1beta1_echo_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_google_showcase_v1beta1_echo_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_google_showcase_v1beta1_echo_proto_goTypes = []interface{}{
-	(Severity)(0),                    // 0: google.showcase.v1beta1.Severity
-	(*EchoRequest)(nil),              // 1: google.showcase.v1beta1.EchoRequest
-	(*EchoResponse)(nil),             // 2: google.showcase.v1beta1.EchoResponse
-	(*ExpandRequest)(nil),            // 3: google.showcase.v1beta1.ExpandRequest
-	(*PagedExpandRequest)(nil),       // 4: google.showcase.v1beta1.PagedExpandRequest
-	(*PagedExpandLegacyRequest)(nil), // 5: google.showcase.v1beta1.PagedExpandLegacyRequest
-	(*PagedExpandResponse)(nil),      // 6: google.showcase.v1beta1.PagedExpandResponse
-	(*WaitRequest)(nil),              // 7: google.showcase.v1beta1.WaitRequest
-	(*WaitResponse)(nil),             // 8: google.showcase.v1beta1.WaitResponse
-	(*WaitMetadata)(nil),             // 9: google.showcase.v1beta1.WaitMetadata
-	(*BlockRequest)(nil),             // 10: google.showcase.v1beta1.BlockRequest
-	(*BlockResponse)(nil),            // 11: google.showcase.v1beta1.BlockResponse
-	(*status.Status)(nil),            // 12: google.rpc.Status
-	(*timestamppb.Timestamp)(nil),    // 13: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),      // 14: google.protobuf.Duration
-	(*longrunning.Operation)(nil),    // 15: google.longrunning.Operation
-}
-var file_google_showcase_v1beta1_echo_proto_depIdxs = []int32{
-	12, // 0: google.showcase.v1beta1.EchoRequest.error:type_name -> google.rpc.Status
-	0,  // 1: google.showcase.v1beta1.EchoRequest.severity:type_name -> google.showcase.v1beta1.Severity
-	0,  // 2: google.showcase.v1beta1.EchoResponse.severity:type_name -> google.showcase.v1beta1.Severity
-	12, // 3: google.showcase.v1beta1.ExpandRequest.error:type_name -> google.rpc.Status
-	2,  // 4: google.showcase.v1beta1.PagedExpandResponse.responses:type_name -> google.showcase.v1beta1.EchoResponse
-	13, // 5: google.showcase.v1beta1.WaitRequest.end_time:type_name -> google.protobuf.Timestamp
-	14, // 6: google.showcase.v1beta1.WaitRequest.ttl:type_name -> google.protobuf.Duration
-	12, // 7: google.showcase.v1beta1.WaitRequest.error:type_name -> google.rpc.Status
-	8,  // 8: google.showcase.v1beta1.WaitRequest.success:type_name -> google.showcase.v1beta1.WaitResponse
-	13, // 9: google.showcase.v1beta1.WaitMetadata.end_time:type_name -> google.protobuf.Timestamp
-	14, // 10: google.showcase.v1beta1.BlockRequest.response_delay:type_name -> google.protobuf.Duration
-	12, // 11: google.showcase.v1beta1.BlockRequest.error:type_name -> google.rpc.Status
-	11, // 12: google.showcase.v1beta1.BlockRequest.success:type_name -> google.showcase.v1beta1.BlockResponse
-	1,  // 13: google.showcase.v1beta1.Echo.Echo:input_type -> google.showcase.v1beta1.EchoRequest
-	3,  // 14: google.showcase.v1beta1.Echo.Expand:input_type -> google.showcase.v1beta1.ExpandRequest
-	1,  // 15: google.showcase.v1beta1.Echo.Collect:input_type -> google.showcase.v1beta1.EchoRequest
-	1,  // 16: google.showcase.v1beta1.Echo.Chat:input_type -> google.showcase.v1beta1.EchoRequest
-	4,  // 17: google.showcase.v1beta1.Echo.PagedExpand:input_type -> google.showcase.v1beta1.PagedExpandRequest
-	5,  // 18: google.showcase.v1beta1.Echo.PagedExpandLegacy:input_type -> google.showcase.v1beta1.PagedExpandLegacyRequest
-	7,  // 19: google.showcase.v1beta1.Echo.Wait:input_type -> google.showcase.v1beta1.WaitRequest
-	10, // 20: google.showcase.v1beta1.Echo.Block:input_type -> google.showcase.v1beta1.BlockRequest
-	2,  // 21: google.showcase.v1beta1.Echo.Echo:output_type -> google.showcase.v1beta1.EchoResponse
-	2,  // 22: google.showcase.v1beta1.Echo.Expand:output_type -> google.showcase.v1beta1.EchoResponse
-	2,  // 23: google.showcase.v1beta1.Echo.Collect:output_type -> google.showcase.v1beta1.EchoResponse
-	2,  // 24: google.showcase.v1beta1.Echo.Chat:output_type -> google.showcase.v1beta1.EchoResponse
-	6,  // 25: google.showcase.v1beta1.Echo.PagedExpand:output_type -> google.showcase.v1beta1.PagedExpandResponse
-	6,  // 26: google.showcase.v1beta1.Echo.PagedExpandLegacy:output_type -> google.showcase.v1beta1.PagedExpandResponse
-	15, // 27: google.showcase.v1beta1.Echo.Wait:output_type -> google.longrunning.Operation
-	11, // 28: google.showcase.v1beta1.Echo.Block:output_type -> google.showcase.v1beta1.BlockResponse
-	21, // [21:29] is the sub-list for method output_type
-	13, // [13:21] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
-}
-
-func init() { file_google_showcase_v1beta1_echo_proto_init() }
-func file_google_showcase_v1beta1_echo_proto_init() {
-	if File_google_showcase_v1beta1_echo_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_google_showcase_v1beta1_echo_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EchoRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeDeadlineResponse.ProtoReflect.Descriptor instead.
+func (*ProbeDeadlineResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ProbeDeadlineResponse) GetHasDeadline() bool {
+	if x != nil {
+		return x.HasDeadline
+	}
+	return false
+}
+
+func (x *ProbeDeadlineResponse) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *ProbeDeadlineResponse) GetRemaining() *durationpb.Duration {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+// The request for the GeneratePayload method.
+type GeneratePayloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of payload bytes to return, across all chunks. At most 256 MiB.
+	Size int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	// The number of chunks the payload is split into, as evenly as possible, in
+	// the repeated `chunks` field of the response. If 0, the payload is
+	// returned in a single chunk. At most 1,000,000.
+	ChunkCount int32 `protobuf:"varint,2,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	// Whether the payload is pseudo-random, and so incompressible, rather than
+	// a repeating pattern of the lowercase letters.
+	Random bool `protobuf:"varint,3,opt,name=random,proto3" json:"random,omitempty"`
+	// The seed of the pseudo-random payload, so that it can be regenerated.
+	Seed int64 `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *GeneratePayloadRequest) Reset() {
+	*x = GeneratePayloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneratePayloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePayloadRequest) ProtoMessage() {}
+
+func (x *GeneratePayloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePayloadRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePayloadRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GeneratePayloadRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GeneratePayloadRequest) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *GeneratePayloadRequest) GetRandom() bool {
+	if x != nil {
+		return x.Random
+	}
+	return false
+}
+
+func (x *GeneratePayloadRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// The response for the GeneratePayload method.
+type GeneratePayloadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The chunks of the payload, in order.
+	Chunks [][]byte `protobuf:"bytes,1,rep,name=chunks,proto3" json:"chunks,omitempty"`
+	// The total number of payload bytes in the chunks.
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *GeneratePayloadResponse) Reset() {
+	*x = GeneratePayloadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneratePayloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePayloadResponse) ProtoMessage() {}
+
+func (x *GeneratePayloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePayloadResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePayloadResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GeneratePayloadResponse) GetChunks() [][]byte {
+	if x != nil {
+		return x.Chunks
+	}
+	return nil
+}
+
+func (x *GeneratePayloadResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// The request for the FanOut method.
+type FanOutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of sub-tasks to run. At most 10,000.
+	TaskCount int32 `protobuf:"varint,1,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	// The most sub-tasks to run at once. If 0, they all run at once.
+	Concurrency int32 `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	// How long each sub-task runs for.
+	TaskDuration *durationpb.Duration `protobuf:"bytes,3,opt,name=task_duration,json=taskDuration,proto3" json:"task_duration,omitempty"`
+	// The sub-tasks to fail, and the errors they fail with. The others succeed.
+	Failures []*FanOutRequest_Failure `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures,omitempty"`
+	// Whether the call fails, with the error of the first failed sub-task, if
+	// any sub-task fails, rather than return the results of all sub-tasks.
+	FailOnError bool `protobuf:"varint,5,opt,name=fail_on_error,json=failOnError,proto3" json:"fail_on_error,omitempty"`
+}
+
+func (x *FanOutRequest) Reset() {
+	*x = FanOutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FanOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutRequest) ProtoMessage() {}
+
+func (x *FanOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutRequest.ProtoReflect.Descriptor instead.
+func (*FanOutRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FanOutRequest) GetTaskCount() int32 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *FanOutRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *FanOutRequest) GetTaskDuration() *durationpb.Duration {
+	if x != nil {
+		return x.TaskDuration
+	}
+	return nil
+}
+
+func (x *FanOutRequest) GetFailures() []*FanOutRequest_Failure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *FanOutRequest) GetFailOnError() bool {
+	if x != nil {
+		return x.FailOnError
+	}
+	return false
+}
+
+// The response for the FanOut method.
+type FanOutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The results of the sub-tasks, in the order of their indexes.
+	Results []*FanOutResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// The number of sub-tasks that succeeded.
+	SucceededCount int32 `protobuf:"varint,2,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	// The number of sub-tasks that failed.
+	FailedCount int32 `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	// The most sub-tasks that ran at once.
+	PeakConcurrency int32 `protobuf:"varint,4,opt,name=peak_concurrency,json=peakConcurrency,proto3" json:"peak_concurrency,omitempty"`
+}
+
+func (x *FanOutResponse) Reset() {
+	*x = FanOutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FanOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutResponse) ProtoMessage() {}
+
+func (x *FanOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutResponse.ProtoReflect.Descriptor instead.
+func (*FanOutResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FanOutResponse) GetResults() []*FanOutResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *FanOutResponse) GetSucceededCount() int32 {
+	if x != nil {
+		return x.SucceededCount
+	}
+	return 0
+}
+
+func (x *FanOutResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *FanOutResponse) GetPeakConcurrency() int32 {
+	if x != nil {
+		return x.PeakConcurrency
+	}
+	return 0
+}
+
+// A header received with the call.
+type EchoHeadersResponse_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the header, lower-cased.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The values of the header, in the order received. The values of binary
+	// headers, whose names end in "-bin", are base64-encoded.
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *EchoHeadersResponse_Header) Reset() {
+	*x = EchoHeadersResponse_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EchoHeadersResponse_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EchoHeadersResponse_Header) ProtoMessage() {}
+
+func (x *EchoHeadersResponse_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EchoHeadersResponse_Header.ProtoReflect.Descriptor instead.
+func (*EchoHeadersResponse_Header) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *EchoHeadersResponse_Header) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EchoHeadersResponse_Header) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// A sub-task to fail.
+type FanOutRequest_Failure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The index of the sub-task, from 0 to `task_count` - 1.
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// The error the sub-task fails with.
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *FanOutRequest_Failure) Reset() {
+	*x = FanOutRequest_Failure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FanOutRequest_Failure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutRequest_Failure) ProtoMessage() {}
+
+func (x *FanOutRequest_Failure) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutRequest_Failure.ProtoReflect.Descriptor instead.
+func (*FanOutRequest_Failure) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *FanOutRequest_Failure) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *FanOutRequest_Failure) GetError() *status.Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// The result of a sub-task.
+type FanOutResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The index of the sub-task.
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// The status of the sub-task: OK if it succeeded.
+	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// The content of the sub-task, if it succeeded.
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *FanOutResponse_Result) Reset() {
+	*x = FanOutResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FanOutResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutResponse_Result) ProtoMessage() {}
+
+func (x *FanOutResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_echo_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutResponse_Result.ProtoReflect.Descriptor instead.
+func (*FanOutResponse_Result) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_echo_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *FanOutResponse_Result) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *FanOutResponse_Result) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *FanOutResponse_Result) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+var File_google_showcase_v1beta1_echo_proto protoreflect.FileDescriptor
+
+var file_google_showcase_v1beta1_echo_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x6c, 0x6f,
+	0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x29, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x81, 0x04, 0x0a, 0x0b, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x40, 0x0a, 0x0e, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x46, 0x0a, 0x0a, 0x66,
+	0x61, 0x69, 0x6c, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x14, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x12, 0x66, 0x61, 0x69, 0x6c, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x38, 0x0a,
+	0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65,
+	0x61, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x60, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x81, 0x02, 0x0a, 0x0c, 0x45, 0x63, 0x68, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xe0, 0x02, 0x0a, 0x0d, 0x45,
+	0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x40, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6a, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x45, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x52, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0xad, 0x01,
+	0x0a, 0x12, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0xca, 0xda, 0x18, 0x02, 0x20, 0x00, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0xca, 0xda, 0x18, 0x02, 0x20, 0x00, 0x52, 0x04, 0x73,
+	0x6b, 0x69, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0xcf, 0x01,
+	0x0a, 0x1d, 0x4c, 0x6f, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67,
+	0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4a, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x64,
+	0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22,
+	0x79, 0x0a, 0x18, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
+	0x02, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x50,
+	0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xb7, 0x05, 0x0a, 0x0b, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x37, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x01, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x57,
+	0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x01, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x5f, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x34, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0d, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x06, 0xca, 0xda, 0x18, 0x02, 0x20, 0x00, 0x48, 0x02, 0x52, 0x0c, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x73, 0x88, 0x01, 0x01, 0x22, 0x95, 0x01, 0x0a,
+	0x0f, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x56, 0x41, 0x52,
+	0x49, 0x41, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x54, 0x41,
+	0x44, 0x41, 0x54, 0x41, 0x5f, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10,
+	0x02, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12,
+	0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4d, 0x41, 0x4c, 0x46, 0x4f, 0x52, 0x4d,
+	0x45, 0x44, 0x10, 0x04, 0x42, 0x05, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x73, 0x22, 0x28, 0x0a, 0x0c, 0x57, 0x61, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0xef, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x52,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x11, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12,
+	0x38, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x51, 0x0a, 0x12, 0x4e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcc, 0x01, 0x0a,
+	0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12,
+	0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x42, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x0d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x2a, 0x0a, 0x12, 0x45, 0x63, 0x68, 0x6f, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x45, 0x63, 0x68, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x34, 0x0a, 0x06, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0x58, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0xab, 0x01, 0x0a, 0x15, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x44, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x37,
+	0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x93, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0c, 0xca, 0xda, 0x18, 0x08, 0x20, 0x00, 0x28, 0x80, 0x80, 0x80, 0x80, 0x01, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xca, 0xda, 0x18, 0x06, 0x20,
+	0x00, 0x28, 0xc0, 0x84, 0x3d, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0x45, 0x0a,
+	0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0xe7, 0x02, 0x0a, 0x0d, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xca, 0xda, 0x18, 0x05,
+	0x20, 0x00, 0x28, 0x90, 0x4e, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x28, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0xca, 0xda, 0x18, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x61,
+	0x73, 0x6b, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x08, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x07, 0xca, 0xda,
+	0x18, 0x03, 0x38, 0x90, 0x4e, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x1a, 0x49, 0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb7,
+	0x02, 0x0a, 0x0e, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x6e,
+	0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x61, 0x6b, 0x5f,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x70, 0x65, 0x61, 0x6b, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x1a, 0x64, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2a, 0x44, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x4e, 0x45, 0x43, 0x45, 0x53, 0x53,
+	0x41, 0x52, 0x59, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x45, 0x43, 0x45, 0x53, 0x53, 0x41,
+	0x52, 0x59, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x52, 0x47, 0x45, 0x4e, 0x54, 0x10, 0x02,
+	0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x03, 0x32, 0xd7,
+	0x0f, 0x0a, 0x04, 0x45, 0x63, 0x68, 0x6f, 0x12, 0x72, 0x0a, 0x04, 0x45, 0x63, 0x68, 0x6f, 0x12,
+	0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65,
+	0x63, 0x68, 0x6f, 0x3a, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x01, 0x2a, 0x12, 0x8a, 0x01, 0x0a, 0x06,
+	0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x65, 0x78, 0x70,
+	0x61, 0x6e, 0x64, 0x3a, 0x01, 0x2a, 0xda, 0x41, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x2c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x30, 0x01, 0x12, 0x7a, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63,
+	0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x3a,
+	0x01, 0x2a, 0x28, 0x01, 0x12, 0x57, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x24, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x8e, 0x01,
+	0x0a, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x12, 0x2b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
+	0x22, 0x19, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a,
+	0x70, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0xc6,
+	0x01, 0x0a, 0x16, 0x4c, 0x6f, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61,
+	0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x12, 0x36, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x55, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x24, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x6c, 0x6f, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x3a, 0x01,
+	0x2a, 0xca, 0x41, 0x23, 0x0a, 0x13, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0xa0, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x67, 0x65,
+	0x64, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x12, 0x31, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70,
+	0x61, 0x6e, 0x64, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x64,
+	0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x70, 0x61, 0x67, 0x65, 0x64, 0x45, 0x78, 0x70, 0x61, 0x6e,
+	0x64, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x89, 0x01, 0x0a, 0x04, 0x57,
+	0x61, 0x69, 0x74, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x57, 0x61,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x22, 0x12, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a,
+	0x77, 0x61, 0x69, 0x74, 0x3a, 0x01, 0x2a, 0xca, 0x41, 0x1c, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0xa1, 0x01, 0x0a, 0x0a, 0x4e, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x57, 0x61, 0x69, 0x74, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x48, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x57, 0x61,
+	0x69, 0x74, 0x3a, 0x01, 0x2a, 0xca, 0x41, 0x22, 0x0a, 0x12, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x57, 0x61, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0c, 0x57, 0x61,
+	0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x76, 0x0a, 0x05, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x3a,
+	0x01, 0x2a, 0x12, 0x8a, 0x01, 0x0a, 0x0b, 0x45, 0x63, 0x68, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68,
+	0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x65, 0x63, 0x68, 0x6f, 0x3a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0x96, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65,
+	0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x44, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x9e, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2f, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x7a, 0x0a, 0x06, 0x46, 0x61, 0x6e,
+	0x4f, 0x75, 0x74, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x61,
+	0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x63, 0x68, 0x6f, 0x3a, 0x66, 0x61, 0x6e, 0x4f,
+	0x75, 0x74, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0xca, 0x41, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68,
+	0x6f, 0x73, 0x74, 0x3a, 0x37, 0x34, 0x36, 0x39, 0x42, 0x71, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73,
+	0x2f, 0x67, 0x61, 0x70, 0x69, 0x63, 0x2d, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xea,
+	0x02, 0x19, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x68, 0x6f, 0x77, 0x63, 0x61,
+	0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x42, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_google_showcase_v1beta1_echo_proto_rawDescOnce sync.Once
+	file_google_showcase_v1beta1_echo_proto_rawDescData = file_google_showcase_v1beta1_echo_proto_rawDesc
+)
+
+func file_google_showcase_v1beta1_echo_proto_rawDescGZIP() []byte {
+	file_google_showcase_v1beta1_echo_proto_rawDescOnce.Do(func() {
+		file_google_showcase_v1beta1_echo_proto_rawDescData = protoimpl.X.CompressGZIP(file_google_showcase_v1beta1_echo_proto_rawDescData)
+	})
+	return file_google_showcase_v1beta1_echo_proto_rawDescData
+}
+
+var file_google_showcase_v1beta1_echo_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_google_showcase_v1beta1_echo_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_google_showcase_v1beta1_echo_proto_goTypes = []interface{}{
+	(Severity)(0),                         // 0: google.showcase.v1beta1.Severity
+	(WaitRequest_MetadataVariant)(0),      // 1: google.showcase.v1beta1.WaitRequest.MetadataVariant
+	(*EchoRequest)(nil),                   // 2: google.showcase.v1beta1.EchoRequest
+	(*CollectFailure)(nil),                // 3: google.showcase.v1beta1.CollectFailure
+	(*StreamFailure)(nil),                 // 4: google.showcase.v1beta1.StreamFailure
+	(*EchoResponse)(nil),                  // 5: google.showcase.v1beta1.EchoResponse
+	(*ExpandRequest)(nil),                 // 6: google.showcase.v1beta1.ExpandRequest
+	(*PagedExpandRequest)(nil),            // 7: google.showcase.v1beta1.PagedExpandRequest
+	(*LongRunningPagedExpandRequest)(nil), // 8: google.showcase.v1beta1.LongRunningPagedExpandRequest
+	(*PagedExpandLegacyRequest)(nil),      // 9: google.showcase.v1beta1.PagedExpandLegacyRequest
+	(*PagedExpandResponse)(nil),           // 10: google.showcase.v1beta1.PagedExpandResponse
+	(*WaitRequest)(nil),                   // 11: google.showcase.v1beta1.WaitRequest
+	(*WaitResponse)(nil),                  // 12: google.showcase.v1beta1.WaitResponse
+	(*WaitMetadata)(nil),                  // 13: google.showcase.v1beta1.WaitMetadata
+	(*NestedWaitRequest)(nil),             // 14: google.showcase.v1beta1.NestedWaitRequest
+	(*NestedWaitResponse)(nil),            // 15: google.showcase.v1beta1.NestedWaitResponse
+	(*BlockRequest)(nil),                  // 16: google.showcase.v1beta1.BlockRequest
+	(*BlockResponse)(nil),                 // 17: google.showcase.v1beta1.BlockResponse
+	(*EchoHeadersRequest)(nil),            // 18: google.showcase.v1beta1.EchoHeadersRequest
+	(*EchoHeadersResponse)(nil),           // 19: google.showcase.v1beta1.EchoHeadersResponse
+	(*ProbeDeadlineRequest)(nil),          // 20: google.showcase.v1beta1.ProbeDeadlineRequest
+	(*ProbeDeadlineResponse)(nil),         // 21: google.showcase.v1beta1.ProbeDeadlineResponse
+	(*GeneratePayloadRequest)(nil),        // 22: google.showcase.v1beta1.GeneratePayloadRequest
+	(*GeneratePayloadResponse)(nil),       // 23: google.showcase.v1beta1.GeneratePayloadResponse
+	(*FanOutRequest)(nil),                 // 24: google.showcase.v1beta1.FanOutRequest
+	(*FanOutResponse)(nil),                // 25: google.showcase.v1beta1.FanOutResponse
+	(*EchoHeadersResponse_Header)(nil),    // 26: google.showcase.v1beta1.EchoHeadersResponse.Header
+	(*FanOutRequest_Failure)(nil),         // 27: google.showcase.v1beta1.FanOutRequest.Failure
+	(*FanOutResponse_Result)(nil),         // 28: google.showcase.v1beta1.FanOutResponse.Result
+	(*status.Status)(nil),                 // 29: google.rpc.Status
+	(*durationpb.Duration)(nil),           // 30: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),         // 31: google.protobuf.Timestamp
+	(*longrunning.Operation)(nil),         // 32: google.longrunning.Operation
+}
+var file_google_showcase_v1beta1_echo_proto_depIdxs = []int32{
+	29, // 0: google.showcase.v1beta1.EchoRequest.error:type_name -> google.rpc.Status
+	0,  // 1: google.showcase.v1beta1.EchoRequest.severity:type_name -> google.showcase.v1beta1.Severity
+	30, // 2: google.showcase.v1beta1.EchoRequest.response_delay:type_name -> google.protobuf.Duration
+	3,  // 3: google.showcase.v1beta1.EchoRequest.fail_after:type_name -> google.showcase.v1beta1.CollectFailure
+	4,  // 4: google.showcase.v1beta1.EchoRequest.fail_after_responses:type_name -> google.showcase.v1beta1.StreamFailure
+	30, // 5: google.showcase.v1beta1.EchoRequest.read_delay:type_name -> google.protobuf.Duration
+	29, // 6: google.showcase.v1beta1.CollectFailure.error:type_name -> google.rpc.Status
+	29, // 7: google.showcase.v1beta1.StreamFailure.error:type_name -> google.rpc.Status
+	0,  // 8: google.showcase.v1beta1.EchoResponse.severity:type_name -> google.showcase.v1beta1.Severity
+	29, // 9: google.showcase.v1beta1.ExpandRequest.error:type_name -> google.rpc.Status
+	30, // 10: google.showcase.v1beta1.ExpandRequest.response_delay:type_name -> google.protobuf.Duration
+	30, // 11: google.showcase.v1beta1.ExpandRequest.jitter:type_name -> google.protobuf.Duration
+	4,  // 12: google.showcase.v1beta1.ExpandRequest.fail_after:type_name -> google.showcase.v1beta1.StreamFailure
+	7,  // 13: google.showcase.v1beta1.LongRunningPagedExpandRequest.request:type_name -> google.showcase.v1beta1.PagedExpandRequest
+	31, // 14: google.showcase.v1beta1.LongRunningPagedExpandRequest.end_time:type_name -> google.protobuf.Timestamp
+	30, // 15: google.showcase.v1beta1.LongRunningPagedExpandRequest.ttl:type_name -> google.protobuf.Duration
+	5,  // 16: google.showcase.v1beta1.PagedExpandResponse.responses:type_name -> google.showcase.v1beta1.EchoResponse
+	31, // 17: google.showcase.v1beta1.WaitRequest.end_time:type_name -> google.protobuf.Timestamp
+	30, // 18: google.showcase.v1beta1.WaitRequest.ttl:type_name -> google.protobuf.Duration
+	29, // 19: google.showcase.v1beta1.WaitRequest.error:type_name -> google.rpc.Status
+	12, // 20: google.showcase.v1beta1.WaitRequest.success:type_name -> google.showcase.v1beta1.WaitResponse
+	1,  // 21: google.showcase.v1beta1.WaitRequest.metadata_variant:type_name -> google.showcase.v1beta1.WaitRequest.MetadataVariant
+	30, // 22: google.showcase.v1beta1.WaitRequest.progress_interval:type_name -> google.protobuf.Duration
+	31, // 23: google.showcase.v1beta1.WaitRequest.start_time:type_name -> google.protobuf.Timestamp
+	31, // 24: google.showcase.v1beta1.WaitMetadata.end_time:type_name -> google.protobuf.Timestamp
+	30, // 25: google.showcase.v1beta1.WaitMetadata.time_remaining:type_name -> google.protobuf.Duration
+	31, // 26: google.showcase.v1beta1.WaitMetadata.update_time:type_name -> google.protobuf.Timestamp
+	31, // 27: google.showcase.v1beta1.NestedWaitRequest.end_time:type_name -> google.protobuf.Timestamp
+	30, // 28: google.showcase.v1beta1.NestedWaitRequest.ttl:type_name -> google.protobuf.Duration
+	11, // 29: google.showcase.v1beta1.NestedWaitRequest.next:type_name -> google.showcase.v1beta1.WaitRequest
+	32, // 30: google.showcase.v1beta1.NestedWaitResponse.operation:type_name -> google.longrunning.Operation
+	30, // 31: google.showcase.v1beta1.BlockRequest.response_delay:type_name -> google.protobuf.Duration
+	29, // 32: google.showcase.v1beta1.BlockRequest.error:type_name -> google.rpc.Status
+	17, // 33: google.showcase.v1beta1.BlockRequest.success:type_name -> google.showcase.v1beta1.BlockResponse
+	26, // 34: google.showcase.v1beta1.EchoHeadersResponse.headers:type_name -> google.showcase.v1beta1.EchoHeadersResponse.Header
+	30, // 35: google.showcase.v1beta1.ProbeDeadlineRequest.response_delay:type_name -> google.protobuf.Duration
+	31, // 36: google.showcase.v1beta1.ProbeDeadlineResponse.deadline:type_name -> google.protobuf.Timestamp
+	30, // 37: google.showcase.v1beta1.ProbeDeadlineResponse.remaining:type_name -> google.protobuf.Duration
+	30, // 38: google.showcase.v1beta1.FanOutRequest.task_duration:type_name -> google.protobuf.Duration
+	27, // 39: google.showcase.v1beta1.FanOutRequest.failures:type_name -> google.showcase.v1beta1.FanOutRequest.Failure
+	28, // 40: google.showcase.v1beta1.FanOutResponse.results:type_name -> google.showcase.v1beta1.FanOutResponse.Result
+	29, // 41: google.showcase.v1beta1.FanOutRequest.Failure.error:type_name -> google.rpc.Status
+	29, // 42: google.showcase.v1beta1.FanOutResponse.Result.status:type_name -> google.rpc.Status
+	2,  // 43: google.showcase.v1beta1.Echo.Echo:input_type -> google.showcase.v1beta1.EchoRequest
+	6,  // 44: google.showcase.v1beta1.Echo.Expand:input_type -> google.showcase.v1beta1.ExpandRequest
+	2,  // 45: google.showcase.v1beta1.Echo.Collect:input_type -> google.showcase.v1beta1.EchoRequest
+	2,  // 46: google.showcase.v1beta1.Echo.Chat:input_type -> google.showcase.v1beta1.EchoRequest
+	7,  // 47: google.showcase.v1beta1.Echo.PagedExpand:input_type -> google.showcase.v1beta1.PagedExpandRequest
+	8,  // 48: google.showcase.v1beta1.Echo.LongRunningPagedExpand:input_type -> google.showcase.v1beta1.LongRunningPagedExpandRequest
+	9,  // 49: google.showcase.v1beta1.Echo.PagedExpandLegacy:input_type -> google.showcase.v1beta1.PagedExpandLegacyRequest
+	11, // 50: google.showcase.v1beta1.Echo.Wait:input_type -> google.showcase.v1beta1.WaitRequest
+	14, // 51: google.showcase.v1beta1.Echo.NestedWait:input_type -> google.showcase.v1beta1.NestedWaitRequest
+	16, // 52: google.showcase.v1beta1.Echo.Block:input_type -> google.showcase.v1beta1.BlockRequest
+	18, // 53: google.showcase.v1beta1.Echo.EchoHeaders:input_type -> google.showcase.v1beta1.EchoHeadersRequest
+	20, // 54: google.showcase.v1beta1.Echo.ProbeDeadline:input_type -> google.showcase.v1beta1.ProbeDeadlineRequest
+	22, // 55: google.showcase.v1beta1.Echo.GeneratePayload:input_type -> google.showcase.v1beta1.GeneratePayloadRequest
+	24, // 56: google.showcase.v1beta1.Echo.FanOut:input_type -> google.showcase.v1beta1.FanOutRequest
+	5,  // 57: google.showcase.v1beta1.Echo.Echo:output_type -> google.showcase.v1beta1.EchoResponse
+	5,  // 58: google.showcase.v1beta1.Echo.Expand:output_type -> google.showcase.v1beta1.EchoResponse
+	5,  // 59: google.showcase.v1beta1.Echo.Collect:output_type -> google.showcase.v1beta1.EchoResponse
+	5,  // 60: google.showcase.v1beta1.Echo.Chat:output_type -> google.showcase.v1beta1.EchoResponse
+	10, // 61: google.showcase.v1beta1.Echo.PagedExpand:output_type -> google.showcase.v1beta1.PagedExpandResponse
+	32, // 62: google.showcase.v1beta1.Echo.LongRunningPagedExpand:output_type -> google.longrunning.Operation
+	10, // 63: google.showcase.v1beta1.Echo.PagedExpandLegacy:output_type -> google.showcase.v1beta1.PagedExpandResponse
+	32, // 64: google.showcase.v1beta1.Echo.Wait:output_type -> google.longrunning.Operation
+	32, // 65: google.showcase.v1beta1.Echo.NestedWait:output_type -> google.longrunning.Operation
+	17, // 66: google.showcase.v1beta1.Echo.Block:output_type -> google.showcase.v1beta1.BlockResponse
+	19, // 67: google.showcase.v1beta1.Echo.EchoHeaders:output_type -> google.showcase.v1beta1.EchoHeadersResponse
+	21, // 68: google.showcase.v1beta1.Echo.ProbeDeadline:output_type -> google.showcase.v1beta1.ProbeDeadlineResponse
+	23, // 69: google.showcase.v1beta1.Echo.GeneratePayload:output_type -> google.showcase.v1beta1.GeneratePayloadResponse
+	25, // 70: google.showcase.v1beta1.Echo.FanOut:output_type -> google.showcase.v1beta1.FanOutResponse
+	57, // [57:71] is the sub-list for method output_type
+	43, // [43:57] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
+}
+
+func init() { file_google_showcase_v1beta1_echo_proto_init() }
+func file_google_showcase_v1beta1_echo_proto_init() {
+	if File_google_showcase_v1beta1_echo_proto != nil {
+		return
+	}
+	file_google_showcase_v1beta1_constraints_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_google_showcase_v1beta1_echo_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PagedExpandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LongRunningPagedExpandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PagedExpandLegacyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PagedExpandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NestedWaitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NestedWaitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_echo_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoHeadersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1143,8 +3017,8 @@ func file_google_showcase_v1beta1_echo_proto_init() {
 				return nil
 			}
 		}
-		file_google_showcase_v1beta1_echo_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EchoResponse); i {
+		file_google_showcase_v1beta1_echo_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoHeadersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1155,8 +3029,8 @@ func file_google_showcase_v1beta1_echo_proto_init() {
 				return nil
 			}
 		}
-		file_google_showcase_v1beta1_echo_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExpandRequest); i {
+		file_google_showcase_v1beta1_echo_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeDeadlineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1167,8 +3041,8 @@ func file_google_showcase_v1beta1_echo_proto_init() {
 				return nil
 			}
 		}
-		file_google_showcase_v1beta1_echo_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PagedExpandRequest); i {
+		file_google_showcase_v1beta1_echo_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeDeadlineResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1179,8 +3053,8 @@ func file_google_showcase_v1beta1_echo_proto_init() {
 				return nil
 			}
 		}
-		file_google_showcase_v1beta1_echo_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PagedExpandLegacyRequest); i {
+		file_google_showcase_v1beta1_echo_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneratePayloadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1191,8 +3065,8 @@ func file_google_showcase_v1beta1_echo_proto_init() {
 				return nil
 			}
 		}
-		file_google_showcase_v1beta1_echo_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PagedExpandResponse); i {
+		file_google_showcase_v1beta1_echo_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneratePayloadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1203,8 +3077,8 @@ func file_google_showcase_v1beta1_echo_proto_init() {
 				return nil
 			}
 		}
-		file_google_showcase_v1beta1_echo_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WaitRequest); i {
+		file_google_showcase_v1beta1_echo_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FanOutRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1215,8 +3089,8 @@ func file_google_showcase_v1beta1_echo_proto_init() {
 				return nil
 			}
 		}
-		file_google_showcase_v1beta1_echo_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WaitResponse); i {
+		file_google_showcase_v1beta1_echo_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FanOutResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1227,8 +3101,8 @@ func file_google_showcase_v1beta1_echo_proto_init() {
 				return nil
 			}
 		}
-		file_google_showcase_v1beta1_echo_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WaitMetadata); i {
+		file_google_showcase_v1beta1_echo_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoHeadersResponse_Header); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1239,8 +3113,8 @@ func file_google_showcase_v1beta1_echo_proto_init() {
 				return nil
 			}
 		}
-		file_google_showcase_v1beta1_echo_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockRequest); i {
+		file_google_showcase_v1beta1_echo_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FanOutRequest_Failure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1251,8 +3125,8 @@ func file_google_showcase_v1beta1_echo_proto_init() {
 				return nil
 			}
 		}
-		file_google_showcase_v1beta1_echo_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockResponse); i {
+		file_google_showcase_v1beta1_echo_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FanOutResponse_Result); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1268,13 +3142,13 @@ func file_google_showcase_v1beta1_echo_proto_init() {
 		(*EchoRequest_Content)(nil),
 		(*EchoRequest_Error)(nil),
 	}
-	file_google_showcase_v1beta1_echo_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_google_showcase_v1beta1_echo_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*WaitRequest_EndTime)(nil),
 		(*WaitRequest_Ttl)(nil),
 		(*WaitRequest_Error)(nil),
 		(*WaitRequest_Success)(nil),
 	}
-	file_google_showcase_v1beta1_echo_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_google_showcase_v1beta1_echo_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*BlockRequest_Error)(nil),
 		(*BlockRequest_Success)(nil),
 	}
@@ -1283,8 +3157,8 @@ func file_google_showcase_v1beta1_echo_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_google_showcase_v1beta1_echo_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1312,32 +3186,84 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type EchoClient interface {
 	// This method simply echoes the request. This method showcases unary RPCs.
+	// Over gRPC, the `x-showcase-request-compression` response header names the
+	// compression the request was sent with, or "identity", so that clients can
+	// verify their compression settings take effect.
 	Echo(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (*EchoResponse, error)
 	// This method splits the given content into words and will pass each word back
 	// through the stream. This method showcases server-side streaming RPCs.
+	// Each word carries a `stream_resume_token`, so that clients can resume
+	// dropped streams where they left off.
 	Expand(ctx context.Context, in *ExpandRequest, opts ...grpc.CallOption) (Echo_ExpandClient, error)
 	// This method will collect the words given to it. When the stream is closed
 	// by the client, this method will return the a concatenation of the strings
-	// passed to it. This method showcases client-side streaming RPCs.
+	// passed to it. This method showcases client-side streaming RPCs. Set
+	// `fail_after` on the first request to fail the call after a given number
+	// of requests, and `read_delay` to have the server read the requests
+	// slowly. The response reports how many requests, and bytes of them, the
+	// server received.
 	Collect(ctx context.Context, opts ...grpc.CallOption) (Echo_CollectClient, error)
 	// This method, upon receiving a request on the stream, will pass the same
 	// content back on the stream. This method showcases bidirectional
-	// streaming RPCs.
+	// streaming RPCs. Send the `x-showcase-push-count` header to have the server
+	// also push that many messages of its own, one every
+	// `x-showcase-push-interval` (a duration such as "250ms", "1s" by default),
+	// whatever the client sends; the call ends once the client half-closes the
+	// stream and all the messages are pushed.
 	Chat(ctx context.Context, opts ...grpc.CallOption) (Echo_ChatClient, error)
 	// This is similar to the Expand method but instead of returning a stream of
-	// expanded words, this method returns a paged list of expanded words.
+	// expanded words, this method returns a paged list of expanded words. Send
+	// the `x-showcase-page-tokens: signed` header to be given opaque page tokens
+	// signed by the server instead of the index of the next word; calls sending
+	// it fail with a `PAGE_TOKEN_TAMPERED` ErrorInfo for any other token. Send
+	// the `x-showcase-page-token-ttl` header, a duration such as "30s", to also
+	// have the tokens expire: calls sending an expired token fail with
+	// FAILED_PRECONDITION and a `PAGE_TOKEN_EXPIRED` ErrorInfo.
 	PagedExpand(ctx context.Context, in *PagedExpandRequest, opts ...grpc.CallOption) (*PagedExpandResponse, error)
+	// This is similar to the PagedExpand method, but returns a long-running
+	// operation whose response is the first page of expanded words. Its
+	// next_page_token is consumed by PagedExpand, with the same content and
+	// page_size, to retrieve the following pages. This method showcases
+	// long-running operations whose results are paginated.
+	LongRunningPagedExpand(ctx context.Context, in *LongRunningPagedExpandRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
 	// This is similar to the PagedExpand except that it uses
 	// max_results instead of page_size, as some legacy APIs still
 	// do. New APIs should NOT use this pattern.
 	PagedExpandLegacy(ctx context.Context, in *PagedExpandLegacyRequest, opts ...grpc.CallOption) (*PagedExpandResponse, error)
 	// This method will wait for the requested amount of time and then return.
-	// This method showcases how a client handles a request timeout.
+	// This method showcases how a client handles a request timeout. Cancelling
+	// the operation while it is pending completes it with a CANCELLED error;
+	// deleting it makes later polls fail with NOT_FOUND.
 	Wait(ctx context.Context, in *WaitRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
+	// This method will wait for the requested amount of time and then complete
+	// with a response holding a second operation, started as the first
+	// completed, which must be polled in turn for the final result.
+	// This method showcases how a client handles chained long-running
+	// operations.
+	NestedWait(ctx context.Context, in *NestedWaitRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
 	// This method will block (wait) for the requested amount of time
 	// and then return the response or error.
 	// This method showcases how a client handles delays or retries.
 	Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// This method returns the metadata the server received with the call, such
+	// as the x-goog-request-params routing header, the user-agent and the
+	// x-goog-api-client header, so that clients can assert on what they send.
+	// Over REST, the metadata is the request's headers.
+	EchoHeaders(ctx context.Context, in *EchoHeadersRequest, opts ...grpc.CallOption) (*EchoHeadersResponse, error)
+	// This method returns the deadline the server observed for the call, so
+	// that clients can verify their timeout settings are sent: over gRPC in the
+	// grpc-timeout header, and over REST in the X-Server-Timeout header, in
+	// seconds.
+	ProbeDeadline(ctx context.Context, in *ProbeDeadlineRequest, opts ...grpc.CallOption) (*ProbeDeadlineResponse, error)
+	// This method returns a payload of the requested size, optionally split
+	// into a number of chunks, so that clients can test message size limits,
+	// chunking and memory behavior without crafting large requests.
+	GeneratePayload(ctx context.Context, in *GeneratePayloadRequest, opts ...grpc.CallOption) (*GeneratePayloadResponse, error)
+	// This method runs a number of sub-tasks concurrently, as batch APIs do for
+	// the items of a batch, and returns the status of each, so that clients can
+	// test surfacing per-item results, including partial failures, and that
+	// servers can be stressed with many concurrent sub-tasks per call.
+	FanOut(ctx context.Context, in *FanOutRequest, opts ...grpc.CallOption) (*FanOutResponse, error)
 }
 
 type echoClient struct {
@@ -1463,6 +3389,15 @@ func (c *echoClient) PagedExpand(ctx context.Context, in *PagedExpandRequest, op
 	return out, nil
 }
 
+func (c *echoClient) LongRunningPagedExpand(ctx context.Context, in *LongRunningPagedExpandRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Echo/LongRunningPagedExpand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *echoClient) PagedExpandLegacy(ctx context.Context, in *PagedExpandLegacyRequest, opts ...grpc.CallOption) (*PagedExpandResponse, error) {
 	out := new(PagedExpandResponse)
 	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Echo/PagedExpandLegacy", in, out, opts...)
@@ -1481,6 +3416,15 @@ func (c *echoClient) Wait(ctx context.Context, in *WaitRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *echoClient) NestedWait(ctx context.Context, in *NestedWaitRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Echo/NestedWait", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *echoClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
 	out := new(BlockResponse)
 	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Echo/Block", in, out, opts...)
@@ -1490,35 +3434,123 @@ func (c *echoClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *echoClient) EchoHeaders(ctx context.Context, in *EchoHeadersRequest, opts ...grpc.CallOption) (*EchoHeadersResponse, error) {
+	out := new(EchoHeadersResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Echo/EchoHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *echoClient) ProbeDeadline(ctx context.Context, in *ProbeDeadlineRequest, opts ...grpc.CallOption) (*ProbeDeadlineResponse, error) {
+	out := new(ProbeDeadlineResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Echo/ProbeDeadline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *echoClient) GeneratePayload(ctx context.Context, in *GeneratePayloadRequest, opts ...grpc.CallOption) (*GeneratePayloadResponse, error) {
+	out := new(GeneratePayloadResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Echo/GeneratePayload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *echoClient) FanOut(ctx context.Context, in *FanOutRequest, opts ...grpc.CallOption) (*FanOutResponse, error) {
+	out := new(FanOutResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Echo/FanOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EchoServer is the server API for Echo service.
 type EchoServer interface {
 	// This method simply echoes the request. This method showcases unary RPCs.
+	// Over gRPC, the `x-showcase-request-compression` response header names the
+	// compression the request was sent with, or "identity", so that clients can
+	// verify their compression settings take effect.
 	Echo(context.Context, *EchoRequest) (*EchoResponse, error)
 	// This method splits the given content into words and will pass each word back
 	// through the stream. This method showcases server-side streaming RPCs.
+	// Each word carries a `stream_resume_token`, so that clients can resume
+	// dropped streams where they left off.
 	Expand(*ExpandRequest, Echo_ExpandServer) error
 	// This method will collect the words given to it. When the stream is closed
 	// by the client, this method will return the a concatenation of the strings
-	// passed to it. This method showcases client-side streaming RPCs.
+	// passed to it. This method showcases client-side streaming RPCs. Set
+	// `fail_after` on the first request to fail the call after a given number
+	// of requests, and `read_delay` to have the server read the requests
+	// slowly. The response reports how many requests, and bytes of them, the
+	// server received.
 	Collect(Echo_CollectServer) error
 	// This method, upon receiving a request on the stream, will pass the same
 	// content back on the stream. This method showcases bidirectional
-	// streaming RPCs.
+	// streaming RPCs. Send the `x-showcase-push-count` header to have the server
+	// also push that many messages of its own, one every
+	// `x-showcase-push-interval` (a duration such as "250ms", "1s" by default),
+	// whatever the client sends; the call ends once the client half-closes the
+	// stream and all the messages are pushed.
 	Chat(Echo_ChatServer) error
 	// This is similar to the Expand method but instead of returning a stream of
-	// expanded words, this method returns a paged list of expanded words.
+	// expanded words, this method returns a paged list of expanded words. Send
+	// the `x-showcase-page-tokens: signed` header to be given opaque page tokens
+	// signed by the server instead of the index of the next word; calls sending
+	// it fail with a `PAGE_TOKEN_TAMPERED` ErrorInfo for any other token. Send
+	// the `x-showcase-page-token-ttl` header, a duration such as "30s", to also
+	// have the tokens expire: calls sending an expired token fail with
+	// FAILED_PRECONDITION and a `PAGE_TOKEN_EXPIRED` ErrorInfo.
 	PagedExpand(context.Context, *PagedExpandRequest) (*PagedExpandResponse, error)
+	// This is similar to the PagedExpand method, but returns a long-running
+	// operation whose response is the first page of expanded words. Its
+	// next_page_token is consumed by PagedExpand, with the same content and
+	// page_size, to retrieve the following pages. This method showcases
+	// long-running operations whose results are paginated.
+	LongRunningPagedExpand(context.Context, *LongRunningPagedExpandRequest) (*longrunning.Operation, error)
 	// This is similar to the PagedExpand except that it uses
 	// max_results instead of page_size, as some legacy APIs still
 	// do. New APIs should NOT use this pattern.
 	PagedExpandLegacy(context.Context, *PagedExpandLegacyRequest) (*PagedExpandResponse, error)
 	// This method will wait for the requested amount of time and then return.
-	// This method showcases how a client handles a request timeout.
+	// This method showcases how a client handles a request timeout. Cancelling
+	// the operation while it is pending completes it with a CANCELLED error;
+	// deleting it makes later polls fail with NOT_FOUND.
 	Wait(context.Context, *WaitRequest) (*longrunning.Operation, error)
+	// This method will wait for the requested amount of time and then complete
+	// with a response holding a second operation, started as the first
+	// completed, which must be polled in turn for the final result.
+	// This method showcases how a client handles chained long-running
+	// operations.
+	NestedWait(context.Context, *NestedWaitRequest) (*longrunning.Operation, error)
 	// This method will block (wait) for the requested amount of time
 	// and then return the response or error.
 	// This method showcases how a client handles delays or retries.
 	Block(context.Context, *BlockRequest) (*BlockResponse, error)
+	// This method returns the metadata the server received with the call, such
+	// as the x-goog-request-params routing header, the user-agent and the
+	// x-goog-api-client header, so that clients can assert on what they send.
+	// Over REST, the metadata is the request's headers.
+	EchoHeaders(context.Context, *EchoHeadersRequest) (*EchoHeadersResponse, error)
+	// This method returns the deadline the server observed for the call, so
+	// that clients can verify their timeout settings are sent: over gRPC in the
+	// grpc-timeout header, and over REST in the X-Server-Timeout header, in
+	// seconds.
+	ProbeDeadline(context.Context, *ProbeDeadlineRequest) (*ProbeDeadlineResponse, error)
+	// This method returns a payload of the requested size, optionally split
+	// into a number of chunks, so that clients can test message size limits,
+	// chunking and memory behavior without crafting large requests.
+	GeneratePayload(context.Context, *GeneratePayloadRequest) (*GeneratePayloadResponse, error)
+	// This method runs a number of sub-tasks concurrently, as batch APIs do for
+	// the items of a batch, and returns the status of each, so that clients can
+	// test surfacing per-item results, including partial failures, and that
+	// servers can be stressed with many concurrent sub-tasks per call.
+	FanOut(context.Context, *FanOutRequest) (*FanOutResponse, error)
 }
 
 // UnimplementedEchoServer can be embedded to have forward compatible implementations.
@@ -1540,15 +3572,33 @@ func (*UnimplementedEchoServer) Chat(Echo_ChatServer) error {
 func (*UnimplementedEchoServer) PagedExpand(context.Context, *PagedExpandRequest) (*PagedExpandResponse, error) {
 	return nil, status1.Errorf(codes.Unimplemented, "method PagedExpand not implemented")
 }
+func (*UnimplementedEchoServer) LongRunningPagedExpand(context.Context, *LongRunningPagedExpandRequest) (*longrunning.Operation, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method LongRunningPagedExpand not implemented")
+}
 func (*UnimplementedEchoServer) PagedExpandLegacy(context.Context, *PagedExpandLegacyRequest) (*PagedExpandResponse, error) {
 	return nil, status1.Errorf(codes.Unimplemented, "method PagedExpandLegacy not implemented")
 }
 func (*UnimplementedEchoServer) Wait(context.Context, *WaitRequest) (*longrunning.Operation, error) {
 	return nil, status1.Errorf(codes.Unimplemented, "method Wait not implemented")
 }
+func (*UnimplementedEchoServer) NestedWait(context.Context, *NestedWaitRequest) (*longrunning.Operation, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method NestedWait not implemented")
+}
 func (*UnimplementedEchoServer) Block(context.Context, *BlockRequest) (*BlockResponse, error) {
 	return nil, status1.Errorf(codes.Unimplemented, "method Block not implemented")
 }
+func (*UnimplementedEchoServer) EchoHeaders(context.Context, *EchoHeadersRequest) (*EchoHeadersResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method EchoHeaders not implemented")
+}
+func (*UnimplementedEchoServer) ProbeDeadline(context.Context, *ProbeDeadlineRequest) (*ProbeDeadlineResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ProbeDeadline not implemented")
+}
+func (*UnimplementedEchoServer) GeneratePayload(context.Context, *GeneratePayloadRequest) (*GeneratePayloadResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method GeneratePayload not implemented")
+}
+func (*UnimplementedEchoServer) FanOut(context.Context, *FanOutRequest) (*FanOutResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method FanOut not implemented")
+}
 
 func RegisterEchoServer(s *grpc.Server, srv EchoServer) {
 	s.RegisterService(&_Echo_serviceDesc, srv)
@@ -1663,6 +3713,24 @@ func _Echo_PagedExpand_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Echo_LongRunningPagedExpand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LongRunningPagedExpandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EchoServer).LongRunningPagedExpand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Echo/LongRunningPagedExpand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EchoServer).LongRunningPagedExpand(ctx, req.(*LongRunningPagedExpandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Echo_PagedExpandLegacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PagedExpandLegacyRequest)
 	if err := dec(in); err != nil {
@@ -1699,6 +3767,24 @@ func _Echo_Wait_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Echo_NestedWait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NestedWaitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EchoServer).NestedWait(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Echo/NestedWait",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EchoServer).NestedWait(ctx, req.(*NestedWaitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Echo_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockRequest)
 	if err := dec(in); err != nil {
@@ -1717,6 +3803,78 @@ func _Echo_Block_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Echo_EchoHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EchoHeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EchoServer).EchoHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Echo/EchoHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EchoServer).EchoHeaders(ctx, req.(*EchoHeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Echo_ProbeDeadline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeDeadlineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EchoServer).ProbeDeadline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Echo/ProbeDeadline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EchoServer).ProbeDeadline(ctx, req.(*ProbeDeadlineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Echo_GeneratePayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EchoServer).GeneratePayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Echo/GeneratePayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EchoServer).GeneratePayload(ctx, req.(*GeneratePayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Echo_FanOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FanOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EchoServer).FanOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Echo/FanOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EchoServer).FanOut(ctx, req.(*FanOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Echo_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "google.showcase.v1beta1.Echo",
 	HandlerType: (*EchoServer)(nil),
@@ -1729,6 +3887,10 @@ var _Echo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PagedExpand",
 			Handler:    _Echo_PagedExpand_Handler,
 		},
+		{
+			MethodName: "LongRunningPagedExpand",
+			Handler:    _Echo_LongRunningPagedExpand_Handler,
+		},
 		{
 			MethodName: "PagedExpandLegacy",
 			Handler:    _Echo_PagedExpandLegacy_Handler,
@@ -1737,10 +3899,30 @@ var _Echo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Wait",
 			Handler:    _Echo_Wait_Handler,
 		},
+		{
+			MethodName: "NestedWait",
+			Handler:    _Echo_NestedWait_Handler,
+		},
 		{
 			MethodName: "Block",
 			Handler:    _Echo_Block_Handler,
 		},
+		{
+			MethodName: "EchoHeaders",
+			Handler:    _Echo_EchoHeaders_Handler,
+		},
+		{
+			MethodName: "ProbeDeadline",
+			Handler:    _Echo_ProbeDeadline_Handler,
+		},
+		{
+			MethodName: "GeneratePayload",
+			Handler:    _Echo_GeneratePayload_Handler,
+		},
+		{
+			MethodName: "FanOut",
+			Handler:    _Echo_FanOut_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
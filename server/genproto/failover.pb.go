@@ -0,0 +1,627 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.15.0
+// source: google/showcase/v1beta1/failover.proto
+
+package genproto
+
+import (
+	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The role a server plays in a failover pair.
+type FailoverState_Role int32
+
+const (
+	FailoverState_ROLE_UNSPECIFIED FailoverState_Role = 0
+	// The server handles all calls.
+	FailoverState_LEADER FailoverState_Role = 1
+	// The server rejects all calls but those of the Failover service.
+	FailoverState_STANDBY FailoverState_Role = 2
+)
+
+// Enum value maps for FailoverState_Role.
+var (
+	FailoverState_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "LEADER",
+		2: "STANDBY",
+	}
+	FailoverState_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"LEADER":           1,
+		"STANDBY":          2,
+	}
+)
+
+func (x FailoverState_Role) Enum() *FailoverState_Role {
+	p := new(FailoverState_Role)
+	*p = x
+	return p
+}
+
+func (x FailoverState_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FailoverState_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_google_showcase_v1beta1_failover_proto_enumTypes[0].Descriptor()
+}
+
+func (FailoverState_Role) Type() protoreflect.EnumType {
+	return &file_google_showcase_v1beta1_failover_proto_enumTypes[0]
+}
+
+func (x FailoverState_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FailoverState_Role.Descriptor instead.
+func (FailoverState_Role) EnumDescriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_failover_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// The failover state of a server.
+type FailoverState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current role of the server.
+	Role FailoverState_Role `protobuf:"varint,1,opt,name=role,proto3,enum=google.showcase.v1beta1.FailoverState_Role" json:"role,omitempty"`
+	// The number of handoffs this server has taken part in. It increases with
+	// every failover, so the most recent leader always has the highest term.
+	Term int64 `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
+	// The address of the other server of the pair, if any.
+	Peer string `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`
+}
+
+func (x *FailoverState) Reset() {
+	*x = FailoverState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_failover_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailoverState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailoverState) ProtoMessage() {}
+
+func (x *FailoverState) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_failover_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailoverState.ProtoReflect.Descriptor instead.
+func (*FailoverState) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_failover_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FailoverState) GetRole() FailoverState_Role {
+	if x != nil {
+		return x.Role
+	}
+	return FailoverState_ROLE_UNSPECIFIED
+}
+
+func (x *FailoverState) GetTerm() int64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *FailoverState) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+// The request message for the GetFailoverState method.
+type GetFailoverStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetFailoverStateRequest) Reset() {
+	*x = GetFailoverStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_failover_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFailoverStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFailoverStateRequest) ProtoMessage() {}
+
+func (x *GetFailoverStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_failover_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFailoverStateRequest.ProtoReflect.Descriptor instead.
+func (*GetFailoverStateRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_failover_proto_rawDescGZIP(), []int{1}
+}
+
+// The request message for the TriggerFailover method.
+type TriggerFailoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TriggerFailoverRequest) Reset() {
+	*x = TriggerFailoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_failover_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerFailoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerFailoverRequest) ProtoMessage() {}
+
+func (x *TriggerFailoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_failover_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerFailoverRequest.ProtoReflect.Descriptor instead.
+func (*TriggerFailoverRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_failover_proto_rawDescGZIP(), []int{2}
+}
+
+// The request message for the Handoff method.
+type HandoffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The term the receiving server leads in. It must be greater than the
+	// receiver's current term.
+	Term int64 `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+}
+
+func (x *HandoffRequest) Reset() {
+	*x = HandoffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_failover_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandoffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoffRequest) ProtoMessage() {}
+
+func (x *HandoffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_failover_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoffRequest.ProtoReflect.Descriptor instead.
+func (*HandoffRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_failover_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HandoffRequest) GetTerm() int64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+var File_google_showcase_v1beta1_failover_proto protoreflect.FileDescriptor
+
+var file_google_showcase_v1beta1_failover_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x01, 0x0a, 0x0d, 0x46, 0x61, 0x69,
+	0x6c, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x65, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x42, 0x59, 0x10, 0x02, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x24, 0x0a, 0x0e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x74, 0x65, 0x72, 0x6d, 0x32, 0xbd, 0x03, 0x0a, 0x08, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x12, 0x87, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x90, 0x01, 0x0a,
+	0x0f, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72,
+	0x12, 0x2f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c,
+	0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x61, 0x69, 0x6c,
+	0x6f, 0x76, 0x65, 0x72, 0x3a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12,
+	0x80, 0x01, 0x0a, 0x07, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x12, 0x27, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46,
+	0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x24, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66,
+	0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x3a, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x3a,
+	0x01, 0x2a, 0x1a, 0x11, 0xca, 0x41, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74,
+	0x3a, 0x37, 0x34, 0x36, 0x39, 0x42, 0x71, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x61,
+	0x70, 0x69, 0x63, 0x2d, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xea, 0x02, 0x19, 0x47,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x3a,
+	0x3a, 0x56, 0x31, 0x42, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_google_showcase_v1beta1_failover_proto_rawDescOnce sync.Once
+	file_google_showcase_v1beta1_failover_proto_rawDescData = file_google_showcase_v1beta1_failover_proto_rawDesc
+)
+
+func file_google_showcase_v1beta1_failover_proto_rawDescGZIP() []byte {
+	file_google_showcase_v1beta1_failover_proto_rawDescOnce.Do(func() {
+		file_google_showcase_v1beta1_failover_proto_rawDescData = protoimpl.X.CompressGZIP(file_google_showcase_v1beta1_failover_proto_rawDescData)
+	})
+	return file_google_showcase_v1beta1_failover_proto_rawDescData
+}
+
+var file_google_showcase_v1beta1_failover_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_google_showcase_v1beta1_failover_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_google_showcase_v1beta1_failover_proto_goTypes = []interface{}{
+	(FailoverState_Role)(0),         // 0: google.showcase.v1beta1.FailoverState.Role
+	(*FailoverState)(nil),           // 1: google.showcase.v1beta1.FailoverState
+	(*GetFailoverStateRequest)(nil), // 2: google.showcase.v1beta1.GetFailoverStateRequest
+	(*TriggerFailoverRequest)(nil),  // 3: google.showcase.v1beta1.TriggerFailoverRequest
+	(*HandoffRequest)(nil),          // 4: google.showcase.v1beta1.HandoffRequest
+}
+var file_google_showcase_v1beta1_failover_proto_depIdxs = []int32{
+	0, // 0: google.showcase.v1beta1.FailoverState.role:type_name -> google.showcase.v1beta1.FailoverState.Role
+	2, // 1: google.showcase.v1beta1.Failover.GetFailoverState:input_type -> google.showcase.v1beta1.GetFailoverStateRequest
+	3, // 2: google.showcase.v1beta1.Failover.TriggerFailover:input_type -> google.showcase.v1beta1.TriggerFailoverRequest
+	4, // 3: google.showcase.v1beta1.Failover.Handoff:input_type -> google.showcase.v1beta1.HandoffRequest
+	1, // 4: google.showcase.v1beta1.Failover.GetFailoverState:output_type -> google.showcase.v1beta1.FailoverState
+	1, // 5: google.showcase.v1beta1.Failover.TriggerFailover:output_type -> google.showcase.v1beta1.FailoverState
+	1, // 6: google.showcase.v1beta1.Failover.Handoff:output_type -> google.showcase.v1beta1.FailoverState
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_google_showcase_v1beta1_failover_proto_init() }
+func file_google_showcase_v1beta1_failover_proto_init() {
+	if File_google_showcase_v1beta1_failover_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_google_showcase_v1beta1_failover_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailoverState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_failover_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFailoverStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_failover_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerFailoverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_failover_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandoffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_google_showcase_v1beta1_failover_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_google_showcase_v1beta1_failover_proto_goTypes,
+		DependencyIndexes: file_google_showcase_v1beta1_failover_proto_depIdxs,
+		EnumInfos:         file_google_showcase_v1beta1_failover_proto_enumTypes,
+		MessageInfos:      file_google_showcase_v1beta1_failover_proto_msgTypes,
+	}.Build()
+	File_google_showcase_v1beta1_failover_proto = out.File
+	file_google_showcase_v1beta1_failover_proto_rawDesc = nil
+	file_google_showcase_v1beta1_failover_proto_goTypes = nil
+	file_google_showcase_v1beta1_failover_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// FailoverClient is the client API for Failover service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FailoverClient interface {
+	// Gets the failover state of this server.
+	GetFailoverState(ctx context.Context, in *GetFailoverStateRequest, opts ...grpc.CallOption) (*FailoverState, error)
+	// Makes this server, which must be the leader, hand off leadership to its
+	// peer and become the standby.
+	TriggerFailover(ctx context.Context, in *TriggerFailoverRequest, opts ...grpc.CallOption) (*FailoverState, error)
+	// Makes this server the leader. This is called by the leader on its peer
+	// while failing over.
+	Handoff(ctx context.Context, in *HandoffRequest, opts ...grpc.CallOption) (*FailoverState, error)
+}
+
+type failoverClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFailoverClient(cc grpc.ClientConnInterface) FailoverClient {
+	return &failoverClient{cc}
+}
+
+func (c *failoverClient) GetFailoverState(ctx context.Context, in *GetFailoverStateRequest, opts ...grpc.CallOption) (*FailoverState, error) {
+	out := new(FailoverState)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Failover/GetFailoverState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *failoverClient) TriggerFailover(ctx context.Context, in *TriggerFailoverRequest, opts ...grpc.CallOption) (*FailoverState, error) {
+	out := new(FailoverState)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Failover/TriggerFailover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *failoverClient) Handoff(ctx context.Context, in *HandoffRequest, opts ...grpc.CallOption) (*FailoverState, error) {
+	out := new(FailoverState)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Failover/Handoff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FailoverServer is the server API for Failover service.
+type FailoverServer interface {
+	// Gets the failover state of this server.
+	GetFailoverState(context.Context, *GetFailoverStateRequest) (*FailoverState, error)
+	// Makes this server, which must be the leader, hand off leadership to its
+	// peer and become the standby.
+	TriggerFailover(context.Context, *TriggerFailoverRequest) (*FailoverState, error)
+	// Makes this server the leader. This is called by the leader on its peer
+	// while failing over.
+	Handoff(context.Context, *HandoffRequest) (*FailoverState, error)
+}
+
+// UnimplementedFailoverServer can be embedded to have forward compatible implementations.
+type UnimplementedFailoverServer struct {
+}
+
+func (*UnimplementedFailoverServer) GetFailoverState(context.Context, *GetFailoverStateRequest) (*FailoverState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFailoverState not implemented")
+}
+func (*UnimplementedFailoverServer) TriggerFailover(context.Context, *TriggerFailoverRequest) (*FailoverState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerFailover not implemented")
+}
+func (*UnimplementedFailoverServer) Handoff(context.Context, *HandoffRequest) (*FailoverState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Handoff not implemented")
+}
+
+func RegisterFailoverServer(s *grpc.Server, srv FailoverServer) {
+	s.RegisterService(&_Failover_serviceDesc, srv)
+}
+
+func _Failover_GetFailoverState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFailoverStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FailoverServer).GetFailoverState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Failover/GetFailoverState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FailoverServer).GetFailoverState(ctx, req.(*GetFailoverStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Failover_TriggerFailover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerFailoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FailoverServer).TriggerFailover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Failover/TriggerFailover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FailoverServer).TriggerFailover(ctx, req.(*TriggerFailoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Failover_Handoff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandoffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FailoverServer).Handoff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Failover/Handoff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FailoverServer).Handoff(ctx, req.(*HandoffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Failover_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "google.showcase.v1beta1.Failover",
+	HandlerType: (*FailoverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFailoverState",
+			Handler:    _Failover_GetFailoverState_Handler,
+		},
+		{
+			MethodName: "TriggerFailover",
+			Handler:    _Failover_TriggerFailover_Handler,
+		},
+		{
+			MethodName: "Handoff",
+			Handler:    _Failover_Handoff_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "google/showcase/v1beta1/failover.proto",
+}
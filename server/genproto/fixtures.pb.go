@@ -0,0 +1,1117 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.15.0
+// source: google/showcase/v1beta1/fixtures.proto
+
+package genproto
+
+import (
+	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A part of the server state.
+type ResetStateRequest_Scope int32
+
+const (
+	ResetStateRequest_SCOPE_UNSPECIFIED ResetStateRequest_Scope = 0
+	// The users of the Identity service. Resetting it but not MESSAGING
+	// leaves the blurbs of deleted users in place.
+	ResetStateRequest_IDENTITY ResetStateRequest_Scope = 1
+	// The rooms and blurbs of the Messaging service.
+	ResetStateRequest_MESSAGING ResetStateRequest_Scope = 2
+	// The sequences of the SequenceService and their reports.
+	ResetStateRequest_SEQUENCES ResetStateRequest_Scope = 3
+	// The long-running operations. They are currently encoded in their names,
+	// so there is no server state to discard.
+	ResetStateRequest_OPERATIONS ResetStateRequest_Scope = 4
+	// The sessions of the Testing service and the calls they recorded.
+	ResetStateRequest_CALLS ResetStateRequest_Scope = 5
+)
+
+// Enum value maps for ResetStateRequest_Scope.
+var (
+	ResetStateRequest_Scope_name = map[int32]string{
+		0: "SCOPE_UNSPECIFIED",
+		1: "IDENTITY",
+		2: "MESSAGING",
+		3: "SEQUENCES",
+		4: "OPERATIONS",
+		5: "CALLS",
+	}
+	ResetStateRequest_Scope_value = map[string]int32{
+		"SCOPE_UNSPECIFIED": 0,
+		"IDENTITY":          1,
+		"MESSAGING":         2,
+		"SEQUENCES":         3,
+		"OPERATIONS":        4,
+		"CALLS":             5,
+	}
+)
+
+func (x ResetStateRequest_Scope) Enum() *ResetStateRequest_Scope {
+	p := new(ResetStateRequest_Scope)
+	*p = x
+	return p
+}
+
+func (x ResetStateRequest_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResetStateRequest_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_google_showcase_v1beta1_fixtures_proto_enumTypes[0].Descriptor()
+}
+
+func (ResetStateRequest_Scope) Type() protoreflect.EnumType {
+	return &file_google_showcase_v1beta1_fixtures_proto_enumTypes[0]
+}
+
+func (x ResetStateRequest_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResetStateRequest_Scope.Descriptor instead.
+func (ResetStateRequest_Scope) EnumDescriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_fixtures_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// The request message for the CreateFixtures method.
+type CreateFixturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of users to create.
+	UserCount int32 `protobuf:"varint,1,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	// The template of the users to create.
+	UserTemplate *User `protobuf:"bytes,2,opt,name=user_template,json=userTemplate,proto3" json:"user_template,omitempty"`
+	// The number of rooms to create.
+	RoomCount int32 `protobuf:"varint,3,opt,name=room_count,json=roomCount,proto3" json:"room_count,omitempty"`
+	// The template of the rooms to create.
+	RoomTemplate *Room `protobuf:"bytes,4,opt,name=room_template,json=roomTemplate,proto3" json:"room_template,omitempty"`
+	// The number of blurbs to create in each room created.
+	BlurbsPerRoom int32 `protobuf:"varint,5,opt,name=blurbs_per_room,json=blurbsPerRoom,proto3" json:"blurbs_per_room,omitempty"`
+	// The number of blurbs to create in the profile of each user created.
+	BlurbsPerProfile int32 `protobuf:"varint,6,opt,name=blurbs_per_profile,json=blurbsPerProfile,proto3" json:"blurbs_per_profile,omitempty"`
+	// The template of the blurbs to create. If its user is not set, the blurbs
+	// posted in rooms are attributed to the users created in turn, and those
+	// posted in profiles to the owner of the profile.
+	BlurbTemplate *Blurb `protobuf:"bytes,7,opt,name=blurb_template,json=blurbTemplate,proto3" json:"blurb_template,omitempty"`
+}
+
+func (x *CreateFixturesRequest) Reset() {
+	*x = CreateFixturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFixturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFixturesRequest) ProtoMessage() {}
+
+func (x *CreateFixturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFixturesRequest.ProtoReflect.Descriptor instead.
+func (*CreateFixturesRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_fixtures_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateFixturesRequest) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *CreateFixturesRequest) GetUserTemplate() *User {
+	if x != nil {
+		return x.UserTemplate
+	}
+	return nil
+}
+
+func (x *CreateFixturesRequest) GetRoomCount() int32 {
+	if x != nil {
+		return x.RoomCount
+	}
+	return 0
+}
+
+func (x *CreateFixturesRequest) GetRoomTemplate() *Room {
+	if x != nil {
+		return x.RoomTemplate
+	}
+	return nil
+}
+
+func (x *CreateFixturesRequest) GetBlurbsPerRoom() int32 {
+	if x != nil {
+		return x.BlurbsPerRoom
+	}
+	return 0
+}
+
+func (x *CreateFixturesRequest) GetBlurbsPerProfile() int32 {
+	if x != nil {
+		return x.BlurbsPerProfile
+	}
+	return 0
+}
+
+func (x *CreateFixturesRequest) GetBlurbTemplate() *Blurb {
+	if x != nil {
+		return x.BlurbTemplate
+	}
+	return nil
+}
+
+// The response message for the CreateFixtures method.
+type CreateFixturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The names of the users created.
+	Users []string `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// The names of the rooms created.
+	Rooms []string `protobuf:"bytes,2,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	// The names of the blurbs created.
+	Blurbs []string `protobuf:"bytes,3,rep,name=blurbs,proto3" json:"blurbs,omitempty"`
+}
+
+func (x *CreateFixturesResponse) Reset() {
+	*x = CreateFixturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFixturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFixturesResponse) ProtoMessage() {}
+
+func (x *CreateFixturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFixturesResponse.ProtoReflect.Descriptor instead.
+func (*CreateFixturesResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_fixtures_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateFixturesResponse) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *CreateFixturesResponse) GetRooms() []string {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *CreateFixturesResponse) GetBlurbs() []string {
+	if x != nil {
+		return x.Blurbs
+	}
+	return nil
+}
+
+// The request message for the ResetState method.
+type ResetStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The scopes to reset. All of them are reset if none is given.
+	Scopes []ResetStateRequest_Scope `protobuf:"varint,1,rep,packed,name=scopes,proto3,enum=google.showcase.v1beta1.ResetStateRequest_Scope" json:"scopes,omitempty"`
+}
+
+func (x *ResetStateRequest) Reset() {
+	*x = ResetStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetStateRequest) ProtoMessage() {}
+
+func (x *ResetStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetStateRequest.ProtoReflect.Descriptor instead.
+func (*ResetStateRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_fixtures_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResetStateRequest) GetScopes() []ResetStateRequest_Scope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// The response message for the ResetState method.
+type ResetStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The scopes that were reset.
+	Scopes []ResetStateRequest_Scope `protobuf:"varint,1,rep,packed,name=scopes,proto3,enum=google.showcase.v1beta1.ResetStateRequest_Scope" json:"scopes,omitempty"`
+}
+
+func (x *ResetStateResponse) Reset() {
+	*x = ResetStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetStateResponse) ProtoMessage() {}
+
+func (x *ResetStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetStateResponse.ProtoReflect.Descriptor instead.
+func (*ResetStateResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_fixtures_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResetStateResponse) GetScopes() []ResetStateRequest_Scope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// Background mutations of users and rooms, contending with the updates
+// clients make.
+type Contention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the contention, of the form "contentions/{id}".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The resource names of the users and rooms mutated.
+	Resources []string `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
+	// How many times per second each resource is mutated.
+	MutationsPerSecond float64 `protobuf:"fixed64,3,opt,name=mutations_per_second,json=mutationsPerSecond,proto3" json:"mutations_per_second,omitempty"`
+	// The time at which the mutations stop, if set.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The number of mutations made so far.
+	MutationCount int64 `protobuf:"varint,5,opt,name=mutation_count,json=mutationCount,proto3" json:"mutation_count,omitempty"`
+	// Whether the resources are still being mutated.
+	Active bool `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *Contention) Reset() {
+	*x = Contention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Contention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contention) ProtoMessage() {}
+
+func (x *Contention) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contention.ProtoReflect.Descriptor instead.
+func (*Contention) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_fixtures_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Contention) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contention) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *Contention) GetMutationsPerSecond() float64 {
+	if x != nil {
+		return x.MutationsPerSecond
+	}
+	return 0
+}
+
+func (x *Contention) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *Contention) GetMutationCount() int64 {
+	if x != nil {
+		return x.MutationCount
+	}
+	return 0
+}
+
+func (x *Contention) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// The request message for the StartContention method.
+type StartContentionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource names of the users and rooms to mutate. Each must exist.
+	Resources []string `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	// How many times per second to mutate each resource, up to 1000.
+	MutationsPerSecond float64 `protobuf:"fixed64,2,opt,name=mutations_per_second,json=mutationsPerSecond,proto3" json:"mutations_per_second,omitempty"`
+	// How long to mutate the resources for. If unset, they are mutated until
+	// the contention is stopped.
+	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *StartContentionRequest) Reset() {
+	*x = StartContentionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartContentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartContentionRequest) ProtoMessage() {}
+
+func (x *StartContentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartContentionRequest.ProtoReflect.Descriptor instead.
+func (*StartContentionRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_fixtures_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StartContentionRequest) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *StartContentionRequest) GetMutationsPerSecond() float64 {
+	if x != nil {
+		return x.MutationsPerSecond
+	}
+	return 0
+}
+
+func (x *StartContentionRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// The request message for the StopContention method.
+type StopContentionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the contention to stop.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *StopContentionRequest) Reset() {
+	*x = StopContentionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopContentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopContentionRequest) ProtoMessage() {}
+
+func (x *StopContentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_fixtures_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopContentionRequest.ProtoReflect.Descriptor instead.
+func (*StopContentionRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_fixtures_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StopContentionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_google_showcase_v1beta1_fixtures_proto protoreflect.FileDescriptor
+
+var file_google_showcase_v1beta1_fixtures_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x27, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x02, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f,
+	0x6f, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x0c, 0x72,
+	0x6f, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62,
+	0x6c, 0x75, 0x72, 0x62, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x50, 0x65, 0x72, 0x52,
+	0x6f, 0x6f, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x50, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x45, 0x0a, 0x0e, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x52, 0x0d, 0x62, 0x6c, 0x75, 0x72, 0x62,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x5c, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x06,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49,
+	0x54, 0x59, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x49, 0x4e,
+	0x47, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x53,
+	0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53,
+	0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x41, 0x4c, 0x4c, 0x53, 0x10, 0x05, 0x22, 0x5e, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0xec, 0x01,
+	0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x30,
+	0x0a, 0x14, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6d, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xa9, 0x01, 0x0a,
+	0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
+	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x14, 0x6d, 0x75,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x12, 0x6d,
+	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0xdd, 0x04, 0x0a, 0x08, 0x46,
+	0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x69,
+	0x78, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x89, 0x01, 0x0a, 0x0a, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0x94, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74,
+	0x6f, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x22, 0x22, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x3d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x3a,
+	0x73, 0x74, 0x6f, 0x70, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0xca, 0x41, 0x0e, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x37, 0x34, 0x36, 0x39, 0x42, 0x71, 0x0a, 0x1b, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70,
+	0x69, 0x73, 0x2f, 0x67, 0x61, 0x70, 0x69, 0x63, 0x2d, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0xea, 0x02, 0x19, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x42, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_google_showcase_v1beta1_fixtures_proto_rawDescOnce sync.Once
+	file_google_showcase_v1beta1_fixtures_proto_rawDescData = file_google_showcase_v1beta1_fixtures_proto_rawDesc
+)
+
+func file_google_showcase_v1beta1_fixtures_proto_rawDescGZIP() []byte {
+	file_google_showcase_v1beta1_fixtures_proto_rawDescOnce.Do(func() {
+		file_google_showcase_v1beta1_fixtures_proto_rawDescData = protoimpl.X.CompressGZIP(file_google_showcase_v1beta1_fixtures_proto_rawDescData)
+	})
+	return file_google_showcase_v1beta1_fixtures_proto_rawDescData
+}
+
+var file_google_showcase_v1beta1_fixtures_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_google_showcase_v1beta1_fixtures_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_google_showcase_v1beta1_fixtures_proto_goTypes = []interface{}{
+	(ResetStateRequest_Scope)(0),   // 0: google.showcase.v1beta1.ResetStateRequest.Scope
+	(*CreateFixturesRequest)(nil),  // 1: google.showcase.v1beta1.CreateFixturesRequest
+	(*CreateFixturesResponse)(nil), // 2: google.showcase.v1beta1.CreateFixturesResponse
+	(*ResetStateRequest)(nil),      // 3: google.showcase.v1beta1.ResetStateRequest
+	(*ResetStateResponse)(nil),     // 4: google.showcase.v1beta1.ResetStateResponse
+	(*Contention)(nil),             // 5: google.showcase.v1beta1.Contention
+	(*StartContentionRequest)(nil), // 6: google.showcase.v1beta1.StartContentionRequest
+	(*StopContentionRequest)(nil),  // 7: google.showcase.v1beta1.StopContentionRequest
+	(*User)(nil),                   // 8: google.showcase.v1beta1.User
+	(*Room)(nil),                   // 9: google.showcase.v1beta1.Room
+	(*Blurb)(nil),                  // 10: google.showcase.v1beta1.Blurb
+	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 12: google.protobuf.Duration
+}
+var file_google_showcase_v1beta1_fixtures_proto_depIdxs = []int32{
+	8,  // 0: google.showcase.v1beta1.CreateFixturesRequest.user_template:type_name -> google.showcase.v1beta1.User
+	9,  // 1: google.showcase.v1beta1.CreateFixturesRequest.room_template:type_name -> google.showcase.v1beta1.Room
+	10, // 2: google.showcase.v1beta1.CreateFixturesRequest.blurb_template:type_name -> google.showcase.v1beta1.Blurb
+	0,  // 3: google.showcase.v1beta1.ResetStateRequest.scopes:type_name -> google.showcase.v1beta1.ResetStateRequest.Scope
+	0,  // 4: google.showcase.v1beta1.ResetStateResponse.scopes:type_name -> google.showcase.v1beta1.ResetStateRequest.Scope
+	11, // 5: google.showcase.v1beta1.Contention.expire_time:type_name -> google.protobuf.Timestamp
+	12, // 6: google.showcase.v1beta1.StartContentionRequest.duration:type_name -> google.protobuf.Duration
+	1,  // 7: google.showcase.v1beta1.Fixtures.CreateFixtures:input_type -> google.showcase.v1beta1.CreateFixturesRequest
+	3,  // 8: google.showcase.v1beta1.Fixtures.ResetState:input_type -> google.showcase.v1beta1.ResetStateRequest
+	6,  // 9: google.showcase.v1beta1.Fixtures.StartContention:input_type -> google.showcase.v1beta1.StartContentionRequest
+	7,  // 10: google.showcase.v1beta1.Fixtures.StopContention:input_type -> google.showcase.v1beta1.StopContentionRequest
+	2,  // 11: google.showcase.v1beta1.Fixtures.CreateFixtures:output_type -> google.showcase.v1beta1.CreateFixturesResponse
+	4,  // 12: google.showcase.v1beta1.Fixtures.ResetState:output_type -> google.showcase.v1beta1.ResetStateResponse
+	5,  // 13: google.showcase.v1beta1.Fixtures.StartContention:output_type -> google.showcase.v1beta1.Contention
+	5,  // 14: google.showcase.v1beta1.Fixtures.StopContention:output_type -> google.showcase.v1beta1.Contention
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_google_showcase_v1beta1_fixtures_proto_init() }
+func file_google_showcase_v1beta1_fixtures_proto_init() {
+	if File_google_showcase_v1beta1_fixtures_proto != nil {
+		return
+	}
+	file_google_showcase_v1beta1_identity_proto_init()
+	file_google_showcase_v1beta1_messaging_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_google_showcase_v1beta1_fixtures_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFixturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_fixtures_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFixturesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_fixtures_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_fixtures_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_fixtures_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Contention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_fixtures_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartContentionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_fixtures_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopContentionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_google_showcase_v1beta1_fixtures_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_google_showcase_v1beta1_fixtures_proto_goTypes,
+		DependencyIndexes: file_google_showcase_v1beta1_fixtures_proto_depIdxs,
+		EnumInfos:         file_google_showcase_v1beta1_fixtures_proto_enumTypes,
+		MessageInfos:      file_google_showcase_v1beta1_fixtures_proto_msgTypes,
+	}.Build()
+	File_google_showcase_v1beta1_fixtures_proto = out.File
+	file_google_showcase_v1beta1_fixtures_proto_rawDesc = nil
+	file_google_showcase_v1beta1_fixtures_proto_goTypes = nil
+	file_google_showcase_v1beta1_fixtures_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// FixturesClient is the client API for Fixtures service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FixturesClient interface {
+	// Creates users, rooms, and blurbs from templates. Every occurrence of
+	// "{index}" in the string fields of a template is replaced with the
+	// zero-based index of the resource being created, so that fields which
+	// must be unique, such as a user's email, can be. If creating a resource
+	// fails, the error details include a CreateFixturesResponse listing the
+	// resources created until then.
+	CreateFixtures(ctx context.Context, in *CreateFixturesRequest, opts ...grpc.CallOption) (*CreateFixturesResponse, error)
+	// Discards the state of the server in the given scopes, as if it had just
+	// started. Resource IDs keep increasing, so that the names of discarded
+	// resources are not reused.
+	ResetState(ctx context.Context, in *ResetStateRequest, opts ...grpc.CallOption) (*ResetStateResponse, error)
+	// Starts mutating users and rooms in the background at a steady rate, each
+	// mutation changing their etag, so that the clients retrying the updates
+	// aborted by concurrent modifications face genuine contention.
+	StartContention(ctx context.Context, in *StartContentionRequest, opts ...grpc.CallOption) (*Contention, error)
+	// Stops the mutations of a contention.
+	StopContention(ctx context.Context, in *StopContentionRequest, opts ...grpc.CallOption) (*Contention, error)
+}
+
+type fixturesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFixturesClient(cc grpc.ClientConnInterface) FixturesClient {
+	return &fixturesClient{cc}
+}
+
+func (c *fixturesClient) CreateFixtures(ctx context.Context, in *CreateFixturesRequest, opts ...grpc.CallOption) (*CreateFixturesResponse, error) {
+	out := new(CreateFixturesResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Fixtures/CreateFixtures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fixturesClient) ResetState(ctx context.Context, in *ResetStateRequest, opts ...grpc.CallOption) (*ResetStateResponse, error) {
+	out := new(ResetStateResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Fixtures/ResetState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fixturesClient) StartContention(ctx context.Context, in *StartContentionRequest, opts ...grpc.CallOption) (*Contention, error) {
+	out := new(Contention)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Fixtures/StartContention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fixturesClient) StopContention(ctx context.Context, in *StopContentionRequest, opts ...grpc.CallOption) (*Contention, error) {
+	out := new(Contention)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Fixtures/StopContention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FixturesServer is the server API for Fixtures service.
+type FixturesServer interface {
+	// Creates users, rooms, and blurbs from templates. Every occurrence of
+	// "{index}" in the string fields of a template is replaced with the
+	// zero-based index of the resource being created, so that fields which
+	// must be unique, such as a user's email, can be. If creating a resource
+	// fails, the error details include a CreateFixturesResponse listing the
+	// resources created until then.
+	CreateFixtures(context.Context, *CreateFixturesRequest) (*CreateFixturesResponse, error)
+	// Discards the state of the server in the given scopes, as if it had just
+	// started. Resource IDs keep increasing, so that the names of discarded
+	// resources are not reused.
+	ResetState(context.Context, *ResetStateRequest) (*ResetStateResponse, error)
+	// Starts mutating users and rooms in the background at a steady rate, each
+	// mutation changing their etag, so that the clients retrying the updates
+	// aborted by concurrent modifications face genuine contention.
+	StartContention(context.Context, *StartContentionRequest) (*Contention, error)
+	// Stops the mutations of a contention.
+	StopContention(context.Context, *StopContentionRequest) (*Contention, error)
+}
+
+// UnimplementedFixturesServer can be embedded to have forward compatible implementations.
+type UnimplementedFixturesServer struct {
+}
+
+func (*UnimplementedFixturesServer) CreateFixtures(context.Context, *CreateFixturesRequest) (*CreateFixturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFixtures not implemented")
+}
+func (*UnimplementedFixturesServer) ResetState(context.Context, *ResetStateRequest) (*ResetStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetState not implemented")
+}
+func (*UnimplementedFixturesServer) StartContention(context.Context, *StartContentionRequest) (*Contention, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartContention not implemented")
+}
+func (*UnimplementedFixturesServer) StopContention(context.Context, *StopContentionRequest) (*Contention, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopContention not implemented")
+}
+
+func RegisterFixturesServer(s *grpc.Server, srv FixturesServer) {
+	s.RegisterService(&_Fixtures_serviceDesc, srv)
+}
+
+func _Fixtures_CreateFixtures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFixturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FixturesServer).CreateFixtures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Fixtures/CreateFixtures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FixturesServer).CreateFixtures(ctx, req.(*CreateFixturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fixtures_ResetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FixturesServer).ResetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Fixtures/ResetState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FixturesServer).ResetState(ctx, req.(*ResetStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fixtures_StartContention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartContentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FixturesServer).StartContention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Fixtures/StartContention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FixturesServer).StartContention(ctx, req.(*StartContentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fixtures_StopContention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopContentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FixturesServer).StopContention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Fixtures/StopContention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FixturesServer).StopContention(ctx, req.(*StopContentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Fixtures_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "google.showcase.v1beta1.Fixtures",
+	HandlerType: (*FixturesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFixtures",
+			Handler:    _Fixtures_CreateFixtures_Handler,
+		},
+		{
+			MethodName: "ResetState",
+			Handler:    _Fixtures_ResetState_Handler,
+		},
+		{
+			MethodName: "StartContention",
+			Handler:    _Fixtures_StartContention_Handler,
+		},
+		{
+			MethodName: "StopContention",
+			Handler:    _Fixtures_StopContention_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "google/showcase/v1beta1/fixtures.proto",
+}
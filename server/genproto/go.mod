@@ -0,0 +1,9 @@
+module github.com/googleapis/gapic-showcase/server/genproto
+
+go 1.16
+
+require (
+	google.golang.org/genproto v0.0.0-20211118181313-81c1377c94b1
+	google.golang.org/grpc v1.40.0
+	google.golang.org/protobuf v1.27.1
+)
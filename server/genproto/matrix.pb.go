@@ -0,0 +1,1034 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.15.0
+// source: google/showcase/v1beta1/matrix.proto
+
+package genproto
+
+import (
+	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	longrunning "google.golang.org/genproto/googleapis/longrunning"
+	status "google.golang.org/genproto/googleapis/rpc/status"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status1 "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The request message of the methods of the Matrix service.
+type MatrixRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The content of the responses.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The number of responses to stream. Defaults to 1.
+	ResponseCount int32 `protobuf:"varint,2,opt,name=response_count,json=responseCount,proto3" json:"response_count,omitempty"`
+	// The error to fail the call with instead of responding.
+	Error *status.Status `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MatrixRequest) Reset() {
+	*x = MatrixRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_matrix_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixRequest) ProtoMessage() {}
+
+func (x *MatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_matrix_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixRequest.ProtoReflect.Descriptor instead.
+func (*MatrixRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_matrix_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MatrixRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MatrixRequest) GetResponseCount() int32 {
+	if x != nil {
+		return x.ResponseCount
+	}
+	return 0
+}
+
+func (x *MatrixRequest) GetError() *status.Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// The response message of the methods of the Matrix service.
+type MatrixResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The content of the request.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The position of the response among the responses to the same request,
+	// starting at 0.
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// The full name of the method called, such as
+	// "google.showcase.v1beta1.Matrix.Unary".
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *MatrixResponse) Reset() {
+	*x = MatrixResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_matrix_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixResponse) ProtoMessage() {}
+
+func (x *MatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_matrix_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixResponse.ProtoReflect.Descriptor instead.
+func (*MatrixResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_matrix_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MatrixResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MatrixResponse) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *MatrixResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+// The metadata of the operations started by the LongRunning method.
+type MatrixMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The full name of the method that started the operation.
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *MatrixMetadata) Reset() {
+	*x = MatrixMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_matrix_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatrixMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixMetadata) ProtoMessage() {}
+
+func (x *MatrixMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_matrix_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixMetadata.ProtoReflect.Descriptor instead.
+func (*MatrixMetadata) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_matrix_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MatrixMetadata) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+// The request message for the PagedList method.
+type PagedListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The content of the responses.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The number of responses to list. Defaults to 1.
+	ResponseCount int32 `protobuf:"varint,2,opt,name=response_count,json=responseCount,proto3" json:"response_count,omitempty"`
+	// The error to fail the call with instead of responding.
+	Error *status.Status `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// The maximum number of responses to return in a page.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The value of next_page_token of the previous page.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *PagedListRequest) Reset() {
+	*x = PagedListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_matrix_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PagedListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagedListRequest) ProtoMessage() {}
+
+func (x *PagedListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_matrix_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagedListRequest.ProtoReflect.Descriptor instead.
+func (*PagedListRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_matrix_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PagedListRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PagedListRequest) GetResponseCount() int32 {
+	if x != nil {
+		return x.ResponseCount
+	}
+	return 0
+}
+
+func (x *PagedListRequest) GetError() *status.Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *PagedListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PagedListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// The response message for the PagedList method.
+type PagedListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A page of responses.
+	Responses []*MatrixResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	// The token to retrieve the next page of responses, or empty if there are
+	// no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *PagedListResponse) Reset() {
+	*x = PagedListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_matrix_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PagedListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagedListResponse) ProtoMessage() {}
+
+func (x *PagedListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_matrix_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagedListResponse.ProtoReflect.Descriptor instead.
+func (*PagedListResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_matrix_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PagedListResponse) GetResponses() []*MatrixResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *PagedListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_google_showcase_v1beta1_matrix_proto protoreflect.FileDescriptor
+
+var file_google_showcase_v1beta1_matrix_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x6c,
+	0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x7a, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x58, 0x0a,
+	0x0e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x28, 0x0a, 0x0e, 0x4d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x22, 0xb9, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x67, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x82, 0x01,
+	0x0a, 0x11, 0x50, 0x61, 0x67, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x32, 0x87, 0x07, 0x0a, 0x06, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x7a, 0x0a,
+	0x05, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22,
+	0x15, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x3a, 0x75, 0x6e, 0x61, 0x72, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x09, 0x4e, 0x6f, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x3a,
+	0x6e, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x8a, 0x01, 0x0a,
+	0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x26, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x3a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x0c, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x61, 0x0a, 0x0a,
+	0x42, 0x69, 0x64, 0x69, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x9f, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x3a,
+	0x6c, 0x6f, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x3a, 0x01, 0x2a, 0xca, 0x41,
+	0x20, 0x0a, 0x0e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x85, 0x01, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x29, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x3a,
+	0x70, 0x61, 0x67, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x11, 0xca, 0x41, 0x0e, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x37, 0x34, 0x36, 0x39, 0x42, 0x71, 0x0a, 0x1b,
+	0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x50, 0x01, 0x5a, 0x34, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x61, 0x70, 0x69, 0x63, 0x2d, 0x73, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0xea, 0x02, 0x19, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x68,
+	0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x42, 0x65, 0x74, 0x61, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_google_showcase_v1beta1_matrix_proto_rawDescOnce sync.Once
+	file_google_showcase_v1beta1_matrix_proto_rawDescData = file_google_showcase_v1beta1_matrix_proto_rawDesc
+)
+
+func file_google_showcase_v1beta1_matrix_proto_rawDescGZIP() []byte {
+	file_google_showcase_v1beta1_matrix_proto_rawDescOnce.Do(func() {
+		file_google_showcase_v1beta1_matrix_proto_rawDescData = protoimpl.X.CompressGZIP(file_google_showcase_v1beta1_matrix_proto_rawDescData)
+	})
+	return file_google_showcase_v1beta1_matrix_proto_rawDescData
+}
+
+var file_google_showcase_v1beta1_matrix_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_google_showcase_v1beta1_matrix_proto_goTypes = []interface{}{
+	(*MatrixRequest)(nil),         // 0: google.showcase.v1beta1.MatrixRequest
+	(*MatrixResponse)(nil),        // 1: google.showcase.v1beta1.MatrixResponse
+	(*MatrixMetadata)(nil),        // 2: google.showcase.v1beta1.MatrixMetadata
+	(*PagedListRequest)(nil),      // 3: google.showcase.v1beta1.PagedListRequest
+	(*PagedListResponse)(nil),     // 4: google.showcase.v1beta1.PagedListResponse
+	(*status.Status)(nil),         // 5: google.rpc.Status
+	(*emptypb.Empty)(nil),         // 6: google.protobuf.Empty
+	(*longrunning.Operation)(nil), // 7: google.longrunning.Operation
+}
+var file_google_showcase_v1beta1_matrix_proto_depIdxs = []int32{
+	5,  // 0: google.showcase.v1beta1.MatrixRequest.error:type_name -> google.rpc.Status
+	5,  // 1: google.showcase.v1beta1.PagedListRequest.error:type_name -> google.rpc.Status
+	1,  // 2: google.showcase.v1beta1.PagedListResponse.responses:type_name -> google.showcase.v1beta1.MatrixResponse
+	0,  // 3: google.showcase.v1beta1.Matrix.Unary:input_type -> google.showcase.v1beta1.MatrixRequest
+	0,  // 4: google.showcase.v1beta1.Matrix.NoContent:input_type -> google.showcase.v1beta1.MatrixRequest
+	0,  // 5: google.showcase.v1beta1.Matrix.ServerStream:input_type -> google.showcase.v1beta1.MatrixRequest
+	0,  // 6: google.showcase.v1beta1.Matrix.ClientStream:input_type -> google.showcase.v1beta1.MatrixRequest
+	0,  // 7: google.showcase.v1beta1.Matrix.BidiStream:input_type -> google.showcase.v1beta1.MatrixRequest
+	0,  // 8: google.showcase.v1beta1.Matrix.LongRunning:input_type -> google.showcase.v1beta1.MatrixRequest
+	3,  // 9: google.showcase.v1beta1.Matrix.PagedList:input_type -> google.showcase.v1beta1.PagedListRequest
+	1,  // 10: google.showcase.v1beta1.Matrix.Unary:output_type -> google.showcase.v1beta1.MatrixResponse
+	6,  // 11: google.showcase.v1beta1.Matrix.NoContent:output_type -> google.protobuf.Empty
+	1,  // 12: google.showcase.v1beta1.Matrix.ServerStream:output_type -> google.showcase.v1beta1.MatrixResponse
+	1,  // 13: google.showcase.v1beta1.Matrix.ClientStream:output_type -> google.showcase.v1beta1.MatrixResponse
+	1,  // 14: google.showcase.v1beta1.Matrix.BidiStream:output_type -> google.showcase.v1beta1.MatrixResponse
+	7,  // 15: google.showcase.v1beta1.Matrix.LongRunning:output_type -> google.longrunning.Operation
+	4,  // 16: google.showcase.v1beta1.Matrix.PagedList:output_type -> google.showcase.v1beta1.PagedListResponse
+	10, // [10:17] is the sub-list for method output_type
+	3,  // [3:10] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_google_showcase_v1beta1_matrix_proto_init() }
+func file_google_showcase_v1beta1_matrix_proto_init() {
+	if File_google_showcase_v1beta1_matrix_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_google_showcase_v1beta1_matrix_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatrixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_matrix_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatrixResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_matrix_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatrixMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_matrix_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PagedListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_matrix_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PagedListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_google_showcase_v1beta1_matrix_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_google_showcase_v1beta1_matrix_proto_goTypes,
+		DependencyIndexes: file_google_showcase_v1beta1_matrix_proto_depIdxs,
+		MessageInfos:      file_google_showcase_v1beta1_matrix_proto_msgTypes,
+	}.Build()
+	File_google_showcase_v1beta1_matrix_proto = out.File
+	file_google_showcase_v1beta1_matrix_proto_rawDesc = nil
+	file_google_showcase_v1beta1_matrix_proto_goTypes = nil
+	file_google_showcase_v1beta1_matrix_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// MatrixClient is the client API for Matrix service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MatrixClient interface {
+	// Returns a single response.
+	Unary(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*MatrixResponse, error)
+	// Returns no content.
+	NoContent(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Streams response_count responses.
+	ServerStream(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (Matrix_ServerStreamClient, error)
+	// Returns a single response once the client is done streaming requests,
+	// whose content is the content of the requests separated by spaces.
+	ClientStream(ctx context.Context, opts ...grpc.CallOption) (Matrix_ClientStreamClient, error)
+	// Streams response_count responses to each request streamed by the client.
+	BidiStream(ctx context.Context, opts ...grpc.CallOption) (Matrix_BidiStreamClient, error)
+	// Starts a long-running operation, which is done right away with a single
+	// response as its result.
+	LongRunning(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
+	// Lists response_count responses, a page at a time.
+	PagedList(ctx context.Context, in *PagedListRequest, opts ...grpc.CallOption) (*PagedListResponse, error)
+}
+
+type matrixClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMatrixClient(cc grpc.ClientConnInterface) MatrixClient {
+	return &matrixClient{cc}
+}
+
+func (c *matrixClient) Unary(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*MatrixResponse, error) {
+	out := new(MatrixResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Matrix/Unary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matrixClient) NoContent(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Matrix/NoContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matrixClient) ServerStream(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (Matrix_ServerStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Matrix_serviceDesc.Streams[0], "/google.showcase.v1beta1.Matrix/ServerStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &matrixServerStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Matrix_ServerStreamClient interface {
+	Recv() (*MatrixResponse, error)
+	grpc.ClientStream
+}
+
+type matrixServerStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *matrixServerStreamClient) Recv() (*MatrixResponse, error) {
+	m := new(MatrixResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *matrixClient) ClientStream(ctx context.Context, opts ...grpc.CallOption) (Matrix_ClientStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Matrix_serviceDesc.Streams[1], "/google.showcase.v1beta1.Matrix/ClientStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &matrixClientStreamClient{stream}
+	return x, nil
+}
+
+type Matrix_ClientStreamClient interface {
+	Send(*MatrixRequest) error
+	CloseAndRecv() (*MatrixResponse, error)
+	grpc.ClientStream
+}
+
+type matrixClientStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *matrixClientStreamClient) Send(m *MatrixRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *matrixClientStreamClient) CloseAndRecv() (*MatrixResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(MatrixResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *matrixClient) BidiStream(ctx context.Context, opts ...grpc.CallOption) (Matrix_BidiStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Matrix_serviceDesc.Streams[2], "/google.showcase.v1beta1.Matrix/BidiStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &matrixBidiStreamClient{stream}
+	return x, nil
+}
+
+type Matrix_BidiStreamClient interface {
+	Send(*MatrixRequest) error
+	Recv() (*MatrixResponse, error)
+	grpc.ClientStream
+}
+
+type matrixBidiStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *matrixBidiStreamClient) Send(m *MatrixRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *matrixBidiStreamClient) Recv() (*MatrixResponse, error) {
+	m := new(MatrixResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *matrixClient) LongRunning(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Matrix/LongRunning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matrixClient) PagedList(ctx context.Context, in *PagedListRequest, opts ...grpc.CallOption) (*PagedListResponse, error) {
+	out := new(PagedListResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Matrix/PagedList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MatrixServer is the server API for Matrix service.
+type MatrixServer interface {
+	// Returns a single response.
+	Unary(context.Context, *MatrixRequest) (*MatrixResponse, error)
+	// Returns no content.
+	NoContent(context.Context, *MatrixRequest) (*emptypb.Empty, error)
+	// Streams response_count responses.
+	ServerStream(*MatrixRequest, Matrix_ServerStreamServer) error
+	// Returns a single response once the client is done streaming requests,
+	// whose content is the content of the requests separated by spaces.
+	ClientStream(Matrix_ClientStreamServer) error
+	// Streams response_count responses to each request streamed by the client.
+	BidiStream(Matrix_BidiStreamServer) error
+	// Starts a long-running operation, which is done right away with a single
+	// response as its result.
+	LongRunning(context.Context, *MatrixRequest) (*longrunning.Operation, error)
+	// Lists response_count responses, a page at a time.
+	PagedList(context.Context, *PagedListRequest) (*PagedListResponse, error)
+}
+
+// UnimplementedMatrixServer can be embedded to have forward compatible implementations.
+type UnimplementedMatrixServer struct {
+}
+
+func (*UnimplementedMatrixServer) Unary(context.Context, *MatrixRequest) (*MatrixResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Unary not implemented")
+}
+func (*UnimplementedMatrixServer) NoContent(context.Context, *MatrixRequest) (*emptypb.Empty, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method NoContent not implemented")
+}
+func (*UnimplementedMatrixServer) ServerStream(*MatrixRequest, Matrix_ServerStreamServer) error {
+	return status1.Errorf(codes.Unimplemented, "method ServerStream not implemented")
+}
+func (*UnimplementedMatrixServer) ClientStream(Matrix_ClientStreamServer) error {
+	return status1.Errorf(codes.Unimplemented, "method ClientStream not implemented")
+}
+func (*UnimplementedMatrixServer) BidiStream(Matrix_BidiStreamServer) error {
+	return status1.Errorf(codes.Unimplemented, "method BidiStream not implemented")
+}
+func (*UnimplementedMatrixServer) LongRunning(context.Context, *MatrixRequest) (*longrunning.Operation, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method LongRunning not implemented")
+}
+func (*UnimplementedMatrixServer) PagedList(context.Context, *PagedListRequest) (*PagedListResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method PagedList not implemented")
+}
+
+func RegisterMatrixServer(s *grpc.Server, srv MatrixServer) {
+	s.RegisterService(&_Matrix_serviceDesc, srv)
+}
+
+func _Matrix_Unary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatrixServer).Unary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Matrix/Unary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatrixServer).Unary(ctx, req.(*MatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Matrix_NoContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatrixServer).NoContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Matrix/NoContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatrixServer).NoContent(ctx, req.(*MatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Matrix_ServerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MatrixRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MatrixServer).ServerStream(m, &matrixServerStreamServer{stream})
+}
+
+type Matrix_ServerStreamServer interface {
+	Send(*MatrixResponse) error
+	grpc.ServerStream
+}
+
+type matrixServerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *matrixServerStreamServer) Send(m *MatrixResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Matrix_ClientStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MatrixServer).ClientStream(&matrixClientStreamServer{stream})
+}
+
+type Matrix_ClientStreamServer interface {
+	SendAndClose(*MatrixResponse) error
+	Recv() (*MatrixRequest, error)
+	grpc.ServerStream
+}
+
+type matrixClientStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *matrixClientStreamServer) SendAndClose(m *MatrixResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *matrixClientStreamServer) Recv() (*MatrixRequest, error) {
+	m := new(MatrixRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Matrix_BidiStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MatrixServer).BidiStream(&matrixBidiStreamServer{stream})
+}
+
+type Matrix_BidiStreamServer interface {
+	Send(*MatrixResponse) error
+	Recv() (*MatrixRequest, error)
+	grpc.ServerStream
+}
+
+type matrixBidiStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *matrixBidiStreamServer) Send(m *MatrixResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *matrixBidiStreamServer) Recv() (*MatrixRequest, error) {
+	m := new(MatrixRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Matrix_LongRunning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatrixServer).LongRunning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Matrix/LongRunning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatrixServer).LongRunning(ctx, req.(*MatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Matrix_PagedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PagedListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatrixServer).PagedList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Matrix/PagedList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatrixServer).PagedList(ctx, req.(*PagedListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Matrix_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "google.showcase.v1beta1.Matrix",
+	HandlerType: (*MatrixServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Unary",
+			Handler:    _Matrix_Unary_Handler,
+		},
+		{
+			MethodName: "NoContent",
+			Handler:    _Matrix_NoContent_Handler,
+		},
+		{
+			MethodName: "LongRunning",
+			Handler:    _Matrix_LongRunning_Handler,
+		},
+		{
+			MethodName: "PagedList",
+			Handler:    _Matrix_PagedList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ServerStream",
+			Handler:       _Matrix_ServerStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ClientStream",
+			Handler:       _Matrix_ClientStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BidiStream",
+			Handler:       _Matrix_BidiStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "google/showcase/v1beta1/matrix.proto",
+}
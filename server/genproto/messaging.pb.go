@@ -44,6 +44,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The action that triggered the room to be returned.
+type WatchRoomResponse_Action int32
+
+const (
+	WatchRoomResponse_ACTION_UNSPECIFIED WatchRoomResponse_Action = 0
+	// Specifies that the room was created. Only emitted when resuming a stream
+	// from before the room's creation.
+	WatchRoomResponse_CREATE WatchRoomResponse_Action = 1
+	// Specifies that the room was updated.
+	WatchRoomResponse_UPDATE WatchRoomResponse_Action = 2
+	// Specifies that the room was deleted.
+	WatchRoomResponse_DELETE WatchRoomResponse_Action = 3
+)
+
+// Enum value maps for WatchRoomResponse_Action.
+var (
+	WatchRoomResponse_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "CREATE",
+		2: "UPDATE",
+		3: "DELETE",
+	}
+	WatchRoomResponse_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"CREATE":             1,
+		"UPDATE":             2,
+		"DELETE":             3,
+	}
+)
+
+func (x WatchRoomResponse_Action) Enum() *WatchRoomResponse_Action {
+	p := new(WatchRoomResponse_Action)
+	*p = x
+	return p
+}
+
+func (x WatchRoomResponse_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchRoomResponse_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_google_showcase_v1beta1_messaging_proto_enumTypes[0].Descriptor()
+}
+
+func (WatchRoomResponse_Action) Type() protoreflect.EnumType {
+	return &file_google_showcase_v1beta1_messaging_proto_enumTypes[0]
+}
+
+func (x WatchRoomResponse_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchRoomResponse_Action.Descriptor instead.
+func (WatchRoomResponse_Action) EnumDescriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{8, 0}
+}
+
 // The action that triggered the blurb to be returned.
 type StreamBlurbsResponse_Action int32
 
@@ -84,11 +141,11 @@ func (x StreamBlurbsResponse_Action) String() string {
 }
 
 func (StreamBlurbsResponse_Action) Descriptor() protoreflect.EnumDescriptor {
-	return file_google_showcase_v1beta1_messaging_proto_enumTypes[0].Descriptor()
+	return file_google_showcase_v1beta1_messaging_proto_enumTypes[1].Descriptor()
 }
 
 func (StreamBlurbsResponse_Action) Type() protoreflect.EnumType {
-	return &file_google_showcase_v1beta1_messaging_proto_enumTypes[0]
+	return &file_google_showcase_v1beta1_messaging_proto_enumTypes[1]
 }
 
 func (x StreamBlurbsResponse_Action) Number() protoreflect.EnumNumber {
@@ -97,7 +154,7 @@ func (x StreamBlurbsResponse_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StreamBlurbsResponse_Action.Descriptor instead.
 func (StreamBlurbsResponse_Action) EnumDescriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{18, 0}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{20, 0}
 }
 
 // A chat room.
@@ -116,6 +173,10 @@ type Room struct {
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// The latest timestamp at which the room was updated.
 	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// A checksum of the room's state, changing every time it is written. When
+	// set in an update, the update fails with ABORTED unless it is the room's
+	// current etag, so that concurrent modifications are not overwritten.
+	Etag string `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
 }
 
 func (x *Room) Reset() {
@@ -185,6 +246,13 @@ func (x *Room) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Room) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 // The request message for the google.showcase.v1beta1.Messaging\CreateRoom
 // method.
 type CreateRoomRequest struct {
@@ -519,6 +587,149 @@ func (x *ListRoomsResponse) GetNextPageToken() string {
 	return ""
 }
 
+// The request message for the google.showcase.v1beta1.Messaging\WatchRoom
+// method.
+type WatchRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resource name of the chat room to watch.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The value of google.showcase.v1beta1.WatchRoomResponse.resume_token
+	// received last from a previous call to
+	// `google.showcase.v1beta1.Messaging\WatchRoom`, to resume emitting the
+	// changes that followed it. If empty, only the changes made from now on are
+	// emitted.
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// The time at which this stream will close. If unset, it stays open until
+	// cancelled or the room is deleted.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+}
+
+func (x *WatchRoomRequest) Reset() {
+	*x = WatchRoomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRoomRequest) ProtoMessage() {}
+
+func (x *WatchRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRoomRequest.ProtoReflect.Descriptor instead.
+func (*WatchRoomRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WatchRoomRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchRoomRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchRoomRequest) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+// The response message for the google.showcase.v1beta1.Messaging\WatchRoom
+// method.
+type WatchRoomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The room that was either created, updated, or deleted. For deletions, the
+	// room as it was last.
+	Room *Room `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The action that triggered the room to be returned.
+	Action WatchRoomResponse_Action `protobuf:"varint,2,opt,name=action,proto3,enum=google.showcase.v1beta1.WatchRoomResponse_Action" json:"action,omitempty"`
+	// A token to pass in WatchRoomRequest.resume_token to resume the stream from
+	// after this change.
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchRoomResponse) Reset() {
+	*x = WatchRoomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRoomResponse) ProtoMessage() {}
+
+func (x *WatchRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRoomResponse.ProtoReflect.Descriptor instead.
+func (*WatchRoomResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *WatchRoomResponse) GetAction() WatchRoomResponse_Action {
+	if x != nil {
+		return x.Action
+	}
+	return WatchRoomResponse_ACTION_UNSPECIFIED
+}
+
+func (x *WatchRoomResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 // This protocol buffer message represents a blurb sent to a chat room or
 // posted on a user profile.
 type Blurb struct {
@@ -551,7 +762,7 @@ type Blurb struct {
 func (x *Blurb) Reset() {
 	*x = Blurb{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[7]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -564,7 +775,7 @@ func (x *Blurb) String() string {
 func (*Blurb) ProtoMessage() {}
 
 func (x *Blurb) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[7]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,7 +788,7 @@ func (x *Blurb) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Blurb.ProtoReflect.Descriptor instead.
 func (*Blurb) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{7}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Blurb) GetName() string {
@@ -707,7 +918,7 @@ type CreateBlurbRequest struct {
 func (x *CreateBlurbRequest) Reset() {
 	*x = CreateBlurbRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[8]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -720,7 +931,7 @@ func (x *CreateBlurbRequest) String() string {
 func (*CreateBlurbRequest) ProtoMessage() {}
 
 func (x *CreateBlurbRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[8]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +944,7 @@ func (x *CreateBlurbRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBlurbRequest.ProtoReflect.Descriptor instead.
 func (*CreateBlurbRequest) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{8}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateBlurbRequest) GetParent() string {
@@ -764,7 +975,7 @@ type GetBlurbRequest struct {
 func (x *GetBlurbRequest) Reset() {
 	*x = GetBlurbRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[9]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -777,7 +988,7 @@ func (x *GetBlurbRequest) String() string {
 func (*GetBlurbRequest) ProtoMessage() {}
 
 func (x *GetBlurbRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[9]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +1001,7 @@ func (x *GetBlurbRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlurbRequest.ProtoReflect.Descriptor instead.
 func (*GetBlurbRequest) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{9}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetBlurbRequest) GetName() string {
@@ -817,7 +1028,7 @@ type UpdateBlurbRequest struct {
 func (x *UpdateBlurbRequest) Reset() {
 	*x = UpdateBlurbRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[10]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -830,7 +1041,7 @@ func (x *UpdateBlurbRequest) String() string {
 func (*UpdateBlurbRequest) ProtoMessage() {}
 
 func (x *UpdateBlurbRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[10]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +1054,7 @@ func (x *UpdateBlurbRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBlurbRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBlurbRequest) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{10}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateBlurbRequest) GetBlurb() *Blurb {
@@ -874,7 +1085,7 @@ type DeleteBlurbRequest struct {
 func (x *DeleteBlurbRequest) Reset() {
 	*x = DeleteBlurbRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[11]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -887,7 +1098,7 @@ func (x *DeleteBlurbRequest) String() string {
 func (*DeleteBlurbRequest) ProtoMessage() {}
 
 func (x *DeleteBlurbRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[11]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +1111,7 @@ func (x *DeleteBlurbRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBlurbRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBlurbRequest) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{11}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteBlurbRequest) GetName() string {
@@ -932,7 +1143,7 @@ type ListBlurbsRequest struct {
 func (x *ListBlurbsRequest) Reset() {
 	*x = ListBlurbsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[12]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -945,7 +1156,7 @@ func (x *ListBlurbsRequest) String() string {
 func (*ListBlurbsRequest) ProtoMessage() {}
 
 func (x *ListBlurbsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[12]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1169,7 @@ func (x *ListBlurbsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBlurbsRequest.ProtoReflect.Descriptor instead.
 func (*ListBlurbsRequest) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{12}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListBlurbsRequest) GetParent() string {
@@ -1001,7 +1212,7 @@ type ListBlurbsResponse struct {
 func (x *ListBlurbsResponse) Reset() {
 	*x = ListBlurbsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[13]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1014,7 +1225,7 @@ func (x *ListBlurbsResponse) String() string {
 func (*ListBlurbsResponse) ProtoMessage() {}
 
 func (x *ListBlurbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[13]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,7 +1238,7 @@ func (x *ListBlurbsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBlurbsResponse.ProtoReflect.Descriptor instead.
 func (*ListBlurbsResponse) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{13}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListBlurbsResponse) GetBlurbs() []*Blurb {
@@ -1071,7 +1282,7 @@ type SearchBlurbsRequest struct {
 func (x *SearchBlurbsRequest) Reset() {
 	*x = SearchBlurbsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[14]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1084,7 +1295,7 @@ func (x *SearchBlurbsRequest) String() string {
 func (*SearchBlurbsRequest) ProtoMessage() {}
 
 func (x *SearchBlurbsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[14]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1097,7 +1308,7 @@ func (x *SearchBlurbsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchBlurbsRequest.ProtoReflect.Descriptor instead.
 func (*SearchBlurbsRequest) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{14}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SearchBlurbsRequest) GetQuery() string {
@@ -1142,7 +1353,7 @@ type SearchBlurbsMetadata struct {
 func (x *SearchBlurbsMetadata) Reset() {
 	*x = SearchBlurbsMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[15]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1155,7 +1366,7 @@ func (x *SearchBlurbsMetadata) String() string {
 func (*SearchBlurbsMetadata) ProtoMessage() {}
 
 func (x *SearchBlurbsMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[15]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,7 +1379,7 @@ func (x *SearchBlurbsMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchBlurbsMetadata.ProtoReflect.Descriptor instead.
 func (*SearchBlurbsMetadata) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{15}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SearchBlurbsMetadata) GetRetryInfo() *errdetails.RetryInfo {
@@ -1197,7 +1408,7 @@ type SearchBlurbsResponse struct {
 func (x *SearchBlurbsResponse) Reset() {
 	*x = SearchBlurbsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[16]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1210,7 +1421,7 @@ func (x *SearchBlurbsResponse) String() string {
 func (*SearchBlurbsResponse) ProtoMessage() {}
 
 func (x *SearchBlurbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[16]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,7 +1434,7 @@ func (x *SearchBlurbsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchBlurbsResponse.ProtoReflect.Descriptor instead.
 func (*SearchBlurbsResponse) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{16}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SearchBlurbsResponse) GetBlurbs() []*Blurb {
@@ -1256,7 +1467,7 @@ type StreamBlurbsRequest struct {
 func (x *StreamBlurbsRequest) Reset() {
 	*x = StreamBlurbsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[17]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1269,7 +1480,7 @@ func (x *StreamBlurbsRequest) String() string {
 func (*StreamBlurbsRequest) ProtoMessage() {}
 
 func (x *StreamBlurbsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[17]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1282,7 +1493,7 @@ func (x *StreamBlurbsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamBlurbsRequest.ProtoReflect.Descriptor instead.
 func (*StreamBlurbsRequest) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{17}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *StreamBlurbsRequest) GetName() string {
@@ -1315,7 +1526,7 @@ type StreamBlurbsResponse struct {
 func (x *StreamBlurbsResponse) Reset() {
 	*x = StreamBlurbsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[18]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1328,7 +1539,7 @@ func (x *StreamBlurbsResponse) String() string {
 func (*StreamBlurbsResponse) ProtoMessage() {}
 
 func (x *StreamBlurbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[18]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,7 +1552,7 @@ func (x *StreamBlurbsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamBlurbsResponse.ProtoReflect.Descriptor instead.
 func (*StreamBlurbsResponse) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{18}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *StreamBlurbsResponse) GetBlurb() *Blurb {
@@ -1372,7 +1583,7 @@ type SendBlurbsResponse struct {
 func (x *SendBlurbsResponse) Reset() {
 	*x = SendBlurbsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[19]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1385,7 +1596,7 @@ func (x *SendBlurbsResponse) String() string {
 func (*SendBlurbsResponse) ProtoMessage() {}
 
 func (x *SendBlurbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[19]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1398,7 +1609,7 @@ func (x *SendBlurbsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendBlurbsResponse.ProtoReflect.Descriptor instead.
 func (*SendBlurbsResponse) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{19}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SendBlurbsResponse) GetNames() []string {
@@ -1424,7 +1635,7 @@ type ConnectRequest struct {
 func (x *ConnectRequest) Reset() {
 	*x = ConnectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[20]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1437,7 +1648,7 @@ func (x *ConnectRequest) String() string {
 func (*ConnectRequest) ProtoMessage() {}
 
 func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[20]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1450,7 +1661,7 @@ func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
 func (*ConnectRequest) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{20}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{22}
 }
 
 func (m *ConnectRequest) GetRequest() isConnectRequest_Request {
@@ -1505,7 +1716,7 @@ type ConnectRequest_ConnectConfig struct {
 func (x *ConnectRequest_ConnectConfig) Reset() {
 	*x = ConnectRequest_ConnectConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[21]
+		mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1518,7 +1729,7 @@ func (x *ConnectRequest_ConnectConfig) String() string {
 func (*ConnectRequest_ConnectConfig) ProtoMessage() {}
 
 func (x *ConnectRequest_ConnectConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[21]
+	mi := &file_google_showcase_v1beta1_messaging_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,7 +1742,7 @@ func (x *ConnectRequest_ConnectConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectRequest_ConnectConfig.ProtoReflect.Descriptor instead.
 func (*ConnectRequest_ConnectConfig) Descriptor() ([]byte, []int) {
-	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{20, 0}
+	return file_google_showcase_v1beta1_messaging_proto_rawDescGZIP(), []int{22, 0}
 }
 
 func (x *ConnectRequest_ConnectConfig) GetParent() string {
@@ -1565,7 +1776,7 @@ var file_google_showcase_v1beta1_messaging_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
 	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x02, 0x0a, 0x04, 0x52,
+	0x61, 0x69, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x02, 0x0a, 0x04, 0x52,
 	0x6f, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c,
 	0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
@@ -1580,45 +1791,73 @@ var file_google_showcase_v1beta1_messaging_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
 	0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x2f, 0xea, 0x41, 0x2c, 0x0a, 0x1c, 0x73, 0x68, 0x6f, 0x77,
-	0x63, 0x61, 0x73, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x0c, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f,
-	0x7b, 0x72, 0x6f, 0x6f, 0x6d, 0x7d, 0x22, 0x46, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x72,
-	0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x4a,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x38, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24,
-	0xfa, 0x41, 0x1e, 0x0a, 0x1c, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f,
-	0x6d, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72,
-	0x6f, 0x6f, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
-	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
-	0x22, 0x4d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x24, 0xfa, 0x41, 0x1e, 0x0a, 0x1c, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
-	0x73, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x4e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x70, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
-	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f,
-	0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
-	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0xc3, 0x04, 0x0a, 0x05, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x3a, 0x2f, 0xea, 0x41, 0x2c, 0x0a, 0x1c,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x0c, 0x72, 0x6f,
+	0x6f, 0x6d, 0x73, 0x2f, 0x7b, 0x72, 0x6f, 0x6f, 0x6d, 0x7d, 0x22, 0x46, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x31, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f,
+	0x6f, 0x6d, 0x22, 0x4a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x24, 0xfa, 0x41, 0x1e, 0x0a, 0x1c, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x83,
+	0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x22, 0x4d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xfa, 0x41, 0x1e, 0x0a, 0x1c, 0x73, 0x68,
+	0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x70, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xfa, 0x41, 0x1e, 0x0a, 0x1c, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x6f, 0x6f, 0x6d, 0xe0, 0x41, 0x02, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x49, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x44, 0x0a, 0x06, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10,
+	0x03, 0x22, 0xc3, 0x04, 0x0a, 0x05, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x38, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xfa,
 	0x41, 0x1e, 0x0a, 0x1c, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x67, 0x6f, 0x6f,
@@ -1762,7 +2001,7 @@ var file_google_showcase_v1beta1_messaging_proto_rawDesc = []byte{
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xfa, 0x41, 0x1f, 0x12, 0x1d, 0x73, 0x68, 0x6f, 0x77,
 	0x63, 0x61, 0x73, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0xa2, 0x13, 0x0a,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0xba, 0x14, 0x0a,
 	0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x97, 0x01, 0x0a, 0x0a, 0x43,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
@@ -1804,127 +2043,137 @@ var file_google_showcase_v1beta1_messaging_proto_rawDesc = []byte{
 	0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c,
 	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0xf6, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x12, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
-	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x42, 0x6c, 0x75, 0x72, 0x62, 0x22, 0x99, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x54, 0x22, 0x20,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73,
-	0x3a, 0x01, 0x2a, 0x5a, 0x2d, 0x22, 0x28, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x3a,
-	0x01, 0x2a, 0xda, 0x41, 0x1c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x2c, 0x62, 0x6c, 0x75, 0x72,
-	0x62, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2c, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x2e, 0x74, 0x65, 0x78,
-	0x74, 0xda, 0x41, 0x1d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x2c, 0x62, 0x6c, 0x75, 0x72, 0x62,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2c, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x2e, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0xb1, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x12, 0x28,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x75, 0x72,
-	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x22, 0x5b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e,
-	0x12, 0x20, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x2f,
-	0x2a, 0x7d, 0x5a, 0x2a, 0x12, 0x28, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b,
-	0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x2f, 0x2a, 0x7d, 0xda, 0x41,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xc2, 0x01, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x42, 0x6c, 0x75, 0x72, 0x62, 0x12, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
-	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
-	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6c, 0x75,
-	0x72, 0x62, 0x22, 0x66, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x60, 0x32, 0x26, 0x2f, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x2e, 0x6e, 0x61, 0x6d, 0x65,
-	0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x2f,
-	0x2a, 0x7d, 0x3a, 0x01, 0x2a, 0x5a, 0x33, 0x32, 0x2e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x7b, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x62, 0x6c,
-	0x75, 0x72, 0x62, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xaf, 0x01, 0x0a, 0x0b, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x12, 0x2b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x61, 0x31, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x09, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x29, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x30, 0x01,
+	0x12, 0xf6, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x75, 0x72, 0x62,
+	0x12, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x22, 0x99, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x54, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a,
+	0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x3a, 0x01, 0x2a, 0x5a, 0x2d, 0x22, 0x28, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x7d,
+	0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x3a, 0x01, 0x2a, 0xda, 0x41, 0x1c, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x2c, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2c, 0x62,
+	0x6c, 0x75, 0x72, 0x62, 0x2e, 0x74, 0x65, 0x78, 0x74, 0xda, 0x41, 0x1d, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x2c, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2c, 0x62, 0x6c,
+	0x75, 0x72, 0x62, 0x2e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0xb1, 0x01, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x72, 0x62,
+	0x22, 0x5b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a,
+	0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x2f, 0x2a, 0x7d, 0x5a, 0x2a, 0x12, 0x28, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x62, 0x6c, 0x75,
+	0x72, 0x62, 0x73, 0x2f, 0x2a, 0x7d, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xc2, 0x01,
+	0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x12, 0x2b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c,
+	0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6c, 0x75, 0x72, 0x62,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x5b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e, 0x2a, 0x20, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x2f,
-	0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x2f, 0x2a, 0x7d, 0x5a, 0x2a, 0x2a, 0x28, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x62, 0x6c, 0x75, 0x72,
-	0x62, 0x73, 0x2f, 0x2a, 0x7d, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xc4, 0x01, 0x0a,
-	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x12, 0x2a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e, 0x12, 0x20, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x72,
-	0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x5a, 0x2a,
-	0x12, 0x28, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x12, 0xfa, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c,
-	0x75, 0x72, 0x62, 0x73, 0x12, 0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68,
-	0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67,
-	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x9c, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x5f, 0x22, 0x27, 0x2f, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x72, 0x6f, 0x6f,
-	0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x3a, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x5a, 0x31, 0x22, 0x2f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x22, 0x66, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x60, 0x32, 0x26, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x62, 0x6c,
+	0x75, 0x72, 0x62, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a,
+	0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x01, 0x2a, 0x5a, 0x33, 0x32,
+	0x2e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x62, 0x6c, 0x75, 0x72, 0x62,
+	0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x2f, 0x2a, 0x7d, 0x3a,
+	0x01, 0x2a, 0x12, 0xaf, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6c, 0x75,
+	0x72, 0x62, 0x12, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x5b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e, 0x2a,
+	0x20, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d,
+	0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x2f, 0x2a,
+	0x7d, 0x5a, 0x2a, 0x2a, 0x28, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x2f, 0x2a, 0x7d, 0xda, 0x41, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0xc4, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x75,
+	0x72, 0x62, 0x73, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c,
+	0x75, 0x72, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x4e, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x2f,
+	0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x5a, 0x2a, 0x12, 0x28, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73,
 	0x2f, 0x2a, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72,
-	0x62, 0x73, 0x3a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0xca, 0x41, 0x2c, 0x0a, 0x14, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xda, 0x41, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0xd3, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x75, 0x72, 0x62,
-	0x73, 0x12, 0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x5e, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x2f,
-	0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x3a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x01, 0x2a,
-	0x5a, 0x32, 0x22, 0x2d, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x3a, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0xce, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x42,
-	0x6c, 0x75, 0x72, 0x62, 0x73, 0x12, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
-	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
-	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6e,
-	0x64, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x5e, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f,
-	0x2a, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x3a, 0x73, 0x65, 0x6e, 0x64, 0x3a, 0x01,
-	0x2a, 0x5a, 0x32, 0x22, 0x2d, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x3a, 0x73, 0x65,
-	0x6e, 0x64, 0x3a, 0x01, 0x2a, 0x28, 0x01, 0x12, 0x65, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
-	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x75, 0x72,
-	0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x1a, 0x11,
-	0xca, 0x41, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x37, 0x34, 0x36,
-	0x39, 0x42, 0x71, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x61, 0x70, 0x69, 0x63, 0x2d,
-	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xea, 0x02, 0x19, 0x47, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x3a, 0x3a, 0x53, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x42,
-	0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x73, 0xda, 0x41, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0xfa, 0x01, 0x0a, 0x0c,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x12, 0x2c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x75,
+	0x72, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x5f, 0x22, 0x27, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x62, 0x6c,
+	0x75, 0x72, 0x62, 0x73, 0x3a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x5a, 0x31,
+	0x22, 0x2f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x3a, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0xca, 0x41, 0x2c, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x75, 0x72,
+	0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xda, 0x41, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0xd3, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x12, 0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x5e, 0x22, 0x25,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x72,
+	0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x3a, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x01, 0x2a, 0x5a, 0x32, 0x22, 0x2d, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2f, 0x2a, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72,
+	0x62, 0x73, 0x3a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0xce,
+	0x01, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x12, 0x2b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c,
+	0x75, 0x72, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x5e, 0x22,
+	0x25, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x3d, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x2f, 0x2a, 0x7d, 0x2f, 0x62, 0x6c, 0x75, 0x72, 0x62,
+	0x73, 0x3a, 0x73, 0x65, 0x6e, 0x64, 0x3a, 0x01, 0x2a, 0x5a, 0x32, 0x22, 0x2d, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x7d, 0x2f, 0x62,
+	0x6c, 0x75, 0x72, 0x62, 0x73, 0x3a, 0x73, 0x65, 0x6e, 0x64, 0x3a, 0x01, 0x2a, 0x28, 0x01, 0x12,
+	0x65, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x75, 0x72, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x1a, 0x11, 0xca, 0x41, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x68, 0x6f, 0x73, 0x74, 0x3a, 0x37, 0x34, 0x36, 0x39, 0x42, 0x71, 0x0a, 0x1b, 0x63, 0x6f, 0x6d,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69,
+	0x73, 0x2f, 0x67, 0x61, 0x70, 0x69, 0x63, 0x2d, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0xea, 0x02, 0x19, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x42, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1939,91 +2188,99 @@ func file_google_showcase_v1beta1_messaging_proto_rawDescGZIP() []byte {
 	return file_google_showcase_v1beta1_messaging_proto_rawDescData
 }
 
-var file_google_showcase_v1beta1_messaging_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_google_showcase_v1beta1_messaging_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_google_showcase_v1beta1_messaging_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_google_showcase_v1beta1_messaging_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_google_showcase_v1beta1_messaging_proto_goTypes = []interface{}{
-	(StreamBlurbsResponse_Action)(0),     // 0: google.showcase.v1beta1.StreamBlurbsResponse.Action
-	(*Room)(nil),                         // 1: google.showcase.v1beta1.Room
-	(*CreateRoomRequest)(nil),            // 2: google.showcase.v1beta1.CreateRoomRequest
-	(*GetRoomRequest)(nil),               // 3: google.showcase.v1beta1.GetRoomRequest
-	(*UpdateRoomRequest)(nil),            // 4: google.showcase.v1beta1.UpdateRoomRequest
-	(*DeleteRoomRequest)(nil),            // 5: google.showcase.v1beta1.DeleteRoomRequest
-	(*ListRoomsRequest)(nil),             // 6: google.showcase.v1beta1.ListRoomsRequest
-	(*ListRoomsResponse)(nil),            // 7: google.showcase.v1beta1.ListRoomsResponse
-	(*Blurb)(nil),                        // 8: google.showcase.v1beta1.Blurb
-	(*CreateBlurbRequest)(nil),           // 9: google.showcase.v1beta1.CreateBlurbRequest
-	(*GetBlurbRequest)(nil),              // 10: google.showcase.v1beta1.GetBlurbRequest
-	(*UpdateBlurbRequest)(nil),           // 11: google.showcase.v1beta1.UpdateBlurbRequest
-	(*DeleteBlurbRequest)(nil),           // 12: google.showcase.v1beta1.DeleteBlurbRequest
-	(*ListBlurbsRequest)(nil),            // 13: google.showcase.v1beta1.ListBlurbsRequest
-	(*ListBlurbsResponse)(nil),           // 14: google.showcase.v1beta1.ListBlurbsResponse
-	(*SearchBlurbsRequest)(nil),          // 15: google.showcase.v1beta1.SearchBlurbsRequest
-	(*SearchBlurbsMetadata)(nil),         // 16: google.showcase.v1beta1.SearchBlurbsMetadata
-	(*SearchBlurbsResponse)(nil),         // 17: google.showcase.v1beta1.SearchBlurbsResponse
-	(*StreamBlurbsRequest)(nil),          // 18: google.showcase.v1beta1.StreamBlurbsRequest
-	(*StreamBlurbsResponse)(nil),         // 19: google.showcase.v1beta1.StreamBlurbsResponse
-	(*SendBlurbsResponse)(nil),           // 20: google.showcase.v1beta1.SendBlurbsResponse
-	(*ConnectRequest)(nil),               // 21: google.showcase.v1beta1.ConnectRequest
-	(*ConnectRequest_ConnectConfig)(nil), // 22: google.showcase.v1beta1.ConnectRequest.ConnectConfig
-	(*timestamppb.Timestamp)(nil),        // 23: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),        // 24: google.protobuf.FieldMask
-	(*errdetails.RetryInfo)(nil),         // 25: google.rpc.RetryInfo
-	(*emptypb.Empty)(nil),                // 26: google.protobuf.Empty
-	(*longrunning.Operation)(nil),        // 27: google.longrunning.Operation
+	(WatchRoomResponse_Action)(0),        // 0: google.showcase.v1beta1.WatchRoomResponse.Action
+	(StreamBlurbsResponse_Action)(0),     // 1: google.showcase.v1beta1.StreamBlurbsResponse.Action
+	(*Room)(nil),                         // 2: google.showcase.v1beta1.Room
+	(*CreateRoomRequest)(nil),            // 3: google.showcase.v1beta1.CreateRoomRequest
+	(*GetRoomRequest)(nil),               // 4: google.showcase.v1beta1.GetRoomRequest
+	(*UpdateRoomRequest)(nil),            // 5: google.showcase.v1beta1.UpdateRoomRequest
+	(*DeleteRoomRequest)(nil),            // 6: google.showcase.v1beta1.DeleteRoomRequest
+	(*ListRoomsRequest)(nil),             // 7: google.showcase.v1beta1.ListRoomsRequest
+	(*ListRoomsResponse)(nil),            // 8: google.showcase.v1beta1.ListRoomsResponse
+	(*WatchRoomRequest)(nil),             // 9: google.showcase.v1beta1.WatchRoomRequest
+	(*WatchRoomResponse)(nil),            // 10: google.showcase.v1beta1.WatchRoomResponse
+	(*Blurb)(nil),                        // 11: google.showcase.v1beta1.Blurb
+	(*CreateBlurbRequest)(nil),           // 12: google.showcase.v1beta1.CreateBlurbRequest
+	(*GetBlurbRequest)(nil),              // 13: google.showcase.v1beta1.GetBlurbRequest
+	(*UpdateBlurbRequest)(nil),           // 14: google.showcase.v1beta1.UpdateBlurbRequest
+	(*DeleteBlurbRequest)(nil),           // 15: google.showcase.v1beta1.DeleteBlurbRequest
+	(*ListBlurbsRequest)(nil),            // 16: google.showcase.v1beta1.ListBlurbsRequest
+	(*ListBlurbsResponse)(nil),           // 17: google.showcase.v1beta1.ListBlurbsResponse
+	(*SearchBlurbsRequest)(nil),          // 18: google.showcase.v1beta1.SearchBlurbsRequest
+	(*SearchBlurbsMetadata)(nil),         // 19: google.showcase.v1beta1.SearchBlurbsMetadata
+	(*SearchBlurbsResponse)(nil),         // 20: google.showcase.v1beta1.SearchBlurbsResponse
+	(*StreamBlurbsRequest)(nil),          // 21: google.showcase.v1beta1.StreamBlurbsRequest
+	(*StreamBlurbsResponse)(nil),         // 22: google.showcase.v1beta1.StreamBlurbsResponse
+	(*SendBlurbsResponse)(nil),           // 23: google.showcase.v1beta1.SendBlurbsResponse
+	(*ConnectRequest)(nil),               // 24: google.showcase.v1beta1.ConnectRequest
+	(*ConnectRequest_ConnectConfig)(nil), // 25: google.showcase.v1beta1.ConnectRequest.ConnectConfig
+	(*timestamppb.Timestamp)(nil),        // 26: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),        // 27: google.protobuf.FieldMask
+	(*errdetails.RetryInfo)(nil),         // 28: google.rpc.RetryInfo
+	(*emptypb.Empty)(nil),                // 29: google.protobuf.Empty
+	(*longrunning.Operation)(nil),        // 30: google.longrunning.Operation
 }
 var file_google_showcase_v1beta1_messaging_proto_depIdxs = []int32{
-	23, // 0: google.showcase.v1beta1.Room.create_time:type_name -> google.protobuf.Timestamp
-	23, // 1: google.showcase.v1beta1.Room.update_time:type_name -> google.protobuf.Timestamp
-	1,  // 2: google.showcase.v1beta1.CreateRoomRequest.room:type_name -> google.showcase.v1beta1.Room
-	1,  // 3: google.showcase.v1beta1.UpdateRoomRequest.room:type_name -> google.showcase.v1beta1.Room
-	24, // 4: google.showcase.v1beta1.UpdateRoomRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 5: google.showcase.v1beta1.ListRoomsResponse.rooms:type_name -> google.showcase.v1beta1.Room
-	23, // 6: google.showcase.v1beta1.Blurb.create_time:type_name -> google.protobuf.Timestamp
-	23, // 7: google.showcase.v1beta1.Blurb.update_time:type_name -> google.protobuf.Timestamp
-	8,  // 8: google.showcase.v1beta1.CreateBlurbRequest.blurb:type_name -> google.showcase.v1beta1.Blurb
-	8,  // 9: google.showcase.v1beta1.UpdateBlurbRequest.blurb:type_name -> google.showcase.v1beta1.Blurb
-	24, // 10: google.showcase.v1beta1.UpdateBlurbRequest.update_mask:type_name -> google.protobuf.FieldMask
-	8,  // 11: google.showcase.v1beta1.ListBlurbsResponse.blurbs:type_name -> google.showcase.v1beta1.Blurb
-	25, // 12: google.showcase.v1beta1.SearchBlurbsMetadata.retry_info:type_name -> google.rpc.RetryInfo
-	8,  // 13: google.showcase.v1beta1.SearchBlurbsResponse.blurbs:type_name -> google.showcase.v1beta1.Blurb
-	23, // 14: google.showcase.v1beta1.StreamBlurbsRequest.expire_time:type_name -> google.protobuf.Timestamp
-	8,  // 15: google.showcase.v1beta1.StreamBlurbsResponse.blurb:type_name -> google.showcase.v1beta1.Blurb
-	0,  // 16: google.showcase.v1beta1.StreamBlurbsResponse.action:type_name -> google.showcase.v1beta1.StreamBlurbsResponse.Action
-	22, // 17: google.showcase.v1beta1.ConnectRequest.config:type_name -> google.showcase.v1beta1.ConnectRequest.ConnectConfig
-	8,  // 18: google.showcase.v1beta1.ConnectRequest.blurb:type_name -> google.showcase.v1beta1.Blurb
-	2,  // 19: google.showcase.v1beta1.Messaging.CreateRoom:input_type -> google.showcase.v1beta1.CreateRoomRequest
-	3,  // 20: google.showcase.v1beta1.Messaging.GetRoom:input_type -> google.showcase.v1beta1.GetRoomRequest
-	4,  // 21: google.showcase.v1beta1.Messaging.UpdateRoom:input_type -> google.showcase.v1beta1.UpdateRoomRequest
-	5,  // 22: google.showcase.v1beta1.Messaging.DeleteRoom:input_type -> google.showcase.v1beta1.DeleteRoomRequest
-	6,  // 23: google.showcase.v1beta1.Messaging.ListRooms:input_type -> google.showcase.v1beta1.ListRoomsRequest
-	9,  // 24: google.showcase.v1beta1.Messaging.CreateBlurb:input_type -> google.showcase.v1beta1.CreateBlurbRequest
-	10, // 25: google.showcase.v1beta1.Messaging.GetBlurb:input_type -> google.showcase.v1beta1.GetBlurbRequest
-	11, // 26: google.showcase.v1beta1.Messaging.UpdateBlurb:input_type -> google.showcase.v1beta1.UpdateBlurbRequest
-	12, // 27: google.showcase.v1beta1.Messaging.DeleteBlurb:input_type -> google.showcase.v1beta1.DeleteBlurbRequest
-	13, // 28: google.showcase.v1beta1.Messaging.ListBlurbs:input_type -> google.showcase.v1beta1.ListBlurbsRequest
-	15, // 29: google.showcase.v1beta1.Messaging.SearchBlurbs:input_type -> google.showcase.v1beta1.SearchBlurbsRequest
-	18, // 30: google.showcase.v1beta1.Messaging.StreamBlurbs:input_type -> google.showcase.v1beta1.StreamBlurbsRequest
-	9,  // 31: google.showcase.v1beta1.Messaging.SendBlurbs:input_type -> google.showcase.v1beta1.CreateBlurbRequest
-	21, // 32: google.showcase.v1beta1.Messaging.Connect:input_type -> google.showcase.v1beta1.ConnectRequest
-	1,  // 33: google.showcase.v1beta1.Messaging.CreateRoom:output_type -> google.showcase.v1beta1.Room
-	1,  // 34: google.showcase.v1beta1.Messaging.GetRoom:output_type -> google.showcase.v1beta1.Room
-	1,  // 35: google.showcase.v1beta1.Messaging.UpdateRoom:output_type -> google.showcase.v1beta1.Room
-	26, // 36: google.showcase.v1beta1.Messaging.DeleteRoom:output_type -> google.protobuf.Empty
-	7,  // 37: google.showcase.v1beta1.Messaging.ListRooms:output_type -> google.showcase.v1beta1.ListRoomsResponse
-	8,  // 38: google.showcase.v1beta1.Messaging.CreateBlurb:output_type -> google.showcase.v1beta1.Blurb
-	8,  // 39: google.showcase.v1beta1.Messaging.GetBlurb:output_type -> google.showcase.v1beta1.Blurb
-	8,  // 40: google.showcase.v1beta1.Messaging.UpdateBlurb:output_type -> google.showcase.v1beta1.Blurb
-	26, // 41: google.showcase.v1beta1.Messaging.DeleteBlurb:output_type -> google.protobuf.Empty
-	14, // 42: google.showcase.v1beta1.Messaging.ListBlurbs:output_type -> google.showcase.v1beta1.ListBlurbsResponse
-	27, // 43: google.showcase.v1beta1.Messaging.SearchBlurbs:output_type -> google.longrunning.Operation
-	19, // 44: google.showcase.v1beta1.Messaging.StreamBlurbs:output_type -> google.showcase.v1beta1.StreamBlurbsResponse
-	20, // 45: google.showcase.v1beta1.Messaging.SendBlurbs:output_type -> google.showcase.v1beta1.SendBlurbsResponse
-	19, // 46: google.showcase.v1beta1.Messaging.Connect:output_type -> google.showcase.v1beta1.StreamBlurbsResponse
-	33, // [33:47] is the sub-list for method output_type
-	19, // [19:33] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	26, // 0: google.showcase.v1beta1.Room.create_time:type_name -> google.protobuf.Timestamp
+	26, // 1: google.showcase.v1beta1.Room.update_time:type_name -> google.protobuf.Timestamp
+	2,  // 2: google.showcase.v1beta1.CreateRoomRequest.room:type_name -> google.showcase.v1beta1.Room
+	2,  // 3: google.showcase.v1beta1.UpdateRoomRequest.room:type_name -> google.showcase.v1beta1.Room
+	27, // 4: google.showcase.v1beta1.UpdateRoomRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 5: google.showcase.v1beta1.ListRoomsResponse.rooms:type_name -> google.showcase.v1beta1.Room
+	26, // 6: google.showcase.v1beta1.WatchRoomRequest.expire_time:type_name -> google.protobuf.Timestamp
+	2,  // 7: google.showcase.v1beta1.WatchRoomResponse.room:type_name -> google.showcase.v1beta1.Room
+	0,  // 8: google.showcase.v1beta1.WatchRoomResponse.action:type_name -> google.showcase.v1beta1.WatchRoomResponse.Action
+	26, // 9: google.showcase.v1beta1.Blurb.create_time:type_name -> google.protobuf.Timestamp
+	26, // 10: google.showcase.v1beta1.Blurb.update_time:type_name -> google.protobuf.Timestamp
+	11, // 11: google.showcase.v1beta1.CreateBlurbRequest.blurb:type_name -> google.showcase.v1beta1.Blurb
+	11, // 12: google.showcase.v1beta1.UpdateBlurbRequest.blurb:type_name -> google.showcase.v1beta1.Blurb
+	27, // 13: google.showcase.v1beta1.UpdateBlurbRequest.update_mask:type_name -> google.protobuf.FieldMask
+	11, // 14: google.showcase.v1beta1.ListBlurbsResponse.blurbs:type_name -> google.showcase.v1beta1.Blurb
+	28, // 15: google.showcase.v1beta1.SearchBlurbsMetadata.retry_info:type_name -> google.rpc.RetryInfo
+	11, // 16: google.showcase.v1beta1.SearchBlurbsResponse.blurbs:type_name -> google.showcase.v1beta1.Blurb
+	26, // 17: google.showcase.v1beta1.StreamBlurbsRequest.expire_time:type_name -> google.protobuf.Timestamp
+	11, // 18: google.showcase.v1beta1.StreamBlurbsResponse.blurb:type_name -> google.showcase.v1beta1.Blurb
+	1,  // 19: google.showcase.v1beta1.StreamBlurbsResponse.action:type_name -> google.showcase.v1beta1.StreamBlurbsResponse.Action
+	25, // 20: google.showcase.v1beta1.ConnectRequest.config:type_name -> google.showcase.v1beta1.ConnectRequest.ConnectConfig
+	11, // 21: google.showcase.v1beta1.ConnectRequest.blurb:type_name -> google.showcase.v1beta1.Blurb
+	3,  // 22: google.showcase.v1beta1.Messaging.CreateRoom:input_type -> google.showcase.v1beta1.CreateRoomRequest
+	4,  // 23: google.showcase.v1beta1.Messaging.GetRoom:input_type -> google.showcase.v1beta1.GetRoomRequest
+	5,  // 24: google.showcase.v1beta1.Messaging.UpdateRoom:input_type -> google.showcase.v1beta1.UpdateRoomRequest
+	6,  // 25: google.showcase.v1beta1.Messaging.DeleteRoom:input_type -> google.showcase.v1beta1.DeleteRoomRequest
+	7,  // 26: google.showcase.v1beta1.Messaging.ListRooms:input_type -> google.showcase.v1beta1.ListRoomsRequest
+	9,  // 27: google.showcase.v1beta1.Messaging.WatchRoom:input_type -> google.showcase.v1beta1.WatchRoomRequest
+	12, // 28: google.showcase.v1beta1.Messaging.CreateBlurb:input_type -> google.showcase.v1beta1.CreateBlurbRequest
+	13, // 29: google.showcase.v1beta1.Messaging.GetBlurb:input_type -> google.showcase.v1beta1.GetBlurbRequest
+	14, // 30: google.showcase.v1beta1.Messaging.UpdateBlurb:input_type -> google.showcase.v1beta1.UpdateBlurbRequest
+	15, // 31: google.showcase.v1beta1.Messaging.DeleteBlurb:input_type -> google.showcase.v1beta1.DeleteBlurbRequest
+	16, // 32: google.showcase.v1beta1.Messaging.ListBlurbs:input_type -> google.showcase.v1beta1.ListBlurbsRequest
+	18, // 33: google.showcase.v1beta1.Messaging.SearchBlurbs:input_type -> google.showcase.v1beta1.SearchBlurbsRequest
+	21, // 34: google.showcase.v1beta1.Messaging.StreamBlurbs:input_type -> google.showcase.v1beta1.StreamBlurbsRequest
+	12, // 35: google.showcase.v1beta1.Messaging.SendBlurbs:input_type -> google.showcase.v1beta1.CreateBlurbRequest
+	24, // 36: google.showcase.v1beta1.Messaging.Connect:input_type -> google.showcase.v1beta1.ConnectRequest
+	2,  // 37: google.showcase.v1beta1.Messaging.CreateRoom:output_type -> google.showcase.v1beta1.Room
+	2,  // 38: google.showcase.v1beta1.Messaging.GetRoom:output_type -> google.showcase.v1beta1.Room
+	2,  // 39: google.showcase.v1beta1.Messaging.UpdateRoom:output_type -> google.showcase.v1beta1.Room
+	29, // 40: google.showcase.v1beta1.Messaging.DeleteRoom:output_type -> google.protobuf.Empty
+	8,  // 41: google.showcase.v1beta1.Messaging.ListRooms:output_type -> google.showcase.v1beta1.ListRoomsResponse
+	10, // 42: google.showcase.v1beta1.Messaging.WatchRoom:output_type -> google.showcase.v1beta1.WatchRoomResponse
+	11, // 43: google.showcase.v1beta1.Messaging.CreateBlurb:output_type -> google.showcase.v1beta1.Blurb
+	11, // 44: google.showcase.v1beta1.Messaging.GetBlurb:output_type -> google.showcase.v1beta1.Blurb
+	11, // 45: google.showcase.v1beta1.Messaging.UpdateBlurb:output_type -> google.showcase.v1beta1.Blurb
+	29, // 46: google.showcase.v1beta1.Messaging.DeleteBlurb:output_type -> google.protobuf.Empty
+	17, // 47: google.showcase.v1beta1.Messaging.ListBlurbs:output_type -> google.showcase.v1beta1.ListBlurbsResponse
+	30, // 48: google.showcase.v1beta1.Messaging.SearchBlurbs:output_type -> google.longrunning.Operation
+	22, // 49: google.showcase.v1beta1.Messaging.StreamBlurbs:output_type -> google.showcase.v1beta1.StreamBlurbsResponse
+	23, // 50: google.showcase.v1beta1.Messaging.SendBlurbs:output_type -> google.showcase.v1beta1.SendBlurbsResponse
+	22, // 51: google.showcase.v1beta1.Messaging.Connect:output_type -> google.showcase.v1beta1.StreamBlurbsResponse
+	37, // [37:52] is the sub-list for method output_type
+	22, // [22:37] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_google_showcase_v1beta1_messaging_proto_init() }
@@ -2117,7 +2374,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Blurb); i {
+			switch v := v.(*WatchRoomRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2129,7 +2386,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBlurbRequest); i {
+			switch v := v.(*WatchRoomResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2141,7 +2398,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlurbRequest); i {
+			switch v := v.(*Blurb); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2153,7 +2410,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBlurbRequest); i {
+			switch v := v.(*CreateBlurbRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2165,7 +2422,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBlurbRequest); i {
+			switch v := v.(*GetBlurbRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2177,7 +2434,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBlurbsRequest); i {
+			switch v := v.(*UpdateBlurbRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2189,7 +2446,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBlurbsResponse); i {
+			switch v := v.(*DeleteBlurbRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2201,7 +2458,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchBlurbsRequest); i {
+			switch v := v.(*ListBlurbsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2213,7 +2470,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchBlurbsMetadata); i {
+			switch v := v.(*ListBlurbsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2225,7 +2482,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchBlurbsResponse); i {
+			switch v := v.(*SearchBlurbsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2237,7 +2494,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamBlurbsRequest); i {
+			switch v := v.(*SearchBlurbsMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2249,7 +2506,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamBlurbsResponse); i {
+			switch v := v.(*SearchBlurbsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2261,7 +2518,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendBlurbsResponse); i {
+			switch v := v.(*StreamBlurbsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2273,7 +2530,7 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectRequest); i {
+			switch v := v.(*StreamBlurbsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2285,6 +2542,30 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 		file_google_showcase_v1beta1_messaging_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendBlurbsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_messaging_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_messaging_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConnectRequest_ConnectConfig); i {
 			case 0:
 				return &v.state
@@ -2297,13 +2578,13 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 			}
 		}
 	}
-	file_google_showcase_v1beta1_messaging_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_google_showcase_v1beta1_messaging_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*Blurb_Text)(nil),
 		(*Blurb_Image)(nil),
 		(*Blurb_LegacyRoomId)(nil),
 		(*Blurb_LegacyUserId)(nil),
 	}
-	file_google_showcase_v1beta1_messaging_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_google_showcase_v1beta1_messaging_proto_msgTypes[22].OneofWrappers = []interface{}{
 		(*ConnectRequest_Config)(nil),
 		(*ConnectRequest_Blurb)(nil),
 	}
@@ -2312,8 +2593,8 @@ func file_google_showcase_v1beta1_messaging_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_google_showcase_v1beta1_messaging_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   22,
+			NumEnums:      2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2340,7 +2621,9 @@ const _ = grpc.SupportPackageIsVersion6
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MessagingClient interface {
-	// Creates a room.
+	// Creates a room. Over REST, retries sending the `Idempotency-Key` header
+	// of an earlier create are given its response, with an
+	// `Idempotent-Replayed: true` header, rather than creating another room.
 	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*Room, error)
 	// Retrieves the Room with the given resource name.
 	GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*Room, error)
@@ -2350,9 +2633,16 @@ type MessagingClient interface {
 	DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Lists all chat rooms.
 	ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error)
+	// This returns a stream that emits the changes made to a chat room as it is
+	// updated and deleted, closing after its deletion. Each response carries a
+	// token resuming the stream from after it, so that an interrupted stream may
+	// be resumed without missing changes.
+	WatchRoom(ctx context.Context, in *WatchRoomRequest, opts ...grpc.CallOption) (Messaging_WatchRoomClient, error)
 	// Creates a blurb. If the parent is a room, the blurb is understood to be a
 	// message in that room. If the parent is a profile, the blurb is understood
-	// to be a post on the profile.
+	// to be a post on the profile. Over REST, retries sending the
+	// `Idempotency-Key` header of an earlier create are given its response, as
+	// for CreateRoom.
 	CreateBlurb(ctx context.Context, in *CreateBlurbRequest, opts ...grpc.CallOption) (*Blurb, error)
 	// Retrieves the Blurb with the given resource name.
 	GetBlurb(ctx context.Context, in *GetBlurbRequest, opts ...grpc.CallOption) (*Blurb, error)
@@ -2433,6 +2723,38 @@ func (c *messagingClient) ListRooms(ctx context.Context, in *ListRoomsRequest, o
 	return out, nil
 }
 
+func (c *messagingClient) WatchRoom(ctx context.Context, in *WatchRoomRequest, opts ...grpc.CallOption) (Messaging_WatchRoomClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Messaging_serviceDesc.Streams[0], "/google.showcase.v1beta1.Messaging/WatchRoom", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &messagingWatchRoomClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Messaging_WatchRoomClient interface {
+	Recv() (*WatchRoomResponse, error)
+	grpc.ClientStream
+}
+
+type messagingWatchRoomClient struct {
+	grpc.ClientStream
+}
+
+func (x *messagingWatchRoomClient) Recv() (*WatchRoomResponse, error) {
+	m := new(WatchRoomResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *messagingClient) CreateBlurb(ctx context.Context, in *CreateBlurbRequest, opts ...grpc.CallOption) (*Blurb, error) {
 	out := new(Blurb)
 	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Messaging/CreateBlurb", in, out, opts...)
@@ -2488,7 +2810,7 @@ func (c *messagingClient) SearchBlurbs(ctx context.Context, in *SearchBlurbsRequ
 }
 
 func (c *messagingClient) StreamBlurbs(ctx context.Context, in *StreamBlurbsRequest, opts ...grpc.CallOption) (Messaging_StreamBlurbsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Messaging_serviceDesc.Streams[0], "/google.showcase.v1beta1.Messaging/StreamBlurbs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Messaging_serviceDesc.Streams[1], "/google.showcase.v1beta1.Messaging/StreamBlurbs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2520,7 +2842,7 @@ func (x *messagingStreamBlurbsClient) Recv() (*StreamBlurbsResponse, error) {
 }
 
 func (c *messagingClient) SendBlurbs(ctx context.Context, opts ...grpc.CallOption) (Messaging_SendBlurbsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Messaging_serviceDesc.Streams[1], "/google.showcase.v1beta1.Messaging/SendBlurbs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Messaging_serviceDesc.Streams[2], "/google.showcase.v1beta1.Messaging/SendBlurbs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2554,7 +2876,7 @@ func (x *messagingSendBlurbsClient) CloseAndRecv() (*SendBlurbsResponse, error)
 }
 
 func (c *messagingClient) Connect(ctx context.Context, opts ...grpc.CallOption) (Messaging_ConnectClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Messaging_serviceDesc.Streams[2], "/google.showcase.v1beta1.Messaging/Connect", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Messaging_serviceDesc.Streams[3], "/google.showcase.v1beta1.Messaging/Connect", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2586,7 +2908,9 @@ func (x *messagingConnectClient) Recv() (*StreamBlurbsResponse, error) {
 
 // MessagingServer is the server API for Messaging service.
 type MessagingServer interface {
-	// Creates a room.
+	// Creates a room. Over REST, retries sending the `Idempotency-Key` header
+	// of an earlier create are given its response, with an
+	// `Idempotent-Replayed: true` header, rather than creating another room.
 	CreateRoom(context.Context, *CreateRoomRequest) (*Room, error)
 	// Retrieves the Room with the given resource name.
 	GetRoom(context.Context, *GetRoomRequest) (*Room, error)
@@ -2596,9 +2920,16 @@ type MessagingServer interface {
 	DeleteRoom(context.Context, *DeleteRoomRequest) (*emptypb.Empty, error)
 	// Lists all chat rooms.
 	ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error)
+	// This returns a stream that emits the changes made to a chat room as it is
+	// updated and deleted, closing after its deletion. Each response carries a
+	// token resuming the stream from after it, so that an interrupted stream may
+	// be resumed without missing changes.
+	WatchRoom(*WatchRoomRequest, Messaging_WatchRoomServer) error
 	// Creates a blurb. If the parent is a room, the blurb is understood to be a
 	// message in that room. If the parent is a profile, the blurb is understood
-	// to be a post on the profile.
+	// to be a post on the profile. Over REST, retries sending the
+	// `Idempotency-Key` header of an earlier create are given its response, as
+	// for CreateRoom.
 	CreateBlurb(context.Context, *CreateBlurbRequest) (*Blurb, error)
 	// Retrieves the Blurb with the given resource name.
 	GetBlurb(context.Context, *GetBlurbRequest) (*Blurb, error)
@@ -2645,6 +2976,9 @@ func (*UnimplementedMessagingServer) DeleteRoom(context.Context, *DeleteRoomRequ
 func (*UnimplementedMessagingServer) ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRooms not implemented")
 }
+func (*UnimplementedMessagingServer) WatchRoom(*WatchRoomRequest, Messaging_WatchRoomServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRoom not implemented")
+}
 func (*UnimplementedMessagingServer) CreateBlurb(context.Context, *CreateBlurbRequest) (*Blurb, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBlurb not implemented")
 }
@@ -2767,6 +3101,27 @@ func _Messaging_ListRooms_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Messaging_WatchRoom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRoomRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MessagingServer).WatchRoom(m, &messagingWatchRoomServer{stream})
+}
+
+type Messaging_WatchRoomServer interface {
+	Send(*WatchRoomResponse) error
+	grpc.ServerStream
+}
+
+type messagingWatchRoomServer struct {
+	grpc.ServerStream
+}
+
+func (x *messagingWatchRoomServer) Send(m *WatchRoomResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Messaging_CreateBlurb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateBlurbRequest)
 	if err := dec(in); err != nil {
@@ -2998,6 +3353,11 @@ var _Messaging_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRoom",
+			Handler:       _Messaging_WatchRoom_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "StreamBlurbs",
 			Handler:       _Messaging_StreamBlurbs_Handler,
@@ -0,0 +1,467 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.15.0
+// source: google/showcase/v1beta1/rollout.proto
+
+package genproto
+
+import (
+	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A version of the schema.
+type SchemaRollout_Version int32
+
+const (
+	// The server serves the GREEN version.
+	SchemaRollout_VERSION_UNSPECIFIED SchemaRollout_Version = 0
+	// The schema before the change. The server ignores EchoRequest.label and
+	// never sets EchoResponse.label, as a server that predates the fields
+	// would.
+	SchemaRollout_BLUE SchemaRollout_Version = 1
+	// The schema after the change. The server echoes EchoRequest.label in
+	// EchoResponse.label.
+	SchemaRollout_GREEN SchemaRollout_Version = 2
+)
+
+// Enum value maps for SchemaRollout_Version.
+var (
+	SchemaRollout_Version_name = map[int32]string{
+		0: "VERSION_UNSPECIFIED",
+		1: "BLUE",
+		2: "GREEN",
+	}
+	SchemaRollout_Version_value = map[string]int32{
+		"VERSION_UNSPECIFIED": 0,
+		"BLUE":                1,
+		"GREEN":               2,
+	}
+)
+
+func (x SchemaRollout_Version) Enum() *SchemaRollout_Version {
+	p := new(SchemaRollout_Version)
+	*p = x
+	return p
+}
+
+func (x SchemaRollout_Version) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchemaRollout_Version) Descriptor() protoreflect.EnumDescriptor {
+	return file_google_showcase_v1beta1_rollout_proto_enumTypes[0].Descriptor()
+}
+
+func (SchemaRollout_Version) Type() protoreflect.EnumType {
+	return &file_google_showcase_v1beta1_rollout_proto_enumTypes[0]
+}
+
+func (x SchemaRollout_Version) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchemaRollout_Version.Descriptor instead.
+func (SchemaRollout_Version) EnumDescriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_rollout_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// The rollout of a schema change adding the EchoRequest.label and
+// EchoResponse.label fields.
+type SchemaRollout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the schema the server serves.
+	Version SchemaRollout_Version `protobuf:"varint,1,opt,name=version,proto3,enum=google.showcase.v1beta1.SchemaRollout_Version" json:"version,omitempty"`
+	// When positive, the server switches to the other version of the schema
+	// after serving this many calls to Echo, as consecutive calls reaching
+	// instances on either side of the rollout would.
+	SwitchEvery int32 `protobuf:"varint,2,opt,name=switch_every,json=switchEvery,proto3" json:"switch_every,omitempty"`
+	// The number of calls to Echo served since the version last switched.
+	// Output only.
+	CallsServed int32 `protobuf:"varint,3,opt,name=calls_served,json=callsServed,proto3" json:"calls_served,omitempty"`
+}
+
+func (x *SchemaRollout) Reset() {
+	*x = SchemaRollout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_rollout_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaRollout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaRollout) ProtoMessage() {}
+
+func (x *SchemaRollout) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_rollout_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaRollout.ProtoReflect.Descriptor instead.
+func (*SchemaRollout) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_rollout_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SchemaRollout) GetVersion() SchemaRollout_Version {
+	if x != nil {
+		return x.Version
+	}
+	return SchemaRollout_VERSION_UNSPECIFIED
+}
+
+func (x *SchemaRollout) GetSwitchEvery() int32 {
+	if x != nil {
+		return x.SwitchEvery
+	}
+	return 0
+}
+
+func (x *SchemaRollout) GetCallsServed() int32 {
+	if x != nil {
+		return x.CallsServed
+	}
+	return 0
+}
+
+// The request message for the GetSchemaRollout method.
+type GetSchemaRolloutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSchemaRolloutRequest) Reset() {
+	*x = GetSchemaRolloutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_rollout_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchemaRolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchemaRolloutRequest) ProtoMessage() {}
+
+func (x *GetSchemaRolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_rollout_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchemaRolloutRequest.ProtoReflect.Descriptor instead.
+func (*GetSchemaRolloutRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_rollout_proto_rawDescGZIP(), []int{1}
+}
+
+var File_google_showcase_v1beta1_rollout_proto protoreflect.FileDescriptor
+
+var file_google_showcase_v1beta1_rollout_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x01, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x48, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x65, 0x76,
+	0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x45, 0x76, 0x65, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x61,
+	0x6c, 0x6c, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x64, 0x22, 0x37, 0x0a, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x42, 0x4c, 0x55, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x45, 0x45, 0x4e,
+	0x10, 0x02, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0xb5, 0x02,
+	0x0a, 0x07, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x86, 0x01, 0x0a, 0x10, 0x53, 0x65,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x26,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x3a,
+	0x01, 0x2a, 0x12, 0x8d, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x30, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x2f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x1a, 0x11, 0xca, 0x41, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74,
+	0x3a, 0x37, 0x34, 0x36, 0x39, 0x42, 0x71, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x61,
+	0x70, 0x69, 0x63, 0x2d, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xea, 0x02, 0x19, 0x47,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x3a,
+	0x3a, 0x56, 0x31, 0x42, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_google_showcase_v1beta1_rollout_proto_rawDescOnce sync.Once
+	file_google_showcase_v1beta1_rollout_proto_rawDescData = file_google_showcase_v1beta1_rollout_proto_rawDesc
+)
+
+func file_google_showcase_v1beta1_rollout_proto_rawDescGZIP() []byte {
+	file_google_showcase_v1beta1_rollout_proto_rawDescOnce.Do(func() {
+		file_google_showcase_v1beta1_rollout_proto_rawDescData = protoimpl.X.CompressGZIP(file_google_showcase_v1beta1_rollout_proto_rawDescData)
+	})
+	return file_google_showcase_v1beta1_rollout_proto_rawDescData
+}
+
+var file_google_showcase_v1beta1_rollout_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_google_showcase_v1beta1_rollout_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_google_showcase_v1beta1_rollout_proto_goTypes = []interface{}{
+	(SchemaRollout_Version)(0),      // 0: google.showcase.v1beta1.SchemaRollout.Version
+	(*SchemaRollout)(nil),           // 1: google.showcase.v1beta1.SchemaRollout
+	(*GetSchemaRolloutRequest)(nil), // 2: google.showcase.v1beta1.GetSchemaRolloutRequest
+}
+var file_google_showcase_v1beta1_rollout_proto_depIdxs = []int32{
+	0, // 0: google.showcase.v1beta1.SchemaRollout.version:type_name -> google.showcase.v1beta1.SchemaRollout.Version
+	1, // 1: google.showcase.v1beta1.Rollout.SetSchemaRollout:input_type -> google.showcase.v1beta1.SchemaRollout
+	2, // 2: google.showcase.v1beta1.Rollout.GetSchemaRollout:input_type -> google.showcase.v1beta1.GetSchemaRolloutRequest
+	1, // 3: google.showcase.v1beta1.Rollout.SetSchemaRollout:output_type -> google.showcase.v1beta1.SchemaRollout
+	1, // 4: google.showcase.v1beta1.Rollout.GetSchemaRollout:output_type -> google.showcase.v1beta1.SchemaRollout
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_google_showcase_v1beta1_rollout_proto_init() }
+func file_google_showcase_v1beta1_rollout_proto_init() {
+	if File_google_showcase_v1beta1_rollout_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_google_showcase_v1beta1_rollout_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaRollout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_rollout_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchemaRolloutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_google_showcase_v1beta1_rollout_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_google_showcase_v1beta1_rollout_proto_goTypes,
+		DependencyIndexes: file_google_showcase_v1beta1_rollout_proto_depIdxs,
+		EnumInfos:         file_google_showcase_v1beta1_rollout_proto_enumTypes,
+		MessageInfos:      file_google_showcase_v1beta1_rollout_proto_msgTypes,
+	}.Build()
+	File_google_showcase_v1beta1_rollout_proto = out.File
+	file_google_showcase_v1beta1_rollout_proto_rawDesc = nil
+	file_google_showcase_v1beta1_rollout_proto_goTypes = nil
+	file_google_showcase_v1beta1_rollout_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// RolloutClient is the client API for Rollout service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RolloutClient interface {
+	// Sets the version of the schema the server serves, and how often it
+	// switches to the other version.
+	SetSchemaRollout(ctx context.Context, in *SchemaRollout, opts ...grpc.CallOption) (*SchemaRollout, error)
+	// Returns the version of the schema the server serves.
+	GetSchemaRollout(ctx context.Context, in *GetSchemaRolloutRequest, opts ...grpc.CallOption) (*SchemaRollout, error)
+}
+
+type rolloutClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRolloutClient(cc grpc.ClientConnInterface) RolloutClient {
+	return &rolloutClient{cc}
+}
+
+func (c *rolloutClient) SetSchemaRollout(ctx context.Context, in *SchemaRollout, opts ...grpc.CallOption) (*SchemaRollout, error) {
+	out := new(SchemaRollout)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Rollout/SetSchemaRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolloutClient) GetSchemaRollout(ctx context.Context, in *GetSchemaRolloutRequest, opts ...grpc.CallOption) (*SchemaRollout, error) {
+	out := new(SchemaRollout)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Rollout/GetSchemaRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RolloutServer is the server API for Rollout service.
+type RolloutServer interface {
+	// Sets the version of the schema the server serves, and how often it
+	// switches to the other version.
+	SetSchemaRollout(context.Context, *SchemaRollout) (*SchemaRollout, error)
+	// Returns the version of the schema the server serves.
+	GetSchemaRollout(context.Context, *GetSchemaRolloutRequest) (*SchemaRollout, error)
+}
+
+// UnimplementedRolloutServer can be embedded to have forward compatible implementations.
+type UnimplementedRolloutServer struct {
+}
+
+func (*UnimplementedRolloutServer) SetSchemaRollout(context.Context, *SchemaRollout) (*SchemaRollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSchemaRollout not implemented")
+}
+func (*UnimplementedRolloutServer) GetSchemaRollout(context.Context, *GetSchemaRolloutRequest) (*SchemaRollout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaRollout not implemented")
+}
+
+func RegisterRolloutServer(s *grpc.Server, srv RolloutServer) {
+	s.RegisterService(&_Rollout_serviceDesc, srv)
+}
+
+func _Rollout_SetSchemaRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaRollout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServer).SetSchemaRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Rollout/SetSchemaRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServer).SetSchemaRollout(ctx, req.(*SchemaRollout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rollout_GetSchemaRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServer).GetSchemaRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Rollout/GetSchemaRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServer).GetSchemaRollout(ctx, req.(*GetSchemaRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Rollout_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "google.showcase.v1beta1.Rollout",
+	HandlerType: (*RolloutServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetSchemaRollout",
+			Handler:    _Rollout_SetSchemaRollout_Handler,
+		},
+		{
+			MethodName: "GetSchemaRollout",
+			Handler:    _Rollout_GetSchemaRollout_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "google/showcase/v1beta1/rollout.proto",
+}
@@ -0,0 +1,2476 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.15.0
+// source: google/showcase/v1beta1/transport.proto
+
+package genproto
+
+import (
+	context "context"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status1 "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The request message for the GetStreamQueueReport method.
+type GetStreamQueueReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only report the connections opened from the caller's host.
+	CallerOnly bool `protobuf:"varint,1,opt,name=caller_only,json=callerOnly,proto3" json:"caller_only,omitempty"`
+}
+
+func (x *GetStreamQueueReportRequest) Reset() {
+	*x = GetStreamQueueReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStreamQueueReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamQueueReportRequest) ProtoMessage() {}
+
+func (x *GetStreamQueueReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamQueueReportRequest.ProtoReflect.Descriptor instead.
+func (*GetStreamQueueReportRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetStreamQueueReportRequest) GetCallerOnly() bool {
+	if x != nil {
+		return x.CallerOnly
+	}
+	return false
+}
+
+// The streams opened on the gRPC connections to the server.
+type StreamQueueReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The SETTINGS_MAX_CONCURRENT_STREAMS the server advertises, or 0 if the
+	// number of concurrent streams is not limited.
+	MaxConcurrentStreams int32 `protobuf:"varint,1,opt,name=max_concurrent_streams,json=maxConcurrentStreams,proto3" json:"max_concurrent_streams,omitempty"`
+	// The gRPC connections currently open, oldest first.
+	Connections []*StreamQueueReport_Connection `protobuf:"bytes,2,rep,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *StreamQueueReport) Reset() {
+	*x = StreamQueueReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamQueueReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamQueueReport) ProtoMessage() {}
+
+func (x *StreamQueueReport) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamQueueReport.ProtoReflect.Descriptor instead.
+func (*StreamQueueReport) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreamQueueReport) GetMaxConcurrentStreams() int32 {
+	if x != nil {
+		return x.MaxConcurrentStreams
+	}
+	return 0
+}
+
+func (x *StreamQueueReport) GetConnections() []*StreamQueueReport_Connection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+// The request message for the TriggerGoAway method.
+type TriggerGoAwayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The HTTP/2 error code of the GOAWAY, such as 0 for NO_ERROR or 11 for
+	// ENHANCE_YOUR_CALM.
+	ErrorCode uint32 `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// The debug data of the GOAWAY.
+	DebugData string `protobuf:"bytes,2,opt,name=debug_data,json=debugData,proto3" json:"debug_data,omitempty"`
+}
+
+func (x *TriggerGoAwayRequest) Reset() {
+	*x = TriggerGoAwayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerGoAwayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerGoAwayRequest) ProtoMessage() {}
+
+func (x *TriggerGoAwayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerGoAwayRequest.ProtoReflect.Descriptor instead.
+func (*TriggerGoAwayRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TriggerGoAwayRequest) GetErrorCode() uint32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *TriggerGoAwayRequest) GetDebugData() string {
+	if x != nil {
+		return x.DebugData
+	}
+	return ""
+}
+
+// The response message for the TriggerGoAway method.
+type TriggerGoAwayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address of the connection the GOAWAY will be sent on.
+	RemoteAddress string `protobuf:"bytes,1,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+}
+
+func (x *TriggerGoAwayResponse) Reset() {
+	*x = TriggerGoAwayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerGoAwayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerGoAwayResponse) ProtoMessage() {}
+
+func (x *TriggerGoAwayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerGoAwayResponse.ProtoReflect.Descriptor instead.
+func (*TriggerGoAwayResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TriggerGoAwayResponse) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+// The request message for the ListBinaryLogEntries method.
+type ListBinaryLogEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only list the entries of calls to this method, in the form
+	// "/google.showcase.v1beta1.Echo/Echo".
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// Only list the entries of the call with this ID.
+	CallId uint64 `protobuf:"varint,2,opt,name=call_id,json=callId,proto3" json:"call_id,omitempty"`
+	// Only list the entries of calls from the caller's host.
+	CallerOnly bool `protobuf:"varint,3,opt,name=caller_only,json=callerOnly,proto3" json:"caller_only,omitempty"`
+}
+
+func (x *ListBinaryLogEntriesRequest) Reset() {
+	*x = ListBinaryLogEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBinaryLogEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBinaryLogEntriesRequest) ProtoMessage() {}
+
+func (x *ListBinaryLogEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBinaryLogEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListBinaryLogEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListBinaryLogEntriesRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ListBinaryLogEntriesRequest) GetCallId() uint64 {
+	if x != nil {
+		return x.CallId
+	}
+	return 0
+}
+
+func (x *ListBinaryLogEntriesRequest) GetCallerOnly() bool {
+	if x != nil {
+		return x.CallerOnly
+	}
+	return false
+}
+
+// The response message for the ListBinaryLogEntries method.
+type ListBinaryLogEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entries, each a grpc.binarylog.v1.GrpcLogEntry, in the order they were
+	// captured.
+	Entries []*anypb.Any `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListBinaryLogEntriesResponse) Reset() {
+	*x = ListBinaryLogEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBinaryLogEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBinaryLogEntriesResponse) ProtoMessage() {}
+
+func (x *ListBinaryLogEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBinaryLogEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListBinaryLogEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListBinaryLogEntriesResponse) GetEntries() []*anypb.Any {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// The request message for the StartPacketCapture method.
+type StartPacketCaptureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the capture, such as the name of the test session it is for.
+	CaptureId string `protobuf:"bytes,1,opt,name=capture_id,json=captureId,proto3" json:"capture_id,omitempty"`
+}
+
+func (x *StartPacketCaptureRequest) Reset() {
+	*x = StartPacketCaptureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPacketCaptureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPacketCaptureRequest) ProtoMessage() {}
+
+func (x *StartPacketCaptureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPacketCaptureRequest.ProtoReflect.Descriptor instead.
+func (*StartPacketCaptureRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StartPacketCaptureRequest) GetCaptureId() string {
+	if x != nil {
+		return x.CaptureId
+	}
+	return ""
+}
+
+// The request message for the StopPacketCapture method.
+type StopPacketCaptureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the capture to stop.
+	CaptureId string `protobuf:"bytes,1,opt,name=capture_id,json=captureId,proto3" json:"capture_id,omitempty"`
+}
+
+func (x *StopPacketCaptureRequest) Reset() {
+	*x = StopPacketCaptureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPacketCaptureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPacketCaptureRequest) ProtoMessage() {}
+
+func (x *StopPacketCaptureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPacketCaptureRequest.ProtoReflect.Descriptor instead.
+func (*StopPacketCaptureRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StopPacketCaptureRequest) GetCaptureId() string {
+	if x != nil {
+		return x.CaptureId
+	}
+	return ""
+}
+
+// A capture of the traffic of the server's connections.
+type PacketCapture struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the capture.
+	CaptureId string `protobuf:"bytes,1,opt,name=capture_id,json=captureId,proto3" json:"capture_id,omitempty"`
+	// The time the capture started.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The number of packets captured.
+	Packets int32 `protobuf:"varint,3,opt,name=packets,proto3" json:"packets,omitempty"`
+	// Whether packets were dropped because the capture reached its size limit.
+	Truncated bool `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// The capture, in pcap format. Only set once the capture has stopped.
+	Pcap []byte `protobuf:"bytes,5,opt,name=pcap,proto3" json:"pcap,omitempty"`
+}
+
+func (x *PacketCapture) Reset() {
+	*x = PacketCapture{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PacketCapture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCapture) ProtoMessage() {}
+
+func (x *PacketCapture) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCapture.ProtoReflect.Descriptor instead.
+func (*PacketCapture) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PacketCapture) GetCaptureId() string {
+	if x != nil {
+		return x.CaptureId
+	}
+	return ""
+}
+
+func (x *PacketCapture) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *PacketCapture) GetPackets() int32 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *PacketCapture) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *PacketCapture) GetPcap() []byte {
+	if x != nil {
+		return x.Pcap
+	}
+	return nil
+}
+
+// The request message for the ListAuthorities method.
+type ListAuthoritiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only list the calls to this method, in the form
+	// "/google.showcase.v1beta1.Echo/Echo" for gRPC calls and
+	// "POST /v1beta1/echo:echo" for REST calls.
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *ListAuthoritiesRequest) Reset() {
+	*x = ListAuthoritiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuthoritiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthoritiesRequest) ProtoMessage() {}
+
+func (x *ListAuthoritiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthoritiesRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthoritiesRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListAuthoritiesRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+// The response message for the ListAuthorities method.
+type ListAuthoritiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The calls, oldest first.
+	Records []*AuthorityRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *ListAuthoritiesResponse) Reset() {
+	*x = ListAuthoritiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuthoritiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthoritiesResponse) ProtoMessage() {}
+
+func (x *ListAuthoritiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthoritiesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthoritiesResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAuthoritiesResponse) GetRecords() []*AuthorityRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// The authority one call was sent to.
+type AuthorityRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The method called, in the form the ListAuthorities request filters on.
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// The transport of the call, "grpc" or "rest".
+	Transport string `protobuf:"bytes,2,opt,name=transport,proto3" json:"transport,omitempty"`
+	// The :authority of the gRPC call, or the Host header of the REST call.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The time the call was received.
+	Time *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	// Whether the call was failed because it was not sent to the expected
+	// authority.
+	Rejected bool `protobuf:"varint,5,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *AuthorityRecord) Reset() {
+	*x = AuthorityRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorityRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorityRecord) ProtoMessage() {}
+
+func (x *AuthorityRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorityRecord.ProtoReflect.Descriptor instead.
+func (*AuthorityRecord) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AuthorityRecord) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuthorityRecord) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+func (x *AuthorityRecord) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *AuthorityRecord) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuthorityRecord) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+// The request message for the ExpectAuthority method.
+type ExpectAuthorityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The authority calls must be sent to. If it has no port, only the host of
+	// the calls' authority is compared to it. If empty, calls may be sent to any
+	// authority.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (x *ExpectAuthorityRequest) Reset() {
+	*x = ExpectAuthorityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpectAuthorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpectAuthorityRequest) ProtoMessage() {}
+
+func (x *ExpectAuthorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpectAuthorityRequest.ProtoReflect.Descriptor instead.
+func (*ExpectAuthorityRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExpectAuthorityRequest) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+// The response message for the ExpectAuthority method.
+type ExpectAuthorityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The authority calls had to be sent to before this call.
+	PreviousAuthority string `protobuf:"bytes,1,opt,name=previous_authority,json=previousAuthority,proto3" json:"previous_authority,omitempty"`
+}
+
+func (x *ExpectAuthorityResponse) Reset() {
+	*x = ExpectAuthorityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpectAuthorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpectAuthorityResponse) ProtoMessage() {}
+
+func (x *ExpectAuthorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpectAuthorityResponse.ProtoReflect.Descriptor instead.
+func (*ExpectAuthorityResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExpectAuthorityResponse) GetPreviousAuthority() string {
+	if x != nil {
+		return x.PreviousAuthority
+	}
+	return ""
+}
+
+// The request message for the VerifySignature method. The call must carry
+// the signature, as lowercase hex, in the x-showcase-signature header, and the
+// names of the signed headers, separated by semicolons, in the
+// x-showcase-signed-headers header.
+//
+// The canonical request signed is made of the following lines, separated by
+// newlines:
+//   - "SHOWCASE-HMAC-SHA256".
+//   - The HTTP method and the path, followed by "?" and the query string if
+//     any, separated by a space. The method of gRPC calls is "POST" and their
+//     path is the full method name, e.g.
+//     "/google.showcase.v1beta1.Transport/VerifySignature".
+//   - For each signed header, sorted by name, its lowercase name, a colon and
+//     its values, trimmed, separated by commas. Over REST, the "host" header is
+//     the Host of the call.
+//   - The lowercase hex SHA-256 digest of the body: the raw body of REST calls,
+//     and the deterministic protobuf encoding of this message for gRPC calls.
+type VerifySignatureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arbitrary content signed along with the rest of the call.
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *VerifySignatureRequest) Reset() {
+	*x = VerifySignatureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifySignatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySignatureRequest) ProtoMessage() {}
+
+func (x *VerifySignatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySignatureRequest.ProtoReflect.Descriptor instead.
+func (*VerifySignatureRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VerifySignatureRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// The response message for the VerifySignature method.
+type VerifySignatureResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the call carried the signature the server computed.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The canonical request the server computed for the call.
+	CanonicalRequest string `protobuf:"bytes,2,opt,name=canonical_request,json=canonicalRequest,proto3" json:"canonical_request,omitempty"`
+	// The lowercase hex signature the server computed for the call.
+	ExpectedSignature string `protobuf:"bytes,3,opt,name=expected_signature,json=expectedSignature,proto3" json:"expected_signature,omitempty"`
+	// The signature the call carried.
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The names of the headers the call signed, sorted.
+	SignedHeaders []string `protobuf:"bytes,5,rep,name=signed_headers,json=signedHeaders,proto3" json:"signed_headers,omitempty"`
+}
+
+func (x *VerifySignatureResponse) Reset() {
+	*x = VerifySignatureResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifySignatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySignatureResponse) ProtoMessage() {}
+
+func (x *VerifySignatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySignatureResponse.ProtoReflect.Descriptor instead.
+func (*VerifySignatureResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VerifySignatureResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifySignatureResponse) GetCanonicalRequest() string {
+	if x != nil {
+		return x.CanonicalRequest
+	}
+	return ""
+}
+
+func (x *VerifySignatureResponse) GetExpectedSignature() string {
+	if x != nil {
+		return x.ExpectedSignature
+	}
+	return ""
+}
+
+func (x *VerifySignatureResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *VerifySignatureResponse) GetSignedHeaders() []string {
+	if x != nil {
+		return x.SignedHeaders
+	}
+	return nil
+}
+
+// The request message for the ListCapturedCalls method.
+type ListCapturedCallsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The method whose calls are listed, in the form
+	// "/google.showcase.v1beta1.Echo/Echo".
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// How many of the most recent calls to list. All of the calls kept are
+	// listed if 0.
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListCapturedCallsRequest) Reset() {
+	*x = ListCapturedCallsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCapturedCallsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCapturedCallsRequest) ProtoMessage() {}
+
+func (x *ListCapturedCallsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCapturedCallsRequest.ProtoReflect.Descriptor instead.
+func (*ListCapturedCallsRequest) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListCapturedCallsRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ListCapturedCallsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// The response message for the ListCapturedCalls method.
+type ListCapturedCallsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The calls, oldest first.
+	Calls []*CapturedCall `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (x *ListCapturedCallsResponse) Reset() {
+	*x = ListCapturedCallsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCapturedCallsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCapturedCallsResponse) ProtoMessage() {}
+
+func (x *ListCapturedCallsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCapturedCallsResponse.ProtoReflect.Descriptor instead.
+func (*ListCapturedCallsResponse) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCapturedCallsResponse) GetCalls() []*CapturedCall {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+// A gRPC call captured by the server.
+type CapturedCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The method called, in the form "/google.showcase.v1beta1.Echo/Echo".
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// The time the call was received.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The time the call ended.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The request metadata of the call, sorted by key.
+	RequestHeaders []*CapturedCall_Header `protobuf:"bytes,4,rep,name=request_headers,json=requestHeaders,proto3" json:"request_headers,omitempty"`
+	// The request messages received, in order. At most 100 are kept.
+	Requests []*anypb.Any `protobuf:"bytes,5,rep,name=requests,proto3" json:"requests,omitempty"`
+	// The response headers sent, sorted by key.
+	ResponseHeaders []*CapturedCall_Header `protobuf:"bytes,6,rep,name=response_headers,json=responseHeaders,proto3" json:"response_headers,omitempty"`
+	// The response messages sent, in order. At most 100 are kept.
+	Responses []*anypb.Any `protobuf:"bytes,7,rep,name=responses,proto3" json:"responses,omitempty"`
+	// The response trailers sent, sorted by key.
+	ResponseTrailers []*CapturedCall_Header `protobuf:"bytes,8,rep,name=response_trailers,json=responseTrailers,proto3" json:"response_trailers,omitempty"`
+	// The status the call ended with.
+	Status *status.Status `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CapturedCall) Reset() {
+	*x = CapturedCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapturedCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturedCall) ProtoMessage() {}
+
+func (x *CapturedCall) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturedCall.ProtoReflect.Descriptor instead.
+func (*CapturedCall) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CapturedCall) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CapturedCall) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CapturedCall) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CapturedCall) GetRequestHeaders() []*CapturedCall_Header {
+	if x != nil {
+		return x.RequestHeaders
+	}
+	return nil
+}
+
+func (x *CapturedCall) GetRequests() []*anypb.Any {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *CapturedCall) GetResponseHeaders() []*CapturedCall_Header {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+func (x *CapturedCall) GetResponses() []*anypb.Any {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *CapturedCall) GetResponseTrailers() []*CapturedCall_Header {
+	if x != nil {
+		return x.ResponseTrailers
+	}
+	return nil
+}
+
+func (x *CapturedCall) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// The streams opened on one connection.
+type StreamQueueReport_Connection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address the client connected from.
+	RemoteAddress string `protobuf:"bytes,1,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	// The time the connection was opened.
+	OpenTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	// The number of streams currently open.
+	ActiveStreams int32 `protobuf:"varint,3,opt,name=active_streams,json=activeStreams,proto3" json:"active_streams,omitempty"`
+	// The largest number of streams that were open at once.
+	PeakStreams int32 `protobuf:"varint,4,opt,name=peak_streams,json=peakStreams,proto3" json:"peak_streams,omitempty"`
+	// The number of streams opened in total.
+	TotalStreams int64 `protobuf:"varint,5,opt,name=total_streams,json=totalStreams,proto3" json:"total_streams,omitempty"`
+	// The number of streams that were opened as soon as another one closed
+	// while the connection was at the limit of concurrent streams, which means
+	// the client had queued them waiting for a stream slot.
+	QueuedStreams int64 `protobuf:"varint,6,opt,name=queued_streams,json=queuedStreams,proto3" json:"queued_streams,omitempty"`
+	// The time the last queued stream was opened.
+	LastQueuedTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_queued_time,json=lastQueuedTime,proto3" json:"last_queued_time,omitempty"`
+}
+
+func (x *StreamQueueReport_Connection) Reset() {
+	*x = StreamQueueReport_Connection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamQueueReport_Connection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamQueueReport_Connection) ProtoMessage() {}
+
+func (x *StreamQueueReport_Connection) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamQueueReport_Connection.ProtoReflect.Descriptor instead.
+func (*StreamQueueReport_Connection) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *StreamQueueReport_Connection) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *StreamQueueReport_Connection) GetOpenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenTime
+	}
+	return nil
+}
+
+func (x *StreamQueueReport_Connection) GetActiveStreams() int32 {
+	if x != nil {
+		return x.ActiveStreams
+	}
+	return 0
+}
+
+func (x *StreamQueueReport_Connection) GetPeakStreams() int32 {
+	if x != nil {
+		return x.PeakStreams
+	}
+	return 0
+}
+
+func (x *StreamQueueReport_Connection) GetTotalStreams() int64 {
+	if x != nil {
+		return x.TotalStreams
+	}
+	return 0
+}
+
+func (x *StreamQueueReport_Connection) GetQueuedStreams() int64 {
+	if x != nil {
+		return x.QueuedStreams
+	}
+	return 0
+}
+
+func (x *StreamQueueReport_Connection) GetLastQueuedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastQueuedTime
+	}
+	return nil
+}
+
+// A metadata entry of the call.
+type CapturedCall_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the entry.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The values of the entry, in order.
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *CapturedCall_Header) Reset() {
+	*x = CapturedCall_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapturedCall_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturedCall_Header) ProtoMessage() {}
+
+func (x *CapturedCall_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_google_showcase_v1beta1_transport_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturedCall_Header.ProtoReflect.Descriptor instead.
+func (*CapturedCall_Header) Descriptor() ([]byte, []int) {
+	return file_google_showcase_v1beta1_transport_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *CapturedCall_Header) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CapturedCall_Header) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_google_showcase_v1beta1_transport_proto protoreflect.FileDescriptor
+
+var file_google_showcase_v1beta1_transport_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70,
+	0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3e,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xed,
+	0x03, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x57, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0xc8, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x61,
+	0x6b, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x70, 0x65, 0x61, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e,
+	0x6c, 0x61, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x54,
+	0x0a, 0x14, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x47, 0x6f, 0x41, 0x77, 0x61, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x3e, 0x0a, 0x15, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x47,
+	0x6f, 0x41, 0x77, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x6f, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61,
+	0x6c, 0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x4e, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x64, 0x22, 0x39, 0x0a, 0x18, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x22, 0xb5, 0x01, 0x0a,
+	0x0d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x63, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x70, 0x63, 0x61, 0x70, 0x22, 0x30, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x5d, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x36, 0x0a, 0x16, 0x45, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x22, 0x48, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x12,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x32, 0x0a, 0x16, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
+	0xd0, 0x01, 0x0a, 0x17, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x61,
+	0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d,
+	0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x22, 0x48, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x63, 0x61, 0x6c,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0xe9, 0x04, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x55, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x43, 0x61, 0x6c,
+	0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x57, 0x0a, 0x10, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x09, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x32,
+	0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x32, 0xdb, 0x0b, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x9c, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x34, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x22, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12,
+	0x94, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x47, 0x6f, 0x41, 0x77, 0x61,
+	0x79, 0x12, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x47, 0x6f, 0x41, 0x77, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x47, 0x6f, 0x41, 0x77, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x67, 0x6f, 0x61,
+	0x77, 0x61, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0xa9, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x34, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x6c,
+	0x6f, 0x67, 0x12, 0x98, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xa8, 0x01,
+	0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x31, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74,
+	0x6f, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x22, 0x38,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x2d, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x3a, 0x73, 0x74, 0x6f, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x9c, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0xa6, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x3a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x3a, 0x01, 0x2a,
+	0x12, 0xa3, 0x01, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22,
+	0x22, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x3a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xa4, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x31, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f,
+	0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x1a, 0x11, 0xca,
+	0x41, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x37, 0x34, 0x36, 0x39,
+	0x42, 0x71, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x50,
+	0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x61, 0x70, 0x69, 0x63, 0x2d, 0x73,
+	0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67,
+	0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xea, 0x02, 0x19, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x3a, 0x3a, 0x53, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x42, 0x65,
+	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_google_showcase_v1beta1_transport_proto_rawDescOnce sync.Once
+	file_google_showcase_v1beta1_transport_proto_rawDescData = file_google_showcase_v1beta1_transport_proto_rawDesc
+)
+
+func file_google_showcase_v1beta1_transport_proto_rawDescGZIP() []byte {
+	file_google_showcase_v1beta1_transport_proto_rawDescOnce.Do(func() {
+		file_google_showcase_v1beta1_transport_proto_rawDescData = protoimpl.X.CompressGZIP(file_google_showcase_v1beta1_transport_proto_rawDescData)
+	})
+	return file_google_showcase_v1beta1_transport_proto_rawDescData
+}
+
+var file_google_showcase_v1beta1_transport_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_google_showcase_v1beta1_transport_proto_goTypes = []interface{}{
+	(*GetStreamQueueReportRequest)(nil),  // 0: google.showcase.v1beta1.GetStreamQueueReportRequest
+	(*StreamQueueReport)(nil),            // 1: google.showcase.v1beta1.StreamQueueReport
+	(*TriggerGoAwayRequest)(nil),         // 2: google.showcase.v1beta1.TriggerGoAwayRequest
+	(*TriggerGoAwayResponse)(nil),        // 3: google.showcase.v1beta1.TriggerGoAwayResponse
+	(*ListBinaryLogEntriesRequest)(nil),  // 4: google.showcase.v1beta1.ListBinaryLogEntriesRequest
+	(*ListBinaryLogEntriesResponse)(nil), // 5: google.showcase.v1beta1.ListBinaryLogEntriesResponse
+	(*StartPacketCaptureRequest)(nil),    // 6: google.showcase.v1beta1.StartPacketCaptureRequest
+	(*StopPacketCaptureRequest)(nil),     // 7: google.showcase.v1beta1.StopPacketCaptureRequest
+	(*PacketCapture)(nil),                // 8: google.showcase.v1beta1.PacketCapture
+	(*ListAuthoritiesRequest)(nil),       // 9: google.showcase.v1beta1.ListAuthoritiesRequest
+	(*ListAuthoritiesResponse)(nil),      // 10: google.showcase.v1beta1.ListAuthoritiesResponse
+	(*AuthorityRecord)(nil),              // 11: google.showcase.v1beta1.AuthorityRecord
+	(*ExpectAuthorityRequest)(nil),       // 12: google.showcase.v1beta1.ExpectAuthorityRequest
+	(*ExpectAuthorityResponse)(nil),      // 13: google.showcase.v1beta1.ExpectAuthorityResponse
+	(*VerifySignatureRequest)(nil),       // 14: google.showcase.v1beta1.VerifySignatureRequest
+	(*VerifySignatureResponse)(nil),      // 15: google.showcase.v1beta1.VerifySignatureResponse
+	(*ListCapturedCallsRequest)(nil),     // 16: google.showcase.v1beta1.ListCapturedCallsRequest
+	(*ListCapturedCallsResponse)(nil),    // 17: google.showcase.v1beta1.ListCapturedCallsResponse
+	(*CapturedCall)(nil),                 // 18: google.showcase.v1beta1.CapturedCall
+	(*StreamQueueReport_Connection)(nil), // 19: google.showcase.v1beta1.StreamQueueReport.Connection
+	(*CapturedCall_Header)(nil),          // 20: google.showcase.v1beta1.CapturedCall.Header
+	(*anypb.Any)(nil),                    // 21: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),        // 22: google.protobuf.Timestamp
+	(*status.Status)(nil),                // 23: google.rpc.Status
+}
+var file_google_showcase_v1beta1_transport_proto_depIdxs = []int32{
+	19, // 0: google.showcase.v1beta1.StreamQueueReport.connections:type_name -> google.showcase.v1beta1.StreamQueueReport.Connection
+	21, // 1: google.showcase.v1beta1.ListBinaryLogEntriesResponse.entries:type_name -> google.protobuf.Any
+	22, // 2: google.showcase.v1beta1.PacketCapture.start_time:type_name -> google.protobuf.Timestamp
+	11, // 3: google.showcase.v1beta1.ListAuthoritiesResponse.records:type_name -> google.showcase.v1beta1.AuthorityRecord
+	22, // 4: google.showcase.v1beta1.AuthorityRecord.time:type_name -> google.protobuf.Timestamp
+	18, // 5: google.showcase.v1beta1.ListCapturedCallsResponse.calls:type_name -> google.showcase.v1beta1.CapturedCall
+	22, // 6: google.showcase.v1beta1.CapturedCall.start_time:type_name -> google.protobuf.Timestamp
+	22, // 7: google.showcase.v1beta1.CapturedCall.end_time:type_name -> google.protobuf.Timestamp
+	20, // 8: google.showcase.v1beta1.CapturedCall.request_headers:type_name -> google.showcase.v1beta1.CapturedCall.Header
+	21, // 9: google.showcase.v1beta1.CapturedCall.requests:type_name -> google.protobuf.Any
+	20, // 10: google.showcase.v1beta1.CapturedCall.response_headers:type_name -> google.showcase.v1beta1.CapturedCall.Header
+	21, // 11: google.showcase.v1beta1.CapturedCall.responses:type_name -> google.protobuf.Any
+	20, // 12: google.showcase.v1beta1.CapturedCall.response_trailers:type_name -> google.showcase.v1beta1.CapturedCall.Header
+	23, // 13: google.showcase.v1beta1.CapturedCall.status:type_name -> google.rpc.Status
+	22, // 14: google.showcase.v1beta1.StreamQueueReport.Connection.open_time:type_name -> google.protobuf.Timestamp
+	22, // 15: google.showcase.v1beta1.StreamQueueReport.Connection.last_queued_time:type_name -> google.protobuf.Timestamp
+	0,  // 16: google.showcase.v1beta1.Transport.GetStreamQueueReport:input_type -> google.showcase.v1beta1.GetStreamQueueReportRequest
+	2,  // 17: google.showcase.v1beta1.Transport.TriggerGoAway:input_type -> google.showcase.v1beta1.TriggerGoAwayRequest
+	4,  // 18: google.showcase.v1beta1.Transport.ListBinaryLogEntries:input_type -> google.showcase.v1beta1.ListBinaryLogEntriesRequest
+	6,  // 19: google.showcase.v1beta1.Transport.StartPacketCapture:input_type -> google.showcase.v1beta1.StartPacketCaptureRequest
+	7,  // 20: google.showcase.v1beta1.Transport.StopPacketCapture:input_type -> google.showcase.v1beta1.StopPacketCaptureRequest
+	9,  // 21: google.showcase.v1beta1.Transport.ListAuthorities:input_type -> google.showcase.v1beta1.ListAuthoritiesRequest
+	12, // 22: google.showcase.v1beta1.Transport.ExpectAuthority:input_type -> google.showcase.v1beta1.ExpectAuthorityRequest
+	14, // 23: google.showcase.v1beta1.Transport.VerifySignature:input_type -> google.showcase.v1beta1.VerifySignatureRequest
+	16, // 24: google.showcase.v1beta1.Transport.ListCapturedCalls:input_type -> google.showcase.v1beta1.ListCapturedCallsRequest
+	1,  // 25: google.showcase.v1beta1.Transport.GetStreamQueueReport:output_type -> google.showcase.v1beta1.StreamQueueReport
+	3,  // 26: google.showcase.v1beta1.Transport.TriggerGoAway:output_type -> google.showcase.v1beta1.TriggerGoAwayResponse
+	5,  // 27: google.showcase.v1beta1.Transport.ListBinaryLogEntries:output_type -> google.showcase.v1beta1.ListBinaryLogEntriesResponse
+	8,  // 28: google.showcase.v1beta1.Transport.StartPacketCapture:output_type -> google.showcase.v1beta1.PacketCapture
+	8,  // 29: google.showcase.v1beta1.Transport.StopPacketCapture:output_type -> google.showcase.v1beta1.PacketCapture
+	10, // 30: google.showcase.v1beta1.Transport.ListAuthorities:output_type -> google.showcase.v1beta1.ListAuthoritiesResponse
+	13, // 31: google.showcase.v1beta1.Transport.ExpectAuthority:output_type -> google.showcase.v1beta1.ExpectAuthorityResponse
+	15, // 32: google.showcase.v1beta1.Transport.VerifySignature:output_type -> google.showcase.v1beta1.VerifySignatureResponse
+	17, // 33: google.showcase.v1beta1.Transport.ListCapturedCalls:output_type -> google.showcase.v1beta1.ListCapturedCallsResponse
+	25, // [25:34] is the sub-list for method output_type
+	16, // [16:25] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_google_showcase_v1beta1_transport_proto_init() }
+func file_google_showcase_v1beta1_transport_proto_init() {
+	if File_google_showcase_v1beta1_transport_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_google_showcase_v1beta1_transport_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamQueueReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamQueueReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerGoAwayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerGoAwayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBinaryLogEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBinaryLogEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPacketCaptureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPacketCaptureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PacketCapture); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuthoritiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuthoritiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorityRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpectAuthorityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpectAuthorityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifySignatureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifySignatureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCapturedCallsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCapturedCallsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapturedCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamQueueReport_Connection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_google_showcase_v1beta1_transport_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapturedCall_Header); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_google_showcase_v1beta1_transport_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_google_showcase_v1beta1_transport_proto_goTypes,
+		DependencyIndexes: file_google_showcase_v1beta1_transport_proto_depIdxs,
+		MessageInfos:      file_google_showcase_v1beta1_transport_proto_msgTypes,
+	}.Build()
+	File_google_showcase_v1beta1_transport_proto = out.File
+	file_google_showcase_v1beta1_transport_proto_rawDesc = nil
+	file_google_showcase_v1beta1_transport_proto_goTypes = nil
+	file_google_showcase_v1beta1_transport_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// TransportClient is the client API for Transport service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TransportClient interface {
+	// Reports the streams gRPC clients have opened on each of their connections,
+	// including the streams clients held back until a stream slot was freed
+	// because the connection was at its limit of concurrent streams.
+	GetStreamQueueReport(ctx context.Context, in *GetStreamQueueReportRequest, opts ...grpc.CallOption) (*StreamQueueReport, error)
+	// Makes the server send a GOAWAY on the caller's connection right after the
+	// response to this call. A GOAWAY with an error code is followed by the
+	// server closing the connection. This is only available over gRPC.
+	TriggerGoAway(ctx context.Context, in *TriggerGoAwayRequest, opts ...grpc.CallOption) (*TriggerGoAwayResponse, error)
+	// Lists the gRPC binary log entries the server captured for calls matching
+	// the request. The server only captures calls to the methods selected with
+	// its --binary-log-methods flag, and keeps the most recent entries.
+	ListBinaryLogEntries(ctx context.Context, in *ListBinaryLogEntriesRequest, opts ...grpc.CallOption) (*ListBinaryLogEntriesResponse, error)
+	// Starts capturing the traffic of the server's connections. The capture
+	// holds the bytes the server read and wrote, still encrypted when TLS is on,
+	// as the TCP packets that would have carried them on the loopback
+	// interface. Connections opened before the capture started cannot be
+	// decrypted, since their TLS handshake is not in the capture.
+	StartPacketCapture(ctx context.Context, in *StartPacketCaptureRequest, opts ...grpc.CallOption) (*PacketCapture, error)
+	// Stops a packet capture, and returns it in pcap format, which packet
+	// analyzers such as Wireshark open. Given the TLS key log the server writes
+	// with --tls-key-log-file, they also decrypt it.
+	StopPacketCapture(ctx context.Context, in *StopPacketCaptureRequest, opts ...grpc.CallOption) (*PacketCapture, error)
+	// Lists the authorities recent calls were sent to: the :authority of gRPC
+	// calls and the Host header of REST calls. This lets tests check which
+	// endpoint a client resolved, e.g. after an endpoint override or with a
+	// universe domain set.
+	ListAuthorities(ctx context.Context, in *ListAuthoritiesRequest, opts ...grpc.CallOption) (*ListAuthoritiesResponse, error)
+	// Makes the server fail the calls that are not sent to the given authority,
+	// with FAILED_PRECONDITION over gRPC and 421 Misdirected Request over REST.
+	// Calls to this service are never failed. An empty authority lifts the
+	// expectation.
+	ExpectAuthority(ctx context.Context, in *ExpectAuthorityRequest, opts ...grpc.CallOption) (*ExpectAuthorityResponse, error)
+	// Verifies the HMAC-SHA256 signature of this very call, computed with the
+	// server's signing key over the canonical form of the call's method, path,
+	// signed headers and body. The response carries the canonical request the
+	// server computed, so that clients implementing request signing can check
+	// their canonicalization exactly. Mismatches are reported, not failed.
+	VerifySignature(ctx context.Context, in *VerifySignatureRequest, opts ...grpc.CallOption) (*VerifySignatureResponse, error)
+	// Lists the most recent gRPC calls to a method, as captured when they hit
+	// the wire: their request metadata, the messages exchanged, the response
+	// metadata and the status they ended with. This lets tests assert on exactly
+	// what a client sent without standing up a proxy. The server keeps the last
+	// 100 calls to each method.
+	ListCapturedCalls(ctx context.Context, in *ListCapturedCallsRequest, opts ...grpc.CallOption) (*ListCapturedCallsResponse, error)
+}
+
+type transportClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransportClient(cc grpc.ClientConnInterface) TransportClient {
+	return &transportClient{cc}
+}
+
+func (c *transportClient) GetStreamQueueReport(ctx context.Context, in *GetStreamQueueReportRequest, opts ...grpc.CallOption) (*StreamQueueReport, error) {
+	out := new(StreamQueueReport)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Transport/GetStreamQueueReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportClient) TriggerGoAway(ctx context.Context, in *TriggerGoAwayRequest, opts ...grpc.CallOption) (*TriggerGoAwayResponse, error) {
+	out := new(TriggerGoAwayResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Transport/TriggerGoAway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportClient) ListBinaryLogEntries(ctx context.Context, in *ListBinaryLogEntriesRequest, opts ...grpc.CallOption) (*ListBinaryLogEntriesResponse, error) {
+	out := new(ListBinaryLogEntriesResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Transport/ListBinaryLogEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportClient) StartPacketCapture(ctx context.Context, in *StartPacketCaptureRequest, opts ...grpc.CallOption) (*PacketCapture, error) {
+	out := new(PacketCapture)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Transport/StartPacketCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportClient) StopPacketCapture(ctx context.Context, in *StopPacketCaptureRequest, opts ...grpc.CallOption) (*PacketCapture, error) {
+	out := new(PacketCapture)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Transport/StopPacketCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportClient) ListAuthorities(ctx context.Context, in *ListAuthoritiesRequest, opts ...grpc.CallOption) (*ListAuthoritiesResponse, error) {
+	out := new(ListAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Transport/ListAuthorities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportClient) ExpectAuthority(ctx context.Context, in *ExpectAuthorityRequest, opts ...grpc.CallOption) (*ExpectAuthorityResponse, error) {
+	out := new(ExpectAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Transport/ExpectAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportClient) VerifySignature(ctx context.Context, in *VerifySignatureRequest, opts ...grpc.CallOption) (*VerifySignatureResponse, error) {
+	out := new(VerifySignatureResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Transport/VerifySignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportClient) ListCapturedCalls(ctx context.Context, in *ListCapturedCallsRequest, opts ...grpc.CallOption) (*ListCapturedCallsResponse, error) {
+	out := new(ListCapturedCallsResponse)
+	err := c.cc.Invoke(ctx, "/google.showcase.v1beta1.Transport/ListCapturedCalls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransportServer is the server API for Transport service.
+type TransportServer interface {
+	// Reports the streams gRPC clients have opened on each of their connections,
+	// including the streams clients held back until a stream slot was freed
+	// because the connection was at its limit of concurrent streams.
+	GetStreamQueueReport(context.Context, *GetStreamQueueReportRequest) (*StreamQueueReport, error)
+	// Makes the server send a GOAWAY on the caller's connection right after the
+	// response to this call. A GOAWAY with an error code is followed by the
+	// server closing the connection. This is only available over gRPC.
+	TriggerGoAway(context.Context, *TriggerGoAwayRequest) (*TriggerGoAwayResponse, error)
+	// Lists the gRPC binary log entries the server captured for calls matching
+	// the request. The server only captures calls to the methods selected with
+	// its --binary-log-methods flag, and keeps the most recent entries.
+	ListBinaryLogEntries(context.Context, *ListBinaryLogEntriesRequest) (*ListBinaryLogEntriesResponse, error)
+	// Starts capturing the traffic of the server's connections. The capture
+	// holds the bytes the server read and wrote, still encrypted when TLS is on,
+	// as the TCP packets that would have carried them on the loopback
+	// interface. Connections opened before the capture started cannot be
+	// decrypted, since their TLS handshake is not in the capture.
+	StartPacketCapture(context.Context, *StartPacketCaptureRequest) (*PacketCapture, error)
+	// Stops a packet capture, and returns it in pcap format, which packet
+	// analyzers such as Wireshark open. Given the TLS key log the server writes
+	// with --tls-key-log-file, they also decrypt it.
+	StopPacketCapture(context.Context, *StopPacketCaptureRequest) (*PacketCapture, error)
+	// Lists the authorities recent calls were sent to: the :authority of gRPC
+	// calls and the Host header of REST calls. This lets tests check which
+	// endpoint a client resolved, e.g. after an endpoint override or with a
+	// universe domain set.
+	ListAuthorities(context.Context, *ListAuthoritiesRequest) (*ListAuthoritiesResponse, error)
+	// Makes the server fail the calls that are not sent to the given authority,
+	// with FAILED_PRECONDITION over gRPC and 421 Misdirected Request over REST.
+	// Calls to this service are never failed. An empty authority lifts the
+	// expectation.
+	ExpectAuthority(context.Context, *ExpectAuthorityRequest) (*ExpectAuthorityResponse, error)
+	// Verifies the HMAC-SHA256 signature of this very call, computed with the
+	// server's signing key over the canonical form of the call's method, path,
+	// signed headers and body. The response carries the canonical request the
+	// server computed, so that clients implementing request signing can check
+	// their canonicalization exactly. Mismatches are reported, not failed.
+	VerifySignature(context.Context, *VerifySignatureRequest) (*VerifySignatureResponse, error)
+	// Lists the most recent gRPC calls to a method, as captured when they hit
+	// the wire: their request metadata, the messages exchanged, the response
+	// metadata and the status they ended with. This lets tests assert on exactly
+	// what a client sent without standing up a proxy. The server keeps the last
+	// 100 calls to each method.
+	ListCapturedCalls(context.Context, *ListCapturedCallsRequest) (*ListCapturedCallsResponse, error)
+}
+
+// UnimplementedTransportServer can be embedded to have forward compatible implementations.
+type UnimplementedTransportServer struct {
+}
+
+func (*UnimplementedTransportServer) GetStreamQueueReport(context.Context, *GetStreamQueueReportRequest) (*StreamQueueReport, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method GetStreamQueueReport not implemented")
+}
+func (*UnimplementedTransportServer) TriggerGoAway(context.Context, *TriggerGoAwayRequest) (*TriggerGoAwayResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method TriggerGoAway not implemented")
+}
+func (*UnimplementedTransportServer) ListBinaryLogEntries(context.Context, *ListBinaryLogEntriesRequest) (*ListBinaryLogEntriesResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListBinaryLogEntries not implemented")
+}
+func (*UnimplementedTransportServer) StartPacketCapture(context.Context, *StartPacketCaptureRequest) (*PacketCapture, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method StartPacketCapture not implemented")
+}
+func (*UnimplementedTransportServer) StopPacketCapture(context.Context, *StopPacketCaptureRequest) (*PacketCapture, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method StopPacketCapture not implemented")
+}
+func (*UnimplementedTransportServer) ListAuthorities(context.Context, *ListAuthoritiesRequest) (*ListAuthoritiesResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListAuthorities not implemented")
+}
+func (*UnimplementedTransportServer) ExpectAuthority(context.Context, *ExpectAuthorityRequest) (*ExpectAuthorityResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ExpectAuthority not implemented")
+}
+func (*UnimplementedTransportServer) VerifySignature(context.Context, *VerifySignatureRequest) (*VerifySignatureResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method VerifySignature not implemented")
+}
+func (*UnimplementedTransportServer) ListCapturedCalls(context.Context, *ListCapturedCallsRequest) (*ListCapturedCallsResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListCapturedCalls not implemented")
+}
+
+func RegisterTransportServer(s *grpc.Server, srv TransportServer) {
+	s.RegisterService(&_Transport_serviceDesc, srv)
+}
+
+func _Transport_GetStreamQueueReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStreamQueueReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportServer).GetStreamQueueReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Transport/GetStreamQueueReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportServer).GetStreamQueueReport(ctx, req.(*GetStreamQueueReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transport_TriggerGoAway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerGoAwayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportServer).TriggerGoAway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Transport/TriggerGoAway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportServer).TriggerGoAway(ctx, req.(*TriggerGoAwayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transport_ListBinaryLogEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBinaryLogEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportServer).ListBinaryLogEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Transport/ListBinaryLogEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportServer).ListBinaryLogEntries(ctx, req.(*ListBinaryLogEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transport_StartPacketCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPacketCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportServer).StartPacketCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Transport/StartPacketCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportServer).StartPacketCapture(ctx, req.(*StartPacketCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transport_StopPacketCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopPacketCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportServer).StopPacketCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Transport/StopPacketCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportServer).StopPacketCapture(ctx, req.(*StopPacketCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transport_ListAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthoritiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportServer).ListAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Transport/ListAuthorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportServer).ListAuthorities(ctx, req.(*ListAuthoritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transport_ExpectAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpectAuthorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportServer).ExpectAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Transport/ExpectAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportServer).ExpectAuthority(ctx, req.(*ExpectAuthorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transport_VerifySignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportServer).VerifySignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Transport/VerifySignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportServer).VerifySignature(ctx, req.(*VerifySignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transport_ListCapturedCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCapturedCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportServer).ListCapturedCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.showcase.v1beta1.Transport/ListCapturedCalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportServer).ListCapturedCalls(ctx, req.(*ListCapturedCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Transport_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "google.showcase.v1beta1.Transport",
+	HandlerType: (*TransportServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStreamQueueReport",
+			Handler:    _Transport_GetStreamQueueReport_Handler,
+		},
+		{
+			MethodName: "TriggerGoAway",
+			Handler:    _Transport_TriggerGoAway_Handler,
+		},
+		{
+			MethodName: "ListBinaryLogEntries",
+			Handler:    _Transport_ListBinaryLogEntries_Handler,
+		},
+		{
+			MethodName: "StartPacketCapture",
+			Handler:    _Transport_StartPacketCapture_Handler,
+		},
+		{
+			MethodName: "StopPacketCapture",
+			Handler:    _Transport_StopPacketCapture_Handler,
+		},
+		{
+			MethodName: "ListAuthorities",
+			Handler:    _Transport_ListAuthorities_Handler,
+		},
+		{
+			MethodName: "ExpectAuthority",
+			Handler:    _Transport_ExpectAuthority_Handler,
+		},
+		{
+			MethodName: "VerifySignature",
+			Handler:    _Transport_VerifySignature_Handler,
+		},
+		{
+			MethodName: "ListCapturedCalls",
+			Handler:    _Transport_ListCapturedCalls_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "google/showcase/v1beta1/transport.proto",
+}
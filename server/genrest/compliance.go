@@ -13,13 +13,12 @@
 // limitations under the License.
 
 // DO NOT EDIT. This is an auto-generated file containing the REST handlers
-// for service #0: "Compliance" (.google.showcase.v1beta1.Compliance).
+// for service #4: "Compliance" (.google.showcase.v1beta1.Compliance).
 
 package genrest
 
 import (
 	"bytes"
-	"context"
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
 	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
 	gmux "github.com/gorilla/mux"
@@ -33,6 +32,8 @@ func (backend *RESTBackend) HandleRepeatDataBody(w http.ResponseWriter, r *http.
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/repeat:body", urlPathParams, "*", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/repeat:body': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -70,24 +71,35 @@ func (backend *RESTBackend) HandleRepeatDataBody(w http.ResponseWriter, r *http.
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.ComplianceServer.RepeatDataBody(context.Background(), request)
+	response, err := backend.ComplianceServer.RepeatDataBody(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleRepeatDataBodyInfo translates REST requests/responses on the wire to internal proto messages for RepeatDataBodyInfo
@@ -96,6 +108,8 @@ func (backend *RESTBackend) HandleRepeatDataBodyInfo(w http.ResponseWriter, r *h
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/repeat:bodyinfo", urlPathParams, "info", r.URL.Query(), []string{"info"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/repeat:bodyinfo': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -143,24 +157,35 @@ func (backend *RESTBackend) HandleRepeatDataBodyInfo(w http.ResponseWriter, r *h
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.ComplianceServer.RepeatDataBodyInfo(context.Background(), request)
+	response, err := backend.ComplianceServer.RepeatDataBodyInfo(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleRepeatDataQuery translates REST requests/responses on the wire to internal proto messages for RepeatDataQuery
@@ -169,6 +194,8 @@ func (backend *RESTBackend) HandleRepeatDataQuery(w http.ResponseWriter, r *http
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/repeat:query", urlPathParams, "", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/repeat:query': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -194,24 +221,35 @@ func (backend *RESTBackend) HandleRepeatDataQuery(w http.ResponseWriter, r *http
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.ComplianceServer.RepeatDataQuery(context.Background(), request)
+	response, err := backend.ComplianceServer.RepeatDataQuery(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleRepeatDataSimplePath translates REST requests/responses on the wire to internal proto messages for RepeatDataSimplePath
@@ -220,6 +258,8 @@ func (backend *RESTBackend) HandleRepeatDataSimplePath(w http.ResponseWriter, r
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/repeat/{info.f_string}/{info.f_int32}/{info.f_double}/{info.f_bool}/{info.f_kingdom}:simplepath", urlPathParams, "", r.URL.Query(), []string{"info.f_string", "info.f_int32", "info.f_double", "info.f_bool", "info.f_kingdom"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/repeat/{info.f_string}/{info.f_int32}/{info.f_double}/{info.f_bool}/{info.f_kingdom}:simplepath': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 5, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -250,24 +290,35 @@ func (backend *RESTBackend) HandleRepeatDataSimplePath(w http.ResponseWriter, r
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.ComplianceServer.RepeatDataSimplePath(context.Background(), request)
+	response, err := backend.ComplianceServer.RepeatDataSimplePath(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleRepeatDataPathResource translates REST requests/responses on the wire to internal proto messages for RepeatDataPathResource
@@ -276,6 +327,8 @@ func (backend *RESTBackend) HandleRepeatDataPathResource(w http.ResponseWriter,
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/repeat/{info.f_string=first/*}/{info.f_child.f_string=second/*}/bool/{info.f_bool}:pathresource", urlPathParams, "", r.URL.Query(), []string{"info.f_string", "info.f_child.f_string", "info.f_bool"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/repeat/{info.f_string=first/*}/{info.f_child.f_string=second/*}/bool/{info.f_bool}:pathresource': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 3, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -306,24 +359,35 @@ func (backend *RESTBackend) HandleRepeatDataPathResource(w http.ResponseWriter,
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.ComplianceServer.RepeatDataPathResource(context.Background(), request)
+	response, err := backend.ComplianceServer.RepeatDataPathResource(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleRepeatDataPathTrailingResource translates REST requests/responses on the wire to internal proto messages for RepeatDataPathTrailingResource
@@ -332,6 +396,8 @@ func (backend *RESTBackend) HandleRepeatDataPathTrailingResource(w http.Response
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/repeat/{info.f_string=first/*}/{info.f_child.f_string=second/**}:pathtrailingresource", urlPathParams, "", r.URL.Query(), []string{"info.f_string", "info.f_child.f_string"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/repeat/{info.f_string=first/*}/{info.f_child.f_string=second/**}:pathtrailingresource': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 2, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -362,24 +428,35 @@ func (backend *RESTBackend) HandleRepeatDataPathTrailingResource(w http.Response
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.ComplianceServer.RepeatDataPathTrailingResource(context.Background(), request)
+	response, err := backend.ComplianceServer.RepeatDataPathTrailingResource(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleRepeatDataBodyPut translates REST requests/responses on the wire to internal proto messages for RepeatDataBodyPut
@@ -388,6 +465,8 @@ func (backend *RESTBackend) HandleRepeatDataBodyPut(w http.ResponseWriter, r *ht
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/repeat:bodyput", urlPathParams, "*", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/repeat:bodyput': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -425,24 +504,35 @@ func (backend *RESTBackend) HandleRepeatDataBodyPut(w http.ResponseWriter, r *ht
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.ComplianceServer.RepeatDataBodyPut(context.Background(), request)
+	response, err := backend.ComplianceServer.RepeatDataBodyPut(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleRepeatDataBodyPatch translates REST requests/responses on the wire to internal proto messages for RepeatDataBodyPatch
@@ -451,6 +541,8 @@ func (backend *RESTBackend) HandleRepeatDataBodyPatch(w http.ResponseWriter, r *
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/repeat:bodypatch", urlPathParams, "*", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/repeat:bodypatch': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -488,22 +580,33 @@ func (backend *RESTBackend) HandleRepeatDataBodyPatch(w http.ResponseWriter, r *
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.ComplianceServer.RepeatDataBodyPatch(context.Background(), request)
+	response, err := backend.ComplianceServer.RepeatDataBodyPatch(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
@@ -13,13 +13,12 @@
 // limitations under the License.
 
 // DO NOT EDIT. This is an auto-generated file containing the REST handlers
-// for service #1: "Echo" (.google.showcase.v1beta1.Echo).
+// for service #7: "Echo" (.google.showcase.v1beta1.Echo).
 
 package genrest
 
 import (
 	"bytes"
-	"context"
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
 	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
 	gmux "github.com/gorilla/mux"
@@ -33,6 +32,8 @@ func (backend *RESTBackend) HandleEcho(w http.ResponseWriter, r *http.Request) {
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/echo:echo", urlPathParams, "*", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/echo:echo': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -70,24 +71,35 @@ func (backend *RESTBackend) HandleEcho(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.EchoServer.Echo(context.Background(), request)
+	response, err := backend.EchoServer.Echo(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleExpand translates REST requests/responses on the wire to internal proto messages for Expand
@@ -108,6 +120,8 @@ func (backend *RESTBackend) HandlePagedExpand(w http.ResponseWriter, r *http.Req
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/echo:pagedExpand", urlPathParams, "*", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/echo:pagedExpand': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -145,24 +159,111 @@ func (backend *RESTBackend) HandlePagedExpand(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.EchoServer.PagedExpand(context.Background(), request)
+	response, err := backend.EchoServer.PagedExpand(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
+
+// HandleLongRunningPagedExpand translates REST requests/responses on the wire to internal proto messages for LongRunningPagedExpand
+//    Generated for HTTP binding pattern: "/v1beta1/echo:longRunningPagedExpand"
+func (backend *RESTBackend) HandleLongRunningPagedExpand(w http.ResponseWriter, r *http.Request) {
+	urlPathParams := gmux.Vars(r)
+	numUrlPathParams := len(urlPathParams)
+
+	resttools.SetTranscodingHeader(w, "/v1beta1/echo:longRunningPagedExpand", urlPathParams, "*", r.URL.Query(), []string{})
+
+	backend.StdLog.Printf("Received %s request matching '/v1beta1/echo:longRunningPagedExpand': %q", r.Method, r.URL)
+	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
+
+	if numUrlPathParams != 0 {
+		backend.Error(w, http.StatusBadRequest, "found unexpected number of URL variables: expected 0, have %d: %#v", numUrlPathParams, urlPathParams)
+		return
+	}
+
+	request := &genprotopb.LongRunningPagedExpandRequest{}
+	// Intentional: Field values in the URL path override those set in the body.
+	var jsonReader bytes.Buffer
+	bodyReader := io.TeeReader(r.Body, &jsonReader)
+	rBytes := make([]byte, r.ContentLength)
+	if _, err := bodyReader.Read(rBytes); err != nil && err != io.EOF {
+		backend.Error(w, http.StatusBadRequest, "error reading body content: %s", err)
+		return
+	}
+
+	if err := resttools.FromJSON().Unmarshal(rBytes, request); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading body params '*': %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequestFormat(&jsonReader, r, request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed format check: %s", err)
+		return
+	}
+
+	if queryParams := r.URL.Query(); len(queryParams) > 0 {
+		backend.Error(w, http.StatusBadRequest, "encountered unexpected query params: %v", queryParams)
+		return
+	}
+	if err := resttools.PopulateSingularFields(request, urlPathParams); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading URL path params: %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
+	marshaler := resttools.ToJSON()
+	requestJSON, _ := marshaler.Marshal(request)
+	backend.StdLog.Printf("  request: %s", requestJSON)
+
+	response, err := backend.EchoServer.LongRunningPagedExpand(r.Context(), request)
+	if err != nil {
+		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
+		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
+		return
+	}
+
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
+	if err != nil {
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandlePagedExpandLegacy translates REST requests/responses on the wire to internal proto messages for PagedExpandLegacy
@@ -171,6 +272,8 @@ func (backend *RESTBackend) HandlePagedExpandLegacy(w http.ResponseWriter, r *ht
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/echo:pagedExpandLegacy", urlPathParams, "*", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/echo:pagedExpandLegacy': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -208,24 +311,35 @@ func (backend *RESTBackend) HandlePagedExpandLegacy(w http.ResponseWriter, r *ht
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.EchoServer.PagedExpandLegacy(context.Background(), request)
+	response, err := backend.EchoServer.PagedExpandLegacy(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleWait translates REST requests/responses on the wire to internal proto messages for Wait
@@ -234,6 +348,8 @@ func (backend *RESTBackend) HandleWait(w http.ResponseWriter, r *http.Request) {
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/echo:wait", urlPathParams, "*", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/echo:wait': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -271,24 +387,111 @@ func (backend *RESTBackend) HandleWait(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.EchoServer.Wait(context.Background(), request)
+	response, err := backend.EchoServer.Wait(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
+
+// HandleNestedWait translates REST requests/responses on the wire to internal proto messages for NestedWait
+//    Generated for HTTP binding pattern: "/v1beta1/echo:nestedWait"
+func (backend *RESTBackend) HandleNestedWait(w http.ResponseWriter, r *http.Request) {
+	urlPathParams := gmux.Vars(r)
+	numUrlPathParams := len(urlPathParams)
+
+	resttools.SetTranscodingHeader(w, "/v1beta1/echo:nestedWait", urlPathParams, "*", r.URL.Query(), []string{})
+
+	backend.StdLog.Printf("Received %s request matching '/v1beta1/echo:nestedWait': %q", r.Method, r.URL)
+	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
+
+	if numUrlPathParams != 0 {
+		backend.Error(w, http.StatusBadRequest, "found unexpected number of URL variables: expected 0, have %d: %#v", numUrlPathParams, urlPathParams)
+		return
+	}
+
+	request := &genprotopb.NestedWaitRequest{}
+	// Intentional: Field values in the URL path override those set in the body.
+	var jsonReader bytes.Buffer
+	bodyReader := io.TeeReader(r.Body, &jsonReader)
+	rBytes := make([]byte, r.ContentLength)
+	if _, err := bodyReader.Read(rBytes); err != nil && err != io.EOF {
+		backend.Error(w, http.StatusBadRequest, "error reading body content: %s", err)
+		return
+	}
+
+	if err := resttools.FromJSON().Unmarshal(rBytes, request); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading body params '*': %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequestFormat(&jsonReader, r, request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed format check: %s", err)
+		return
+	}
+
+	if queryParams := r.URL.Query(); len(queryParams) > 0 {
+		backend.Error(w, http.StatusBadRequest, "encountered unexpected query params: %v", queryParams)
+		return
+	}
+	if err := resttools.PopulateSingularFields(request, urlPathParams); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading URL path params: %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
+	marshaler := resttools.ToJSON()
+	requestJSON, _ := marshaler.Marshal(request)
+	backend.StdLog.Printf("  request: %s", requestJSON)
+
+	response, err := backend.EchoServer.NestedWait(r.Context(), request)
+	if err != nil {
+		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
+		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
+		return
+	}
+
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
+	if err != nil {
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleBlock translates REST requests/responses on the wire to internal proto messages for Block
@@ -297,6 +500,8 @@ func (backend *RESTBackend) HandleBlock(w http.ResponseWriter, r *http.Request)
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/echo:block", urlPathParams, "*", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/echo:block': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -334,22 +539,337 @@ func (backend *RESTBackend) HandleBlock(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
+	marshaler := resttools.ToJSON()
+	requestJSON, _ := marshaler.Marshal(request)
+	backend.StdLog.Printf("  request: %s", requestJSON)
+
+	response, err := backend.EchoServer.Block(r.Context(), request)
+	if err != nil {
+		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
+		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
+		return
+	}
+
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
+	if err != nil {
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
+
+// HandleEchoHeaders translates REST requests/responses on the wire to internal proto messages for EchoHeaders
+//    Generated for HTTP binding pattern: "/v1beta1/echo:headers"
+func (backend *RESTBackend) HandleEchoHeaders(w http.ResponseWriter, r *http.Request) {
+	urlPathParams := gmux.Vars(r)
+	numUrlPathParams := len(urlPathParams)
+
+	resttools.SetTranscodingHeader(w, "/v1beta1/echo:headers", urlPathParams, "*", r.URL.Query(), []string{})
+
+	backend.StdLog.Printf("Received %s request matching '/v1beta1/echo:headers': %q", r.Method, r.URL)
+	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
+
+	if numUrlPathParams != 0 {
+		backend.Error(w, http.StatusBadRequest, "found unexpected number of URL variables: expected 0, have %d: %#v", numUrlPathParams, urlPathParams)
+		return
+	}
+
+	request := &genprotopb.EchoHeadersRequest{}
+	// Intentional: Field values in the URL path override those set in the body.
+	var jsonReader bytes.Buffer
+	bodyReader := io.TeeReader(r.Body, &jsonReader)
+	rBytes := make([]byte, r.ContentLength)
+	if _, err := bodyReader.Read(rBytes); err != nil && err != io.EOF {
+		backend.Error(w, http.StatusBadRequest, "error reading body content: %s", err)
+		return
+	}
+
+	if err := resttools.FromJSON().Unmarshal(rBytes, request); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading body params '*': %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequestFormat(&jsonReader, r, request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed format check: %s", err)
+		return
+	}
+
+	if queryParams := r.URL.Query(); len(queryParams) > 0 {
+		backend.Error(w, http.StatusBadRequest, "encountered unexpected query params: %v", queryParams)
+		return
+	}
+	if err := resttools.PopulateSingularFields(request, urlPathParams); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading URL path params: %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
+	marshaler := resttools.ToJSON()
+	requestJSON, _ := marshaler.Marshal(request)
+	backend.StdLog.Printf("  request: %s", requestJSON)
+
+	response, err := backend.EchoServer.EchoHeaders(r.Context(), request)
+	if err != nil {
+		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
+		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
+		return
+	}
+
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
+	if err != nil {
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
+
+// HandleProbeDeadline translates REST requests/responses on the wire to internal proto messages for ProbeDeadline
+//    Generated for HTTP binding pattern: "/v1beta1/echo:probeDeadline"
+func (backend *RESTBackend) HandleProbeDeadline(w http.ResponseWriter, r *http.Request) {
+	urlPathParams := gmux.Vars(r)
+	numUrlPathParams := len(urlPathParams)
+
+	resttools.SetTranscodingHeader(w, "/v1beta1/echo:probeDeadline", urlPathParams, "*", r.URL.Query(), []string{})
+
+	backend.StdLog.Printf("Received %s request matching '/v1beta1/echo:probeDeadline': %q", r.Method, r.URL)
+	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
+
+	if numUrlPathParams != 0 {
+		backend.Error(w, http.StatusBadRequest, "found unexpected number of URL variables: expected 0, have %d: %#v", numUrlPathParams, urlPathParams)
+		return
+	}
+
+	request := &genprotopb.ProbeDeadlineRequest{}
+	// Intentional: Field values in the URL path override those set in the body.
+	var jsonReader bytes.Buffer
+	bodyReader := io.TeeReader(r.Body, &jsonReader)
+	rBytes := make([]byte, r.ContentLength)
+	if _, err := bodyReader.Read(rBytes); err != nil && err != io.EOF {
+		backend.Error(w, http.StatusBadRequest, "error reading body content: %s", err)
+		return
+	}
+
+	if err := resttools.FromJSON().Unmarshal(rBytes, request); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading body params '*': %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequestFormat(&jsonReader, r, request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed format check: %s", err)
+		return
+	}
+
+	if queryParams := r.URL.Query(); len(queryParams) > 0 {
+		backend.Error(w, http.StatusBadRequest, "encountered unexpected query params: %v", queryParams)
+		return
+	}
+	if err := resttools.PopulateSingularFields(request, urlPathParams); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading URL path params: %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
+	marshaler := resttools.ToJSON()
+	requestJSON, _ := marshaler.Marshal(request)
+	backend.StdLog.Printf("  request: %s", requestJSON)
+
+	response, err := backend.EchoServer.ProbeDeadline(r.Context(), request)
+	if err != nil {
+		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
+		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
+		return
+	}
+
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
+	if err != nil {
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
+
+// HandleGeneratePayload translates REST requests/responses on the wire to internal proto messages for GeneratePayload
+//    Generated for HTTP binding pattern: "/v1beta1/echo:generatePayload"
+func (backend *RESTBackend) HandleGeneratePayload(w http.ResponseWriter, r *http.Request) {
+	urlPathParams := gmux.Vars(r)
+	numUrlPathParams := len(urlPathParams)
+
+	resttools.SetTranscodingHeader(w, "/v1beta1/echo:generatePayload", urlPathParams, "*", r.URL.Query(), []string{})
+
+	backend.StdLog.Printf("Received %s request matching '/v1beta1/echo:generatePayload': %q", r.Method, r.URL)
+	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
+
+	if numUrlPathParams != 0 {
+		backend.Error(w, http.StatusBadRequest, "found unexpected number of URL variables: expected 0, have %d: %#v", numUrlPathParams, urlPathParams)
+		return
+	}
+
+	request := &genprotopb.GeneratePayloadRequest{}
+	// Intentional: Field values in the URL path override those set in the body.
+	var jsonReader bytes.Buffer
+	bodyReader := io.TeeReader(r.Body, &jsonReader)
+	rBytes := make([]byte, r.ContentLength)
+	if _, err := bodyReader.Read(rBytes); err != nil && err != io.EOF {
+		backend.Error(w, http.StatusBadRequest, "error reading body content: %s", err)
+		return
+	}
+
+	if err := resttools.FromJSON().Unmarshal(rBytes, request); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading body params '*': %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequestFormat(&jsonReader, r, request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed format check: %s", err)
+		return
+	}
+
+	if queryParams := r.URL.Query(); len(queryParams) > 0 {
+		backend.Error(w, http.StatusBadRequest, "encountered unexpected query params: %v", queryParams)
+		return
+	}
+	if err := resttools.PopulateSingularFields(request, urlPathParams); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading URL path params: %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
+	marshaler := resttools.ToJSON()
+	requestJSON, _ := marshaler.Marshal(request)
+	backend.StdLog.Printf("  request: %s", requestJSON)
+
+	response, err := backend.EchoServer.GeneratePayload(r.Context(), request)
+	if err != nil {
+		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
+		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
+		return
+	}
+
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
+	if err != nil {
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
+
+// HandleFanOut translates REST requests/responses on the wire to internal proto messages for FanOut
+//    Generated for HTTP binding pattern: "/v1beta1/echo:fanOut"
+func (backend *RESTBackend) HandleFanOut(w http.ResponseWriter, r *http.Request) {
+	urlPathParams := gmux.Vars(r)
+	numUrlPathParams := len(urlPathParams)
+
+	resttools.SetTranscodingHeader(w, "/v1beta1/echo:fanOut", urlPathParams, "*", r.URL.Query(), []string{})
+
+	backend.StdLog.Printf("Received %s request matching '/v1beta1/echo:fanOut': %q", r.Method, r.URL)
+	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
+
+	if numUrlPathParams != 0 {
+		backend.Error(w, http.StatusBadRequest, "found unexpected number of URL variables: expected 0, have %d: %#v", numUrlPathParams, urlPathParams)
+		return
+	}
+
+	request := &genprotopb.FanOutRequest{}
+	// Intentional: Field values in the URL path override those set in the body.
+	var jsonReader bytes.Buffer
+	bodyReader := io.TeeReader(r.Body, &jsonReader)
+	rBytes := make([]byte, r.ContentLength)
+	if _, err := bodyReader.Read(rBytes); err != nil && err != io.EOF {
+		backend.Error(w, http.StatusBadRequest, "error reading body content: %s", err)
+		return
+	}
+
+	if err := resttools.FromJSON().Unmarshal(rBytes, request); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading body params '*': %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequestFormat(&jsonReader, r, request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed format check: %s", err)
+		return
+	}
+
+	if queryParams := r.URL.Query(); len(queryParams) > 0 {
+		backend.Error(w, http.StatusBadRequest, "encountered unexpected query params: %v", queryParams)
+		return
+	}
+	if err := resttools.PopulateSingularFields(request, urlPathParams); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading URL path params: %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.EchoServer.Block(context.Background(), request)
+	response, err := backend.EchoServer.FanOut(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
@@ -30,6 +30,16 @@ type RESTBackend services.Backend
 
 func RegisterHandlers(router *gmux.Router, backend *services.Backend) {
 	rest := (*RESTBackend)(backend)
+	router.HandleFunc("/v1beta1/admin/config", rest.HandleGetServerConfig).Methods("GET")
+	router.HandleFunc("/v1beta1/admin/config", rest.HandleUpdateServerConfig).Methods("PATCH")
+	router.HandleFunc("/v1beta1/admin:reset", rest.HandleResetServer).Methods("POST")
+	router.HandleFunc("/v1beta1/auditLog:tail", rest.HandleTailAuditLog).Methods("POST")
+	router.HandleFunc("/v1beta1/barriers", rest.HandleArmBarrier).Methods("POST")
+	router.HandleFunc("/v1beta1/barriers/{barrierId:.+}:release", rest.HandleReleaseBarrier).Methods("POST")
+	router.HandleFunc("/v1beta1/clock", rest.HandleGetClock).Methods("GET")
+	router.HandleFunc("/v1beta1/clock:advance", rest.HandleAdvanceClock).Methods("POST")
+	router.HandleFunc("/v1beta1/clock:reset", rest.HandleResetClock).Methods("POST")
+	router.HandleFunc("/v1beta1/clock:sync", rest.HandleSyncClock).Methods("POST")
 	router.HandleFunc("/v1beta1/repeat:body", rest.HandleRepeatDataBody).Methods("POST")
 	router.HandleFunc("/v1beta1/repeat:bodyinfo", rest.HandleRepeatDataBodyInfo).Methods("POST")
 	router.HandleFunc("/v1beta1/repeat:query", rest.HandleRepeatDataQuery).Methods("GET")
@@ -38,23 +48,41 @@ func RegisterHandlers(router *gmux.Router, backend *services.Backend) {
 	router.HandleFunc("/v1beta1/repeat/{info.fString:first/.+}/{info.fChild.fString:second/.+}:pathtrailingresource", rest.HandleRepeatDataPathTrailingResource).Methods("GET")
 	router.HandleFunc("/v1beta1/repeat:bodyput", rest.HandleRepeatDataBodyPut).Methods("PUT")
 	router.HandleFunc("/v1beta1/repeat:bodypatch", rest.HandleRepeatDataBodyPatch).Methods("PATCH")
+	router.HandleFunc("/v1beta1/{name:cryptoKeys/.+}", rest.HandleGetCryptoKey).Methods("GET")
+	router.HandleFunc("/v1beta1/{name:cryptoKeys/.+}:encrypt", rest.HandleEncrypt).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:cryptoKeys/.+}:decrypt", rest.HandleDecrypt).Methods("POST")
+	router.HandleFunc("/v1beta1/debug/runtime", rest.HandleGetRuntimeStats).Methods("GET")
+	router.HandleFunc("/v1beta1/debug/events:tail", rest.HandleTailServerEvents).Methods("POST")
+	router.HandleFunc("/v1beta1/debug/latency", rest.HandleGetLatencyStats).Methods("GET")
 	router.HandleFunc("/v1beta1/echo:echo", rest.HandleEcho).Methods("POST")
 	router.HandleFunc("/v1beta1/echo:expand", rest.HandleExpand).Methods("POST")
 	router.HandleFunc("/v1beta1/echo:collect", rest.HandleCollect).Methods("POST")
 	router.HandleFunc("/v1beta1/echo:pagedExpand", rest.HandlePagedExpand).Methods("POST")
+	router.HandleFunc("/v1beta1/echo:longRunningPagedExpand", rest.HandleLongRunningPagedExpand).Methods("POST")
 	router.HandleFunc("/v1beta1/echo:pagedExpandLegacy", rest.HandlePagedExpandLegacy).Methods("POST")
 	router.HandleFunc("/v1beta1/echo:wait", rest.HandleWait).Methods("POST")
+	router.HandleFunc("/v1beta1/echo:nestedWait", rest.HandleNestedWait).Methods("POST")
 	router.HandleFunc("/v1beta1/echo:block", rest.HandleBlock).Methods("POST")
+	router.HandleFunc("/v1beta1/echo:headers", rest.HandleEchoHeaders).Methods("POST")
+	router.HandleFunc("/v1beta1/echo:probeDeadline", rest.HandleProbeDeadline).Methods("POST")
+	router.HandleFunc("/v1beta1/echo:generatePayload", rest.HandleGeneratePayload).Methods("POST")
+	router.HandleFunc("/v1beta1/echo:fanOut", rest.HandleFanOut).Methods("POST")
+	router.HandleFunc("/v1beta1/failover", rest.HandleGetFailoverState).Methods("GET")
+	router.HandleFunc("/v1beta1/failover:trigger", rest.HandleTriggerFailover).Methods("POST")
+	router.HandleFunc("/v1beta1/failover:handoff", rest.HandleHandoff).Methods("POST")
 	router.HandleFunc("/v1beta1/users", rest.HandleCreateUser).Methods("POST")
 	router.HandleFunc("/v1beta1/{name:users/.+}", rest.HandleGetUser).Methods("GET")
 	router.HandleFunc("/v1beta1/{user.name:users/.+}", rest.HandleUpdateUser).Methods("PATCH")
 	router.HandleFunc("/v1beta1/{name:users/.+}", rest.HandleDeleteUser).Methods("DELETE")
 	router.HandleFunc("/v1beta1/users", rest.HandleListUsers).Methods("GET")
+	router.HandleFunc("/v1beta1/users:batchWrite", rest.HandleBatchWrite).Methods("POST")
+	router.HandleFunc("/v1beta1/users:watch", rest.HandleWatchUsers).Methods("POST")
 	router.HandleFunc("/v1beta1/rooms", rest.HandleCreateRoom).Methods("POST")
 	router.HandleFunc("/v1beta1/{name:rooms/.+}", rest.HandleGetRoom).Methods("GET")
 	router.HandleFunc("/v1beta1/{room.name:rooms/.+}", rest.HandleUpdateRoom).Methods("PATCH")
 	router.HandleFunc("/v1beta1/{name:rooms/.+}", rest.HandleDeleteRoom).Methods("DELETE")
 	router.HandleFunc("/v1beta1/rooms", rest.HandleListRooms).Methods("GET")
+	router.HandleFunc("/v1beta1/{name:rooms/.+}:watch", rest.HandleWatchRoom).Methods("POST")
 	router.HandleFunc("/v1beta1/{parent:rooms/.+}/blurbs", rest.HandleCreateBlurb).Methods("POST")
 	router.HandleFunc("/v1beta1/{parent:users/.+/profile}/blurbs", rest.HandleCreateBlurb_1).Methods("POST")
 	router.HandleFunc("/v1beta1/{name:rooms/.+/blurbs/.+}", rest.HandleGetBlurb).Methods("GET")
@@ -71,6 +99,22 @@ func RegisterHandlers(router *gmux.Router, backend *services.Backend) {
 	router.HandleFunc("/v1beta1/{name:users/.+/profile}/blurbs:stream", rest.HandleStreamBlurbs_1).Methods("POST")
 	router.HandleFunc("/v1beta1/{parent:rooms/.+}/blurbs:send", rest.HandleSendBlurbs).Methods("POST")
 	router.HandleFunc("/v1beta1/{parent:users/.+/profile}/blurbs:send", rest.HandleSendBlurbs_1).Methods("POST")
+	router.HandleFunc("/v1beta1/fixtures", rest.HandleCreateFixtures).Methods("POST")
+	router.HandleFunc("/v1beta1/fixtures:reset", rest.HandleResetState).Methods("POST")
+	router.HandleFunc("/v1beta1/contentions", rest.HandleStartContention).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:contentions/.+}:stop", rest.HandleStopContention).Methods("POST")
+	router.HandleFunc("/v1beta1/matrix:unary", rest.HandleUnary).Methods("POST")
+	router.HandleFunc("/v1beta1/matrix:noContent", rest.HandleNoContent).Methods("POST")
+	router.HandleFunc("/v1beta1/matrix:serverStream", rest.HandleServerStream).Methods("POST")
+	router.HandleFunc("/v1beta1/matrix:longRunning", rest.HandleLongRunning).Methods("POST")
+	router.HandleFunc("/v1beta1/matrix:pagedList", rest.HandlePagedList).Methods("GET")
+	router.HandleFunc("/v1beta1/rollout/schema", rest.HandleSetSchemaRollout).Methods("POST")
+	router.HandleFunc("/v1beta1/rollout/schema", rest.HandleGetSchemaRollout).Methods("GET")
+	router.HandleFunc("/v1beta1/routing:overlapping", rest.HandleRouteOverlapping).Methods("POST")
+	router.HandleFunc("/v1beta1/routing:multipleTemplates", rest.HandleRouteMultipleTemplates).Methods("POST")
+	router.HandleFunc("/v1beta1/routing:omitted", rest.HandleRouteOmitted).Methods("POST")
+	router.HandleFunc("/v1beta1/routing:nested", rest.HandleRouteNested).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:projects/.+}:routeEmptyRule", rest.HandleRouteEmptyRule).Methods("POST")
 	router.HandleFunc("/v1beta1/sequences", rest.HandleCreateSequence).Methods("POST")
 	router.HandleFunc("/v1beta1/{name:sequences/.+/sequenceReport}", rest.HandleGetSequenceReport).Methods("GET")
 	router.HandleFunc("/v1beta1/{name:sequences/.+}", rest.HandleAttemptSequence).Methods("POST")
@@ -79,9 +123,24 @@ func RegisterHandlers(router *gmux.Router, backend *services.Backend) {
 	router.HandleFunc("/v1beta1/sessions", rest.HandleListSessions).Methods("GET")
 	router.HandleFunc("/v1beta1/{name:sessions/.+}", rest.HandleDeleteSession).Methods("DELETE")
 	router.HandleFunc("/v1beta1/{name:sessions/.+}:report", rest.HandleReportSession).Methods("POST")
+	router.HandleFunc("/v1beta1/sessions:summary", rest.HandleGetConformanceSummary).Methods("GET")
 	router.HandleFunc("/v1beta1/{parent:sessions/.+}/tests", rest.HandleListTests).Methods("GET")
 	router.HandleFunc("/v1beta1/{name:sessions/.+/tests/.+}", rest.HandleDeleteTest).Methods("DELETE")
 	router.HandleFunc("/v1beta1/{name:sessions/.+/tests/.+}:check", rest.HandleVerifyTest).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:sessions/.+/scratchpad/.+}", rest.HandleGetScratchpadEntry).Methods("GET")
+	router.HandleFunc("/v1beta1/{entry.name:sessions/.+/scratchpad/.+}", rest.HandleSetScratchpadEntry).Methods("PUT")
+	router.HandleFunc("/v1beta1/{name:sessions/.+/scratchpad/.+}", rest.HandleDeleteScratchpadEntry).Methods("DELETE")
+	router.HandleFunc("/v1beta1/transport/streams", rest.HandleGetStreamQueueReport).Methods("GET")
+	router.HandleFunc("/v1beta1/transport:goaway", rest.HandleTriggerGoAway).Methods("POST")
+	router.HandleFunc("/v1beta1/transport/binarylog", rest.HandleListBinaryLogEntries).Methods("GET")
+	router.HandleFunc("/v1beta1/transport/captures", rest.HandleStartPacketCapture).Methods("POST")
+	router.HandleFunc("/v1beta1/transport/captures/{captureId:.+}:stop", rest.HandleStopPacketCapture).Methods("POST")
+	router.HandleFunc("/v1beta1/transport/authorities", rest.HandleListAuthorities).Methods("GET")
+	router.HandleFunc("/v1beta1/transport/authorities:expect", rest.HandleExpectAuthority).Methods("POST")
+	router.HandleFunc("/v1beta1/transport:verifySignature", rest.HandleVerifySignature).Methods("POST")
+	router.HandleFunc("/v1beta1/transport/capturedCalls", rest.HandleListCapturedCalls).Methods("GET")
+	router.HandleFunc("/v1beta1/webhooks", rest.HandleCreateWebhook).Methods("POST")
+	router.HandleFunc("/v1beta1/{name:webhooks/.+}", rest.HandleGetWebhook).Methods("GET")
 	router.PathPrefix("/").HandlerFunc(rest.catchAllHandler)
 }
 
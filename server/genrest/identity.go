@@ -13,13 +13,12 @@
 // limitations under the License.
 
 // DO NOT EDIT. This is an auto-generated file containing the REST handlers
-// for service #2: "Identity" (.google.showcase.v1beta1.Identity).
+// for service #9: "Identity" (.google.showcase.v1beta1.Identity).
 
 package genrest
 
 import (
 	"bytes"
-	"context"
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
 	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
 	gmux "github.com/gorilla/mux"
@@ -33,6 +32,8 @@ func (backend *RESTBackend) HandleCreateUser(w http.ResponseWriter, r *http.Requ
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/users", urlPathParams, "*", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/users': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -70,24 +71,35 @@ func (backend *RESTBackend) HandleCreateUser(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.IdentityServer.CreateUser(context.Background(), request)
+	response, err := backend.IdentityServer.CreateUser(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleGetUser translates REST requests/responses on the wire to internal proto messages for GetUser
@@ -96,6 +108,8 @@ func (backend *RESTBackend) HandleGetUser(w http.ResponseWriter, r *http.Request
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{name=users/*}", urlPathParams, "", r.URL.Query(), []string{"name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{name=users/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -126,24 +140,35 @@ func (backend *RESTBackend) HandleGetUser(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.IdentityServer.GetUser(context.Background(), request)
+	response, err := backend.IdentityServer.GetUser(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleUpdateUser translates REST requests/responses on the wire to internal proto messages for UpdateUser
@@ -152,6 +177,8 @@ func (backend *RESTBackend) HandleUpdateUser(w http.ResponseWriter, r *http.Requ
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{user.name=users/*}", urlPathParams, "*", r.URL.Query(), []string{"user.name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{user.name=users/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -189,24 +216,35 @@ func (backend *RESTBackend) HandleUpdateUser(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.IdentityServer.UpdateUser(context.Background(), request)
+	response, err := backend.IdentityServer.UpdateUser(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleDeleteUser translates REST requests/responses on the wire to internal proto messages for DeleteUser
@@ -215,6 +253,8 @@ func (backend *RESTBackend) HandleDeleteUser(w http.ResponseWriter, r *http.Requ
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{name=users/*}", urlPathParams, "", r.URL.Query(), []string{"name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{name=users/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -245,24 +285,35 @@ func (backend *RESTBackend) HandleDeleteUser(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.IdentityServer.DeleteUser(context.Background(), request)
+	response, err := backend.IdentityServer.DeleteUser(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleListUsers translates REST requests/responses on the wire to internal proto messages for ListUsers
@@ -271,6 +322,8 @@ func (backend *RESTBackend) HandleListUsers(w http.ResponseWriter, r *http.Reque
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/users", urlPathParams, "", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/users': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -296,22 +349,115 @@ func (backend *RESTBackend) HandleListUsers(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
+	marshaler := resttools.ToJSON()
+	requestJSON, _ := marshaler.Marshal(request)
+	backend.StdLog.Printf("  request: %s", requestJSON)
+
+	response, err := backend.IdentityServer.ListUsers(r.Context(), request)
+	if err != nil {
+		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
+		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
+		return
+	}
+
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
+	if err != nil {
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
+
+// HandleBatchWrite translates REST requests/responses on the wire to internal proto messages for BatchWrite
+//    Generated for HTTP binding pattern: "/v1beta1/users:batchWrite"
+func (backend *RESTBackend) HandleBatchWrite(w http.ResponseWriter, r *http.Request) {
+	urlPathParams := gmux.Vars(r)
+	numUrlPathParams := len(urlPathParams)
+
+	resttools.SetTranscodingHeader(w, "/v1beta1/users:batchWrite", urlPathParams, "*", r.URL.Query(), []string{})
+
+	backend.StdLog.Printf("Received %s request matching '/v1beta1/users:batchWrite': %q", r.Method, r.URL)
+	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
+
+	if numUrlPathParams != 0 {
+		backend.Error(w, http.StatusBadRequest, "found unexpected number of URL variables: expected 0, have %d: %#v", numUrlPathParams, urlPathParams)
+		return
+	}
+
+	request := &genprotopb.BatchWriteRequest{}
+	// Intentional: Field values in the URL path override those set in the body.
+	var jsonReader bytes.Buffer
+	bodyReader := io.TeeReader(r.Body, &jsonReader)
+	rBytes := make([]byte, r.ContentLength)
+	if _, err := bodyReader.Read(rBytes); err != nil && err != io.EOF {
+		backend.Error(w, http.StatusBadRequest, "error reading body content: %s", err)
+		return
+	}
+
+	if err := resttools.FromJSON().Unmarshal(rBytes, request); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading body params '*': %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequestFormat(&jsonReader, r, request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed format check: %s", err)
+		return
+	}
+
+	if queryParams := r.URL.Query(); len(queryParams) > 0 {
+		backend.Error(w, http.StatusBadRequest, "encountered unexpected query params: %v", queryParams)
+		return
+	}
+	if err := resttools.PopulateSingularFields(request, urlPathParams); err != nil {
+		backend.Error(w, http.StatusBadRequest, "error reading URL path params: %s", err)
+		return
+	}
+
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.IdentityServer.ListUsers(context.Background(), request)
+	response, err := backend.IdentityServer.BatchWrite(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
+
+// HandleWatchUsers translates REST requests/responses on the wire to internal proto messages for WatchUsers
+//    Generated for HTTP binding pattern: "/v1beta1/users:watch"
+func (backend *RESTBackend) HandleWatchUsers(w http.ResponseWriter, r *http.Request) {
+	backend.Error(w, http.StatusNotImplemented, "streaming methods not implemented yet (request matched '/v1beta1/users:watch': %q)", r.URL)
 }
@@ -13,13 +13,12 @@
 // limitations under the License.
 
 // DO NOT EDIT. This is an auto-generated file containing the REST handlers
-// for service #3: "Messaging" (.google.showcase.v1beta1.Messaging).
+// for service #10: "Messaging" (.google.showcase.v1beta1.Messaging).
 
 package genrest
 
 import (
 	"bytes"
-	"context"
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
 	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
 	gmux "github.com/gorilla/mux"
@@ -33,6 +32,8 @@ func (backend *RESTBackend) HandleCreateRoom(w http.ResponseWriter, r *http.Requ
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/rooms", urlPathParams, "*", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/rooms': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -70,24 +71,35 @@ func (backend *RESTBackend) HandleCreateRoom(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.CreateRoom(context.Background(), request)
+	response, err := backend.MessagingServer.CreateRoom(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleGetRoom translates REST requests/responses on the wire to internal proto messages for GetRoom
@@ -96,6 +108,8 @@ func (backend *RESTBackend) HandleGetRoom(w http.ResponseWriter, r *http.Request
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{name=rooms/*}", urlPathParams, "", r.URL.Query(), []string{"name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{name=rooms/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -126,24 +140,35 @@ func (backend *RESTBackend) HandleGetRoom(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.GetRoom(context.Background(), request)
+	response, err := backend.MessagingServer.GetRoom(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleUpdateRoom translates REST requests/responses on the wire to internal proto messages for UpdateRoom
@@ -152,6 +177,8 @@ func (backend *RESTBackend) HandleUpdateRoom(w http.ResponseWriter, r *http.Requ
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{room.name=rooms/*}", urlPathParams, "*", r.URL.Query(), []string{"room.name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{room.name=rooms/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -189,24 +216,35 @@ func (backend *RESTBackend) HandleUpdateRoom(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.UpdateRoom(context.Background(), request)
+	response, err := backend.MessagingServer.UpdateRoom(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleDeleteRoom translates REST requests/responses on the wire to internal proto messages for DeleteRoom
@@ -215,6 +253,8 @@ func (backend *RESTBackend) HandleDeleteRoom(w http.ResponseWriter, r *http.Requ
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{name=rooms/*}", urlPathParams, "", r.URL.Query(), []string{"name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{name=rooms/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -245,24 +285,35 @@ func (backend *RESTBackend) HandleDeleteRoom(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.DeleteRoom(context.Background(), request)
+	response, err := backend.MessagingServer.DeleteRoom(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleListRooms translates REST requests/responses on the wire to internal proto messages for ListRooms
@@ -271,6 +322,8 @@ func (backend *RESTBackend) HandleListRooms(w http.ResponseWriter, r *http.Reque
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/rooms", urlPathParams, "", r.URL.Query(), []string{})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/rooms': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -296,24 +349,41 @@ func (backend *RESTBackend) HandleListRooms(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.ListRooms(context.Background(), request)
+	response, err := backend.MessagingServer.ListRooms(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
+}
+
+// HandleWatchRoom translates REST requests/responses on the wire to internal proto messages for WatchRoom
+//    Generated for HTTP binding pattern: "/v1beta1/{name=rooms/*}:watch"
+func (backend *RESTBackend) HandleWatchRoom(w http.ResponseWriter, r *http.Request) {
+	backend.Error(w, http.StatusNotImplemented, "streaming methods not implemented yet (request matched '/v1beta1/{name=rooms/*}:watch': %q)", r.URL)
 }
 
 // HandleCreateBlurb translates REST requests/responses on the wire to internal proto messages for CreateBlurb
@@ -322,6 +392,8 @@ func (backend *RESTBackend) HandleCreateBlurb(w http.ResponseWriter, r *http.Req
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{parent=rooms/*}/blurbs", urlPathParams, "*", r.URL.Query(), []string{"parent"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{parent=rooms/*}/blurbs': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -359,24 +431,35 @@ func (backend *RESTBackend) HandleCreateBlurb(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.CreateBlurb(context.Background(), request)
+	response, err := backend.MessagingServer.CreateBlurb(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleCreateBlurb_1 translates REST requests/responses on the wire to internal proto messages for CreateBlurb
@@ -385,6 +468,8 @@ func (backend *RESTBackend) HandleCreateBlurb_1(w http.ResponseWriter, r *http.R
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{parent=users/*/profile}/blurbs", urlPathParams, "*", r.URL.Query(), []string{"parent"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{parent=users/*/profile}/blurbs': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -422,24 +507,35 @@ func (backend *RESTBackend) HandleCreateBlurb_1(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.CreateBlurb(context.Background(), request)
+	response, err := backend.MessagingServer.CreateBlurb(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleGetBlurb translates REST requests/responses on the wire to internal proto messages for GetBlurb
@@ -448,6 +544,8 @@ func (backend *RESTBackend) HandleGetBlurb(w http.ResponseWriter, r *http.Reques
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{name=rooms/*/blurbs/*}", urlPathParams, "", r.URL.Query(), []string{"name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{name=rooms/*/blurbs/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -478,24 +576,35 @@ func (backend *RESTBackend) HandleGetBlurb(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.GetBlurb(context.Background(), request)
+	response, err := backend.MessagingServer.GetBlurb(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleGetBlurb_1 translates REST requests/responses on the wire to internal proto messages for GetBlurb
@@ -504,6 +613,8 @@ func (backend *RESTBackend) HandleGetBlurb_1(w http.ResponseWriter, r *http.Requ
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{name=users/*/profile/blurbs/*}", urlPathParams, "", r.URL.Query(), []string{"name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{name=users/*/profile/blurbs/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -534,24 +645,35 @@ func (backend *RESTBackend) HandleGetBlurb_1(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.GetBlurb(context.Background(), request)
+	response, err := backend.MessagingServer.GetBlurb(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleUpdateBlurb translates REST requests/responses on the wire to internal proto messages for UpdateBlurb
@@ -560,6 +682,8 @@ func (backend *RESTBackend) HandleUpdateBlurb(w http.ResponseWriter, r *http.Req
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{blurb.name=rooms/*/blurbs/*}", urlPathParams, "*", r.URL.Query(), []string{"blurb.name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{blurb.name=rooms/*/blurbs/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -597,24 +721,35 @@ func (backend *RESTBackend) HandleUpdateBlurb(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.UpdateBlurb(context.Background(), request)
+	response, err := backend.MessagingServer.UpdateBlurb(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleUpdateBlurb_1 translates REST requests/responses on the wire to internal proto messages for UpdateBlurb
@@ -623,6 +758,8 @@ func (backend *RESTBackend) HandleUpdateBlurb_1(w http.ResponseWriter, r *http.R
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{blurb.name=users/*/profile/blurbs/*}", urlPathParams, "*", r.URL.Query(), []string{"blurb.name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{blurb.name=users/*/profile/blurbs/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -660,24 +797,35 @@ func (backend *RESTBackend) HandleUpdateBlurb_1(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.UpdateBlurb(context.Background(), request)
+	response, err := backend.MessagingServer.UpdateBlurb(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleDeleteBlurb translates REST requests/responses on the wire to internal proto messages for DeleteBlurb
@@ -686,6 +834,8 @@ func (backend *RESTBackend) HandleDeleteBlurb(w http.ResponseWriter, r *http.Req
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{name=rooms/*/blurbs/*}", urlPathParams, "", r.URL.Query(), []string{"name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{name=rooms/*/blurbs/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -716,24 +866,35 @@ func (backend *RESTBackend) HandleDeleteBlurb(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.DeleteBlurb(context.Background(), request)
+	response, err := backend.MessagingServer.DeleteBlurb(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleDeleteBlurb_1 translates REST requests/responses on the wire to internal proto messages for DeleteBlurb
@@ -742,6 +903,8 @@ func (backend *RESTBackend) HandleDeleteBlurb_1(w http.ResponseWriter, r *http.R
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{name=users/*/profile/blurbs/*}", urlPathParams, "", r.URL.Query(), []string{"name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{name=users/*/profile/blurbs/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -772,24 +935,35 @@ func (backend *RESTBackend) HandleDeleteBlurb_1(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.DeleteBlurb(context.Background(), request)
+	response, err := backend.MessagingServer.DeleteBlurb(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleListBlurbs translates REST requests/responses on the wire to internal proto messages for ListBlurbs
@@ -798,6 +972,8 @@ func (backend *RESTBackend) HandleListBlurbs(w http.ResponseWriter, r *http.Requ
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{parent=rooms/*}/blurbs", urlPathParams, "", r.URL.Query(), []string{"parent"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{parent=rooms/*}/blurbs': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -828,24 +1004,35 @@ func (backend *RESTBackend) HandleListBlurbs(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.ListBlurbs(context.Background(), request)
+	response, err := backend.MessagingServer.ListBlurbs(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleListBlurbs_1 translates REST requests/responses on the wire to internal proto messages for ListBlurbs
@@ -854,6 +1041,8 @@ func (backend *RESTBackend) HandleListBlurbs_1(w http.ResponseWriter, r *http.Re
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{parent=users/*/profile}/blurbs", urlPathParams, "", r.URL.Query(), []string{"parent"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{parent=users/*/profile}/blurbs': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -884,24 +1073,35 @@ func (backend *RESTBackend) HandleListBlurbs_1(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.ListBlurbs(context.Background(), request)
+	response, err := backend.MessagingServer.ListBlurbs(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleSearchBlurbs translates REST requests/responses on the wire to internal proto messages for SearchBlurbs
@@ -910,6 +1110,8 @@ func (backend *RESTBackend) HandleSearchBlurbs(w http.ResponseWriter, r *http.Re
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{parent=rooms/*}/blurbs:search", urlPathParams, "*", r.URL.Query(), []string{"parent"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{parent=rooms/*}/blurbs:search': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -947,24 +1149,35 @@ func (backend *RESTBackend) HandleSearchBlurbs(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.SearchBlurbs(context.Background(), request)
+	response, err := backend.MessagingServer.SearchBlurbs(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleSearchBlurbs_1 translates REST requests/responses on the wire to internal proto messages for SearchBlurbs
@@ -973,6 +1186,8 @@ func (backend *RESTBackend) HandleSearchBlurbs_1(w http.ResponseWriter, r *http.
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{parent=users/*/profile}/blurbs:search", urlPathParams, "", r.URL.Query(), []string{"parent"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{parent=users/*/profile}/blurbs:search': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -1003,24 +1218,35 @@ func (backend *RESTBackend) HandleSearchBlurbs_1(w http.ResponseWriter, r *http.
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.MessagingServer.SearchBlurbs(context.Background(), request)
+	response, err := backend.MessagingServer.SearchBlurbs(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleStreamBlurbs translates REST requests/responses on the wire to internal proto messages for StreamBlurbs
@@ -13,13 +13,12 @@
 // limitations under the License.
 
 // DO NOT EDIT. This is an auto-generated file containing the REST handlers
-// for service #4: "SequenceService" (.google.showcase.v1beta1.SequenceService).
+// for service #15: "SequenceService" (.google.showcase.v1beta1.SequenceService).
 
 package genrest
 
 import (
 	"bytes"
-	"context"
 	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
 	"github.com/googleapis/gapic-showcase/util/genrest/resttools"
 	gmux "github.com/gorilla/mux"
@@ -33,6 +32,8 @@ func (backend *RESTBackend) HandleCreateSequence(w http.ResponseWriter, r *http.
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/sequences", urlPathParams, "sequence", r.URL.Query(), []string{"sequence"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/sequences': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 0, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -80,24 +81,35 @@ func (backend *RESTBackend) HandleCreateSequence(w http.ResponseWriter, r *http.
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.SequenceServiceServer.CreateSequence(context.Background(), request)
+	response, err := backend.SequenceServiceServer.CreateSequence(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleGetSequenceReport translates REST requests/responses on the wire to internal proto messages for GetSequenceReport
@@ -106,6 +118,8 @@ func (backend *RESTBackend) HandleGetSequenceReport(w http.ResponseWriter, r *ht
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{name=sequences/*/sequenceReport}", urlPathParams, "", r.URL.Query(), []string{"name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{name=sequences/*/sequenceReport}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -136,24 +150,35 @@ func (backend *RESTBackend) HandleGetSequenceReport(w http.ResponseWriter, r *ht
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.SequenceServiceServer.GetSequenceReport(context.Background(), request)
+	response, err := backend.SequenceServiceServer.GetSequenceReport(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
 
 // HandleAttemptSequence translates REST requests/responses on the wire to internal proto messages for AttemptSequence
@@ -162,6 +187,8 @@ func (backend *RESTBackend) HandleAttemptSequence(w http.ResponseWriter, r *http
 	urlPathParams := gmux.Vars(r)
 	numUrlPathParams := len(urlPathParams)
 
+	resttools.SetTranscodingHeader(w, "/v1beta1/{name=sequences/*}", urlPathParams, "*", r.URL.Query(), []string{"name"})
+
 	backend.StdLog.Printf("Received %s request matching '/v1beta1/{name=sequences/*}': %q", r.Method, r.URL)
 	backend.StdLog.Printf("  urlPathParams (expect 1, have %d): %q", numUrlPathParams, urlPathParams)
 
@@ -199,22 +226,33 @@ func (backend *RESTBackend) HandleAttemptSequence(w http.ResponseWriter, r *http
 		return
 	}
 
+	if err := resttools.CheckRequiredFields(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %s", err)
+		return
+	}
+
+	if err := resttools.CheckFieldConstraints(request.ProtoReflect()); err != nil {
+		backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %s", err)
+		return
+	}
+
 	marshaler := resttools.ToJSON()
 	requestJSON, _ := marshaler.Marshal(request)
 	backend.StdLog.Printf("  request: %s", requestJSON)
 
-	response, err := backend.SequenceServiceServer.AttemptSequence(context.Background(), request)
+	response, err := backend.SequenceServiceServer.AttemptSequence(r.Context(), request)
 	if err != nil {
 		// TODO: Properly handle error. Is StatusInternalServerError (500) the right response?
 		backend.Error(w, http.StatusInternalServerError, "server error: %s", err.Error())
 		return
 	}
 
-	json, err := marshaler.Marshal(response)
+	body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, response)
 	if err != nil {
-		backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %s", err.Error())
+		backend.Error(w, http.StatusInternalServerError, "error encoding response: %s", err.Error())
 		return
 	}
 
-	w.Write(json)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(body)
 }
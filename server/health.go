@@ -0,0 +1,104 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthStatus reports the serving status of the server and of each of its services through the
+// standard grpc.health.v1.Health service. Services can be reported NOT_SERVING, so that clients
+// and load balancers checking health can be tested. The server as a whole is named "".
+type HealthStatus struct {
+	health *health.Server
+
+	mu         sync.Mutex
+	registered map[string]bool
+	notServing map[string]bool
+}
+
+// NewHealthStatus creates a HealthStatus reporting the services named in notServing as
+// NOT_SERVING.
+func NewHealthStatus(notServing []string) *HealthStatus {
+	h := &HealthStatus{
+		health:     health.NewServer(),
+		registered: map[string]bool{},
+		notServing: map[string]bool{},
+	}
+	h.SetNotServing(notServing)
+	return h
+}
+
+// Server returns the grpc.health.v1.Health service reporting the status.
+func (h *HealthStatus) Server() healthpb.HealthServer {
+	return h.health
+}
+
+// Register reports the services named, and the server as a whole, as SERVING unless they are
+// set not to be.
+func (h *HealthStatus) Register(services []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, name := range append([]string{""}, services...) {
+		h.registered[name] = true
+		h.update(name)
+	}
+}
+
+// SetNotServing reports the services named in notServing as NOT_SERVING, and the others as they
+// were registered.
+func (h *HealthStatus) SetNotServing(notServing []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	previous := h.notServing
+	h.notServing = map[string]bool{}
+	for _, name := range notServing {
+		h.notServing[name] = true
+		h.update(name)
+	}
+	for name := range previous {
+		if !h.notServing[name] {
+			h.update(name)
+		}
+	}
+}
+
+// NotServing returns the names of the services reported as NOT_SERVING, sorted.
+func (h *HealthStatus) NotServing() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	names := []string{}
+	for name := range h.notServing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// update reports the current status of the service named name. h.mu must be held.
+func (h *HealthStatus) update(name string) {
+	switch {
+	case h.notServing[name]:
+		h.health.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+	case h.registered[name]:
+		h.health.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	default:
+		h.health.SetServingStatus(name, healthpb.HealthCheckResponse_SERVICE_UNKNOWN)
+	}
+}
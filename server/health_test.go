@@ -0,0 +1,63 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func checkHealth(t *testing.T, h *HealthStatus, service string) healthpb.HealthCheckResponse_ServingStatus {
+	response, err := h.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("Check(%q): %v", service, err)
+	}
+	return response.GetStatus()
+}
+
+func TestHealthStatus(t *testing.T) {
+	const echo, identity = "google.showcase.v1beta1.Echo", "google.showcase.v1beta1.Identity"
+	h := NewHealthStatus([]string{echo})
+	h.Register([]string{echo, identity})
+
+	if got := checkHealth(t, h, ""); got != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("server: got %v, want SERVING", got)
+	}
+	if got := checkHealth(t, h, echo); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("%s: got %v, want NOT_SERVING", echo, got)
+	}
+	if got := checkHealth(t, h, identity); got != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("%s: got %v, want SERVING", identity, got)
+	}
+	if _, err := h.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Check of an unknown service: got %v, want NotFound", err)
+	}
+
+	h.SetNotServing([]string{identity, ""})
+	if got, want := h.NotServing(), []string{"", identity}; !reflect.DeepEqual(got, want) {
+		t.Errorf("NotServing: got %q, want %q", got, want)
+	}
+	if got := checkHealth(t, h, echo); got != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("%s once serving again: got %v, want SERVING", echo, got)
+	}
+	if got := checkHealth(t, h, ""); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("server: got %v, want NOT_SERVING", got)
+	}
+}
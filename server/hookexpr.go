@@ -0,0 +1,987 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// hookExpr is an expression in the subset of the Common Expression Language (CEL) that response
+// hooks are written in: literals, lists, the variables naming the messages of a call, field
+// selection, indexing, the logical, relational and arithmetic operators, the conditional
+// operator, and the has(), size(), int(), double() and string() functions along with the
+// startsWith(), endsWith(), contains() and matches() string methods.
+//
+// Values are nil, bool, int64, float64, string, []byte, []interface{}, map[interface{}]interface{}
+// and protoreflect.Message. Enum and unsigned integer fields read as int64.
+type hookExpr interface {
+	eval(vars map[string]interface{}) (interface{}, error)
+}
+
+type (
+	hookLiteral struct{ value interface{} }
+	hookIdent   struct{ name string }
+	hookSelect  struct {
+		operand hookExpr
+		field   string
+	}
+	hookIndex struct{ operand, index hookExpr }
+	hookCall  struct {
+		receiver hookExpr // nil for global functions
+		function string
+		args     []hookExpr
+	}
+	hookUnary struct {
+		op      string
+		operand hookExpr
+	}
+	hookBinary struct {
+		op          string
+		left, right hookExpr
+	}
+	hookConditional struct{ cond, then, otherwise hookExpr }
+	hookList        struct{ elems []hookExpr }
+)
+
+// parseHookExpr parses source as a hookExpr.
+func parseHookExpr(source string) (hookExpr, error) {
+	tokens, err := scanHookExpr(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &hookParser{tokens: tokens}
+	expr, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != hookTokenEOF {
+		return nil, fmt.Errorf("unexpected %q at offset %d", p.peek().text, p.peek().pos)
+	}
+	return expr, nil
+}
+
+type hookTokenKind int
+
+const (
+	hookTokenEOF hookTokenKind = iota
+	hookTokenIdent
+	hookTokenInt
+	hookTokenDouble
+	hookTokenString
+	hookTokenOp
+)
+
+type hookToken struct {
+	kind hookTokenKind
+	text string
+	pos  int
+	// value is the value of literals.
+	value interface{}
+}
+
+// hookOperators are the operators and punctuation of expressions, longest first.
+var hookOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "+", "-", "*", "/", "%", "?", ":", ".", ",", "(", ")", "[", "]"}
+
+// scanHookExpr splits source into tokens, following the lexical grammar of CEL: identifiers
+// are ASCII, while strings may hold any text. Offsets are in bytes.
+func scanHookExpr(source string) ([]hookToken, error) {
+	tokens := []hookToken{}
+	for pos := 0; pos < len(source); {
+		c, width := utf8.DecodeRuneInString(source[pos:])
+		switch {
+		case c == utf8.RuneError && width == 1:
+			return nil, fmt.Errorf("invalid UTF-8 at offset %d", pos)
+		case unicode.IsSpace(c):
+			pos += width
+		case (c == 'r' || c == 'R') && pos+1 < len(source) && (source[pos+1] == '"' || source[pos+1] == '\''):
+			end := strings.IndexByte(source[pos+2:], source[pos+1])
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at offset %d", pos)
+			}
+			end += pos + 2
+			tokens = append(tokens, hookToken{kind: hookTokenString, text: source[pos : end+1], pos: pos, value: source[pos+2 : end]})
+			pos = end + 1
+		case isHookIdentStart(c):
+			end := pos + 1
+			for end < len(source) && (isHookIdentStart(rune(source[end])) || isHookDigit(rune(source[end]))) {
+				end++
+			}
+			tokens = append(tokens, hookToken{kind: hookTokenIdent, text: source[pos:end], pos: pos})
+			pos = end
+		case isHookDigit(c):
+			token, err := scanHookNumber(source, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token)
+			pos += len(token.text)
+		case c == '"' || c == '\'':
+			token, err := scanHookString(source, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token)
+			pos += len(token.text)
+		default:
+			op := ""
+			for _, candidate := range hookOperators {
+				if strings.HasPrefix(source[pos:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected %q at offset %d", c, pos)
+			}
+			tokens = append(tokens, hookToken{kind: hookTokenOp, text: op, pos: pos})
+			pos += len(op)
+		}
+	}
+	return append(tokens, hookToken{kind: hookTokenEOF, text: "end of expression", pos: len(source)}), nil
+}
+
+func isHookIdentStart(c rune) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isHookDigit(c rune) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isHookHexDigit(c byte) bool {
+	return isHookDigit(rune(c)) || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+// scanHookNumber scans the number at pos in source: a decimal or hexadecimal int, or a double
+// with a fraction, an exponent or both, such as 1.5, 1e-3 or 2.5E+10.
+func scanHookNumber(source string, pos int) (hookToken, error) {
+	digits := func(from int) int {
+		for from < len(source) && isHookDigit(rune(source[from])) {
+			from++
+		}
+		return from
+	}
+	if strings.HasPrefix(source[pos:], "0x") || strings.HasPrefix(source[pos:], "0X") {
+		end := pos + 2
+		for end < len(source) && isHookHexDigit(source[end]) {
+			end++
+		}
+		text := source[pos:end]
+		i, err := strconv.ParseInt(text[2:], 16, 64)
+		if err != nil {
+			return hookToken{}, fmt.Errorf("invalid number %q at offset %d", text, pos)
+		}
+		return hookToken{kind: hookTokenInt, text: text, pos: pos, value: i}, nil
+	}
+
+	end := digits(pos)
+	double := false
+	if end+1 < len(source) && source[end] == '.' && isHookDigit(rune(source[end+1])) {
+		end = digits(end + 1)
+		double = true
+	}
+	if end < len(source) && (source[end] == 'e' || source[end] == 'E') {
+		exponent := end + 1
+		if exponent < len(source) && (source[exponent] == '+' || source[exponent] == '-') {
+			exponent++
+		}
+		if exponent == len(source) || !isHookDigit(rune(source[exponent])) {
+			return hookToken{}, fmt.Errorf("invalid number %q at offset %d", source[pos:exponent], pos)
+		}
+		end = digits(exponent)
+		double = true
+	}
+	text := source[pos:end]
+	if double {
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return hookToken{}, fmt.Errorf("invalid number %q at offset %d", text, pos)
+		}
+		return hookToken{kind: hookTokenDouble, text: text, pos: pos, value: f}, nil
+	}
+	i, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return hookToken{}, fmt.Errorf("invalid number %q at offset %d", text, pos)
+	}
+	return hookToken{kind: hookTokenInt, text: text, pos: pos, value: i}, nil
+}
+
+// hookEscapes are the characters of CEL's single-character escape sequences.
+var hookEscapes = map[byte]rune{
+	'a': '\a', 'b': '\b', 'f': '\f', 'n': '\n', 'r': '\r', 't': '\t', 'v': '\v',
+	'\\': '\\', '\'': '\'', '"': '"', '`': '`', '?': '?',
+}
+
+// scanHookString scans the quoted string at pos in source, decoding its escape sequences.
+func scanHookString(source string, pos int) (hookToken, error) {
+	quote := source[pos]
+	var b strings.Builder
+	for end := pos + 1; end < len(source); {
+		c := source[end]
+		switch {
+		case c == quote:
+			return hookToken{kind: hookTokenString, text: source[pos : end+1], pos: pos, value: b.String()}, nil
+		case c == '\n' || c == '\r':
+			return hookToken{}, fmt.Errorf("unterminated string at offset %d", pos)
+		case c != '\\':
+			b.WriteByte(c)
+			end++
+			continue
+		}
+
+		// An escape sequence.
+		if end+1 == len(source) {
+			break
+		}
+		e := source[end+1]
+		if r, ok := hookEscapes[e]; ok {
+			b.WriteRune(r)
+			end += 2
+			continue
+		}
+		size, base := 0, 16
+		switch {
+		case e == 'x' || e == 'X':
+			size = 2
+		case e == 'u':
+			size = 4
+		case e == 'U':
+			size = 8
+		case '0' <= e && e <= '3':
+			size, base = 3, 8
+		default:
+			return hookToken{}, fmt.Errorf("invalid escape sequence \\%c at offset %d", e, end)
+		}
+		start := end + 1
+		if base == 16 {
+			start++
+		}
+		if start+size > len(source) {
+			return hookToken{}, fmt.Errorf("invalid escape sequence %q at offset %d", source[end:], end)
+		}
+		code, err := strconv.ParseUint(source[start:start+size], base, 32)
+		if err != nil || !utf8.ValidRune(rune(code)) {
+			return hookToken{}, fmt.Errorf("invalid escape sequence %q at offset %d", source[end:start+size], end)
+		}
+		b.WriteRune(rune(code))
+		end = start + size
+	}
+	return hookToken{}, fmt.Errorf("unterminated string at offset %d", pos)
+}
+
+// hookParser is a recursive descent parser of expressions, with CEL's operator precedence.
+type hookParser struct {
+	tokens []hookToken
+	next   int
+}
+
+func (p *hookParser) peek() hookToken {
+	return p.tokens[p.next]
+}
+
+// accept consumes the next token and returns true if it is the operator op.
+func (p *hookParser) accept(op string) bool {
+	if t := p.peek(); t.kind == hookTokenOp && t.text == op {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *hookParser) expect(op string) error {
+	if !p.accept(op) {
+		return fmt.Errorf("want %q at offset %d, got %q", op, p.peek().pos, p.peek().text)
+	}
+	return nil
+}
+
+func (p *hookParser) expr() (hookExpr, error) {
+	cond, err := p.binary(0)
+	if err != nil || !p.accept("?") {
+		return cond, err
+	}
+	then, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	otherwise, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	return &hookConditional{cond: cond, then: then, otherwise: otherwise}, nil
+}
+
+// hookPrecedence lists the binary operators from the loosest binding to the tightest.
+var hookPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "<", "<=", ">", ">=", "in"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *hookParser) binary(level int) (hookExpr, error) {
+	if level == len(hookPrecedence) {
+		return p.unary()
+	}
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		op := ""
+		for _, candidate := range hookPrecedence[level] {
+			if (t.kind == hookTokenOp || t.kind == hookTokenIdent) && t.text == candidate {
+				op = candidate
+			}
+		}
+		if op == "" {
+			return left, nil
+		}
+		p.next++
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &hookBinary{op: op, left: left, right: right}
+	}
+}
+
+func (p *hookParser) unary() (hookExpr, error) {
+	for _, op := range []string{"!", "-"} {
+		if p.accept(op) {
+			operand, err := p.unary()
+			if err != nil {
+				return nil, err
+			}
+			return &hookUnary{op: op, operand: operand}, nil
+		}
+	}
+	return p.member()
+}
+
+func (p *hookParser) member() (hookExpr, error) {
+	expr, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.accept("."):
+			t := p.peek()
+			if t.kind != hookTokenIdent {
+				return nil, fmt.Errorf("want a field or method name at offset %d, got %q", t.pos, t.text)
+			}
+			p.next++
+			if p.accept("(") {
+				args, err := p.args(")")
+				if err != nil {
+					return nil, err
+				}
+				expr = &hookCall{receiver: expr, function: t.text, args: args}
+			} else {
+				expr = &hookSelect{operand: expr, field: t.text}
+			}
+		case p.accept("["):
+			index, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			expr = &hookIndex{operand: expr, index: index}
+		default:
+			return expr, nil
+		}
+	}
+}
+
+// args parses the comma-separated expressions up to the closing operator end.
+func (p *hookParser) args(end string) ([]hookExpr, error) {
+	args := []hookExpr{}
+	if p.accept(end) {
+		return args, nil
+	}
+	for {
+		arg, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.accept(end) {
+			return args, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *hookParser) primary() (hookExpr, error) {
+	t := p.peek()
+	p.next++
+	switch t.kind {
+	case hookTokenInt, hookTokenDouble, hookTokenString:
+		return &hookLiteral{value: t.value}, nil
+	case hookTokenIdent:
+		switch t.text {
+		case "true", "false":
+			return &hookLiteral{value: t.text == "true"}, nil
+		case "null":
+			return &hookLiteral{}, nil
+		}
+		if p.accept("(") {
+			args, err := p.args(")")
+			if err != nil {
+				return nil, err
+			}
+			if t.text == "has" {
+				if len(args) != 1 {
+					return nil, fmt.Errorf("has() at offset %d takes a single field selection", t.pos)
+				}
+				if _, ok := args[0].(*hookSelect); !ok {
+					return nil, fmt.Errorf("has() at offset %d takes a field selection", t.pos)
+				}
+			}
+			return &hookCall{function: t.text, args: args}, nil
+		}
+		return &hookIdent{name: t.text}, nil
+	case hookTokenOp:
+		switch t.text {
+		case "(":
+			expr, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			return expr, p.expect(")")
+		case "[":
+			elems, err := p.args("]")
+			if err != nil {
+				return nil, err
+			}
+			return &hookList{elems: elems}, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q at offset %d", t.text, t.pos)
+}
+
+func (e *hookLiteral) eval(map[string]interface{}) (interface{}, error) {
+	return e.value, nil
+}
+
+func (e *hookIdent) eval(vars map[string]interface{}) (interface{}, error) {
+	value, ok := vars[e.name]
+	if !ok {
+		return nil, fmt.Errorf("undeclared reference to %q", e.name)
+	}
+	return value, nil
+}
+
+func (e *hookSelect) eval(vars map[string]interface{}) (interface{}, error) {
+	operand, err := e.operand.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	switch operand := operand.(type) {
+	case protoreflect.Message:
+		field, err := hookField(operand, e.field)
+		if err != nil {
+			return nil, err
+		}
+		return hookValue(field, operand.Get(field)), nil
+	case map[interface{}]interface{}:
+		value, ok := operand[e.field]
+		if !ok {
+			return nil, fmt.Errorf("no such key %q", e.field)
+		}
+		return value, nil
+	}
+	return nil, fmt.Errorf("cannot select the field %q of a %s", e.field, hookTypeName(operand))
+}
+
+// has returns whether the field the expression selects is set.
+func (e *hookSelect) has(vars map[string]interface{}) (bool, error) {
+	operand, err := e.operand.eval(vars)
+	if err != nil {
+		return false, err
+	}
+	switch operand := operand.(type) {
+	case protoreflect.Message:
+		field, err := hookField(operand, e.field)
+		if err != nil {
+			return false, err
+		}
+		if field.IsList() {
+			return operand.Get(field).List().Len() > 0, nil
+		}
+		if field.IsMap() {
+			return operand.Get(field).Map().Len() > 0, nil
+		}
+		return operand.Has(field), nil
+	case map[interface{}]interface{}:
+		_, ok := operand[e.field]
+		return ok, nil
+	}
+	return false, fmt.Errorf("cannot test the field %q of a %s", e.field, hookTypeName(operand))
+}
+
+// hookField returns the field of message named name, as in the proto definition.
+func hookField(message protoreflect.Message, name string) (protoreflect.FieldDescriptor, error) {
+	field := message.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if field == nil {
+		return nil, fmt.Errorf("%s has no field %q", message.Descriptor().FullName(), name)
+	}
+	return field, nil
+}
+
+// hookValue returns the expression value of the value of field.
+func hookValue(field protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	switch {
+	case field.IsList():
+		list := value.List()
+		values := make([]interface{}, list.Len())
+		for i := range values {
+			values[i] = hookScalar(field, list.Get(i))
+		}
+		return values
+	case field.IsMap():
+		values := map[interface{}]interface{}{}
+		value.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+			values[hookScalar(field.MapKey(), key.Value())] = hookScalar(field.MapValue(), value)
+			return true
+		})
+		return values
+	}
+	return hookScalar(field, value)
+}
+
+func hookScalar(field protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return value.Bool()
+	case protoreflect.EnumKind:
+		return int64(value.Enum())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return value.Int()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return int64(value.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return value.Float()
+	case protoreflect.StringKind:
+		return value.String()
+	case protoreflect.BytesKind:
+		return value.Bytes()
+	}
+	return value.Message()
+}
+
+func (e *hookIndex) eval(vars map[string]interface{}) (interface{}, error) {
+	operand, err := e.operand.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	index, err := e.index.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	switch operand := operand.(type) {
+	case []interface{}:
+		i, ok := index.(int64)
+		if !ok {
+			return nil, fmt.Errorf("cannot index a list with a %s", hookTypeName(index))
+		}
+		if i < 0 || i >= int64(len(operand)) {
+			return nil, fmt.Errorf("index %d out of range of a list of %d elements", i, len(operand))
+		}
+		return operand[i], nil
+	case map[interface{}]interface{}:
+		if err := hookMapKey(index); err != nil {
+			return nil, err
+		}
+		value, ok := operand[index]
+		if !ok {
+			return nil, fmt.Errorf("no such key %v", index)
+		}
+		return value, nil
+	}
+	return nil, fmt.Errorf("cannot index a %s", hookTypeName(operand))
+}
+
+// hookMapKey returns an error unless key is a scalar, the only values maps are keyed by. Other
+// values, such as lists and bytes, cannot even be looked up in a Go map.
+func hookMapKey(key interface{}) error {
+	switch key.(type) {
+	case nil, bool, int64, float64, string:
+		return nil
+	}
+	return fmt.Errorf("cannot index a map with a %s", hookTypeName(key))
+}
+
+func (e *hookCall) eval(vars map[string]interface{}) (interface{}, error) {
+	if e.function == "has" && e.receiver == nil {
+		return e.args[0].(*hookSelect).has(vars)
+	}
+	args := []interface{}{}
+	if e.receiver != nil {
+		receiver, err := e.receiver.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, receiver)
+	}
+	for _, arg := range e.args {
+		value, err := arg.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, value)
+	}
+	want := 1
+	switch e.function {
+	case "startsWith", "endsWith", "contains", "matches":
+		want = 2
+	case "size", "int", "double", "string":
+	default:
+		return nil, fmt.Errorf("undeclared function %q", e.function)
+	}
+	if len(args) != want {
+		return nil, fmt.Errorf("%s() takes %d arguments, got %d", e.function, want, len(args))
+	}
+
+	switch e.function {
+	case "size":
+		switch arg := args[0].(type) {
+		case string:
+			return int64(len([]rune(arg))), nil
+		case []byte:
+			return int64(len(arg)), nil
+		case []interface{}:
+			return int64(len(arg)), nil
+		case map[interface{}]interface{}:
+			return int64(len(arg)), nil
+		}
+	case "int":
+		switch arg := args[0].(type) {
+		case int64:
+			return arg, nil
+		case float64:
+			return int64(arg), nil
+		case string:
+			i, err := strconv.ParseInt(arg, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("int(): cannot convert %q", arg)
+			}
+			return i, nil
+		}
+	case "double":
+		switch arg := args[0].(type) {
+		case int64:
+			return float64(arg), nil
+		case float64:
+			return arg, nil
+		case string:
+			f, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				return nil, fmt.Errorf("double(): cannot convert %q", arg)
+			}
+			return f, nil
+		}
+	case "string":
+		switch arg := args[0].(type) {
+		case string:
+			return arg, nil
+		case []byte:
+			return string(arg), nil
+		case bool, int64:
+			return fmt.Sprint(arg), nil
+		case float64:
+			return strconv.FormatFloat(arg, 'g', -1, 64), nil
+		}
+	default:
+		s, ok1 := args[0].(string)
+		t, ok2 := args[1].(string)
+		if !ok1 || !ok2 {
+			break
+		}
+		switch e.function {
+		case "startsWith":
+			return strings.HasPrefix(s, t), nil
+		case "endsWith":
+			return strings.HasSuffix(s, t), nil
+		case "contains":
+			return strings.Contains(s, t), nil
+		case "matches":
+			re, err := regexp.Compile(t)
+			if err != nil {
+				return nil, fmt.Errorf("matches(): %v", err)
+			}
+			return re.MatchString(s), nil
+		}
+	}
+	types := []string{}
+	for _, arg := range args {
+		types = append(types, hookTypeName(arg))
+	}
+	return nil, fmt.Errorf("no overload of %s() for (%s)", e.function, strings.Join(types, ", "))
+}
+
+func (e *hookUnary) eval(vars map[string]interface{}) (interface{}, error) {
+	operand, err := e.operand.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	switch operand := operand.(type) {
+	case bool:
+		if e.op == "!" {
+			return !operand, nil
+		}
+	case int64:
+		if e.op == "-" {
+			return -operand, nil
+		}
+	case float64:
+		if e.op == "-" {
+			return -operand, nil
+		}
+	}
+	return nil, fmt.Errorf("no overload of %s for %s", e.op, hookTypeName(operand))
+}
+
+func (e *hookConditional) eval(vars map[string]interface{}) (interface{}, error) {
+	cond, err := e.cond.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	b, ok := cond.(bool)
+	if !ok {
+		return nil, fmt.Errorf("the condition of ?: is a %s, not a bool", hookTypeName(cond))
+	}
+	if b {
+		return e.then.eval(vars)
+	}
+	return e.otherwise.eval(vars)
+}
+
+func (e *hookList) eval(vars map[string]interface{}) (interface{}, error) {
+	values := make([]interface{}, len(e.elems))
+	for i, elem := range e.elems {
+		value, err := elem.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func (e *hookBinary) eval(vars map[string]interface{}) (interface{}, error) {
+	left, err := e.left.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	// The logical operators short-circuit.
+	if e.op == "&&" || e.op == "||" {
+		l, ok := left.(bool)
+		if !ok {
+			return nil, fmt.Errorf("no overload of %s for %s", e.op, hookTypeName(left))
+		}
+		if l == (e.op == "||") {
+			return l, nil
+		}
+		right, err := e.right.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		if r, ok := right.(bool); ok {
+			return r, nil
+		}
+		return nil, fmt.Errorf("no overload of %s for %s", e.op, hookTypeName(right))
+	}
+	right, err := e.right.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch e.op {
+	case "==":
+		return hookEqual(left, right), nil
+	case "!=":
+		return !hookEqual(left, right), nil
+	case "in":
+		switch container := right.(type) {
+		case []interface{}:
+			for _, elem := range container {
+				if hookEqual(left, elem) {
+					return true, nil
+				}
+			}
+			return false, nil
+		case map[interface{}]interface{}:
+			if err := hookMapKey(left); err != nil {
+				return nil, err
+			}
+			_, ok := container[left]
+			return ok, nil
+		}
+	}
+
+	switch l := left.(type) {
+	case int64:
+		if r, ok := right.(int64); ok {
+			return hookIntOp(e.op, l, r)
+		}
+		if r, ok := right.(float64); ok {
+			return hookDoubleOp(e.op, float64(l), r)
+		}
+	case float64:
+		if r, ok := right.(float64); ok {
+			return hookDoubleOp(e.op, l, r)
+		}
+		if r, ok := right.(int64); ok {
+			return hookDoubleOp(e.op, l, float64(r))
+		}
+	case string:
+		if r, ok := right.(string); ok {
+			switch e.op {
+			case "+":
+				return l + r, nil
+			case "<":
+				return l < r, nil
+			case "<=":
+				return l <= r, nil
+			case ">":
+				return l > r, nil
+			case ">=":
+				return l >= r, nil
+			}
+		}
+	case []interface{}:
+		if r, ok := right.([]interface{}); ok && e.op == "+" {
+			return append(append([]interface{}{}, l...), r...), nil
+		}
+	}
+	return nil, fmt.Errorf("no overload of %s for (%s, %s)", e.op, hookTypeName(left), hookTypeName(right))
+}
+
+func hookIntOp(op string, l, r int64) (interface{}, error) {
+	switch op {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/", "%":
+		if r == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		if op == "/" {
+			return l / r, nil
+		}
+		return l % r, nil
+	case "<":
+		return l < r, nil
+	case "<=":
+		return l <= r, nil
+	case ">":
+		return l > r, nil
+	case ">=":
+		return l >= r, nil
+	}
+	return nil, fmt.Errorf("no overload of %s for (int, int)", op)
+}
+
+func hookDoubleOp(op string, l, r float64) (interface{}, error) {
+	switch op {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		return l / r, nil
+	case "<":
+		return l < r, nil
+	case "<=":
+		return l <= r, nil
+	case ">":
+		return l > r, nil
+	case ">=":
+		return l >= r, nil
+	}
+	return nil, fmt.Errorf("no overload of %s for (double, double)", op)
+}
+
+// hookEqual returns whether two values are equal, comparing numbers by value and messages by
+// their fields.
+func hookEqual(left, right interface{}) bool {
+	switch l := left.(type) {
+	case int64:
+		if r, ok := right.(float64); ok {
+			return float64(l) == r
+		}
+	case float64:
+		if r, ok := right.(int64); ok {
+			return l == float64(r)
+		}
+	case protoreflect.Message:
+		if r, ok := right.(protoreflect.Message); ok {
+			return l.Descriptor().FullName() == r.Descriptor().FullName() && proto.Equal(l.Interface(), r.Interface())
+		}
+		return false
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+// hookTypeName returns the CEL name of the type of value.
+func hookTypeName(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "null_type"
+	case bool:
+		return "bool"
+	case int64:
+		return "int"
+	case float64:
+		return "double"
+	case string:
+		return "string"
+	case []byte:
+		return "bytes"
+	case []interface{}:
+		return "list"
+	case map[interface{}]interface{}:
+		return "map"
+	case protoreflect.Message:
+		return string(value.Descriptor().FullName())
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,140 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/genproto/googleapis/rpc/status"
+)
+
+func TestHookExpr(t *testing.T) {
+	request := &pb.EchoRequest{
+		Response: &pb.EchoRequest_Content{Content: "fail: héllo"},
+		Severity: pb.Severity_NECESSARY,
+		Label:    "a",
+	}
+	vars := map[string]interface{}{"request": request.ProtoReflect()}
+	for _, testCase := range []struct {
+		source string
+		want   interface{}
+	}{
+		{"1 + 2 * 3", int64(7)},
+		{"(1 + 2) * 3 - -1", int64(10)},
+		{"7 / 2 + 7 % 2", int64(4)},
+		{"1.5 * 2", 3.0},
+		{"1 == 1.0 && 2 > 1.5", true},
+		{"'a' + \"b\\t\" == 'ab\\t'", true},
+		{"'b' > 'a' || 1 / 0 == 0", true},
+		{"!true ? 1 : 2", int64(2)},
+		{"request.content", "fail: héllo"},
+		{"size(request.content)", int64(11)},
+		{"request.content.startsWith('fail') && request.content.contains('llo')", true},
+		{"request.content.endsWith('x') || request.content.matches('^fa.l:')", true},
+		{"request.severity == 1", true},
+		{"request.label in ['a', 'b']", true},
+		{"has(request.error)", false},
+		{"has(request.label)", true},
+		{"request.error.code", int64(0)},
+		{"int('12') + int(2.9)", int64(14)},
+		{"string(12) + string(true) + string(1.5)", "12true1.5"},
+		{"double(1) / 4", 0.25},
+		{"[1, 2] + [3]", []interface{}{int64(1), int64(2), int64(3)}},
+		{"[1, 2][1]", int64(2)},
+		{"null == null", true},
+		{"1e3", 1000.0},
+		{"1e-3", 0.001},
+		{"2.5E+2", 250.0},
+		{"1.5e-1 < 0.2", true},
+		{"0x1F + 1", int64(32)},
+		{"'\\a\\b\\f\\n\\r\\t\\v\\\\\\'\\\"\\`\\?'", "\a\b\f\n\r\t\v\\'\"`?"},
+		{"'\\x41\\101\\u00e9\\U0001F600'", "AAé😀"},
+		{"r'a\\nb'", "a\\nb"},
+		{"'héllo ✓' + \"😀\"", "héllo ✓😀"},
+		{"size('héllo')", int64(5)},
+		{"request.content.endsWith('héllo')", true},
+	} {
+		expr, err := parseHookExpr(testCase.source)
+		if err != nil {
+			t.Errorf("%s: %v", testCase.source, err)
+			continue
+		}
+		got, err := expr.eval(vars)
+		if err != nil {
+			t.Errorf("%s: %v", testCase.source, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, testCase.want) {
+			t.Errorf("%s: want %v (%T), got %v (%T)", testCase.source, testCase.want, testCase.want, got, got)
+		}
+	}
+}
+
+func TestHookExpr_errors(t *testing.T) {
+	vars := map[string]interface{}{
+		"request": (&pb.EchoRequest{Response: &pb.EchoRequest_Error{Error: &status.Status{Code: 5}}}).ProtoReflect(),
+		"params":  map[interface{}]interface{}{"a": "b"},
+		"key":     []byte("a"),
+	}
+	for _, testCase := range []struct {
+		source string
+		want   string
+	}{
+		{"1 +", "unexpected"},
+		{"(1", `want ")"`},
+		{"'abc", "unterminated string"},
+		{"1 # 2", "unexpected"},
+		{"has(1)", "field selection"},
+		{"response.content", `undeclared reference to "response"`},
+		{"request.nope", `has no field "nope"`},
+		{"request.content.size(1)", "takes 1 arguments"},
+		{"nope(1)", `undeclared function "nope"`},
+		{"1 + 'a'", "no overload of + for (int, string)"},
+		{"1 / 0", "division by zero"},
+		{"1 && true", "no overload of &&"},
+		{"[1][2]", "out of range"},
+		{"request.error.code.x", "cannot select"},
+		{"héllo", `unexpected 'é' at offset 1`},
+		{"é", `unexpected 'é' at offset 0`},
+		{"1 + ✓", `unexpected '✓' at offset 4`},
+		{"'é' + \xff", "invalid UTF-8 at offset 7"},
+		{"1e", `invalid number "1e"`},
+		{"1e+", `invalid number "1e+"`},
+		{"0x", `invalid number "0x"`},
+		{"99999999999999999999", "invalid number"},
+		{"'\\q'", `invalid escape sequence \q`},
+		{"'\\x4'", "invalid escape sequence"},
+		{"'\\uD800'", "invalid escape sequence"},
+		{"'\\400'", `invalid escape sequence \4`},
+		{"'a\nb'", "unterminated string"},
+		{"r'abc", "unterminated string"},
+		{"params[[1]]", "cannot index a map with a list"},
+		{"[1] in params", "cannot index a map with a list"},
+		{"params[key]", "cannot index a map with a bytes"},
+		{"key in params", "cannot index a map with a bytes"},
+		{"params[params]", "cannot index a map with a map"},
+	} {
+		expr, err := parseHookExpr(testCase.source)
+		if err == nil {
+			_, err = expr.eval(vars)
+		}
+		if err == nil || !strings.Contains(err.Error(), testCase.want) {
+			t.Errorf("%s: want an error containing %q, got %v", testCase.source, testCase.want, err)
+		}
+	}
+}
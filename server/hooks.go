@@ -0,0 +1,338 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ResponseHook scripts how the server answers the calls to a method, so that bespoke test
+// behaviors need no change to the server. Its expressions are written in a subset of CEL, where
+// request is the request of the call and response, in the expressions of Set, its response.
+type ResponseHook struct {
+	// Method is the full name of the method hooked, e.g. "google.showcase.v1beta1.Echo/Echo".
+	Method string `json:"method"`
+
+	// When is the expression, evaluating to a bool, deciding whether the hook applies to a call,
+	// e.g. "request.content.startsWith('fail')". The hook applies to every call if it is empty.
+	When string `json:"when"`
+
+	// Error, if set, fails the calls the hook applies to instead of serving them.
+	Error *ResponseHookError `json:"error"`
+
+	// Set maps the paths of response fields, made of field names separated by dots as in
+	// "blurb.text", to the expressions computing their value in the responses to the calls the
+	// hook applies to.
+	Set map[string]string `json:"set"`
+}
+
+// ResponseHookError is the error a ResponseHook fails calls with.
+type ResponseHookError struct {
+	// Code is the name of the status code, e.g. "NOT_FOUND".
+	Code string `json:"code"`
+
+	// Message is the error message.
+	Message string `json:"message"`
+}
+
+// LoadResponseHooks reads a JSON list of ResponseHooks from a file.
+func LoadResponseHooks(path string) ([]ResponseHook, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	hooks := []ResponseHook{}
+	if err := json.Unmarshal(data, &hooks); err != nil {
+		return nil, fmt.Errorf("parsing response hooks in %s: %v", path, err)
+	}
+	return hooks, nil
+}
+
+// ResponseHooks applies ResponseHooks to the unary gRPC calls to the methods they hook.
+type ResponseHooks struct {
+	hooks map[string][]*responseHook
+}
+
+// responseHook is a ResponseHook with its expressions parsed.
+type responseHook struct {
+	when    hookExpr
+	err     error
+	assigns []hookAssignment
+}
+
+// hookAssignment sets the response field at path to the value of expr.
+type hookAssignment struct {
+	path []string
+	expr hookExpr
+}
+
+// NewResponseHooks creates ResponseHooks applying hooks, in order.
+func NewResponseHooks(hooks []ResponseHook) (*ResponseHooks, error) {
+	h := &ResponseHooks{hooks: map[string][]*responseHook{}}
+	for i, hook := range hooks {
+		compiled, err := compileResponseHook(hook)
+		if err != nil {
+			return nil, fmt.Errorf("response hook %d of %q: %v", i, hook.Method, err)
+		}
+		method := strings.TrimPrefix(hook.Method, "/")
+		h.hooks[method] = append(h.hooks[method], compiled)
+	}
+	return h, nil
+}
+
+func compileResponseHook(hook ResponseHook) (*responseHook, error) {
+	if !strings.Contains(hook.Method, "/") {
+		return nil, fmt.Errorf("the method must be named in full, as in \"google.showcase.v1beta1.Echo/Echo\"")
+	}
+	if (hook.Error == nil) == (len(hook.Set) == 0) {
+		return nil, fmt.Errorf("a hook must either fail calls or set response fields")
+	}
+	compiled := &responseHook{}
+	if hook.When != "" {
+		when, err := parseHookExpr(hook.When)
+		if err != nil {
+			return nil, fmt.Errorf("when: %v", err)
+		}
+		compiled.when = when
+	}
+	if hook.Error != nil {
+		code, err := ParseCode(hook.Error.Code)
+		if err != nil {
+			return nil, err
+		}
+		if code == codes.OK {
+			return nil, fmt.Errorf("invalid status code %v: errors must fail calls", code)
+		}
+		message := hook.Error.Message
+		if message == "" {
+			message = "the call was failed by a response hook"
+		}
+		compiled.err = status.Error(code, message)
+	}
+	paths := []string{}
+	for path := range hook.Set {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		expr, err := parseHookExpr(hook.Set[path])
+		if err != nil {
+			return nil, fmt.Errorf("set %s: %v", path, err)
+		}
+		compiled.assigns = append(compiled.assigns, hookAssignment{path: strings.Split(path, "."), expr: expr})
+	}
+	return compiled, nil
+}
+
+// applies returns whether the hook applies to the call whose messages vars holds.
+func (h *responseHook) applies(vars map[string]interface{}) (bool, error) {
+	if h.when == nil {
+		return true, nil
+	}
+	value, err := h.when.eval(vars)
+	if err != nil {
+		return false, fmt.Errorf("when: %v", err)
+	}
+	applies, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("when: evaluates to a %s, not a bool", hookTypeName(value))
+	}
+	return applies, nil
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, applying the hooks of the method
+// called. Hooks failing to evaluate fail the call with Internal.
+func (h *ResponseHooks) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	hooks := h.hooks[strings.TrimPrefix(info.FullMethod, "/")]
+	request, ok := req.(proto.Message)
+	if len(hooks) == 0 || !ok {
+		return handler(ctx, req)
+	}
+	vars := map[string]interface{}{"request": request.ProtoReflect()}
+	for _, hook := range hooks {
+		if hook.err == nil {
+			continue
+		}
+		applies, err := hook.applies(vars)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "response hook of %s: %v", info.FullMethod, err)
+		}
+		if applies {
+			return nil, hook.err
+		}
+	}
+
+	resp, err := handler(ctx, req)
+	response, ok := resp.(proto.Message)
+	if err != nil || !ok {
+		return resp, err
+	}
+	// The response is changed in a copy, as the services may keep the messages they return.
+	var changed proto.Message
+	vars["response"] = response.ProtoReflect()
+	for _, hook := range hooks {
+		if hook.err != nil {
+			continue
+		}
+		applies, err := hook.applies(vars)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "response hook of %s: %v", info.FullMethod, err)
+		}
+		if !applies {
+			continue
+		}
+		if changed == nil {
+			changed = proto.Clone(response)
+			vars["response"] = changed.ProtoReflect()
+		}
+		for _, assign := range hook.assigns {
+			if err := assign.apply(changed.ProtoReflect(), vars); err != nil {
+				return nil, status.Errorf(codes.Internal, "response hook of %s: set %s: %v", info.FullMethod, strings.Join(assign.path, "."), err)
+			}
+		}
+	}
+	if changed == nil {
+		return resp, nil
+	}
+	return changed, nil
+}
+
+// apply sets the field of message at the assignment's path.
+func (a hookAssignment) apply(message protoreflect.Message, vars map[string]interface{}) error {
+	value, err := a.expr.eval(vars)
+	if err != nil {
+		return err
+	}
+	for i, name := range a.path {
+		field, err := hookField(message, name)
+		if err != nil {
+			return err
+		}
+		if i < len(a.path)-1 {
+			if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+				return fmt.Errorf("the field %q is not a message", name)
+			}
+			message = message.Mutable(field).Message()
+			continue
+		}
+		if value == nil {
+			message.Clear(field)
+			return nil
+		}
+		if field.IsMap() {
+			return fmt.Errorf("map fields cannot be set")
+		}
+		if !field.IsList() {
+			v, err := hookProtoValue(field, value)
+			if err != nil {
+				return err
+			}
+			message.Set(field, v)
+			return nil
+		}
+		values, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("the repeated field %q cannot be set to a %s", name, hookTypeName(value))
+		}
+		message.Clear(field)
+		list := message.Mutable(field).List()
+		for _, elem := range values {
+			v, err := hookProtoValue(field, elem)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+	}
+	return nil
+}
+
+// hookProtoValue converts value to the type of a value of field.
+func hookProtoValue(field protoreflect.FieldDescriptor, value interface{}) (protoreflect.Value, error) {
+	mismatch := fmt.Errorf("the %s field %q cannot be set to a %s", field.Kind(), field.Name(), hookTypeName(value))
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		if b, ok := value.(bool); ok {
+			return protoreflect.ValueOfBool(b), nil
+		}
+	case protoreflect.EnumKind:
+		switch v := value.(type) {
+		case int64:
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), nil
+		case string:
+			if enum := field.Enum().Values().ByName(protoreflect.Name(v)); enum != nil {
+				return protoreflect.ValueOfEnum(enum.Number()), nil
+			}
+			return protoreflect.Value{}, fmt.Errorf("%s has no value %q", field.Enum().FullName(), v)
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if i, ok := value.(int64); ok {
+			return protoreflect.ValueOfInt32(int32(i)), nil
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if i, ok := value.(int64); ok {
+			return protoreflect.ValueOfInt64(i), nil
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if i, ok := value.(int64); ok && i >= 0 {
+			return protoreflect.ValueOfUint32(uint32(i)), nil
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if i, ok := value.(int64); ok && i >= 0 {
+			return protoreflect.ValueOfUint64(uint64(i)), nil
+		}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		switch v := value.(type) {
+		case float64:
+			if field.Kind() == protoreflect.FloatKind {
+				return protoreflect.ValueOfFloat32(float32(v)), nil
+			}
+			return protoreflect.ValueOfFloat64(v), nil
+		case int64:
+			return hookProtoValue(field, float64(v))
+		}
+	case protoreflect.StringKind:
+		if s, ok := value.(string); ok {
+			return protoreflect.ValueOfString(s), nil
+		}
+	case protoreflect.BytesKind:
+		switch v := value.(type) {
+		case []byte:
+			return protoreflect.ValueOfBytes(v), nil
+		case string:
+			return protoreflect.ValueOfBytes([]byte(v)), nil
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if m, ok := value.(protoreflect.Message); ok && m.Descriptor().FullName() == field.Message().FullName() {
+			return protoreflect.ValueOfMessage(proto.Clone(m.Interface()).ProtoReflect()), nil
+		}
+	}
+	return protoreflect.Value{}, mismatch
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLoadResponseHooks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hooks.json")
+	data := `[{"method": "google.showcase.v1beta1.Echo/Echo", "when": "request.content == 'x'", "error": {"code": "NOT_FOUND"}}]`
+	if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	hooks, err := LoadResponseHooks(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hooks) != 1 || hooks[0].Error.Code != "NOT_FOUND" || hooks[0].When != "request.content == 'x'" {
+		t.Errorf("unexpected hooks %+v", hooks)
+	}
+}
+
+func TestNewResponseHooks_invalid(t *testing.T) {
+	for _, testCase := range []struct {
+		hook ResponseHook
+		want string
+	}{
+		{ResponseHook{Method: "Echo", Error: &ResponseHookError{Code: "NOT_FOUND"}}, "named in full"},
+		{ResponseHook{Method: "google.showcase.v1beta1.Echo/Echo"}, "either fail calls or set"},
+		{ResponseHook{Method: "google.showcase.v1beta1.Echo/Echo", Error: &ResponseHookError{Code: "NOT_FOUND"}, Set: map[string]string{"content": "''"}}, "either fail calls or set"},
+		{ResponseHook{Method: "google.showcase.v1beta1.Echo/Echo", Error: &ResponseHookError{Code: "NOPE"}}, "unknown status code"},
+		{ResponseHook{Method: "google.showcase.v1beta1.Echo/Echo", Error: &ResponseHookError{Code: "OK"}}, "must fail calls"},
+		{ResponseHook{Method: "google.showcase.v1beta1.Echo/Echo", When: "1 +", Error: &ResponseHookError{Code: "NOT_FOUND"}}, "when:"},
+		{ResponseHook{Method: "google.showcase.v1beta1.Echo/Echo", Set: map[string]string{"content": "'"}}, "set content:"},
+	} {
+		if _, err := NewResponseHooks([]ResponseHook{testCase.hook}); err == nil || !strings.Contains(err.Error(), testCase.want) {
+			t.Errorf("%+v: want an error containing %q, got %v", testCase.hook, testCase.want, err)
+		}
+	}
+}
+
+func TestResponseHooks_interceptor(t *testing.T) {
+	hooks, err := NewResponseHooks([]ResponseHook{
+		{
+			Method: "google.showcase.v1beta1.Echo/Echo",
+			When:   "request.content.startsWith('fail')",
+			Error:  &ResponseHookError{Code: "FAILED_PRECONDITION", Message: "scripted failure"},
+		},
+		{
+			Method: "/google.showcase.v1beta1.Echo/Echo",
+			When:   "request.label == 'shout'",
+			Set:    map[string]string{"content": "response.content + '!'", "severity": "'CRITICAL'"},
+		},
+		{
+			Method: "google.showcase.v1beta1.Echo/Echo",
+			When:   "request.label == 'broken'",
+			Set:    map[string]string{"content": "response.nope"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+	served := &pb.EchoResponse{}
+	handler := func(_ context.Context, req interface{}) (interface{}, error) {
+		served = &pb.EchoResponse{Content: req.(*pb.EchoRequest).GetContent()}
+		return served, nil
+	}
+	call := func(req *pb.EchoRequest) (*pb.EchoResponse, error) {
+		served = nil
+		resp, err := hooks.UnaryInterceptor(context.Background(), req, info, handler)
+		if resp == nil {
+			return nil, err
+		}
+		return resp.(*pb.EchoResponse), err
+	}
+
+	if _, err := call(&pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "fail please"}}); status.Code(err) != codes.FailedPrecondition || status.Convert(err).Message() != "scripted failure" {
+		t.Errorf("want the scripted failure, got %v", err)
+	}
+	if served != nil {
+		t.Errorf("a call failed by a hook should not be served")
+	}
+
+	resp, err := call(&pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hi"}, Label: "shout"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&pb.EchoResponse{Content: "hi!", Severity: pb.Severity_CRITICAL}); !proto.Equal(resp, want) {
+		t.Errorf("want the response changed to %v, got %v", want, resp)
+	}
+	if served.GetContent() != "hi" {
+		t.Errorf("the hook should change a copy of the response served, which became %v", served)
+	}
+
+	if resp, err := call(&pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hi"}}); err != nil || resp != served {
+		t.Errorf("want the response served unchanged when no hook applies, got %v, %v", resp, err)
+	}
+	if _, err := call(&pb.EchoRequest{Label: "broken"}); status.Code(err) != codes.Internal || !strings.Contains(err.Error(), `has no field "nope"`) {
+		t.Errorf("want Internal for a hook failing to evaluate, got %v", err)
+	}
+	other := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Block"}
+	if _, err := hooks.UnaryInterceptor(context.Background(), &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "fail"}}, other, handler); err != nil {
+		t.Errorf("hooks should only apply to the method they hook, got %v", err)
+	}
+}
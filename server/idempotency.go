@@ -0,0 +1,109 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	// IdempotencyKeyHeader is the header REST clients send the key identifying a request and its
+	// retries in, as the IETF's Idempotency-Key HTTP header draft specifies.
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// IdempotentReplayedHeader is the header telling that a response was replayed for a request
+	// repeating the idempotency key of an earlier one, rather than served again.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// DefaultIdempotencyKeyTTL is how long the responses to requests sent with an idempotency
+	// key are remembered for.
+	DefaultIdempotencyKeyTTL = 24 * time.Hour
+)
+
+var (
+	// ErrIdempotencyKeyInUse is the error of the requests repeating the idempotency key of a
+	// request still being served.
+	ErrIdempotencyKeyInUse = errors.New("a request with this idempotency key is still being served")
+
+	// ErrIdempotencyKeyReused is the error of the requests repeating the idempotency key of an
+	// earlier request that asked for something else.
+	ErrIdempotencyKeyReused = errors.New("this idempotency key was sent with a different request")
+)
+
+// IdempotencyKeys remembers the responses to the requests sent with an idempotency key for a
+// TTL, so that retries of a request are given its response rather than served again.
+type IdempotencyKeys struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*idempotentRequest
+	nowF    func() time.Time
+}
+
+// idempotentRequest is a request sent with an idempotency key.
+type idempotentRequest struct {
+	// fingerprint identifies what the request asked for.
+	fingerprint string
+
+	// response is the response to the request, or nil while it is being served.
+	response interface{}
+
+	expires time.Time
+}
+
+// NewIdempotencyKeys creates an IdempotencyKeys remembering responses for ttl.
+func NewIdempotencyKeys(ttl time.Duration) *IdempotencyKeys {
+	return &IdempotencyKeys{ttl: ttl, entries: map[string]*idempotentRequest{}, nowF: time.Now}
+}
+
+// Begin starts serving a request sent with key, whose fingerprint identifies what it asks for.
+// If an earlier request was sent with key, it returns that request's response, or
+// ErrIdempotencyKeyInUse if it is still being served, or ErrIdempotencyKeyReused if its
+// fingerprint differs. Otherwise, it returns a nil response and a function the request must be
+// finished with, given the response to remember, or nil to forget the key so that the request
+// may be retried.
+func (k *IdempotencyKeys) Begin(key, fingerprint string) (interface{}, func(response interface{}), error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	now := k.nowF()
+	for name, entry := range k.entries {
+		if entry.response != nil && !now.Before(entry.expires) {
+			delete(k.entries, name)
+		}
+	}
+	if entry, ok := k.entries[key]; ok {
+		switch {
+		case entry.fingerprint != fingerprint:
+			return nil, nil, ErrIdempotencyKeyReused
+		case entry.response == nil:
+			return nil, nil, ErrIdempotencyKeyInUse
+		}
+		return entry.response, nil, nil
+	}
+	entry := &idempotentRequest{fingerprint: fingerprint}
+	k.entries[key] = entry
+	finish := func(response interface{}) {
+		k.mu.Lock()
+		defer k.mu.Unlock()
+		if response == nil {
+			delete(k.entries, key)
+			return
+		}
+		entry.response = response
+		entry.expires = k.nowF().Add(k.ttl)
+	}
+	return nil, finish, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	now := time.Unix(1000, 0)
+	keys := NewIdempotencyKeys(time.Minute)
+	keys.nowF = func() time.Time { return now }
+
+	response, finish, err := keys.Begin("key", "create a")
+	if response != nil || finish == nil || err != nil {
+		t.Fatalf("first request: want it served, got %v, %v", response, err)
+	}
+	if _, _, err := keys.Begin("key", "create a"); err != ErrIdempotencyKeyInUse {
+		t.Errorf("retry while served: want ErrIdempotencyKeyInUse, got %v", err)
+	}
+	finish("a created")
+
+	if response, _, err := keys.Begin("key", "create a"); response != "a created" || err != nil {
+		t.Errorf("retry: want the response replayed, got %v, %v", response, err)
+	}
+	if _, _, err := keys.Begin("key", "create b"); err != ErrIdempotencyKeyReused {
+		t.Errorf("other request with the key: want ErrIdempotencyKeyReused, got %v", err)
+	}
+
+	now = now.Add(time.Minute)
+	if response, finish, err := keys.Begin("key", "create b"); response != nil || finish == nil || err != nil {
+		t.Errorf("request after the TTL: want it served, got %v, %v", response, err)
+	}
+
+	// Requests finished without a response may be retried.
+	_, finish, _ = keys.Begin("failed", "create c")
+	finish(nil)
+	if response, finish, err := keys.Begin("failed", "create d"); response != nil || finish == nil || err != nil {
+		t.Errorf("request after a failure: want it served, got %v, %v", response, err)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UnknownClient is the client language or version recorded when a reporter does not identify
+// itself.
+const UnknownClient = "unknown"
+
+// Leaderboard aggregates the results of reported sessions by client language and version. When
+// it has an export path, the summary is written there as JSON after every report.
+type Leaderboard struct {
+	mu         sync.Mutex
+	clients    map[clientID]*clientResults
+	exportPath string
+	nowF       func() time.Time
+}
+
+type clientID struct {
+	language, version string
+}
+
+// clientResults holds the latest result of every session reported by one client.
+type clientResults struct {
+	sessions      map[string]pb.ReportSessionResponse_Result
+	latestSession string
+	latestResult  pb.ReportSessionResponse_Result
+	latestTime    time.Time
+}
+
+// NewLeaderboard creates a Leaderboard. If exportPath is not empty, the summary is exported to
+// that file as JSON every time a session is recorded.
+func NewLeaderboard(exportPath string) *Leaderboard {
+	return &Leaderboard{
+		clients:    map[clientID]*clientResults{},
+		exportPath: exportPath,
+		nowF:       time.Now,
+	}
+}
+
+// ClientIdentity extracts the client language and version from the value of an
+// x-goog-api-client header, such as "gl-go/1.16.0 gapic/0.1.0 gax/2.0.5 grpc/1.39.0". Missing
+// parts are returned empty.
+func ClientIdentity(apiClientHeader string) (language, version string) {
+	for _, token := range strings.Fields(apiClientHeader) {
+		kv := strings.SplitN(token, "/", 2)
+		switch {
+		case strings.HasPrefix(kv[0], "gl-") && language == "":
+			language = strings.TrimPrefix(kv[0], "gl-")
+		case kv[0] == "gapic" && len(kv) == 2 && version == "":
+			version = kv[1]
+		}
+	}
+	return language, version
+}
+
+// Record stores result as the latest outcome of session for the given client. Reporting the
+// same session again replaces its previous result rather than counting it twice.
+func (l *Leaderboard) Record(language, version, session string, result pb.ReportSessionResponse_Result) error {
+	if language == "" {
+		language = UnknownClient
+	}
+	if version == "" {
+		version = UnknownClient
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	id := clientID{language: language, version: version}
+	results, ok := l.clients[id]
+	if !ok {
+		results = &clientResults{sessions: map[string]pb.ReportSessionResponse_Result{}}
+		l.clients[id] = results
+	}
+	results.sessions[session] = result
+	results.latestSession = session
+	results.latestResult = result
+	results.latestTime = l.nowF()
+
+	if l.exportPath == "" {
+		return nil
+	}
+	return l.export(l.summary(""))
+}
+
+// Summary returns the results recorded so far, restricted to clients in language unless it is
+// empty.
+func (l *Leaderboard) Summary(language string) *pb.ConformanceSummary {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.summary(language)
+}
+
+func (l *Leaderboard) summary(language string) *pb.ConformanceSummary {
+	summary := &pb.ConformanceSummary{}
+	for id, results := range l.clients {
+		if language != "" && id.language != language {
+			continue
+		}
+		entry := &pb.ConformanceSummary_Entry{
+			ClientLanguage:   id.language,
+			ClientVersion:    id.version,
+			LatestSession:    results.latestSession,
+			LatestResult:     results.latestResult,
+			LatestReportTime: timestamppb.New(results.latestTime),
+		}
+		for _, result := range results.sessions {
+			switch result {
+			case pb.ReportSessionResponse_PASSED:
+				entry.Passed++
+			case pb.ReportSessionResponse_FAILED:
+				entry.Failed++
+			default:
+				entry.Incomplete++
+			}
+		}
+		summary.Entries = append(summary.Entries, entry)
+	}
+	sort.Slice(summary.Entries, func(i, j int) bool {
+		a, b := summary.Entries[i], summary.Entries[j]
+		if a.GetClientLanguage() != b.GetClientLanguage() {
+			return a.GetClientLanguage() < b.GetClientLanguage()
+		}
+		return a.GetClientVersion() < b.GetClientVersion()
+	})
+	return summary
+}
+
+// export atomically replaces the contents of the export file with summary.
+func (l *Leaderboard) export(summary *pb.ConformanceSummary) error {
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(l.exportPath), filepath.Base(l.exportPath)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), l.exportPath)
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestClientIdentity(t *testing.T) {
+	tests := []struct {
+		header, language, version string
+	}{
+		{"gl-go/1.16.0 gapic/0.1.0 gax/2.0.5 grpc/1.39.0", "go", "0.1.0"},
+		{"gl-python/3.9.1 grpc/1.38.0 gax/1.26.0 gapic/2.3.4", "python", "2.3.4"},
+		{"gl-java/11 gapic/ gax/2.0.0", "java", ""},
+		{"grpc/1.39.0", "", ""},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		language, version := ClientIdentity(tt.header)
+		if language != tt.language || version != tt.version {
+			t.Errorf("ClientIdentity(%q): got (%q, %q), want (%q, %q)", tt.header, language, version, tt.language, tt.version)
+		}
+	}
+}
+
+func TestLeaderboard_Export(t *testing.T) {
+	dir, err := ioutil.TempDir("", "leaderboard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "summary.json")
+	l := NewLeaderboard(path)
+	now := time.Unix(1600000000, 0)
+	l.nowF = func() time.Time { return now }
+
+	if err := l.Record("", "", "sessions/1", pb.ReportSessionResponse_INCOMPLETE); err != nil {
+		t.Fatalf("Record: unexpected err %v", err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading export: %v", err)
+	}
+	got := &pb.ConformanceSummary{}
+	if err := protojson.Unmarshal(data, got); err != nil {
+		t.Fatalf("parsing export: %v", err)
+	}
+	want := l.Summary("")
+	if !proto.Equal(got, want) {
+		t.Errorf("export: got %v, want %v", got, want)
+	}
+
+	entry := want.GetEntries()[0]
+	if entry.GetClientLanguage() != UnknownClient || entry.GetClientVersion() != UnknownClient || entry.GetIncomplete() != 1 {
+		t.Errorf("Summary: unexpected entry %v", entry)
+	}
+	if !entry.GetLatestReportTime().AsTime().Equal(now) {
+		t.Errorf("Summary: got report time %v, want %v", entry.GetLatestReportTime().AsTime(), now)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// LintWarningTrailer is the trailer the server reports each problem it finds in the
+	// messages of a call in, when it lints requests.
+	LintWarningTrailer = "x-showcase-lint-warning"
+
+	// maxLintDepth is how deeply messages may nest before they are reported: the recursion
+	// limit of the C++ and Java protobuf runtimes, past which they fail to parse a message.
+	maxLintDepth = 100
+
+	// maxLintWarnings is the most warnings reported per message, to keep trailers small.
+	maxLintWarnings = 20
+)
+
+// LintMessage returns the problems found in m that clients' serialization may be to blame for,
+// though the server parsed it: unknown fields, strings that are not valid UTF-8 and messages
+// nested more deeply than some protobuf runtimes can parse. Each names the path of the field at
+// fault, from the full name of m.
+func LintMessage(m proto.Message) []string {
+	warnings := []string{}
+	lintMessage(m.ProtoReflect(), string(m.ProtoReflect().Descriptor().FullName()), 1, &warnings)
+	sort.Strings(warnings)
+	if len(warnings) > maxLintWarnings {
+		more := len(warnings) - maxLintWarnings
+		warnings = append(warnings[:maxLintWarnings], fmt.Sprintf("and %d more warnings", more))
+	}
+	return warnings
+}
+
+// lintMessage appends to warnings the problems found in m, at path and nested depth messages
+// deep.
+func lintMessage(m protoreflect.Message, path string, depth int, warnings *[]string) {
+	if depth > maxLintDepth {
+		*warnings = append(*warnings, fmt.Sprintf("%s: nested more than %d messages deep, which some protobuf runtimes refuse to parse", path, maxLintDepth))
+		return
+	}
+	for unknown := m.GetUnknown(); len(unknown) > 0; {
+		number, wireType, n := protowire.ConsumeField(unknown)
+		if n < 0 {
+			*warnings = append(*warnings, fmt.Sprintf("%s: malformed unknown fields", path))
+			break
+		}
+		*warnings = append(*warnings, fmt.Sprintf("%s: unknown field %d of wire type %d", path, number, wireType))
+		unknown = unknown[n:]
+	}
+	m.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		fieldPath := path + "." + string(field.Name())
+		switch {
+		case field.IsList():
+			list := value.List()
+			for idx := 0; idx < list.Len(); idx++ {
+				lintValue(field, list.Get(idx), fmt.Sprintf("%s[%d]", fieldPath, idx), depth, warnings)
+			}
+		case field.IsMap():
+			value.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				keyPath := fmt.Sprintf("%s[%q]", fieldPath, key.String())
+				lintValue(field.MapKey(), key.Value(), keyPath, depth, warnings)
+				lintValue(field.MapValue(), value, keyPath, depth, warnings)
+				return true
+			})
+		default:
+			lintValue(field, value, fieldPath, depth, warnings)
+		}
+		return true
+	})
+}
+
+// lintValue appends to warnings the problems found in value, a single value of field at path in
+// a message nested depth messages deep.
+func lintValue(field protoreflect.FieldDescriptor, value protoreflect.Value, path string, depth int, warnings *[]string) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		if !utf8.ValidString(value.String()) {
+			*warnings = append(*warnings, fmt.Sprintf("%s: string is not valid UTF-8", path))
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		lintMessage(value.Message(), path, depth+1, warnings)
+	}
+}
+
+// lintTrailer returns the trailer reporting warnings, each prefixed by prefix.
+func lintTrailer(prefix string, warnings []string) metadata.MD {
+	trailer := metadata.MD{}
+	for _, warning := range warnings {
+		trailer.Append(LintWarningTrailer, prefix+warning)
+	}
+	return trailer
+}
+
+// LintUnaryInterceptor implements grpc.UnaryServerInterceptor, reporting the problems
+// LintMessage finds in requests in LintWarningTrailer trailers, without failing the calls.
+func LintUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if m, ok := req.(proto.Message); ok {
+		if warnings := LintMessage(m); len(warnings) > 0 {
+			grpc.SetTrailer(ctx, lintTrailer("", warnings))
+		}
+	}
+	return handler(ctx, req)
+}
+
+// LintStreamInterceptor implements grpc.StreamServerInterceptor, reporting the problems
+// LintMessage finds in each message received in LintWarningTrailer trailers, prefixed by the
+// number of the message, without failing the calls.
+func LintStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	return handler(srv, &lintingServerStream{ServerStream: ss})
+}
+
+// lintingServerStream is a stream linting the messages received on it.
+type lintingServerStream struct {
+	grpc.ServerStream
+	received int
+}
+
+func (s *lintingServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+	s.received++
+	if message, ok := m.(proto.Message); ok {
+		if warnings := LintMessage(message); len(warnings) > 0 {
+			s.SetTrailer(lintTrailer(fmt.Sprintf("message %d: ", s.received), warnings))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// withUnknownField returns m with an unknown varint field numbered number.
+func withUnknownField(m *pb.EchoRequest, number protowire.Number) *pb.EchoRequest {
+	unknown := protowire.AppendTag(nil, number, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 1)
+	m.ProtoReflect().SetUnknown(unknown)
+	return m
+}
+
+func TestLintMessage(t *testing.T) {
+	if warnings := LintMessage(&pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "fine"}}); len(warnings) != 0 {
+		t.Errorf("LintMessage of a sound message: got %v", warnings)
+	}
+
+	in := &pb.PagedExpandRequest{Content: "ok", PageToken: "\xff"}
+	want := []string{"google.showcase.v1beta1.PagedExpandRequest.page_token: string is not valid UTF-8"}
+	if got := LintMessage(in); !reflect.DeepEqual(got, want) {
+		t.Errorf("LintMessage of invalid UTF-8: want %v, got %v", want, got)
+	}
+
+	want = []string{"google.showcase.v1beta1.EchoRequest: unknown field 99 of wire type 0"}
+	if got := LintMessage(withUnknownField(&pb.EchoRequest{}, 99)); !reflect.DeepEqual(got, want) {
+		t.Errorf("LintMessage of unknown fields: want %v, got %v", want, got)
+	}
+
+	nested := structpb.NewNullValue()
+	for depth := 0; depth < maxLintDepth; depth++ {
+		nested = structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{nested}})
+	}
+	got := LintMessage(nested)
+	if len(got) != 1 || !strings.Contains(got[0], "nested more than 100 messages deep") {
+		t.Errorf("LintMessage of deeply nested messages: got %v", got)
+	}
+
+	many := &structpb.ListValue{}
+	for idx := 0; idx < maxLintWarnings+5; idx++ {
+		many.Values = append(many.Values, structpb.NewStringValue("\xfe"))
+	}
+	got = LintMessage(many)
+	if len(got) != maxLintWarnings+1 || got[maxLintWarnings] != "and 5 more warnings" {
+		t.Errorf("LintMessage of %d problems: got %d warnings: %v", maxLintWarnings+5, len(got), got)
+	}
+}
+
+// lintedEcho echoes and collects content.
+type lintedEcho struct {
+	*pb.UnimplementedEchoServer
+}
+
+func (lintedEcho) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+	return &pb.EchoResponse{Content: in.GetContent()}, nil
+}
+
+func (lintedEcho) Collect(stream pb.Echo_CollectServer) error {
+	for {
+		if _, err := stream.Recv(); err == io.EOF {
+			return stream.SendAndClose(&pb.EchoResponse{})
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func TestLintInterceptors(t *testing.T) {
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(LintUnaryInterceptor),
+		grpc.StreamInterceptor(LintStreamInterceptor))
+	pb.RegisterEchoServer(s, lintedEcho{})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewEchoClient(conn)
+
+	var trailer metadata.MD
+	in := withUnknownField(&pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hi"}}, 42)
+	if _, err := client.Echo(context.Background(), in, grpc.Trailer(&trailer)); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"google.showcase.v1beta1.EchoRequest: unknown field 42 of wire type 0"}
+	if got := trailer.Get(LintWarningTrailer); !reflect.DeepEqual(got, want) {
+		t.Errorf("Echo with an unknown field: want warnings %v, got %v", want, got)
+	}
+
+	trailer = nil
+	if _, err := client.Echo(context.Background(), &pb.EchoRequest{}, grpc.Trailer(&trailer)); err != nil {
+		t.Fatal(err)
+	}
+	if got := trailer.Get(LintWarningTrailer); len(got) != 0 {
+		t.Errorf("Echo of a sound request: want no warnings, got %v", got)
+	}
+
+	stream, err := client.Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, req := range []*pb.EchoRequest{{}, withUnknownField(&pb.EchoRequest{}, 50)} {
+		if err := stream.Send(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := stream.CloseAndRecv(); err != nil {
+		t.Fatal(err)
+	}
+	want = []string{"message 2: google.showcase.v1beta1.EchoRequest: unknown field 50 of wire type 0"}
+	if got := stream.Trailer().Get(LintWarningTrailer); !reflect.DeepEqual(got, want) {
+		t.Errorf("Collect with an unknown field: want warnings %v, got %v", want, got)
+	}
+}
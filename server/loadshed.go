@@ -0,0 +1,134 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// PushbackHeader is the trailer gRPC servers tell clients how many milliseconds to wait
+	// before retrying in, as gRPC's retry design specifies.
+	PushbackHeader = "grpc-retry-pushback-ms"
+
+	// LoadShedReason is the ErrorInfo reason of the calls shed for the server being overloaded.
+	LoadShedReason = "SERVER_OVERLOADED"
+)
+
+// LoadShedder simulates an overloaded server, rejecting the calls to the Showcase API beyond a
+// number served at once with UNAVAILABLE, along with in how long to retry as a PushbackHeader
+// trailer and RetryInfo details, so that clients honoring server pushback can be validated.
+// Calls to the ShowcaseAdmin service are never shed.
+type LoadShedder struct {
+	limit    int
+	pushback time.Duration
+
+	mu       sync.Mutex
+	inFlight int
+}
+
+// NewLoadShedder creates a LoadShedder serving at most limit calls at once, and asking the
+// calls it sheds to retry after pushback.
+func NewLoadShedder(limit int, pushback time.Duration) *LoadShedder {
+	return &LoadShedder{limit: limit, pushback: pushback}
+}
+
+// Pushback returns how long the calls shed are asked to wait before retrying.
+func (l *LoadShedder) Pushback() time.Duration {
+	return l.pushback
+}
+
+// Acquire admits a call to method, as named in error details, returning the function to call
+// once it is served, or an UNAVAILABLE error if as many calls as the limit are being served.
+func (l *LoadShedder) Acquire(method string) (func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.inFlight >= l.limit {
+		message := fmt.Sprintf("the server is overloaded, serving %d calls; retry %s in %v", l.inFlight, method, l.pushback)
+		st, err := status.New(codes.Unavailable, message).WithDetails(
+			&errdetails.ErrorInfo{
+				Reason:   LoadShedReason,
+				Domain:   "showcase.googleapis.com",
+				Metadata: map[string]string{"method": method},
+			},
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(l.pushback)})
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, message)
+		}
+		return nil, st.Err()
+	}
+	l.inFlight++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			l.inFlight--
+		})
+	}, nil
+}
+
+// acquire admits a gRPC call to method, setting the PushbackHeader of the calls shed.
+func (l *LoadShedder) acquire(ctx context.Context, method string) (func(), error) {
+	if !strings.HasPrefix(method, faultedPrefix) || strings.HasPrefix(method, adminPrefix) {
+		return func() {}, nil
+	}
+	release, err := l.Acquire(method)
+	if err != nil {
+		grpc.SetTrailer(ctx, metadata.Pairs(PushbackHeader, strconv.FormatInt(l.pushback.Milliseconds(), 10)))
+	}
+	return release, err
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, shedding the calls beyond the limit.
+func (l *LoadShedder) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	release, err := l.acquire(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, shedding the calls beyond the
+// limit. Streams count towards the limit until they end.
+func (l *LoadShedder) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	release, err := l.acquire(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return handler(srv, ss)
+}
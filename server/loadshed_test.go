@@ -0,0 +1,121 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoadShedder_Acquire(t *testing.T) {
+	shedder := NewLoadShedder(2, 1500*time.Millisecond)
+	first, err := shedder.Acquire("Echo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := shedder.Acquire("Echo"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = shedder.Acquire("Echo")
+	st := status.Convert(err)
+	if st.Code() != codes.Unavailable {
+		t.Fatalf("Acquire beyond the limit: got %v, want Unavailable", err)
+	}
+	var retryDelay time.Duration
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retryDelay = info.GetRetryDelay().AsDuration()
+		}
+	}
+	if retryDelay != 1500*time.Millisecond {
+		t.Errorf("Acquire beyond the limit: got retry delay %v, want 1.5s", retryDelay)
+	}
+
+	first()
+	first()
+	if _, err := shedder.Acquire("Echo"); err != nil {
+		t.Errorf("Acquire after a release: got %v", err)
+	}
+	if _, err := shedder.Acquire("Echo"); status.Code(err) != codes.Unavailable {
+		t.Errorf("Acquire after releasing once twice: got %v, want Unavailable", err)
+	}
+}
+
+// blockingEcho answers Echo calls once released.
+type blockingEcho struct {
+	*pb.UnimplementedEchoServer
+	started chan struct{}
+	release chan struct{}
+}
+
+func (e blockingEcho) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+	e.started <- struct{}{}
+	<-e.release
+	return &pb.EchoResponse{Content: in.GetContent()}, nil
+}
+
+func TestLoadShedder_UnaryInterceptor(t *testing.T) {
+	shedder := NewLoadShedder(1, 250*time.Millisecond)
+	echo := blockingEcho{&pb.UnimplementedEchoServer{}, make(chan struct{}), make(chan struct{})}
+	s := grpc.NewServer(grpc.UnaryInterceptor(shedder.UnaryInterceptor))
+	pb.RegisterEchoServer(s, echo)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewEchoClient(conn)
+
+	served := make(chan error)
+	go func() {
+		_, err := client.Echo(context.Background(), &pb.EchoRequest{})
+		served <- err
+	}()
+	<-echo.started
+
+	var trailer metadata.MD
+	_, err = client.Echo(context.Background(), &pb.EchoRequest{}, grpc.Trailer(&trailer))
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Echo beyond the limit: got %v, want Unavailable", err)
+	}
+	if got := trailer.Get(PushbackHeader); len(got) != 1 || got[0] != "250" {
+		t.Errorf("Echo beyond the limit: got %s %q, want 250", PushbackHeader, got)
+	}
+
+	close(echo.release)
+	if err := <-served; err != nil {
+		t.Errorf("Echo within the limit: got %v", err)
+	}
+	go func() { <-echo.started }()
+	if _, err := client.Echo(context.Background(), &pb.EchoRequest{}); err != nil {
+		t.Errorf("Echo after the load dropped: got %v", err)
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// metricsBuckets are the upper bounds, in seconds, of the buckets of the latency histograms.
+var metricsBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// latencyGrowth is how much wider each bucket of the histograms latency percentiles are
+// estimated from is than the previous one. The first bucket holds the calls that took up to a
+// microsecond.
+const latencyGrowth = 1.05
+
+// callKey identifies the calls to a method over a transport.
+type callKey struct {
+	transport, method string
+}
+
+// callMetrics are the metrics of the calls to a method over a transport.
+type callMetrics struct {
+	codes       map[string]int64
+	buckets     []int64
+	latencies   []int64
+	count       int64
+	sum         float64
+	max         time.Duration
+	received    int64
+	sent        int64
+	streamCalls bool
+}
+
+// Metrics counts the calls the server serves, by method, transport and status code, along with
+// their latency and the messages streamed, and exports them in the Prometheus text format.
+type Metrics struct {
+	mu    sync.Mutex
+	calls map[callKey]*callMetrics
+	nowF  func() time.Time
+}
+
+// NewMetrics creates Metrics having counted no call.
+func NewMetrics() *Metrics {
+	return &Metrics{calls: map[callKey]*callMetrics{}, nowF: time.Now}
+}
+
+// metrics returns the metrics of the calls to method over transport. m.mu must be held.
+func (m *Metrics) metrics(transport, method string) *callMetrics {
+	key := callKey{transport: transport, method: method}
+	metrics, ok := m.calls[key]
+	if !ok {
+		metrics = &callMetrics{codes: map[string]int64{}, buckets: make([]int64, len(metricsBuckets))}
+		m.calls[key] = metrics
+	}
+	return metrics
+}
+
+// Observe counts a call to method over transport, such as "grpc" or "rest", that ended with
+// code after duration.
+func (m *Metrics) Observe(transport, method, code string, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	metrics := m.metrics(transport, method)
+	metrics.codes[code]++
+	metrics.count++
+	metrics.sum += duration.Seconds()
+	for i, bound := range metricsBuckets {
+		if duration.Seconds() <= bound {
+			metrics.buckets[i]++
+		}
+	}
+	bucket := latencyBucket(duration)
+	for len(metrics.latencies) <= bucket {
+		metrics.latencies = append(metrics.latencies, 0)
+	}
+	metrics.latencies[bucket]++
+	if duration > metrics.max {
+		metrics.max = duration
+	}
+}
+
+// latencyBucket returns the bucket of the latency histograms counting the calls that took
+// duration.
+func latencyBucket(duration time.Duration) int {
+	if duration <= time.Microsecond {
+		return 0
+	}
+	return int(math.Ceil(math.Log(float64(duration)/float64(time.Microsecond)) / math.Log(latencyGrowth)))
+}
+
+// latencyBound returns how long the calls counted in a bucket of the latency histograms took at
+// most.
+func latencyBound(bucket int) time.Duration {
+	return time.Duration(float64(time.Microsecond) * math.Pow(latencyGrowth, float64(bucket)))
+}
+
+// percentile estimates the latency that the fraction q of the calls did not exceed.
+func (c *callMetrics) percentile(q float64) time.Duration {
+	rank := int64(math.Ceil(q * float64(c.count)))
+	seen := int64(0)
+	for bucket, count := range c.latencies {
+		seen += count
+		if seen >= rank {
+			if bound := latencyBound(bucket); bound < c.max {
+				return bound
+			}
+			break
+		}
+	}
+	return c.max
+}
+
+// Latencies returns percentiles of the latency of the calls to method over transport, sorted by
+// method then transport. If method or transport is empty, the calls to all methods or over all
+// transports are reported.
+func (m *Metrics) Latencies(transport, method string) []*pb.LatencyStats_Method {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	latencies := []*pb.LatencyStats_Method{}
+	for _, key := range m.keys() {
+		metrics := m.calls[key]
+		if metrics.count == 0 || (transport != "" && key.transport != transport) || (method != "" && key.method != method) {
+			continue
+		}
+		latencies = append(latencies, &pb.LatencyStats_Method{
+			Method:    key.method,
+			Transport: key.transport,
+			Count:     metrics.count,
+			P50:       durationpb.New(metrics.percentile(.5)),
+			P95:       durationpb.New(metrics.percentile(.95)),
+			P99:       durationpb.New(metrics.percentile(.99)),
+			Max:       durationpb.New(metrics.max),
+			Mean:      durationpb.New(time.Duration(metrics.sum / float64(metrics.count) * float64(time.Second))),
+		})
+	}
+	return latencies
+}
+
+// ObserveMessage counts a message received from, or else sent to, the client of a streaming
+// call to method over transport.
+func (m *Metrics) ObserveMessage(transport, method string, received bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	metrics := m.metrics(transport, method)
+	metrics.streamCalls = true
+	if received {
+		metrics.received++
+	} else {
+		metrics.sent++
+	}
+}
+
+// Write writes the metrics to w in the Prometheus text exposition format.
+func (m *Metrics) Write(w io.Writer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keys := m.keys()
+
+	b := bufio.NewWriter(w)
+	fmt.Fprintln(b, "# HELP showcase_rpc_calls_total The calls served, by status code.")
+	fmt.Fprintln(b, "# TYPE showcase_rpc_calls_total counter")
+	for _, key := range keys {
+		codes := []string{}
+		for code := range m.calls[key].codes {
+			codes = append(codes, code)
+		}
+		sort.Strings(codes)
+		for _, code := range codes {
+			fmt.Fprintf(b, "showcase_rpc_calls_total{%s,code=%s} %d\n", key.labels(), quoteLabel(code), m.calls[key].codes[code])
+		}
+	}
+	fmt.Fprintln(b, "# HELP showcase_rpc_duration_seconds How long the calls took to serve.")
+	fmt.Fprintln(b, "# TYPE showcase_rpc_duration_seconds histogram")
+	for _, key := range keys {
+		metrics := m.calls[key]
+		for i, bound := range metricsBuckets {
+			fmt.Fprintf(b, "showcase_rpc_duration_seconds_bucket{%s,le=\"%s\"} %d\n", key.labels(), strconv.FormatFloat(bound, 'g', -1, 64), metrics.buckets[i])
+		}
+		fmt.Fprintf(b, "showcase_rpc_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", key.labels(), metrics.count)
+		fmt.Fprintf(b, "showcase_rpc_duration_seconds_sum{%s} %s\n", key.labels(), strconv.FormatFloat(metrics.sum, 'g', -1, 64))
+		fmt.Fprintf(b, "showcase_rpc_duration_seconds_count{%s} %d\n", key.labels(), metrics.count)
+	}
+	fmt.Fprintln(b, "# HELP showcase_stream_messages_total The messages streamed, by direction.")
+	fmt.Fprintln(b, "# TYPE showcase_stream_messages_total counter")
+	for _, key := range keys {
+		if metrics := m.calls[key]; metrics.streamCalls {
+			fmt.Fprintf(b, "showcase_stream_messages_total{%s,direction=\"received\"} %d\n", key.labels(), metrics.received)
+			fmt.Fprintf(b, "showcase_stream_messages_total{%s,direction=\"sent\"} %d\n", key.labels(), metrics.sent)
+		}
+	}
+	return b.Flush()
+}
+
+// keys returns the keys of the calls counted, sorted by method then transport. m.mu must be
+// held.
+func (m *Metrics) keys() []callKey {
+	keys := make([]callKey, 0, len(m.calls))
+	for key := range m.calls {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].method != keys[j].method {
+			return keys[i].method < keys[j].method
+		}
+		return keys[i].transport < keys[j].transport
+	})
+	return keys
+}
+
+// labels returns the labels identifying the calls of k.
+func (k callKey) labels() string {
+	return fmt.Sprintf("method=%s,transport=%s", quoteLabel(k.method), quoteLabel(k.transport))
+}
+
+// quoteLabel quotes a label value, escaping it as the Prometheus text format requires.
+func quoteLabel(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
+
+// ServeHTTP implements http.Handler, serving the metrics as Prometheus scrapes them.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.Write(w)
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, counting the calls served.
+func (m *Metrics) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	start := m.nowF()
+	resp, err := handler(ctx, req)
+	m.Observe("grpc", info.FullMethod, status.Code(err).String(), m.nowF().Sub(start))
+	return resp, err
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, counting the calls served and the
+// messages they stream.
+func (m *Metrics) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	start := m.nowF()
+	err := handler(srv, &meteredStream{ServerStream: ss, metrics: m, method: info.FullMethod})
+	m.Observe("grpc", info.FullMethod, status.Code(err).String(), m.nowF().Sub(start))
+	return err
+}
+
+// meteredStream counts the messages of a streaming call.
+type meteredStream struct {
+	grpc.ServerStream
+	metrics *Metrics
+	method  string
+}
+
+func (s *meteredStream) SendMsg(msg interface{}) error {
+	err := s.ServerStream.SendMsg(msg)
+	if err == nil {
+		s.metrics.ObserveMessage("grpc", s.method, false)
+	}
+	return err
+}
+
+func (s *meteredStream) RecvMsg(msg interface{}) error {
+	err := s.ServerStream.RecvMsg(msg)
+	if err == nil {
+		s.metrics.ObserveMessage("grpc", s.method, true)
+	}
+	return err
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetrics_Write(t *testing.T) {
+	metrics := NewMetrics()
+	metrics.Observe("grpc", "/google.showcase.v1beta1.Echo/Echo", "OK", 20*time.Millisecond)
+	metrics.Observe("grpc", "/google.showcase.v1beta1.Echo/Echo", "Unavailable", 3*time.Second)
+	metrics.Observe("rest", `POST /v1beta1/echo:echo "quoted"`, "200", time.Millisecond)
+	metrics.ObserveMessage("grpc", "/google.showcase.v1beta1.Echo/Chat", true)
+	metrics.ObserveMessage("grpc", "/google.showcase.v1beta1.Echo/Chat", false)
+	metrics.ObserveMessage("grpc", "/google.showcase.v1beta1.Echo/Chat", false)
+
+	recorder := httptest.NewRecorder()
+	metrics.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	if got := recorder.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/plain; version=0.0.4") {
+		t.Errorf("Content-Type: want the Prometheus text format, got %q", got)
+	}
+	body := recorder.Body.String()
+	for _, want := range []string{
+		"# TYPE showcase_rpc_calls_total counter\n",
+		`showcase_rpc_calls_total{method="/google.showcase.v1beta1.Echo/Echo",transport="grpc",code="OK"} 1` + "\n",
+		`showcase_rpc_calls_total{method="/google.showcase.v1beta1.Echo/Echo",transport="grpc",code="Unavailable"} 1` + "\n",
+		`showcase_rpc_calls_total{method="POST /v1beta1/echo:echo \"quoted\"",transport="rest",code="200"} 1` + "\n",
+		"# TYPE showcase_rpc_duration_seconds histogram\n",
+		`showcase_rpc_duration_seconds_bucket{method="/google.showcase.v1beta1.Echo/Echo",transport="grpc",le="0.01"} 0` + "\n",
+		`showcase_rpc_duration_seconds_bucket{method="/google.showcase.v1beta1.Echo/Echo",transport="grpc",le="0.025"} 1` + "\n",
+		`showcase_rpc_duration_seconds_bucket{method="/google.showcase.v1beta1.Echo/Echo",transport="grpc",le="5"} 2` + "\n",
+		`showcase_rpc_duration_seconds_bucket{method="/google.showcase.v1beta1.Echo/Echo",transport="grpc",le="+Inf"} 2` + "\n",
+		`showcase_rpc_duration_seconds_sum{method="/google.showcase.v1beta1.Echo/Echo",transport="grpc"} 3.02` + "\n",
+		`showcase_rpc_duration_seconds_count{method="/google.showcase.v1beta1.Echo/Echo",transport="grpc"} 2` + "\n",
+		`showcase_stream_messages_total{method="/google.showcase.v1beta1.Echo/Chat",transport="grpc",direction="received"} 1` + "\n",
+		`showcase_stream_messages_total{method="/google.showcase.v1beta1.Echo/Chat",transport="grpc",direction="sent"} 2` + "\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics: want %q, got\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, `showcase_stream_messages_total{method="/google.showcase.v1beta1.Echo/Echo"`) {
+		t.Errorf("metrics: want no message counts of unary calls, got\n%s", body)
+	}
+}
+
+func TestMetrics_Latencies(t *testing.T) {
+	metrics := NewMetrics()
+	for i := 1; i <= 100; i++ {
+		metrics.Observe("grpc", "/google.showcase.v1beta1.Echo/Echo", "OK", time.Duration(i)*time.Millisecond)
+	}
+	metrics.Observe("rest", "POST /v1beta1/echo:echo", "200", 0)
+	metrics.ObserveMessage("grpc", "/google.showcase.v1beta1.Echo/Chat", true)
+
+	latencies := metrics.Latencies("", "")
+	if len(latencies) != 2 || latencies[0].GetTransport() != "grpc" || latencies[1].GetTransport() != "rest" {
+		t.Fatalf("Latencies(): want the gRPC calls then the REST calls, without the streaming calls in flight, got %v", latencies)
+	}
+	echo := latencies[0]
+	if echo.GetCount() != 100 {
+		t.Errorf("count: want 100, got %d", echo.GetCount())
+	}
+	for _, test := range []struct {
+		name      string
+		got, want time.Duration
+	}{
+		{"p50", echo.GetP50().AsDuration(), 50 * time.Millisecond},
+		{"p95", echo.GetP95().AsDuration(), 95 * time.Millisecond},
+		{"p99", echo.GetP99().AsDuration(), 99 * time.Millisecond},
+		{"max", echo.GetMax().AsDuration(), 100 * time.Millisecond},
+		{"mean", echo.GetMean().AsDuration(), 50500 * time.Microsecond},
+	} {
+		if test.got < test.want || float64(test.got) > float64(test.want)*latencyGrowth {
+			t.Errorf("%s: want %v, up to %v%% more, got %v", test.name, test.want, (latencyGrowth-1)*100, test.got)
+		}
+	}
+	if rest := latencies[1]; rest.GetP99().AsDuration() != 0 || rest.GetMax().AsDuration() != 0 {
+		t.Errorf("percentiles must not exceed the longest call, got %v", rest)
+	}
+
+	if got := metrics.Latencies("rest", ""); len(got) != 1 || got[0].GetMethod() != "POST /v1beta1/echo:echo" {
+		t.Errorf(`Latencies("rest", ""): want the REST calls, got %v`, got)
+	}
+	if got := metrics.Latencies("", "/google.showcase.v1beta1.Echo/Expand"); len(got) != 0 {
+		t.Errorf("Latencies() of a method not called: want nothing, got %v", got)
+	}
+}
+
+func TestMetrics_UnaryInterceptor(t *testing.T) {
+	metrics := NewMetrics()
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+	for _, err := range []error{nil, status.Error(codes.NotFound, "missing"), errors.New("plain")} {
+		metrics.UnaryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, err
+		})
+	}
+	for code, want := range map[string]int64{"OK": 1, "NotFound": 1, "Unknown": 1} {
+		if got := metrics.calls[callKey{"grpc", info.FullMethod}].codes[code]; got != want {
+			t.Errorf("calls ended with %s: want %d, got %d", code, want, got)
+		}
+	}
+}
+
+type fakeMessageStream struct {
+	grpc.ServerStream
+	received int
+}
+
+func (s *fakeMessageStream) RecvMsg(msg interface{}) error {
+	if s.received == 2 {
+		return errors.New("EOF")
+	}
+	s.received++
+	return nil
+}
+
+func (s *fakeMessageStream) SendMsg(msg interface{}) error {
+	return nil
+}
+
+func TestMetrics_StreamInterceptor(t *testing.T) {
+	metrics := NewMetrics()
+	info := &grpc.StreamServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Chat"}
+	err := metrics.StreamInterceptor(nil, &fakeMessageStream{}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		for ss.RecvMsg(nil) == nil {
+			ss.SendMsg(nil)
+		}
+		return ss.SendMsg(nil)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := metrics.calls[callKey{"grpc", info.FullMethod}]
+	if got.received != 2 || got.sent != 3 || got.codes["OK"] != 1 {
+		t.Errorf("stream metrics: want 2 received, 3 sent and 1 OK call, got %d, %d and %v", got.received, got.sent, got.codes)
+	}
+}
@@ -101,11 +101,12 @@ func (r *showcaseObserverRegistry) UnaryInterceptor(
 	req interface{},
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler) (interface{}, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
+	// The registry is not locked while the handler runs, so that calls are served
+	// concurrently.
 	resp, err := handler(ctx, req)
 
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	for _, obs := range r.uObservers {
 		obs.ObserveUnary(ctx, req, resp, info, err)
 	}
@@ -121,10 +122,10 @@ type showcaseStream struct {
 }
 
 func (s *showcaseStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+
 	s.registry.mu.Lock()
 	defer s.registry.mu.Unlock()
-
-	err := s.ServerStream.SendMsg(m)
 	for _, obs := range s.registry.sRespObservers {
 		obs.ObserveStreamResponse(s.ServerStream.Context(), m, s.info, err)
 	}
@@ -132,10 +133,10 @@ func (s *showcaseStream) SendMsg(m interface{}) error {
 }
 
 func (s *showcaseStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+
 	s.registry.mu.Lock()
 	defer s.registry.mu.Unlock()
-
-	err := s.ServerStream.RecvMsg(m)
 	for _, obs := range s.registry.sReqObservers {
 		obs.ObserveStreamRequest(s.ServerStream.Context(), m, s.info, err)
 	}
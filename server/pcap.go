@@ -0,0 +1,307 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// maxCaptureBytes is the largest pcap a capture grows to; later packets are dropped.
+	maxCaptureBytes = 64 << 20
+
+	// maxSegmentBytes is the most data put in one synthesized TCP segment, so that the
+	// packets fit the 16-bit length fields of IP headers.
+	maxSegmentBytes = 60000
+
+	// linkTypeRaw is the pcap link type of packets starting with an IPv4 or IPv6 header.
+	linkTypeRaw = 101
+
+	tcpFlagFIN = 0x01
+	tcpFlagSYN = 0x02
+	tcpFlagPSH = 0x08
+	tcpFlagACK = 0x10
+)
+
+// Capture is a packet capture of the traffic of the server's connections, in pcap format.
+type Capture struct {
+	ID        string
+	StartTime time.Time
+	Packets   int
+	Truncated bool
+	Data      []byte
+}
+
+// PacketRecorder records the traffic of the connections accepted by its listeners into the
+// captures currently started, as the TCP packets that would have carried it. Only the bytes
+// are real: the packets, including their handshakes, are synthesized from what the server read
+// and wrote, so that packet analyzers such as Wireshark can dissect, and with a TLS key log
+// decrypt, traffic on the loopback interface without any privileges.
+type PacketRecorder struct {
+	mu       sync.Mutex
+	captures map[string]*capture
+
+	// active is the number of captures started, read without the mutex so that connections
+	// do no work while nothing is captured.
+	active int32
+}
+
+type capture struct {
+	Capture
+	buf bytes.Buffer
+
+	// started are the connections whose handshake was written to the capture.
+	started map[*capturedConn]bool
+}
+
+// NewPacketRecorder creates a PacketRecorder without captures.
+func NewPacketRecorder() *PacketRecorder {
+	return &PacketRecorder{captures: map[string]*capture{}}
+}
+
+// Start starts a capture, identified by id.
+func (r *PacketRecorder) Start(id string) (*Capture, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.captures[id]; ok {
+		return nil, fmt.Errorf("capture %q is already started", id)
+	}
+	c := &capture{Capture: Capture{ID: id, StartTime: time.Now()}, started: map[*capturedConn]bool{}}
+	header := make([]byte, 24)
+	binary.LittleEndian.PutUint32(header[0:], 0xa1b2c3d4)
+	binary.LittleEndian.PutUint16(header[4:], 2)
+	binary.LittleEndian.PutUint16(header[6:], 4)
+	binary.LittleEndian.PutUint32(header[16:], 65535)
+	binary.LittleEndian.PutUint32(header[20:], linkTypeRaw)
+	c.buf.Write(header)
+	r.captures[id] = c
+	atomic.AddInt32(&r.active, 1)
+	snapshot := c.Capture
+	return &snapshot, nil
+}
+
+// Stop stops the capture identified by id, and returns it.
+func (r *PacketRecorder) Stop(id string) (*Capture, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.captures[id]
+	if !ok {
+		return nil, fmt.Errorf("capture %q is not started", id)
+	}
+	delete(r.captures, id)
+	atomic.AddInt32(&r.active, -1)
+	c.Data = c.buf.Bytes()
+	return &c.Capture, nil
+}
+
+// Listener returns a listener recording the traffic of the connections lis accepts.
+func (r *PacketRecorder) Listener(lis net.Listener) net.Listener {
+	return &recordingListener{Listener: lis, recorder: r}
+}
+
+type recordingListener struct {
+	net.Listener
+	recorder *PacketRecorder
+}
+
+func (l *recordingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	client, clientOK := conn.RemoteAddr().(*net.TCPAddr)
+	server, serverOK := conn.LocalAddr().(*net.TCPAddr)
+	if !clientOK || !serverOK {
+		return conn, nil
+	}
+	return &capturedConn{
+		Conn:      conn,
+		recorder:  l.recorder,
+		client:    client,
+		server:    server,
+		clientSeq: 1,
+		serverSeq: 1,
+	}, nil
+}
+
+// capturedConn is a connection whose traffic is recorded.
+type capturedConn struct {
+	net.Conn
+	recorder *PacketRecorder
+	client   *net.TCPAddr
+	server   *net.TCPAddr
+
+	// The next sequence numbers of either side, and whether the connection was closed,
+	// guarded by the recorder's mutex.
+	clientSeq uint32
+	serverSeq uint32
+	closed    bool
+}
+
+func (c *capturedConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.recorder.record(c, true, b[:n], tcpFlagPSH|tcpFlagACK)
+	}
+	return n, err
+}
+
+func (c *capturedConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if n > 0 {
+		c.recorder.record(c, false, b[:n], tcpFlagPSH|tcpFlagACK)
+	}
+	return n, err
+}
+
+func (c *capturedConn) Close() error {
+	c.recorder.record(c, false, nil, tcpFlagFIN|tcpFlagACK)
+	return c.Conn.Close()
+}
+
+// record adds the packets carrying data, sent by the client if fromClient and by the server
+// otherwise, to every capture. Traffic that no capture records is not numbered either: the
+// segments of a connection a capture starts seeing follow the handshake it synthesizes.
+func (r *PacketRecorder) record(c *capturedConn, fromClient bool, data []byte, flags byte) {
+	if atomic.LoadInt32(&r.active) == 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if c.closed {
+		return
+	}
+	now := time.Now()
+	for _, capture := range r.captures {
+		if !capture.started[c] {
+			// Synthesize the handshake of connections first seen by this capture.
+			capture.started[c] = true
+			capture.write(now, c.tcpPacket(true, c.clientSeq-1, 0, tcpFlagSYN, nil))
+			capture.write(now, c.tcpPacket(false, c.serverSeq-1, c.clientSeq, tcpFlagSYN|tcpFlagACK, nil))
+			capture.write(now, c.tcpPacket(true, c.clientSeq, c.serverSeq, tcpFlagACK, nil))
+		}
+	}
+
+	seq, ack := &c.serverSeq, c.clientSeq
+	if fromClient {
+		seq, ack = &c.clientSeq, c.serverSeq
+	}
+	for first := true; first || len(data) > 0; first = false {
+		segment := data
+		if len(segment) > maxSegmentBytes {
+			segment = segment[:maxSegmentBytes]
+		}
+		data = data[len(segment):]
+		packet := c.tcpPacket(fromClient, *seq, ack, flags, segment)
+		for _, capture := range r.captures {
+			capture.write(now, packet)
+		}
+		*seq += uint32(len(segment))
+	}
+	if flags&tcpFlagFIN != 0 {
+		c.closed = true
+		for _, capture := range r.captures {
+			capture.write(now, c.tcpPacket(true, c.clientSeq, c.serverSeq+1, tcpFlagFIN|tcpFlagACK, nil))
+			delete(capture.started, c)
+		}
+	}
+}
+
+func (c *capture) write(t time.Time, packet []byte) {
+	if c.buf.Len()+16+len(packet) > maxCaptureBytes {
+		c.Truncated = true
+		return
+	}
+	header := make([]byte, 16)
+	binary.LittleEndian.PutUint32(header[0:], uint32(t.Unix()))
+	binary.LittleEndian.PutUint32(header[4:], uint32(t.Nanosecond()/1000))
+	binary.LittleEndian.PutUint32(header[8:], uint32(len(packet)))
+	binary.LittleEndian.PutUint32(header[12:], uint32(len(packet)))
+	c.buf.Write(header)
+	c.buf.Write(packet)
+	c.Packets++
+}
+
+// tcpPacket returns the IP packet carrying a TCP segment of the connection.
+func (c *capturedConn) tcpPacket(fromClient bool, seq, ack uint32, flags byte, payload []byte) []byte {
+	src, dst := c.server, c.client
+	if fromClient {
+		src, dst = c.client, c.server
+	}
+	segment := make([]byte, 20+len(payload))
+	binary.BigEndian.PutUint16(segment[0:], uint16(src.Port))
+	binary.BigEndian.PutUint16(segment[2:], uint16(dst.Port))
+	binary.BigEndian.PutUint32(segment[4:], seq)
+	binary.BigEndian.PutUint32(segment[8:], ack)
+	segment[12] = 5 << 4
+	segment[13] = flags
+	binary.BigEndian.PutUint16(segment[14:], 65535)
+	copy(segment[20:], payload)
+
+	srcIP, dstIP := src.IP.To4(), dst.IP.To4()
+	if srcIP == nil || dstIP == nil {
+		srcIP, dstIP = src.IP.To16(), dst.IP.To16()
+	}
+	pseudo := append(append([]byte{}, srcIP...), dstIP...)
+	pseudo = append(pseudo, 0, 6, byte(len(segment)>>8), byte(len(segment)))
+	binary.BigEndian.PutUint16(segment[16:], internetChecksum(pseudo, segment))
+
+	if len(srcIP) == net.IPv4len {
+		header := make([]byte, 20)
+		header[0] = 0x45
+		binary.BigEndian.PutUint16(header[2:], uint16(len(header)+len(segment)))
+		binary.BigEndian.PutUint16(header[6:], 0x4000)
+		header[8] = 64
+		header[9] = 6
+		copy(header[12:], srcIP)
+		copy(header[16:], dstIP)
+		binary.BigEndian.PutUint16(header[10:], internetChecksum(header))
+		return append(header, segment...)
+	}
+	header := make([]byte, 40)
+	header[0] = 0x60
+	binary.BigEndian.PutUint16(header[4:], uint16(len(segment)))
+	header[6] = 6
+	header[7] = 64
+	copy(header[8:], srcIP)
+	copy(header[24:], dstIP)
+	return append(header, segment...)
+}
+
+// internetChecksum returns the checksum of IP and TCP headers over the concatenation of parts.
+func internetChecksum(parts ...[]byte) uint16 {
+	var sum uint32
+	var odd []byte
+	for _, part := range parts {
+		data := append(odd, part...)
+		for ; len(data) >= 2; data = data[2:] {
+			sum += uint32(data[0])<<8 | uint32(data[1])
+		}
+		odd = data
+	}
+	if len(odd) == 1 {
+		sum += uint32(odd[0]) << 8
+	}
+	for sum>>16 != 0 {
+		sum = sum&0xffff + sum>>16
+	}
+	return ^uint16(sum)
+}
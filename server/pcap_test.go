@@ -0,0 +1,157 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+)
+
+// pcapPacket is a packet read back from a capture.
+type pcapPacket struct {
+	flags   byte
+	seq     uint32
+	payload string
+}
+
+func readPcap(t *testing.T, data []byte) []pcapPacket {
+	if len(data) < 24 || binary.LittleEndian.Uint32(data) != 0xa1b2c3d4 || binary.LittleEndian.Uint32(data[20:]) != linkTypeRaw {
+		t.Fatalf("bad pcap header % x", data[:24])
+	}
+	packets := []pcapPacket{}
+	for data = data[24:]; len(data) > 0; {
+		length := binary.LittleEndian.Uint32(data[8:])
+		packet := data[16 : 16+length]
+		data = data[16+length:]
+		if packet[0] != 0x45 {
+			t.Fatalf("not an IPv4 packet: % x", packet)
+		}
+		if internetChecksum(packet[:20]) != 0 {
+			t.Errorf("bad IP checksum in % x", packet[:20])
+		}
+		segment := packet[20:]
+		pseudo := append(append([]byte{}, packet[12:20]...), 0, 6, byte(len(segment)>>8), byte(len(segment)))
+		if internetChecksum(pseudo, segment) != 0 {
+			t.Errorf("bad TCP checksum in % x", segment[:20])
+		}
+		packets = append(packets, pcapPacket{flags: segment[13], seq: binary.BigEndian.Uint32(segment[4:]), payload: string(segment[20:])})
+	}
+	return packets
+}
+
+func TestPacketRecorder(t *testing.T) {
+	recorder := NewPacketRecorder()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis = recorder.Listener(lis)
+	defer lis.Close()
+	served := make(chan struct{})
+	go func() {
+		defer close(served)
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		buf := make([]byte, 5)
+		io.ReadFull(conn, buf)
+		conn.Write([]byte("world"))
+		conn.Close()
+	}()
+
+	if _, err := recorder.Start("session"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recorder.Start("session"); err == nil {
+		t.Error("Start of a started capture: want an error")
+	}
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Write([]byte("hello"))
+	ioutil.ReadAll(conn)
+	conn.Close()
+	<-served
+
+	capture, err := recorder.Stop("session")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recorder.Stop("session"); err == nil {
+		t.Error("Stop of a stopped capture: want an error")
+	}
+
+	want := []pcapPacket{
+		{tcpFlagSYN, 0, ""},
+		{tcpFlagSYN | tcpFlagACK, 0, ""},
+		{tcpFlagACK, 1, ""},
+		{tcpFlagPSH | tcpFlagACK, 1, "hello"},
+		{tcpFlagPSH | tcpFlagACK, 1, "world"},
+		{tcpFlagFIN | tcpFlagACK, 6, ""},
+		{tcpFlagFIN | tcpFlagACK, 6, ""},
+	}
+	got := readPcap(t, capture.Data)
+	if capture.Packets != len(want) || len(got) != len(want) {
+		t.Fatalf("got %d packets (%d counted), want %d: %v", len(got), capture.Packets, len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("packet %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestPacketRecorder_idle(t *testing.T) {
+	recorder := NewPacketRecorder()
+	client, server := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}, &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 7469}
+	conn := &capturedConn{recorder: recorder, client: client, server: server, clientSeq: 1, serverSeq: 1}
+	data := []byte("unrecorded")
+	if allocs := testing.AllocsPerRun(100, func() { recorder.record(conn, true, data, tcpFlagPSH|tcpFlagACK) }); allocs != 0 {
+		t.Errorf("recording without captures: want no allocations, got %v", allocs)
+	}
+
+	if _, err := recorder.Start("late"); err != nil {
+		t.Fatal(err)
+	}
+	recorder.record(conn, true, []byte("hello"), tcpFlagPSH|tcpFlagACK)
+	capture, err := recorder.Stop("late")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []pcapPacket{
+		{tcpFlagSYN, 0, ""},
+		{tcpFlagSYN | tcpFlagACK, 0, ""},
+		{tcpFlagACK, 1, ""},
+		{tcpFlagPSH | tcpFlagACK, 1, "hello"},
+	}
+	got := readPcap(t, capture.Data)
+	if len(got) != len(want) {
+		t.Fatalf("got %d packets, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("packet %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if allocs := testing.AllocsPerRun(100, func() { recorder.record(conn, true, data, tcpFlagPSH|tcpFlagACK) }); allocs != 0 {
+		t.Errorf("recording once the captures stopped: want no allocations, got %v", allocs)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/x509"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// PeerSPIFFEIDHeader is the response header echoing the SPIFFE ID of the client
+	// certificate of a mutual TLS call.
+	PeerSPIFFEIDHeader = "x-showcase-peer-spiffe-id"
+
+	// PeerSANHeader is the response header echoing the subject alternative names of the
+	// client certificate of a mutual TLS call, one value per name.
+	PeerSANHeader = "x-showcase-peer-san"
+)
+
+// PeerIdentityEcho echoes the identity in the client certificates of mutual TLS calls in their
+// response headers, so that workload-identity-style client credentials can be verified end to
+// end.
+type PeerIdentityEcho struct{}
+
+// NewPeerIdentityEcho creates a PeerIdentityEcho.
+func NewPeerIdentityEcho() *PeerIdentityEcho {
+	return &PeerIdentityEcho{}
+}
+
+// PeerIdentity returns the response headers echoing the identity in cert: its SPIFFE ID, the
+// first URI name with the spiffe scheme and a trust domain, if any, and all its subject
+// alternative names.
+func PeerIdentity(cert *x509.Certificate) metadata.MD {
+	md := metadata.MD{}
+	for _, name := range cert.DNSNames {
+		md.Append(PeerSANHeader, "DNS:"+name)
+	}
+	for _, ip := range cert.IPAddresses {
+		md.Append(PeerSANHeader, "IP:"+ip.String())
+	}
+	for _, email := range cert.EmailAddresses {
+		md.Append(PeerSANHeader, "email:"+email)
+	}
+	for _, uri := range cert.URIs {
+		md.Append(PeerSANHeader, "URI:"+uri.String())
+		if uri.Scheme == "spiffe" && uri.Host != "" && len(md.Get(PeerSPIFFEIDHeader)) == 0 {
+			md.Set(PeerSPIFFEIDHeader, uri.String())
+		}
+	}
+	return md
+}
+
+// headers returns the response headers echoing the identity of the client of the call in ctx,
+// if it presented a certificate.
+func (e *PeerIdentityEcho) headers(ctx context.Context) metadata.MD {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.PeerCertificates) == 0 {
+		return nil
+	}
+	return PeerIdentity(info.State.PeerCertificates[0])
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, echoing the identity of the client
+// in the response headers.
+func (e *PeerIdentityEcho) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if md := e.headers(ctx); len(md) > 0 {
+		grpc.SetHeader(ctx, md)
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, echoing the identity of the
+// client in the response headers.
+func (e *PeerIdentityEcho) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if md := e.headers(ss.Context()); len(md) > 0 {
+		ss.SetHeader(md)
+	}
+	return handler(srv, ss)
+}
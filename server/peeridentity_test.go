@@ -0,0 +1,55 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/x509"
+	"net"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestPeerIdentity(t *testing.T) {
+	cert := &x509.Certificate{
+		DNSNames:       []string{"client.example.com"},
+		IPAddresses:    []net.IP{net.ParseIP("127.0.0.1")},
+		EmailAddresses: []string{"client@example.com"},
+		URIs: []*url.URL{
+			{Scheme: "https", Host: "example.com", Path: "/client"},
+			{Scheme: "spiffe", Host: "showcase.test", Path: "/ns/default/sa/client"},
+			{Scheme: "spiffe", Host: "other.test", Path: "/client"},
+		},
+	}
+	md := PeerIdentity(cert)
+	if got, want := md.Get(PeerSPIFFEIDHeader), []string{"spiffe://showcase.test/ns/default/sa/client"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SPIFFE ID: want %v, got %v", want, got)
+	}
+	want := []string{
+		"DNS:client.example.com",
+		"IP:127.0.0.1",
+		"email:client@example.com",
+		"URI:https://example.com/client",
+		"URI:spiffe://showcase.test/ns/default/sa/client",
+		"URI:spiffe://other.test/client",
+	}
+	if got := md.Get(PeerSANHeader); !reflect.DeepEqual(got, want) {
+		t.Errorf("SANs: want %v, got %v", want, got)
+	}
+
+	if md := PeerIdentity(&x509.Certificate{}); len(md) != 0 {
+		t.Errorf("a certificate without names: want no headers, got %v", md)
+	}
+}
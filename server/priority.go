@@ -0,0 +1,241 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// PriorityHeader is the header calls send their priority in: an urgency from 0, the most
+	// urgent, to 7, the least, as in the HTTP priority scheme of RFC 9218. Calls without one
+	// have the DefaultPriority.
+	PriorityHeader = "x-showcase-priority"
+
+	// QueueWaitHeader is the response header reporting how many milliseconds a call waited
+	// for a PriorityScheduler to admit it.
+	QueueWaitHeader = "x-showcase-queue-wait-ms"
+
+	// OvertakenHeader is the response header reporting how many calls that arrived after a
+	// call were admitted by a PriorityScheduler before it, for being more urgent.
+	OvertakenHeader = "x-showcase-overtaken"
+
+	// DefaultPriority is the priority of the calls not sending a PriorityHeader.
+	DefaultPriority = 3
+
+	// lowestPriority is the least urgent priority calls may have.
+	lowestPriority = 7
+)
+
+// ParsePriority returns the priority sent in values, the values of a PriorityHeader, or the
+// DefaultPriority if there are none. It returns an INVALID_ARGUMENT error for anything but a
+// single urgency from 0 to 7.
+func ParsePriority(values []string) (int, error) {
+	if len(values) == 0 {
+		return DefaultPriority, nil
+	}
+	priority, err := strconv.Atoi(strings.TrimSpace(values[0]))
+	if len(values) > 1 || err != nil || priority < 0 || priority > lowestPriority {
+		return 0, status.Errorf(codes.InvalidArgument, "%s must be an urgency from 0 to %d, got %q", PriorityHeader, lowestPriority, values)
+	}
+	return priority, nil
+}
+
+// Admission is how a PriorityScheduler admitted a call.
+type Admission struct {
+	// Wait is how long the call was queued.
+	Wait time.Duration
+
+	// Overtaken is how many calls that arrived after the call were admitted before it.
+	Overtaken int
+}
+
+// Header returns the response headers reporting the admission.
+func (a Admission) Header() metadata.MD {
+	return metadata.Pairs(
+		QueueWaitHeader, strconv.FormatInt(a.Wait.Milliseconds(), 10),
+		OvertakenHeader, strconv.Itoa(a.Overtaken))
+}
+
+// PriorityScheduler serves a number of calls to the Showcase API at once, queuing the others
+// and admitting the most urgent of them as calls end, oldest first among equally urgent ones.
+// Under load, urgent calls thus overtake the others, and the calls overtaken report it, so that
+// clients setting per-call priorities can observe their effects, down to starvation. Calls to
+// the ShowcaseAdmin service are never queued.
+type PriorityScheduler struct {
+	slots int
+	nowF  func() time.Time
+
+	mu      sync.Mutex
+	running int
+	seq     int
+	queue   []*queuedCall
+}
+
+// queuedCall is a call waiting to be admitted.
+type queuedCall struct {
+	priority  int
+	seq       int
+	overtaken int
+	admitted  chan struct{}
+}
+
+// NewPriorityScheduler creates a PriorityScheduler serving at most slots calls at once.
+func NewPriorityScheduler(slots int) *PriorityScheduler {
+	return &PriorityScheduler{slots: slots, nowF: time.Now}
+}
+
+// Queued returns how many calls are waiting to be admitted.
+func (p *PriorityScheduler) Queued() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.queue)
+}
+
+// Admit waits until a call with priority may be served, returning the function to call once it
+// is, and how it was admitted. It returns an error if ctx is done before the call is admitted.
+func (p *PriorityScheduler) Admit(ctx context.Context, priority int) (func(), Admission, error) {
+	start := p.nowF()
+	p.mu.Lock()
+	if p.running < p.slots && len(p.queue) == 0 {
+		p.running++
+		p.mu.Unlock()
+		return p.releaseFunc(), Admission{}, nil
+	}
+	call := &queuedCall{priority: priority, seq: p.seq, admitted: make(chan struct{})}
+	p.seq++
+	p.queue = append(p.queue, call)
+	p.mu.Unlock()
+
+	select {
+	case <-call.admitted:
+	case <-ctx.Done():
+		p.mu.Lock()
+		select {
+		case <-call.admitted:
+			// Admitted as ctx was done: the slot is handed on.
+			p.mu.Unlock()
+			p.releaseFunc()()
+		default:
+			for idx, queued := range p.queue {
+				if queued == call {
+					p.queue = append(p.queue[:idx], p.queue[idx+1:]...)
+					break
+				}
+			}
+			p.mu.Unlock()
+		}
+		return nil, Admission{}, status.FromContextError(ctx.Err()).Err()
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.releaseFunc(), Admission{Wait: p.nowF().Sub(start), Overtaken: call.overtaken}, nil
+}
+
+// releaseFunc returns the function freeing the slot of an admitted call, if not yet freed.
+func (p *PriorityScheduler) releaseFunc() func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			p.running--
+			p.dispatch()
+		})
+	}
+}
+
+// dispatch admits queued calls, most urgent first, while slots are free. The calls still queued
+// that arrived before an admitted one are overtaken by it. It must be called with p.mu held.
+func (p *PriorityScheduler) dispatch() {
+	for p.running < p.slots && len(p.queue) > 0 {
+		next := 0
+		for idx, queued := range p.queue {
+			if queued.priority < p.queue[next].priority {
+				next = idx
+			}
+		}
+		admitted := p.queue[next]
+		p.queue = append(p.queue[:next], p.queue[next+1:]...)
+		for _, queued := range p.queue {
+			if queued.seq < admitted.seq {
+				queued.overtaken++
+			}
+		}
+		p.running++
+		close(admitted.admitted)
+	}
+}
+
+// admit admits a gRPC call to method.
+func (p *PriorityScheduler) admit(ctx context.Context, method string) (func(), metadata.MD, error) {
+	if !strings.HasPrefix(method, faultedPrefix) || strings.HasPrefix(method, adminPrefix) {
+		return func() {}, nil, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	priority, err := ParsePriority(md.Get(PriorityHeader))
+	if err != nil {
+		return nil, nil, err
+	}
+	release, admission, err := p.Admit(ctx, priority)
+	if err != nil {
+		return nil, nil, err
+	}
+	return release, admission.Header(), nil
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, scheduling calls by priority.
+func (p *PriorityScheduler) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	release, header, err := p.admit(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	if header != nil {
+		grpc.SetHeader(ctx, header)
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, scheduling calls by priority.
+// Streams hold their slot until they end.
+func (p *PriorityScheduler) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	release, header, err := p.admit(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+	if header != nil {
+		ss.SetHeader(header)
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,181 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestParsePriority(t *testing.T) {
+	for _, test := range []struct {
+		values []string
+		want   int
+	}{
+		{nil, DefaultPriority},
+		{[]string{"0"}, 0},
+		{[]string{" 7 "}, 7},
+	} {
+		if got, err := ParsePriority(test.values); err != nil || got != test.want {
+			t.Errorf("ParsePriority(%q): got %d, %v, want %d", test.values, got, err, test.want)
+		}
+	}
+	for _, values := range [][]string{{"8"}, {"-1"}, {"urgent"}, {"1", "2"}} {
+		if _, err := ParsePriority(values); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ParsePriority(%q): got %v, want InvalidArgument", values, err)
+		}
+	}
+}
+
+// waitQueued waits until n calls are queued by p.
+func waitQueued(t *testing.T, p *PriorityScheduler, n int) {
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if p.Queued() == n {
+			return
+		}
+	}
+	t.Fatalf("want %d calls queued", n)
+}
+
+func TestPriorityScheduler_Admit(t *testing.T) {
+	p := NewPriorityScheduler(1)
+	release, admission, err := p.Admit(context.Background(), DefaultPriority)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if admission != (Admission{}) {
+		t.Errorf("Admit of an idle scheduler: got %+v, want no wait", admission)
+	}
+
+	type admitted struct {
+		priority  int
+		admission Admission
+	}
+	order := make(chan admitted)
+	priorities := []int{5, 1, 3}
+	for idx, priority := range priorities {
+		go func(priority int) {
+			release, admission, err := p.Admit(context.Background(), priority)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			order <- admitted{priority, admission}
+			release()
+		}(priority)
+		waitQueued(t, p, idx+1)
+	}
+
+	// A call given up on while queued leaves the queue.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := p.Admit(ctx, 0); status.Code(err) != codes.Canceled {
+		t.Errorf("Admit with a done context: got %v, want Canceled", err)
+	}
+	waitQueued(t, p, len(priorities))
+
+	release()
+	release()
+	for _, want := range []struct{ priority, overtaken int }{{1, 0}, {3, 0}, {5, 2}} {
+		got := <-order
+		if got.priority != want.priority || got.admission.Overtaken != want.overtaken {
+			t.Errorf("got priority %d overtaken %d times, want priority %d overtaken %d times",
+				got.priority, got.admission.Overtaken, want.priority, want.overtaken)
+		}
+	}
+}
+
+// priorityEcho echoes the priority of its calls once released.
+type priorityEcho struct {
+	*pb.UnimplementedEchoServer
+	started chan struct{}
+	release chan struct{}
+}
+
+func (e priorityEcho) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+	e.started <- struct{}{}
+	<-e.release
+	return &pb.EchoResponse{Content: in.GetContent()}, nil
+}
+
+func TestPriorityScheduler_UnaryInterceptor(t *testing.T) {
+	p := NewPriorityScheduler(1)
+	echo := priorityEcho{&pb.UnimplementedEchoServer{}, make(chan struct{}), make(chan struct{})}
+	s := grpc.NewServer(grpc.UnaryInterceptor(p.UnaryInterceptor))
+	pb.RegisterEchoServer(s, echo)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewEchoClient(conn)
+
+	if _, err := client.Echo(metadata.AppendToOutgoingContext(context.Background(), PriorityHeader, "9"), &pb.EchoRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Echo with an invalid priority: got %v, want InvalidArgument", err)
+	}
+
+	type served struct {
+		content string
+		header  metadata.MD
+	}
+	done := make(chan served)
+	call := func(content, priority string) {
+		var header metadata.MD
+		ctx := metadata.AppendToOutgoingContext(context.Background(), PriorityHeader, priority)
+		response, err := client.Echo(ctx, &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: content}}, grpc.Header(&header))
+		if err != nil {
+			t.Error(err)
+		}
+		done <- served{response.GetContent(), header}
+	}
+	go call("first", "3")
+	<-echo.started
+	go call("background", "6")
+	waitQueued(t, p, 1)
+	go call("urgent", "0")
+	waitQueued(t, p, 2)
+
+	go func() {
+		for range echo.started {
+		}
+	}()
+	close(echo.release)
+	// Responses may arrive in any order once served.
+	want := map[string]string{"first": "0", "urgent": "0", "background": "1"}
+	for range want {
+		got := <-done
+		if overtaken := got.header.Get(OvertakenHeader); len(overtaken) != 1 || overtaken[0] != want[got.content] {
+			t.Errorf("%s: got %s %q, want %s", got.content, OvertakenHeader, overtaken, want[got.content])
+		}
+		if len(got.header.Get(QueueWaitHeader)) != 1 {
+			t.Errorf("%s: want a %s header", got.content, QueueWaitHeader)
+		}
+	}
+	close(echo.started)
+}
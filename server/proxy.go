@@ -0,0 +1,153 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ProxyBehavior is something intermediaries between clients and servers commonly do to calls.
+type ProxyBehavior string
+
+const (
+	// ProxyForwardedHeaders adds Via and X-Forwarded-* headers to requests, and Via to
+	// responses.
+	ProxyForwardedHeaders ProxyBehavior = "forwarded-headers"
+
+	// ProxyStripTrailers drops the custom trailers of responses. The status of gRPC calls is
+	// kept.
+	ProxyStripTrailers ProxyBehavior = "strip-trailers"
+
+	// ProxyLowercaseHeaders lowercases the names of REST response headers, as proxies
+	// translating from HTTP/2 do. gRPC header names are always lowercase.
+	ProxyLowercaseHeaders ProxyBehavior = "lowercase-headers"
+)
+
+// ProxyVia is the Via header value of the mimicked proxy.
+const ProxyVia = "1.1 showcase-proxy"
+
+var proxyBehaviors = []ProxyBehavior{ProxyForwardedHeaders, ProxyStripTrailers, ProxyLowercaseHeaders}
+
+// ProxyMimic makes the server behave as if calls went through an intermediary, so that clients
+// can be hardened against intermediaries' interference.
+type ProxyMimic struct {
+	behaviors map[ProxyBehavior]bool
+}
+
+// NewProxyMimic creates a ProxyMimic with the given behaviors.
+func NewProxyMimic(behaviors []string) (*ProxyMimic, error) {
+	p := &ProxyMimic{behaviors: map[ProxyBehavior]bool{}}
+	for _, b := range behaviors {
+		known := false
+		for _, k := range proxyBehaviors {
+			known = known || ProxyBehavior(b) == k
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown proxy behavior %q: must be one of %v", b, proxyBehaviors)
+		}
+		p.behaviors[ProxyBehavior(b)] = true
+	}
+	return p, nil
+}
+
+// Has reports whether the proxy behaves as b. A nil ProxyMimic has no behaviors.
+func (p *ProxyMimic) Has(b ProxyBehavior) bool {
+	return p != nil && p.behaviors[b]
+}
+
+// forward returns ctx with the metadata a proxy forwarding the call would have added.
+func (p *ProxyMimic) forward(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Append("via", ProxyVia)
+	proto := "http"
+	if pr, ok := peer.FromContext(ctx); ok {
+		if pr.AuthInfo != nil {
+			proto = "https"
+		}
+		if host, _, err := net.SplitHostPort(pr.Addr.String()); err == nil {
+			md.Append("x-forwarded-for", host)
+		}
+	}
+	md.Set("x-forwarded-proto", proto)
+	if authority := md.Get(":authority"); len(authority) > 0 {
+		md.Set("x-forwarded-host", authority[0])
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, mimicking the proxy's behaviors.
+func (p *ProxyMimic) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if p.Has(ProxyForwardedHeaders) {
+		ctx = p.forward(ctx)
+		grpc.SetHeader(ctx, metadata.Pairs("via", ProxyVia))
+	}
+	if p.Has(ProxyStripTrailers) {
+		if sts := grpc.ServerTransportStreamFromContext(ctx); sts != nil {
+			ctx = grpc.NewContextWithServerTransportStream(ctx, trailerStrippingTransportStream{sts})
+		}
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, mimicking the proxy's behaviors.
+func (p *ProxyMimic) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	ctx := ss.Context()
+	if p.Has(ProxyForwardedHeaders) {
+		ctx = p.forward(ctx)
+		ss.SetHeader(metadata.Pairs("via", ProxyVia))
+	}
+	return handler(srv, &proxiedServerStream{ServerStream: ss, ctx: ctx, stripTrailers: p.Has(ProxyStripTrailers)})
+}
+
+// trailerStrippingTransportStream drops the trailers unary handlers set.
+type trailerStrippingTransportStream struct {
+	grpc.ServerTransportStream
+}
+
+func (s trailerStrippingTransportStream) SetTrailer(metadata.MD) error {
+	return nil
+}
+
+// proxiedServerStream is a stream as seen by handlers behind the proxy.
+type proxiedServerStream struct {
+	grpc.ServerStream
+	ctx           context.Context
+	stripTrailers bool
+}
+
+func (s *proxiedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *proxiedServerStream) SetTrailer(md metadata.MD) {
+	if !s.stripTrailers {
+		s.ServerStream.SetTrailer(md)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// forwardedEcho echoes the forwarded metadata it sees, and sets a trailer.
+type forwardedEcho struct {
+	*pb.UnimplementedEchoServer
+}
+
+func forwardedMetadata(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	seen := []string{}
+	for _, key := range []string{"via", "x-forwarded-for", "x-forwarded-proto", "x-forwarded-host"} {
+		seen = append(seen, strings.Join(md.Get(key), ","))
+	}
+	return strings.Join(seen, ";")
+}
+
+func (forwardedEcho) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+	grpc.SetTrailer(ctx, metadata.Pairs("showcase-trailer", "unary"))
+	return &pb.EchoResponse{Content: forwardedMetadata(ctx)}, nil
+}
+
+func (forwardedEcho) Chat(stream pb.Echo_ChatServer) error {
+	stream.SetTrailer(metadata.Pairs("showcase-trailer", "stream"))
+	return stream.Send(&pb.EchoResponse{Content: forwardedMetadata(stream.Context())})
+}
+
+func TestNewProxyMimic_unknown(t *testing.T) {
+	if _, err := NewProxyMimic([]string{"forwarded-headers", "teleport"}); err == nil {
+		t.Error("NewProxyMimic with an unknown behavior: want an error")
+	}
+}
+
+func TestProxyMimic(t *testing.T) {
+	for _, behaviors := range [][]string{nil, {"forwarded-headers", "strip-trailers"}} {
+		proxy, err := NewProxyMimic(behaviors)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := grpc.NewServer(grpc.UnaryInterceptor(proxy.UnaryInterceptor), grpc.StreamInterceptor(proxy.StreamInterceptor))
+		pb.RegisterEchoServer(s, forwardedEcho{&pb.UnimplementedEchoServer{}})
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		go s.Serve(lis)
+
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+		if err != nil {
+			t.Fatal(err)
+		}
+		client := pb.NewEchoClient(conn)
+
+		wantContent := ";;;"
+		wantVia, wantTrailer := []string(nil), []string{"unary"}
+		if proxy.Has(ProxyForwardedHeaders) {
+			wantContent = ProxyVia + ";127.0.0.1;http;" + lis.Addr().String()
+			wantVia = []string{ProxyVia}
+		}
+		if proxy.Has(ProxyStripTrailers) {
+			wantTrailer = nil
+		}
+
+		var header, trailer metadata.MD
+		resp, err := client.Echo(context.Background(), &pb.EchoRequest{}, grpc.Header(&header), grpc.Trailer(&trailer))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetContent() != wantContent {
+			t.Errorf("%v: Echo saw forwarded metadata %q, want %q", behaviors, resp.GetContent(), wantContent)
+		}
+		if got := header.Get("via"); strings.Join(got, ",") != strings.Join(wantVia, ",") {
+			t.Errorf("%v: Echo header via: got %q, want %q", behaviors, got, wantVia)
+		}
+		if got := trailer.Get("showcase-trailer"); strings.Join(got, ",") != strings.Join(wantTrailer, ",") {
+			t.Errorf("%v: Echo trailer: got %q, want %q", behaviors, got, wantTrailer)
+		}
+
+		stream, err := client.Chat(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		stream.CloseSend()
+		resp, err = stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetContent() != wantContent {
+			t.Errorf("%v: Chat saw forwarded metadata %q, want %q", behaviors, resp.GetContent(), wantContent)
+		}
+		if _, err := stream.Recv(); err != io.EOF {
+			t.Fatalf("%v: Chat: want EOF, got %v", behaviors, err)
+		}
+		if got, want := len(stream.Trailer().Get("showcase-trailer")), len(wantTrailer); got != want {
+			t.Errorf("%v: Chat trailers: got %v", behaviors, stream.Trailer())
+		}
+
+		conn.Close()
+		s.Stop()
+	}
+}
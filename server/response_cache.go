@@ -0,0 +1,166 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// ResponseCacheHeader is the header that tells whether a call to an idempotent method was
+	// answered from the response cache.
+	ResponseCacheHeader = "x-showcase-cache"
+
+	// ResponseCacheHit is the ResponseCacheHeader value of the calls answered from the cache.
+	ResponseCacheHit = "HIT"
+
+	// ResponseCacheMiss is the ResponseCacheHeader value of the calls that were served, their
+	// response being cached if successful.
+	ResponseCacheMiss = "MISS"
+)
+
+// ResponseCache caches the successful responses of idempotent methods for a TTL, answering the
+// identical calls made meanwhile without serving them again, so that client-side deduplication
+// and caching can be verified against a server that behaves differently on repeats. Methods are
+// idempotent if they are bound to an HTTP GET, or declare an idempotency level.
+type ResponseCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	entries    map[string]*cachedResponse
+	idempotent map[string]bool
+	nowF       func() time.Time
+}
+
+// cachedResponse is a response cached until it expires.
+type cachedResponse struct {
+	response interface{}
+	expires  time.Time
+}
+
+// NewResponseCache creates a ResponseCache keeping responses for ttl, for the idempotent
+// methods of every service registered in the given proto packages.
+func NewResponseCache(ttl time.Duration, packages ...string) *ResponseCache {
+	c := &ResponseCache{
+		ttl:        ttl,
+		entries:    map[string]*cachedResponse{},
+		idempotent: map[string]bool{},
+		nowF:       time.Now,
+	}
+	for _, pkg := range packages {
+		protoregistry.GlobalFiles.RangeFilesByPackage(protoreflect.FullName(pkg), func(fd protoreflect.FileDescriptor) bool {
+			for i := 0; i < fd.Services().Len(); i++ {
+				service := fd.Services().Get(i)
+				for j := 0; j < service.Methods().Len(); j++ {
+					method := service.Methods().Get(j)
+					if isIdempotent(method) {
+						c.idempotent[fmt.Sprintf("/%s/%s", service.FullName(), method.Name())] = true
+					}
+				}
+			}
+			return true
+		})
+	}
+	return c
+}
+
+// isIdempotent returns whether method is bound to an HTTP GET or declares an idempotency level.
+func isIdempotent(method protoreflect.MethodDescriptor) bool {
+	if method.IsStreamingClient() || method.IsStreamingServer() {
+		return false
+	}
+	options, ok := method.Options().(*descriptorpb.MethodOptions)
+	if !ok || options == nil {
+		return false
+	}
+	if options.GetIdempotencyLevel() != descriptorpb.MethodOptions_IDEMPOTENCY_UNKNOWN {
+		return true
+	}
+	if !proto.HasExtension(options, annotations.E_Http) {
+		return false
+	}
+	_, get := proto.GetExtension(options, annotations.E_Http).(*annotations.HttpRule).GetPattern().(*annotations.HttpRule_Get)
+	return get
+}
+
+// Idempotent returns whether the responses of the gRPC method, such as
+// "/google.showcase.v1beta1.Identity/GetUser", are cached.
+func (c *ResponseCache) Idempotent(method string) bool {
+	return c.idempotent[method]
+}
+
+// Get returns the response cached for key, if it has not expired.
+func (c *ResponseCache) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !c.nowF().Before(entry.expires) {
+		return nil, false
+	}
+	return entry.response, true
+}
+
+// Put caches response for key for the TTL, dropping the responses that have expired.
+func (c *ResponseCache) Put(key string, response interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.nowF()
+	for k, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = &cachedResponse{response: response, expires: now.Add(c.ttl)}
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, answering the calls to idempotent
+// methods from the cache when an identical call was made within the TTL.
+func (c *ResponseCache) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	msg, ok := req.(proto.Message)
+	if !ok || !c.Idempotent(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return handler(ctx, req)
+	}
+	key := "grpc " + info.FullMethod + " " + string(data)
+	if cached, ok := c.Get(key); ok {
+		grpc.SetHeader(ctx, metadata.Pairs(ResponseCacheHeader, ResponseCacheHit))
+		return proto.Clone(cached.(proto.Message)), nil
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(ResponseCacheHeader, ResponseCacheMiss))
+	resp, err := handler(ctx, req)
+	if err == nil {
+		if response, ok := resp.(proto.Message); ok {
+			c.Put(key, proto.Clone(response))
+		}
+	}
+	return resp, err
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// countingIdentity answers GetUser and CreateUser, counting the calls it serves.
+type countingIdentity struct {
+	*pb.UnimplementedIdentityServer
+	calls int
+}
+
+func (s *countingIdentity) GetUser(ctx context.Context, in *pb.GetUserRequest) (*pb.User, error) {
+	s.calls++
+	if in.GetName() == "users/missing" {
+		return nil, status.Error(codes.NotFound, "missing")
+	}
+	return &pb.User{Name: in.GetName(), DisplayName: fmt.Sprintf("call %d", s.calls)}, nil
+}
+
+func (s *countingIdentity) CreateUser(ctx context.Context, in *pb.CreateUserRequest) (*pb.User, error) {
+	s.calls++
+	return &pb.User{Name: "users/new", DisplayName: fmt.Sprintf("call %d", s.calls)}, nil
+}
+
+func TestNewResponseCache_idempotent(t *testing.T) {
+	cache := NewResponseCache(time.Minute, ShowcasePackage)
+	for method, want := range map[string]bool{
+		"/google.showcase.v1beta1.Identity/GetUser":    true,
+		"/google.showcase.v1beta1.Identity/ListUsers":  true,
+		"/google.showcase.v1beta1.Identity/CreateUser": false,
+		"/google.showcase.v1beta1.Echo/Echo":           false,
+		"/google.showcase.v1beta1.Echo/Expand":         false,
+	} {
+		if got := cache.Idempotent(method); got != want {
+			t.Errorf("Idempotent(%q): got %v, want %v", method, got, want)
+		}
+	}
+}
+
+func TestResponseCache_UnaryInterceptor(t *testing.T) {
+	now := time.Unix(1000, 0)
+	cache := NewResponseCache(time.Minute, ShowcasePackage)
+	cache.nowF = func() time.Time { return now }
+	identity := &countingIdentity{UnimplementedIdentityServer: &pb.UnimplementedIdentityServer{}}
+	s := grpc.NewServer(grpc.UnaryInterceptor(cache.UnaryInterceptor))
+	pb.RegisterIdentityServer(s, identity)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewIdentityClient(conn)
+
+	for _, test := range []struct {
+		desc      string
+		advance   time.Duration
+		call      func(opts ...grpc.CallOption) (*pb.User, error)
+		wantCache string
+		wantUser  string
+	}{
+		{"first get", 0, getUser(client, "users/a"), ResponseCacheMiss, "call 1"},
+		{"repeated get", 30 * time.Second, getUser(client, "users/a"), ResponseCacheHit, "call 1"},
+		{"other get", 0, getUser(client, "users/b"), ResponseCacheMiss, "call 2"},
+		{"expired get", 30 * time.Second, getUser(client, "users/a"), ResponseCacheMiss, "call 3"},
+		{"failed get", 0, getUser(client, "users/missing"), ResponseCacheMiss, ""},
+		{"repeated failed get", 0, getUser(client, "users/missing"), ResponseCacheMiss, ""},
+		{"create", 0, createUser(client), "", "call 6"},
+		{"repeated create", 0, createUser(client), "", "call 7"},
+	} {
+		now = now.Add(test.advance)
+		var header metadata.MD
+		user, _ := test.call(grpc.Header(&header))
+		if got := strings.Join(header.Get(ResponseCacheHeader), ","); got != test.wantCache {
+			t.Errorf("%s: %s: got %q, want %q", test.desc, ResponseCacheHeader, got, test.wantCache)
+		}
+		if got := user.GetDisplayName(); got != test.wantUser {
+			t.Errorf("%s: got the user from %q, want %q", test.desc, got, test.wantUser)
+		}
+	}
+}
+
+func getUser(client pb.IdentityClient, name string) func(opts ...grpc.CallOption) (*pb.User, error) {
+	return func(opts ...grpc.CallOption) (*pb.User, error) {
+		return client.GetUser(context.Background(), &pb.GetUserRequest{Name: name}, opts...)
+	}
+}
+
+func createUser(client pb.IdentityClient) func(opts ...grpc.CallOption) (*pb.User, error) {
+	return func(opts ...grpc.CallOption) (*pb.User, error) {
+		return client.CreateUser(context.Background(), &pb.CreateUserRequest{User: &pb.User{DisplayName: "new"}}, opts...)
+	}
+}
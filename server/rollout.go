@@ -0,0 +1,84 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sync"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SchemaRollout simulates the blue/green rollout of a schema change, switching the version of
+// the schema the server serves every so many calls, so that clients can test that they tolerate
+// fields appearing and disappearing between responses.
+type SchemaRollout struct {
+	mu          sync.Mutex
+	version     pb.SchemaRollout_Version
+	switchEvery int32
+	served      int32
+}
+
+// NewSchemaRollout creates a SchemaRollout serving the GREEN version of the schema, never
+// switching.
+func NewSchemaRollout() *SchemaRollout {
+	return &SchemaRollout{version: pb.SchemaRollout_GREEN}
+}
+
+// Set makes the server serve the version of the schema in rollout, switching versions every
+// rollout.SwitchEvery calls if positive. It returns the new state of the rollout.
+func (r *SchemaRollout) Set(rollout *pb.SchemaRollout) (*pb.SchemaRollout, error) {
+	if rollout.GetSwitchEvery() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "switch_every must not be negative, got %d", rollout.GetSwitchEvery())
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.version = rollout.GetVersion()
+	if r.version == pb.SchemaRollout_VERSION_UNSPECIFIED {
+		r.version = pb.SchemaRollout_GREEN
+	}
+	r.switchEvery = rollout.GetSwitchEvery()
+	r.served = 0
+	return r.state(), nil
+}
+
+// Get returns the state of the rollout.
+func (r *SchemaRollout) Get() *pb.SchemaRollout {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.state()
+}
+
+// Serve returns the version of the schema a call is to be served with, switching to the other
+// version once the current one has served its share of calls.
+func (r *SchemaRollout) Serve() pb.SchemaRollout_Version {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.switchEvery > 0 && r.served >= r.switchEvery {
+		if r.version == pb.SchemaRollout_BLUE {
+			r.version = pb.SchemaRollout_GREEN
+		} else {
+			r.version = pb.SchemaRollout_BLUE
+		}
+		r.served = 0
+	}
+	r.served++
+	return r.version
+}
+
+func (r *SchemaRollout) state() *pb.SchemaRollout {
+	return &pb.SchemaRollout{Version: r.version, SwitchEvery: r.switchEvery, CallsServed: r.served}
+}
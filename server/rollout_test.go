@@ -0,0 +1,60 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSchemaRollout(t *testing.T) {
+	rollout := NewSchemaRollout()
+	for i := 0; i < 3; i++ {
+		if got := rollout.Serve(); got != pb.SchemaRollout_GREEN {
+			t.Fatalf("call %d: want GREEN before any rollout, got %v", i, got)
+		}
+	}
+
+	state, err := rollout.Set(&pb.SchemaRollout{Version: pb.SchemaRollout_BLUE, SwitchEvery: 2})
+	if err != nil {
+		t.Fatalf("Set: unexpected err %v", err)
+	}
+	if state.GetVersion() != pb.SchemaRollout_BLUE || state.GetSwitchEvery() != 2 || state.GetCallsServed() != 0 {
+		t.Errorf("Set: unexpected state %v", state)
+	}
+	want := []pb.SchemaRollout_Version{
+		pb.SchemaRollout_BLUE, pb.SchemaRollout_BLUE,
+		pb.SchemaRollout_GREEN, pb.SchemaRollout_GREEN,
+		pb.SchemaRollout_BLUE,
+	}
+	for i, version := range want {
+		if got := rollout.Serve(); got != version {
+			t.Errorf("call %d: want %v, got %v", i, version, got)
+		}
+	}
+	if got := rollout.Get(); got.GetVersion() != pb.SchemaRollout_BLUE || got.GetCallsServed() != 1 {
+		t.Errorf("Get: unexpected state %v", got)
+	}
+
+	if state, _ := rollout.Set(&pb.SchemaRollout{}); state.GetVersion() != pb.SchemaRollout_GREEN {
+		t.Errorf("Set: an unspecified version should mean GREEN, got %v", state)
+	}
+	if _, err := rollout.Set(&pb.SchemaRollout{SwitchEvery: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Set: want InvalidArgument for a negative switch_every, got %v", err)
+	}
+}
@@ -0,0 +1,401 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// RoutingHeaderKey is the metadata key clients use to send request routing parameters.
+const RoutingHeaderKey = "x-goog-request-params"
+
+// ShowcasePackage is the proto package containing the showcase services.
+const ShowcasePackage = "google.showcase.v1beta1"
+
+// RoutingHeaderVerifier checks that the x-goog-request-params header of every incoming call
+// matches what the method's annotations prescribe. Methods annotated with google.api.routing
+// use those explicit rules; all other methods expect the path variables of their primary
+// google.api.http binding.
+type RoutingHeaderVerifier struct {
+	// methods maps full gRPC method names ("/package.Service/Method") to their rules.
+	methods map[string][]*routingParameter
+}
+
+// routingParameter is a single source of a routing header key-value pair.
+type routingParameter struct {
+	// key is the name of the routing header parameter.
+	key string
+
+	// field is the dotted path of the request field the value is extracted from.
+	field string
+
+	// pattern matches the field value and captures the header value in its first group. A
+	// nil pattern means the whole field value is used.
+	pattern *regexp.Regexp
+}
+
+// NewRoutingHeaderVerifier creates a RoutingHeaderVerifier with rules derived from the
+// annotations of every service registered in the given proto packages.
+func NewRoutingHeaderVerifier(packages ...string) (*RoutingHeaderVerifier, error) {
+	v := &RoutingHeaderVerifier{methods: map[string][]*routingParameter{}}
+	var err error
+	for _, pkg := range packages {
+		protoregistry.GlobalFiles.RangeFilesByPackage(protoreflect.FullName(pkg), func(fd protoreflect.FileDescriptor) bool {
+			services := fd.Services()
+			for i := 0; i < services.Len() && err == nil; i++ {
+				err = v.addService(services.Get(i))
+			}
+			return err == nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+func (v *RoutingHeaderVerifier) addService(service protoreflect.ServiceDescriptor) error {
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		params, err := routingParameters(method)
+		if err != nil {
+			return fmt.Errorf("method %s: %v", method.FullName(), err)
+		}
+		v.methods[fmt.Sprintf("/%s/%s", service.FullName(), method.Name())] = params
+	}
+	return nil
+}
+
+// routingParameters returns the routing header rules for method.
+func routingParameters(method protoreflect.MethodDescriptor) ([]*routingParameter, error) {
+	options := method.Options()
+	if proto.HasExtension(options, annotations.E_Routing) {
+		routing := proto.GetExtension(options, annotations.E_Routing).(*annotations.RoutingRule)
+		params := []*routingParameter{}
+		for _, rp := range routing.GetRoutingParameters() {
+			param, err := newExplicitRoutingParameter(rp)
+			if err != nil {
+				return nil, err
+			}
+			params = append(params, param)
+		}
+		return params, nil
+	}
+
+	if !proto.HasExtension(options, annotations.E_Http) {
+		return nil, nil
+	}
+	rule := proto.GetExtension(options, annotations.E_Http).(*annotations.HttpRule)
+	params := []*routingParameter{}
+	for _, field := range pathVariables(httpRulePath(rule)) {
+		params = append(params, &routingParameter{key: field, field: field})
+	}
+	return params, nil
+}
+
+// newExplicitRoutingParameter converts a google.api.RoutingParameter into a routingParameter.
+func newExplicitRoutingParameter(rp *annotations.RoutingParameter) (*routingParameter, error) {
+	if rp.GetPathTemplate() == "" {
+		return &routingParameter{key: rp.GetField(), field: rp.GetField()}, nil
+	}
+	key, pattern, err := compileRoutingTemplate(rp.GetPathTemplate())
+	if err != nil {
+		return nil, err
+	}
+	return &routingParameter{key: key, field: rp.GetField(), pattern: pattern}, nil
+}
+
+// httpRulePath returns the URL path template of an HttpRule.
+func httpRulePath(rule *annotations.HttpRule) string {
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return pattern.Get
+	case *annotations.HttpRule_Put:
+		return pattern.Put
+	case *annotations.HttpRule_Post:
+		return pattern.Post
+	case *annotations.HttpRule_Delete:
+		return pattern.Delete
+	case *annotations.HttpRule_Patch:
+		return pattern.Patch
+	case *annotations.HttpRule_Custom:
+		return pattern.Custom.GetPath()
+	}
+	return ""
+}
+
+// pathVariables returns the field paths of the variables in a path template, in order.
+func pathVariables(template string) []string {
+	fields := []string{}
+	for {
+		start := strings.Index(template, "{")
+		if start < 0 {
+			return fields
+		}
+		end := strings.Index(template[start:], "}")
+		if end < 0 {
+			return fields
+		}
+		variable := template[start+1 : start+end]
+		if eq := strings.Index(variable, "="); eq >= 0 {
+			variable = variable[:eq]
+		}
+		fields = append(fields, variable)
+		template = template[start+end+1:]
+	}
+}
+
+// compileRoutingTemplate parses a google.api.RoutingParameter path template, which must contain
+// exactly one named variable, into the name of that variable and a regular expression matching
+// a field value, with the variable's value captured in the first group.
+func compileRoutingTemplate(template string) (string, *regexp.Regexp, error) {
+	start := strings.Index(template, "{")
+	end := strings.Index(template, "}")
+	if start < 0 || end < start || strings.Count(template, "{") != 1 || strings.Count(template, "}") != 1 {
+		return "", nil, fmt.Errorf("path template %q must contain exactly one variable", template)
+	}
+	key, segments := template[start+1:end], "*"
+	if eq := strings.Index(key, "="); eq >= 0 {
+		key, segments = key[:eq], key[eq+1:]
+	}
+	if key == "" {
+		return "", nil, fmt.Errorf("path template %q has an unnamed variable", template)
+	}
+
+	expr := "^" + templateExpression(template[:start]) +
+		"(" + templateExpression(segments) + ")" +
+		templateExpression(template[end+1:]) + "$"
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return "", nil, fmt.Errorf("path template %q: %v", template, err)
+	}
+	return key, re, nil
+}
+
+// templateExpression converts literals and wildcards of a path template fragment into a
+// regular expression. A "*" matches a single path segment, while a "**" matches any number of
+// segments, including none when it trails a "/".
+func templateExpression(fragment string) string {
+	var expr strings.Builder
+	for len(fragment) > 0 {
+		switch {
+		case strings.HasPrefix(fragment, "/**"):
+			expr.WriteString("(?:/.*)?")
+			fragment = fragment[3:]
+		case strings.HasPrefix(fragment, "**"):
+			expr.WriteString(".*")
+			fragment = fragment[2:]
+		case strings.HasPrefix(fragment, "*"):
+			expr.WriteString("[^/]+")
+			fragment = fragment[1:]
+		default:
+			next := strings.IndexAny(fragment[1:], "/*") + 1
+			if next == 0 {
+				next = len(fragment)
+			}
+			expr.WriteString(regexp.QuoteMeta(fragment[:next]))
+			fragment = fragment[next:]
+		}
+	}
+	return expr.String()
+}
+
+// Expected returns the routing header key-value pairs that must accompany req when sent to
+// method. The boolean result is false if the verifier has no rules for method.
+func (v *RoutingHeaderVerifier) Expected(method string, req proto.Message) (map[string]string, bool) {
+	params, ok := v.methods[method]
+	if !ok {
+		return nil, false
+	}
+	expected := map[string]string{}
+	for _, param := range params {
+		value, ok := fieldValue(req.ProtoReflect(), param.field)
+		if !ok || value == "" {
+			continue
+		}
+		if param.pattern != nil {
+			match := param.pattern.FindStringSubmatch(value)
+			if match == nil || match[1] == "" {
+				continue
+			}
+			value = match[1]
+		}
+		// When several parameters produce the same key, the last match wins.
+		expected[param.key] = value
+	}
+	return expected, true
+}
+
+// fieldValue returns the string form of the field at the dotted path within msg.
+func fieldValue(msg protoreflect.Message, path string) (string, bool) {
+	parts := strings.Split(path, ".")
+	for i, name := range parts {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.IsList() || fd.IsMap() {
+			return "", false
+		}
+		if i < len(parts)-1 {
+			if fd.Message() == nil || !msg.Has(fd) {
+				return "", false
+			}
+			msg = msg.Get(fd).Message()
+			continue
+		}
+		if fd.Message() != nil {
+			return "", false
+		}
+		value := msg.Get(fd)
+		if fd.Enum() != nil {
+			return fmt.Sprint(int32(value.Enum())), true
+		}
+		return fmt.Sprint(value.Interface()), true
+	}
+	return "", false
+}
+
+// Verify returns an InvalidArgument error if the routing header in the incoming metadata of ctx
+// does not match the one expected for req sent to method. Methods the verifier has no rules for
+// are not checked.
+func (v *RoutingHeaderVerifier) Verify(ctx context.Context, method string, req interface{}) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	expected, ok := v.Expected(method, msg)
+	if !ok {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	actual, err := ParseRoutingHeader(md.Get(RoutingHeaderKey))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s: malformed %s header: %v", method, RoutingHeaderKey, err)
+	}
+
+	mismatch := false
+	for key, value := range expected {
+		if actual[key] != value {
+			mismatch = true
+		}
+	}
+	for key, value := range actual {
+		// Keys whose value the client could not derive may be sent empty.
+		if _, ok := expected[key]; !ok && value != "" {
+			mismatch = true
+		}
+	}
+	if mismatch {
+		return status.Errorf(codes.InvalidArgument,
+			"%s: %s header mismatch: expected %q, got %q",
+			method, RoutingHeaderKey, FormatRoutingHeader(expected), strings.Join(md.Get(RoutingHeaderKey), "&"))
+	}
+	return nil
+}
+
+// ParseRoutingHeader splits the values of a routing header into unescaped key-value pairs.
+func ParseRoutingHeader(values []string) (map[string]string, error) {
+	params := map[string]string{}
+	for _, value := range values {
+		for _, pair := range strings.Split(value, "&") {
+			if pair == "" {
+				continue
+			}
+			kv := strings.SplitN(pair, "=", 2)
+			key, err := url.QueryUnescape(kv[0])
+			if err != nil {
+				return nil, err
+			}
+			val := ""
+			if len(kv) == 2 {
+				if val, err = url.QueryUnescape(kv[1]); err != nil {
+					return nil, err
+				}
+			}
+			params[key] = val
+		}
+	}
+	return params, nil
+}
+
+// FormatRoutingHeader renders params the way clients encode them, with keys sorted.
+func FormatRoutingHeader(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, url.QueryEscape(params[key])))
+	}
+	return strings.Join(pairs, "&")
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, rejecting calls whose routing header
+// does not match the request.
+func (v *RoutingHeaderVerifier) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := v.Verify(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor. Since the routing header is
+// derived from the request, it is checked against the first message received on the stream.
+func (v *RoutingHeaderVerifier) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	return handler(srv, &routingVerifiedStream{verifier: v, method: info.FullMethod, ServerStream: ss})
+}
+
+type routingVerifiedStream struct {
+	verifier *RoutingHeaderVerifier
+	method   string
+	verified bool
+
+	grpc.ServerStream
+}
+
+func (s *routingVerifiedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.verified {
+		return nil
+	}
+	s.verified = true
+	return s.verifier.Verify(s.Context(), s.method, m)
+}
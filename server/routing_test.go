@@ -0,0 +1,154 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestCompileRoutingTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		key      string
+		value    string
+		want     string
+		matches  bool
+	}{
+		{"{routing_id=**}", "routing_id", "projects/p/instances/i", "projects/p/instances/i", true},
+		{"{routing_id=projects/*}/**", "routing_id", "projects/p/instances/i", "projects/p", true},
+		{"{routing_id=projects/*}/**", "routing_id", "projects/p", "projects/p", true},
+		{"{routing_id=projects/*}/**", "routing_id", "regions/r", "", false},
+		{"projects/*/{instance=instances/*}/**", "instance", "projects/p/instances/i/tables/t", "instances/i", true},
+		{"projects/*/{instance=instances/*}/**", "instance", "projects/p", "", false},
+		{"{project}", "project", "p", "p", true},
+		{"{project}", "project", "p/q", "", false},
+	}
+	for _, tt := range tests {
+		key, re, err := compileRoutingTemplate(tt.template)
+		if err != nil {
+			t.Errorf("compileRoutingTemplate(%q): unexpected error: %v", tt.template, err)
+			continue
+		}
+		if key != tt.key {
+			t.Errorf("compileRoutingTemplate(%q): got key %q, want %q", tt.template, key, tt.key)
+		}
+		match := re.FindStringSubmatch(tt.value)
+		if (match != nil) != tt.matches {
+			t.Errorf("compileRoutingTemplate(%q) on %q: got match %v, want %v", tt.template, tt.value, match != nil, tt.matches)
+			continue
+		}
+		if match != nil && match[1] != tt.want {
+			t.Errorf("compileRoutingTemplate(%q) on %q: got %q, want %q", tt.template, tt.value, match[1], tt.want)
+		}
+	}
+
+	for _, template := range []string{"projects/*", "{a}/{b}", "{=projects/*}"} {
+		if _, _, err := compileRoutingTemplate(template); err == nil {
+			t.Errorf("compileRoutingTemplate(%q): expected error", template)
+		}
+	}
+}
+
+func TestRoutingHeaderVerifier_Expected(t *testing.T) {
+	params := []*routingParameter{}
+	for _, rp := range []*annotations.RoutingParameter{
+		{Field: "name"},
+		{Field: "name", PathTemplate: "{routing_id=users/*}"},
+		{Field: "name", PathTemplate: "{routing_id=users/*/profile}"},
+		{Field: "name", PathTemplate: "{other=nothing/*}"},
+	} {
+		param, err := newExplicitRoutingParameter(rp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		params = append(params, param)
+	}
+	v := &RoutingHeaderVerifier{methods: map[string][]*routingParameter{"/Explicit": params}}
+
+	tests := []struct {
+		name string
+		want map[string]string
+	}{
+		{"users/u", map[string]string{"name": "users/u", "routing_id": "users/u"}},
+		{"users/u/profile", map[string]string{"name": "users/u/profile", "routing_id": "users/u/profile"}},
+		{"rooms/r", map[string]string{"name": "rooms/r"}},
+		{"", map[string]string{}},
+	}
+	for _, tt := range tests {
+		got, ok := v.Expected("/Explicit", &pb.GetUserRequest{Name: tt.name})
+		if !ok {
+			t.Fatalf("Expected: method not found")
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Expected(%q): got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if _, ok := v.Expected("/Unknown", &pb.GetUserRequest{}); ok {
+		t.Errorf("Expected for an unknown method: got ok")
+	}
+}
+
+func TestRoutingHeaderVerifier_UnaryInterceptor(t *testing.T) {
+	v, err := NewRoutingHeaderVerifier(ShowcasePackage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		method string
+		req    interface{}
+		header []string
+		code   codes.Code
+	}{
+		{"/google.showcase.v1beta1.Identity/GetUser", &pb.GetUserRequest{Name: "users/a b"}, []string{"name=users%2Fa+b"}, codes.OK},
+		{"/google.showcase.v1beta1.Identity/GetUser", &pb.GetUserRequest{Name: "users/a"}, []string{"name=users%2Fb"}, codes.InvalidArgument},
+		{"/google.showcase.v1beta1.Identity/GetUser", &pb.GetUserRequest{Name: "users/a"}, nil, codes.InvalidArgument},
+		{"/google.showcase.v1beta1.Identity/GetUser", &pb.GetUserRequest{Name: "users/a"}, []string{"name=users%2Fa&x=y"}, codes.InvalidArgument},
+		{"/google.showcase.v1beta1.Identity/GetUser", &pb.GetUserRequest{}, []string{"name="}, codes.OK},
+		{"/google.showcase.v1beta1.Identity/UpdateUser", &pb.UpdateUserRequest{User: &pb.User{Name: "users/a"}}, []string{"user.name=users%2Fa"}, codes.OK},
+		{"/google.showcase.v1beta1.Identity/CreateUser", &pb.CreateUserRequest{}, nil, codes.OK},
+		{"/google.showcase.v1beta1.Identity/CreateUser", &pb.CreateUserRequest{}, []string{"name=users%2Fa"}, codes.InvalidArgument},
+		{"/unknown.Service/Method", &pb.GetUserRequest{Name: "users/a"}, nil, codes.OK},
+	}
+	for _, tt := range tests {
+		md := metadata.MD{}
+		if tt.header != nil {
+			md.Set(RoutingHeaderKey, tt.header...)
+		}
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		called := false
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		}
+		_, err := v.UnaryInterceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+		if got := status.Code(err); got != tt.code {
+			t.Errorf("%s with %q: got code %v, want %v (%v)", tt.method, tt.header, got, tt.code, err)
+		}
+		if called != (tt.code == codes.OK) {
+			t.Errorf("%s with %q: handler called: %v", tt.method, tt.header, called)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"hash/fnv"
+	"math/rand"
+)
+
+// StreamScheduler decides the order in which an event is delivered to the streams observing
+// it. The order is derived from a seed and the event alone, so that the interleaving of events
+// across streams is the same on every run with the same seed, however the goroutines serving
+// the streams happen to be scheduled.
+type StreamScheduler struct {
+	seed int64
+}
+
+// NewStreamScheduler creates a StreamScheduler ordering events from seed.
+func NewStreamScheduler(seed int64) *StreamScheduler {
+	return &StreamScheduler{seed: seed}
+}
+
+// Seed returns the seed events are ordered from.
+func (s *StreamScheduler) Seed() int64 {
+	return s.seed
+}
+
+// Order returns the order in which the event identified by key is delivered to n streams, as a
+// permutation of the indices of the streams in the order they were opened. A nil
+// StreamScheduler delivers events in the order the streams were opened.
+func (s *StreamScheduler) Order(key string, n int) []int {
+	if s == nil {
+		order := make([]int, n)
+		for i := range order {
+			order[i] = i
+		}
+		return order
+	}
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return rand.New(rand.NewSource(s.seed ^ int64(h.Sum64()))).Perm(n)
+}
@@ -0,0 +1,50 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestStreamScheduler_nil(t *testing.T) {
+	var s *StreamScheduler
+	if got, want := s.Order("event", 4), []int{0, 1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Order: got %v, want %v", got, want)
+	}
+}
+
+func TestStreamScheduler(t *testing.T) {
+	a, b := NewStreamScheduler(42), NewStreamScheduler(42)
+	distinct := map[string]bool{}
+	for i := 0; i < 20; i++ {
+		key := fmt.Sprintf("rooms/1/blurbs/%d", i)
+		order := a.Order(key, 5)
+		if again := b.Order(key, 5); !reflect.DeepEqual(order, again) {
+			t.Errorf("Order(%q): got %v and %v with the same seed", key, order, again)
+		}
+		sorted := append([]int{}, order...)
+		sort.Ints(sorted)
+		if !reflect.DeepEqual(sorted, []int{0, 1, 2, 3, 4}) {
+			t.Errorf("Order(%q): %v is not a permutation", key, order)
+		}
+		distinct[fmt.Sprint(order)] = true
+	}
+	if len(distinct) < 2 {
+		t.Errorf("Order: every event was delivered in the same order %v", distinct)
+	}
+}
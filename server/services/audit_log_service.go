@@ -0,0 +1,255 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewAuditLogServer returns a new AuditLogServer for the Showcase API, streaming the events of
+// auditLog.
+func NewAuditLogServer(auditLog *server.AuditLog) pb.AuditLogServer {
+	return &auditLogServerImpl{auditLog: auditLog}
+}
+
+type auditLogServerImpl struct {
+	auditLog *server.AuditLog
+}
+
+func (s *auditLogServerImpl) TailAuditLog(in *pb.TailAuditLogRequest, stream pb.AuditLog_TailAuditLogServer) error {
+	return s.auditLog.Tail(stream.Context(), in.GetResourcePrefix(), in.GetReplay(), stream.Send)
+}
+
+// snapshot returns a copy of the resource get returns, or nil if it fails, for the state of a
+// resource before a call to be audited.
+func snapshot(get func() (proto.Message, error)) proto.Message {
+	resource, err := get()
+	if err != nil {
+		return nil
+	}
+	return proto.Clone(resource)
+}
+
+// NewAuditedIdentityServer returns an IdentityServer serving calls with identity, recording
+// those creating, updating and deleting users in auditLog.
+func NewAuditedIdentityServer(identity pb.IdentityServer, auditLog *server.AuditLog) pb.IdentityServer {
+	return &auditedIdentityServer{IdentityServer: identity, auditLog: auditLog}
+}
+
+type auditedIdentityServer struct {
+	pb.IdentityServer
+	auditLog *server.AuditLog
+}
+
+func (s *auditedIdentityServer) CreateUser(ctx context.Context, in *pb.CreateUserRequest) (*pb.User, error) {
+	user, err := s.IdentityServer.CreateUser(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.Identity/CreateUser", pb.AuditEvent_CREATE, user.GetName(), nil, user)
+	}
+	return user, err
+}
+
+func (s *auditedIdentityServer) UpdateUser(ctx context.Context, in *pb.UpdateUserRequest) (*pb.User, error) {
+	name := in.GetUser().GetName()
+	before := snapshot(func() (proto.Message, error) {
+		return s.IdentityServer.GetUser(ctx, &pb.GetUserRequest{Name: name})
+	})
+	user, err := s.IdentityServer.UpdateUser(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.Identity/UpdateUser", pb.AuditEvent_UPDATE, name, before, user)
+	}
+	return user, err
+}
+
+func (s *auditedIdentityServer) DeleteUser(ctx context.Context, in *pb.DeleteUserRequest) (*empty.Empty, error) {
+	before := snapshot(func() (proto.Message, error) {
+		return s.IdentityServer.GetUser(ctx, &pb.GetUserRequest{Name: in.GetName()})
+	})
+	resp, err := s.IdentityServer.DeleteUser(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.Identity/DeleteUser", pb.AuditEvent_DELETE, in.GetName(), before, nil)
+	}
+	return resp, err
+}
+
+func (s *auditedIdentityServer) BatchWrite(ctx context.Context, in *pb.BatchWriteRequest) (*pb.BatchWriteResponse, error) {
+	// The state of each user before the batch, advanced as each of its mutations is recorded.
+	states := map[string]proto.Message{}
+	for _, m := range in.GetMutations() {
+		name := m.GetUpdate().GetName()
+		if m.GetDelete() != "" {
+			name = m.GetDelete()
+		}
+		if _, ok := states[name]; name != "" && !ok {
+			states[name] = snapshot(func() (proto.Message, error) {
+				return s.IdentityServer.GetUser(ctx, &pb.GetUserRequest{Name: name})
+			})
+		}
+	}
+	resp, err := s.IdentityServer.BatchWrite(ctx, in)
+	if err != nil {
+		return resp, err
+	}
+	for i, user := range resp.GetUsers() {
+		name := user.GetName()
+		switch m := in.GetMutations()[i]; {
+		case m.GetCreate() != nil:
+			s.auditLog.Record(ctx, "google.showcase.v1beta1.Identity/BatchWrite", pb.AuditEvent_CREATE, name, nil, user)
+			states[name] = user
+		case m.GetUpdate() != nil:
+			s.auditLog.Record(ctx, "google.showcase.v1beta1.Identity/BatchWrite", pb.AuditEvent_UPDATE, name, states[name], user)
+			states[name] = user
+		default:
+			s.auditLog.Record(ctx, "google.showcase.v1beta1.Identity/BatchWrite", pb.AuditEvent_DELETE, name, states[name], nil)
+			states[name] = nil
+		}
+	}
+	return resp, err
+}
+
+// NewAuditedMessagingServer returns a MessagingServer serving calls with messaging, recording
+// those creating, updating and deleting rooms and blurbs in auditLog.
+func NewAuditedMessagingServer(messaging pb.MessagingServer, auditLog *server.AuditLog) pb.MessagingServer {
+	return &auditedMessagingServer{MessagingServer: messaging, auditLog: auditLog}
+}
+
+type auditedMessagingServer struct {
+	pb.MessagingServer
+	auditLog *server.AuditLog
+}
+
+func (s *auditedMessagingServer) CreateRoom(ctx context.Context, in *pb.CreateRoomRequest) (*pb.Room, error) {
+	room, err := s.MessagingServer.CreateRoom(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.Messaging/CreateRoom", pb.AuditEvent_CREATE, room.GetName(), nil, room)
+	}
+	return room, err
+}
+
+func (s *auditedMessagingServer) UpdateRoom(ctx context.Context, in *pb.UpdateRoomRequest) (*pb.Room, error) {
+	name := in.GetRoom().GetName()
+	before := snapshot(func() (proto.Message, error) {
+		return s.MessagingServer.GetRoom(ctx, &pb.GetRoomRequest{Name: name})
+	})
+	room, err := s.MessagingServer.UpdateRoom(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.Messaging/UpdateRoom", pb.AuditEvent_UPDATE, name, before, room)
+	}
+	return room, err
+}
+
+func (s *auditedMessagingServer) DeleteRoom(ctx context.Context, in *pb.DeleteRoomRequest) (*empty.Empty, error) {
+	before := snapshot(func() (proto.Message, error) {
+		return s.MessagingServer.GetRoom(ctx, &pb.GetRoomRequest{Name: in.GetName()})
+	})
+	resp, err := s.MessagingServer.DeleteRoom(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.Messaging/DeleteRoom", pb.AuditEvent_DELETE, in.GetName(), before, nil)
+	}
+	return resp, err
+}
+
+func (s *auditedMessagingServer) CreateBlurb(ctx context.Context, in *pb.CreateBlurbRequest) (*pb.Blurb, error) {
+	blurb, err := s.MessagingServer.CreateBlurb(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.Messaging/CreateBlurb", pb.AuditEvent_CREATE, blurb.GetName(), nil, blurb)
+	}
+	return blurb, err
+}
+
+func (s *auditedMessagingServer) UpdateBlurb(ctx context.Context, in *pb.UpdateBlurbRequest) (*pb.Blurb, error) {
+	name := in.GetBlurb().GetName()
+	before := snapshot(func() (proto.Message, error) {
+		return s.MessagingServer.GetBlurb(ctx, &pb.GetBlurbRequest{Name: name})
+	})
+	blurb, err := s.MessagingServer.UpdateBlurb(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.Messaging/UpdateBlurb", pb.AuditEvent_UPDATE, name, before, blurb)
+	}
+	return blurb, err
+}
+
+func (s *auditedMessagingServer) DeleteBlurb(ctx context.Context, in *pb.DeleteBlurbRequest) (*empty.Empty, error) {
+	before := snapshot(func() (proto.Message, error) {
+		return s.MessagingServer.GetBlurb(ctx, &pb.GetBlurbRequest{Name: in.GetName()})
+	})
+	resp, err := s.MessagingServer.DeleteBlurb(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.Messaging/DeleteBlurb", pb.AuditEvent_DELETE, in.GetName(), before, nil)
+	}
+	return resp, err
+}
+
+// NewAuditedSequenceServer returns a SequenceServiceServer serving calls with sequences,
+// recording those creating sequences in auditLog.
+func NewAuditedSequenceServer(sequences pb.SequenceServiceServer, auditLog *server.AuditLog) pb.SequenceServiceServer {
+	return &auditedSequenceServer{SequenceServiceServer: sequences, auditLog: auditLog}
+}
+
+type auditedSequenceServer struct {
+	pb.SequenceServiceServer
+	auditLog *server.AuditLog
+}
+
+func (s *auditedSequenceServer) CreateSequence(ctx context.Context, in *pb.CreateSequenceRequest) (*pb.Sequence, error) {
+	sequence, err := s.SequenceServiceServer.CreateSequence(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.SequenceService/CreateSequence", pb.AuditEvent_CREATE, sequence.GetName(), nil, sequence)
+	}
+	return sequence, err
+}
+
+// NewAuditedTestingServer returns a TestingServer serving calls with testing, recording those
+// creating and deleting sessions and tests in auditLog.
+func NewAuditedTestingServer(testing pb.TestingServer, auditLog *server.AuditLog) pb.TestingServer {
+	return &auditedTestingServer{TestingServer: testing, auditLog: auditLog}
+}
+
+type auditedTestingServer struct {
+	pb.TestingServer
+	auditLog *server.AuditLog
+}
+
+func (s *auditedTestingServer) CreateSession(ctx context.Context, in *pb.CreateSessionRequest) (*pb.Session, error) {
+	session, err := s.TestingServer.CreateSession(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.Testing/CreateSession", pb.AuditEvent_CREATE, session.GetName(), nil, session)
+	}
+	return session, err
+}
+
+func (s *auditedTestingServer) DeleteSession(ctx context.Context, in *pb.DeleteSessionRequest) (*empty.Empty, error) {
+	before := snapshot(func() (proto.Message, error) {
+		return s.TestingServer.GetSession(ctx, &pb.GetSessionRequest{Name: in.GetName()})
+	})
+	resp, err := s.TestingServer.DeleteSession(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.Testing/DeleteSession", pb.AuditEvent_DELETE, in.GetName(), before, nil)
+	}
+	return resp, err
+}
+
+func (s *auditedTestingServer) DeleteTest(ctx context.Context, in *pb.DeleteTestRequest) (*empty.Empty, error) {
+	resp, err := s.TestingServer.DeleteTest(ctx, in)
+	if err == nil {
+		s.auditLog.Record(ctx, "google.showcase.v1beta1.Testing/DeleteTest", pb.AuditEvent_DELETE, in.GetName(), nil, nil)
+	}
+	return resp, err
+}
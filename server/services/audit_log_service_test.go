@@ -0,0 +1,135 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/metadata"
+)
+
+type mockTailAuditLogStream struct {
+	ctx    context.Context
+	cancel func()
+	want   int
+	events []*pb.AuditEvent
+	pb.AuditLog_TailAuditLogServer
+}
+
+func (m *mockTailAuditLogStream) Context() context.Context { return m.ctx }
+
+func (m *mockTailAuditLogStream) Send(event *pb.AuditEvent) error {
+	m.events = append(m.events, event)
+	if len(m.events) == m.want {
+		m.cancel()
+	}
+	return nil
+}
+
+func TestAuditedIdentityServer(t *testing.T) {
+	auditLog := server.NewAuditLog()
+	identity := NewAuditedIdentityServer(NewIdentityServer(), auditLog)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer alice"))
+
+	user, err := identity.CreateUser(ctx, &pb.CreateUserRequest{User: &pb.User{DisplayName: "Alice", Email: "alice@example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = identity.UpdateUser(ctx, &pb.UpdateUserRequest{
+		User: &pb.User{Name: user.GetName(), DisplayName: "Alicia", Email: "alice@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A failed call is not audited.
+	if _, err := identity.DeleteUser(ctx, &pb.DeleteUserRequest{Name: "users/missing"}); err == nil {
+		t.Fatal("want an error deleting a missing user")
+	}
+	if _, err := identity.DeleteUser(context.Background(), &pb.DeleteUserRequest{Name: user.GetName()}); err != nil {
+		t.Fatal(err)
+	}
+
+	tailCtx, cancel := context.WithCancel(context.Background())
+	stream := &mockTailAuditLogStream{ctx: tailCtx, cancel: cancel, want: 3}
+	NewAuditLogServer(auditLog).TailAuditLog(&pb.TailAuditLogRequest{ResourcePrefix: "users/", Replay: true}, stream)
+	if len(stream.events) != 3 {
+		t.Fatalf("want 3 events, got %v", stream.events)
+	}
+
+	actions := []pb.AuditEvent_Action{}
+	actors := []string{}
+	for _, event := range stream.events {
+		if event.GetResource() != user.GetName() {
+			t.Errorf("want events about %q, got %v", user.GetName(), event)
+		}
+		actions = append(actions, event.GetAction())
+		actors = append(actors, event.GetActor())
+	}
+	if want := []pb.AuditEvent_Action{pb.AuditEvent_CREATE, pb.AuditEvent_UPDATE, pb.AuditEvent_DELETE}; !reflect.DeepEqual(actions, want) {
+		t.Errorf("want actions %v, got %v", want, actions)
+	}
+	if want := []string{"bearer:alice", "bearer:alice", "anonymous"}; !reflect.DeepEqual(actors, want) {
+		t.Errorf("want actors %v, got %v", want, actors)
+	}
+
+	changes := map[string][2]string{}
+	for _, change := range stream.events[1].GetChanges() {
+		changes[change.GetPath()] = [2]string{change.GetBefore(), change.GetAfter()}
+	}
+	if got, want := changes["displayName"], [2]string{`"Alice"`, `"Alicia"`}; got != want {
+		t.Errorf("want the update to change displayName from %q to %q, got %v", want[0], want[1], stream.events[1].GetChanges())
+	}
+	if _, ok := changes["email"]; ok {
+		t.Errorf("want the update to leave email unchanged, got %v", stream.events[1].GetChanges())
+	}
+}
+
+func TestAuditedIdentityServer_batchWrite(t *testing.T) {
+	auditLog := server.NewAuditLog()
+	identity := NewAuditedIdentityServer(NewIdentityServer(), auditLog)
+	user, err := identity.CreateUser(context.Background(), &pb.CreateUserRequest{User: &pb.User{DisplayName: "Alice", Email: "alice@example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = identity.BatchWrite(context.Background(), &pb.BatchWriteRequest{
+		Mutations: []*pb.UserMutation{
+			{Operation: &pb.UserMutation_Update{Update: &pb.User{Name: user.GetName(), DisplayName: "Alicia", Email: "alice@example.com"}}},
+			{Operation: &pb.UserMutation_Delete{Delete: user.GetName()}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tailCtx, cancel := context.WithCancel(context.Background())
+	stream := &mockTailAuditLogStream{ctx: tailCtx, cancel: cancel, want: 3}
+	NewAuditLogServer(auditLog).TailAuditLog(&pb.TailAuditLogRequest{Replay: true}, stream)
+	if len(stream.events) != 3 {
+		t.Fatalf("want 3 events, got %v", stream.events)
+	}
+	// The deletion's diff starts from the user as the batch's update left it.
+	for _, change := range stream.events[2].GetChanges() {
+		if change.GetPath() == "displayName" && change.GetBefore() != `"Alicia"` {
+			t.Errorf("want the deleted user's displayName to have been %q, got %v", "Alicia", change)
+		}
+	}
+	if got := stream.events[1].GetMethod(); got != "google.showcase.v1beta1.Identity/BatchWrite" {
+		t.Errorf("want the update audited as made by BatchWrite, got %q", got)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewBarrierServer returns a new BarrierServer for the Showcase API, arming and releasing the
+// barriers of manager.
+func NewBarrierServer(manager *server.BarrierManager) pb.BarrierServer {
+	return &barrierServerImpl{manager: manager}
+}
+
+type barrierServerImpl struct {
+	manager *server.BarrierManager
+}
+
+func (s *barrierServerImpl) ArmBarrier(_ context.Context, in *pb.ArmBarrierRequest) (*pb.BarrierState, error) {
+	if in.GetTimeout() != nil {
+		if err := in.GetTimeout().CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timeout: %v", err)
+		}
+	}
+	return s.manager.Arm(in.GetBarrierId(), in.GetTimeout().AsDuration())
+}
+
+func (s *barrierServerImpl) ReleaseBarrier(ctx context.Context, in *pb.ReleaseBarrierRequest) (*pb.ReleaseBarrierResponse, error) {
+	if in.GetWaitForCalls() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "wait_for_calls must not be negative, got %d", in.GetWaitForCalls())
+	}
+	released, err := s.manager.Release(ctx, in.GetBarrierId(), in.GetOrder(), int(in.GetWaitForCalls()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReleaseBarrierResponse{Released: released}, nil
+}
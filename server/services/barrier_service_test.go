@@ -0,0 +1,79 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestBarrier(t *testing.T) {
+	manager := server.NewBarrierManager()
+	s := NewBarrierServer(manager)
+	if _, err := s.ArmBarrier(context.Background(), &pb.ArmBarrierRequest{BarrierId: "b"}); err != nil {
+		t.Fatal(err)
+	}
+
+	echo := NewEchoServer()
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+	results := make(chan string, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(server.BarrierHeader, "b"))
+			resp, err := manager.UnaryInterceptor(ctx, &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hi"}}, info,
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return echo.Echo(ctx, req.(*pb.EchoRequest))
+				})
+			if err != nil {
+				t.Errorf("Echo: %v", err)
+				results <- ""
+				return
+			}
+			results <- resp.(*pb.EchoResponse).GetContent()
+		}()
+	}
+
+	resp, err := s.ReleaseBarrier(context.Background(), &pb.ReleaseBarrierRequest{BarrierId: "b", Order: []string{"2"}, WaitForCalls: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"2", "1"}; !reflect.DeepEqual(resp.GetReleased(), want) {
+		t.Errorf("ReleaseBarrier: want %v released, got %v", want, resp.GetReleased())
+	}
+	for i := 0; i < 2; i++ {
+		if got := <-results; got != "hi" {
+			t.Errorf("Echo: want %q, got %q", "hi", got)
+		}
+	}
+}
+
+func TestBarrier_invalid(t *testing.T) {
+	s := NewBarrierServer(server.NewBarrierManager())
+	if _, err := s.ArmBarrier(context.Background(), &pb.ArmBarrierRequest{BarrierId: "b", Timeout: durationpb.New(-1)}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ArmBarrier with a negative timeout: want InvalidArgument, got %v", err)
+	}
+	if _, err := s.ReleaseBarrier(context.Background(), &pb.ReleaseBarrierRequest{BarrierId: "b", WaitForCalls: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReleaseBarrier waiting for negative calls: want InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// NewClockServer returns a new ClockServer for the Showcase API, controlling clock.
+func NewClockServer(clock *server.Clock) pb.ClockServer {
+	return &clockServerImpl{clock: clock}
+}
+
+type clockServerImpl struct {
+	clock *server.Clock
+}
+
+func (s *clockServerImpl) GetClock(_ context.Context, _ *pb.GetClockRequest) (*pb.ClockState, error) {
+	return s.state(), nil
+}
+
+func (s *clockServerImpl) AdvanceClock(_ context.Context, in *pb.AdvanceClockRequest) (*pb.ClockState, error) {
+	if in.GetDuration() == nil {
+		return nil, status.Error(codes.InvalidArgument, "duration must be set")
+	}
+	if err := in.GetDuration().CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid duration: %v", err)
+	}
+	d := in.GetDuration().AsDuration()
+	if d < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "the clock cannot be moved back, got duration %v", d)
+	}
+	s.clock.Advance(d)
+	return s.state(), nil
+}
+
+func (s *clockServerImpl) ResetClock(_ context.Context, _ *pb.ResetClockRequest) (*pb.ClockState, error) {
+	s.clock.Reset()
+	return s.state(), nil
+}
+
+func (s *clockServerImpl) SyncClock(ctx context.Context, in *pb.SyncClockRequest) (*pb.SyncClockResponse, error) {
+	received := s.clock.Timestamp()
+	if hold := in.GetHold(); hold != nil {
+		if err := hold.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hold: %v", err)
+		}
+		if hold.AsDuration() < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "the hold must not be negative, got %v", hold.AsDuration())
+		}
+		timer := time.NewTimer(hold.AsDuration())
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	return &pb.SyncClockResponse{
+		ClientTransmitTime: in.GetClientTransmitTime(),
+		ServerReceiveTime:  received,
+		ServerTransmitTime: s.clock.Timestamp(),
+	}, nil
+}
+
+func (s *clockServerImpl) state() *pb.ClockState {
+	return &pb.ClockState{
+		Now:    s.clock.Timestamp(),
+		Offset: durationpb.New(s.clock.Offset()),
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestClock(t *testing.T) {
+	real := time.Unix(1000, 0)
+	s := NewClockServer(server.NewClock(func() time.Time { return real }))
+
+	state, err := s.AdvanceClock(context.Background(), &pb.AdvanceClockRequest{Duration: durationpb.New(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !state.GetNow().AsTime().Equal(real.Add(time.Hour)) || state.GetOffset().AsDuration() != time.Hour {
+		t.Errorf("AdvanceClock: unexpected state %v", state)
+	}
+
+	state, err = s.GetClock(context.Background(), &pb.GetClockRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !state.GetNow().AsTime().Equal(real.Add(time.Hour)) {
+		t.Errorf("GetClock: unexpected state %v", state)
+	}
+
+	state, err = s.ResetClock(context.Background(), &pb.ResetClockRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !state.GetNow().AsTime().Equal(real) || state.GetOffset().AsDuration() != 0 {
+		t.Errorf("ResetClock: unexpected state %v", state)
+	}
+}
+
+func TestAdvanceClock_invalid(t *testing.T) {
+	s := NewClockServer(server.NewClock(time.Now))
+	for _, d := range []*durationpb.Duration{nil, durationpb.New(-time.Second), {Seconds: 1, Nanos: -1}} {
+		_, err := s.AdvanceClock(context.Background(), &pb.AdvanceClockRequest{Duration: d})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("AdvanceClock(%v): got %v, want InvalidArgument", d, err)
+		}
+	}
+}
+
+func TestAdvanceClock_completesWait(t *testing.T) {
+	clock := server.GetClockInstance()
+	defer clock.Reset()
+
+	op, err := NewEchoServer().Wait(context.Background(), &pb.WaitRequest{
+		End:      &pb.WaitRequest_Ttl{Ttl: durationpb.New(24 * time.Hour)},
+		Response: &pb.WaitRequest_Success{Success: &pb.WaitResponse{Content: "done"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.GetDone() {
+		t.Fatal("Wait: operation done before the clock was advanced")
+	}
+
+	_, err = NewClockServer(clock).AdvanceClock(context.Background(), &pb.AdvanceClockRequest{Duration: durationpb.New(25 * time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	op, err = NewOperationsServer(nil).GetOperation(context.Background(), &lropb.GetOperationRequest{Name: op.GetName()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !op.GetDone() || op.GetResponse() == nil {
+		t.Errorf("GetOperation: operation not done after advancing the clock: %v", op)
+	}
+}
+
+func TestSyncClock(t *testing.T) {
+	real := time.Unix(1000, 0)
+	clock := server.NewClock(func() time.Time { return real })
+	clock.Advance(time.Hour)
+	s := NewClockServer(clock)
+
+	sent := timestamppb.New(time.Unix(900, 0))
+	resp, err := s.SyncClock(context.Background(), &pb.SyncClockRequest{ClientTransmitTime: sent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(resp.GetClientTransmitTime(), sent) {
+		t.Errorf("SyncClock: client_transmit_time %v, want %v", resp.GetClientTransmitTime(), sent)
+	}
+	for name, got := range map[string]*timestamppb.Timestamp{
+		"server_receive_time":  resp.GetServerReceiveTime(),
+		"server_transmit_time": resp.GetServerTransmitTime(),
+	} {
+		if !got.AsTime().Equal(real.Add(time.Hour)) {
+			t.Errorf("SyncClock: %s %v, want the server's clock at %v", name, got.AsTime(), real.Add(time.Hour))
+		}
+	}
+}
+
+func TestSyncClock_hold(t *testing.T) {
+	s := NewClockServer(server.NewClock(time.Now))
+	resp, err := s.SyncClock(context.Background(), &pb.SyncClockRequest{Hold: durationpb.New(20 * time.Millisecond)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if held := resp.GetServerTransmitTime().AsTime().Sub(resp.GetServerReceiveTime().AsTime()); held < 20*time.Millisecond {
+		t.Errorf("SyncClock: answered %v after receiving the call, want at least the 20ms hold", held)
+	}
+
+	for _, d := range []*durationpb.Duration{durationpb.New(-time.Second), {Seconds: 1, Nanos: -1}} {
+		if _, err := s.SyncClock(context.Background(), &pb.SyncClockRequest{Hold: d}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("SyncClock(hold %v): got %v, want InvalidArgument", d, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := s.SyncClock(ctx, &pb.SyncClockRequest{Hold: durationpb.New(time.Minute)}); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("SyncClock(hold past the deadline): got %v, want DeadlineExceeded", err)
+	}
+}
@@ -52,7 +52,9 @@ func (csi *complianceServerImpl) requestMatchesExpectation(received *pb.RepeatRe
 	}
 
 	if diff := cmp.Diff(received.GetInfo(), expectedRequest.GetInfo(), cmp.Comparer(proto.Equal)); diff != "" {
-		return fmt.Errorf("(ComplianceSuiteRequestMismatchError) contents of request %q do not match test suite", name)
+		// What the server decoded shows how degenerate values, such as empty strings, came across.
+		decoded, _ := protojson.Marshal(received.GetInfo())
+		return fmt.Errorf("(ComplianceSuiteRequestMismatchError) contents of request %q do not match test suite: the server decoded %s", name, decoded)
 	}
 
 	return nil
@@ -183,3 +183,26 @@ func TestIndexingComplianceSuite(t *testing.T) {
 		t.Errorf("initializing ComplianceSuite a second time should not have errored, but got: %s", err)
 	}
 }
+
+func TestComplianceMismatchReportsDecoded(t *testing.T) {
+	server := NewComplianceServer().(*complianceServerImpl)
+	request := &pb.RepeatRequest{
+		Name:         "Strings of spaces, tabs and newlines", // matches a name in compliance_suite.json
+		ServerVerify: true,
+		Info:         proto.Clone(ComplianceSuiteRequests["Strings of spaces, tabs and newlines"].GetInfo()).(*pb.ComplianceData),
+	}
+	if err := server.requestMatchesExpectation(request); err != nil {
+		t.Fatalf("expected the suite's whitespace-only request to match, got %s", err)
+	}
+
+	// A client trimming whitespace off strings sends empty ones instead.
+	request.Info.FString = ""
+	request.Info.PString = proto.String("")
+	err := server.requestMatchesExpectation(request)
+	if err == nil {
+		t.Fatal("expected a request with trimmed strings to not match")
+	}
+	if got, want := err.Error(), `"pString":""`; !strings.Contains(strings.ReplaceAll(got, " ", ""), want) {
+		t.Errorf("error message does not report the decoded strings: want %q in %q", want, got)
+	}
+}
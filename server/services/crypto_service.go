@@ -0,0 +1,142 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// cryptoKeys are the fixed keys of the Crypto service, by resource name.
+var cryptoKeys = map[string]*pb.CryptoKey{
+	"cryptoKeys/xor": {
+		Name:      "cryptoKeys/xor",
+		Algorithm: pb.CryptoKey_XOR,
+		Material:  []byte("showcase"),
+	},
+	"cryptoKeys/aes-gcm": {
+		Name:      "cryptoKeys/aes-gcm",
+		Algorithm: pb.CryptoKey_AES_256_GCM,
+		Material:  []byte("showcase-aes-256-gcm-test-key-01"),
+	},
+}
+
+// gcmNonceSize is the size of the nonces the AES_256_GCM key prefixes its ciphertexts with.
+const gcmNonceSize = 12
+
+// NewCryptoServer returns a new CryptoServer for the Showcase API.
+func NewCryptoServer() pb.CryptoServer {
+	return &cryptoServerImpl{}
+}
+
+type cryptoServerImpl struct{}
+
+func (s *cryptoServerImpl) GetCryptoKey(_ context.Context, in *pb.GetCryptoKeyRequest) (*pb.CryptoKey, error) {
+	key, err := cryptoKey(in.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(key).(*pb.CryptoKey), nil
+}
+
+func (s *cryptoServerImpl) Encrypt(_ context.Context, in *pb.EncryptRequest) (*pb.EncryptResponse, error) {
+	key, err := cryptoKey(in.GetName())
+	if err != nil {
+		return nil, err
+	}
+	aad := in.GetAdditionalAuthenticatedData()
+	var ciphertext []byte
+	switch key.GetAlgorithm() {
+	case pb.CryptoKey_XOR:
+		if len(aad) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "%s does not support additional authenticated data", key.GetName())
+		}
+		ciphertext = xor(key.GetMaterial(), in.GetPlaintext())
+	case pb.CryptoKey_AES_256_GCM:
+		mac := hmac.New(sha256.New, key.GetMaterial())
+		mac.Write(aad)
+		mac.Write(in.GetPlaintext())
+		nonce := mac.Sum(nil)[:gcmNonceSize]
+		ciphertext = gcm(key.GetMaterial()).Seal(nonce, nonce, in.GetPlaintext(), aad)
+	}
+	return &pb.EncryptResponse{Name: key.GetName(), Ciphertext: ciphertext}, nil
+}
+
+func (s *cryptoServerImpl) Decrypt(_ context.Context, in *pb.DecryptRequest) (*pb.DecryptResponse, error) {
+	key, err := cryptoKey(in.GetName())
+	if err != nil {
+		return nil, err
+	}
+	aad := in.GetAdditionalAuthenticatedData()
+	ciphertext := in.GetCiphertext()
+	var plaintext []byte
+	switch key.GetAlgorithm() {
+	case pb.CryptoKey_XOR:
+		if len(aad) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "%s does not support additional authenticated data", key.GetName())
+		}
+		plaintext = xor(key.GetMaterial(), ciphertext)
+	case pb.CryptoKey_AES_256_GCM:
+		aead := gcm(key.GetMaterial())
+		if len(ciphertext) < gcmNonceSize+aead.Overhead() {
+			return nil, status.Errorf(codes.InvalidArgument, "the ciphertext is %d bytes, shorter than the %d of a nonce and tag", len(ciphertext), gcmNonceSize+aead.Overhead())
+		}
+		plaintext, err = aead.Open(nil, ciphertext[:gcmNonceSize], ciphertext[gcmNonceSize:], aad)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "the ciphertext or additional authenticated data failed authentication")
+		}
+	}
+	return &pb.DecryptResponse{Plaintext: plaintext}, nil
+}
+
+// cryptoKey returns the key named name.
+func cryptoKey(name string) (*pb.CryptoKey, error) {
+	key, ok := cryptoKeys[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "A crypto key with name %s not found.", name)
+	}
+	return key, nil
+}
+
+// xor returns data with each byte XORed with the byte of key at the same index modulo its
+// length.
+func xor(key, data []byte) []byte {
+	out := make([]byte, len(data))
+	for i, b := range data {
+		out[i] = b ^ key[i%len(key)]
+	}
+	return out
+}
+
+// gcm returns the AES-GCM cipher keyed with key.
+func gcm(key []byte) cipher.AEAD {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	return aead
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCrypto_xor(t *testing.T) {
+	s := NewCryptoServer()
+	encrypted, err := s.Encrypt(context.Background(), &pb.EncryptRequest{Name: "cryptoKeys/xor", Plaintext: []byte("hi showcase")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{'h' ^ 's', 'i' ^ 'h', ' ' ^ 'o', 's' ^ 'w', 'h' ^ 'c', 'o' ^ 'a', 'w' ^ 's', 'c' ^ 'e', 'a' ^ 's', 's' ^ 'h', 'e' ^ 'o'}
+	if !bytes.Equal(encrypted.GetCiphertext(), want) {
+		t.Errorf("Encrypt: want %x, got %x", want, encrypted.GetCiphertext())
+	}
+	decrypted, err := s.Decrypt(context.Background(), &pb.DecryptRequest{Name: "cryptoKeys/xor", Ciphertext: encrypted.GetCiphertext()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(decrypted.GetPlaintext()); got != "hi showcase" {
+		t.Errorf("Decrypt: want %q, got %q", "hi showcase", got)
+	}
+
+	_, err = s.Encrypt(context.Background(), &pb.EncryptRequest{Name: "cryptoKeys/xor", AdditionalAuthenticatedData: []byte("aad")})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Encrypt: want InvalidArgument for additional authenticated data, got %v", err)
+	}
+}
+
+func TestCrypto_aesGCM(t *testing.T) {
+	s := NewCryptoServer()
+	key, err := s.GetCryptoKey(context.Background(), &pb.GetCryptoKeyRequest{Name: "cryptoKeys/aes-gcm"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext, aad := []byte{0, 1, 2, 0xfe, 0xff}, []byte("user=ekko")
+
+	// The ciphertext is as documented, computed from the published key material.
+	mac := hmac.New(sha256.New, key.GetMaterial())
+	mac.Write(aad)
+	mac.Write(plaintext)
+	nonce := mac.Sum(nil)[:12]
+	block, _ := aes.NewCipher(key.GetMaterial())
+	aead, _ := cipher.NewGCM(block)
+	want := aead.Seal(append([]byte{}, nonce...), nonce, plaintext, aad)
+
+	for i := 0; i < 2; i++ {
+		encrypted, err := s.Encrypt(context.Background(), &pb.EncryptRequest{Name: key.GetName(), Plaintext: plaintext, AdditionalAuthenticatedData: aad})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(encrypted.GetCiphertext(), want) {
+			t.Errorf("Encrypt: want %x, got %x", want, encrypted.GetCiphertext())
+		}
+	}
+
+	decrypted, err := s.Decrypt(context.Background(), &pb.DecryptRequest{Name: key.GetName(), Ciphertext: want, AdditionalAuthenticatedData: aad})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decrypted.GetPlaintext(), plaintext) {
+		t.Errorf("Decrypt: want %x, got %x", plaintext, decrypted.GetPlaintext())
+	}
+
+	tampered := append([]byte{}, want...)
+	tampered[len(tampered)-1] ^= 1
+	for _, in := range []*pb.DecryptRequest{
+		{Name: key.GetName(), Ciphertext: want, AdditionalAuthenticatedData: []byte("user=misha")},
+		{Name: key.GetName(), Ciphertext: tampered, AdditionalAuthenticatedData: aad},
+		{Name: key.GetName(), Ciphertext: want[:20]},
+	} {
+		if _, err := s.Decrypt(context.Background(), in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Decrypt(%x): want InvalidArgument, got %v", in.GetCiphertext(), err)
+		}
+	}
+}
+
+func TestCrypto_notFound(t *testing.T) {
+	s := NewCryptoServer()
+	if _, err := s.GetCryptoKey(context.Background(), &pb.GetCryptoKeyRequest{Name: "cryptoKeys/rot13"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetCryptoKey: want NotFound, got %v", err)
+	}
+	if _, err := s.Encrypt(context.Background(), &pb.EncryptRequest{Name: "cryptoKeys/rot13"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Encrypt: want NotFound, got %v", err)
+	}
+}
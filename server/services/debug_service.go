@@ -0,0 +1,60 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+)
+
+// NewDebugServer returns a new DebugServer for the Showcase API, reporting the streams monitor
+// sees opened on each service and the latency of the calls metrics counts, and streaming the
+// events published to events.
+func NewDebugServer(monitor *server.TransportMonitor, events *server.ServerEvents, metrics *server.Metrics) pb.DebugServer {
+	return &debugServerImpl{monitor: monitor, events: events, metrics: metrics}
+}
+
+type debugServerImpl struct {
+	monitor *server.TransportMonitor
+	events  *server.ServerEvents
+	metrics *server.Metrics
+}
+
+func (s *debugServerImpl) GetRuntimeStats(_ context.Context, _ *pb.GetRuntimeStatsRequest) (*pb.RuntimeStats, error) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	return &pb.RuntimeStats{
+		Goroutines: int32(runtime.NumGoroutine()),
+		Heap: &pb.RuntimeStats_Heap{
+			AllocBytes:      mem.HeapAlloc,
+			Objects:         mem.HeapObjects,
+			SysBytes:        mem.HeapSys,
+			TotalAllocBytes: mem.TotalAlloc,
+			GcCycles:        mem.NumGC,
+		},
+		Services: s.monitor.Services(),
+	}, nil
+}
+
+func (s *debugServerImpl) TailServerEvents(in *pb.TailServerEventsRequest, stream pb.Debug_TailServerEventsServer) error {
+	return s.events.Tail(stream.Context(), in.GetKinds(), stream.Send)
+}
+
+func (s *debugServerImpl) GetLatencyStats(_ context.Context, in *pb.GetLatencyStatsRequest) (*pb.LatencyStats, error) {
+	return &pb.LatencyStats{Methods: s.metrics.Latencies(in.GetTransport(), in.GetMethod())}, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+)
+
+func TestGetRuntimeStats(t *testing.T) {
+	s := NewDebugServer(server.NewTransportMonitor(0), server.NewServerEvents(), server.NewMetrics())
+	stats, err := s.GetRuntimeStats(context.Background(), &pb.GetRuntimeStatsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.GetGoroutines() <= 0 {
+		t.Errorf("want goroutines to be counted, got %d", stats.GetGoroutines())
+	}
+	if stats.GetHeap().GetAllocBytes() == 0 || stats.GetHeap().GetSysBytes() < stats.GetHeap().GetAllocBytes() {
+		t.Errorf("unexpected heap stats: %v", stats.GetHeap())
+	}
+	if len(stats.GetServices()) != 0 {
+		t.Errorf("want no services called yet, got %v", stats.GetServices())
+	}
+}
+
+func TestGetLatencyStats(t *testing.T) {
+	metrics := server.NewMetrics()
+	metrics.Observe("grpc", "/google.showcase.v1beta1.Echo/Echo", "OK", time.Millisecond)
+	metrics.Observe("rest", "POST /v1beta1/echo:echo", "200", time.Second)
+	s := NewDebugServer(server.NewTransportMonitor(0), server.NewServerEvents(), metrics)
+	stats, err := s.GetLatencyStats(context.Background(), &pb.GetLatencyStatsRequest{Transport: "rest"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.GetMethods()) != 1 || stats.GetMethods()[0].GetMax().AsDuration() != time.Second {
+		t.Errorf("want the latency of the REST call, got %v", stats)
+	}
+}
@@ -16,28 +16,102 @@ package services
 
 import (
 	"context"
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
 	"io"
+	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/googleapis/gapic-showcase/server"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
 	lropb "google.golang.org/genproto/googleapis/longrunning"
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// pagedExpandOperationPrefix is the prefix of the names of the operations started by the
+// LongRunningPagedExpand method, which encode the request.
+const pagedExpandOperationPrefix = "operations/google.showcase.v1beta1.Echo/LongRunningPagedExpand/"
+
+// ResponseDelayHeader is the header calls to the Echo service may send how long to wait before
+// each response in, as a duration such as "1.5s".
+const ResponseDelayHeader = "x-showcase-delay"
+
+const (
+	// ChatPushCountHeader is the header Chat calls may send the number of messages the server
+	// pushes on the stream in, independently of the requests. The call does not end, once the
+	// client half-closes it, until they are all sent.
+	ChatPushCountHeader = "x-showcase-push-count"
+
+	// ChatPushIntervalHeader is the header Chat calls may send the interval between the
+	// messages the server pushes in, as a positive duration such as "1.5s". It defaults to a
+	// second.
+	ChatPushIntervalHeader = "x-showcase-push-interval"
+)
+
+const (
+	// PageTokensHeader is the header PagedExpand calls may send SignedPageTokens in to be given
+	// opaque page tokens signed by the server, rather than the index of the next word, so that
+	// paginators doing arithmetic on tokens are caught.
+	PageTokensHeader = "x-showcase-page-tokens"
+
+	// SignedPageTokens is the value of the PageTokensHeader asking for signed page tokens.
+	SignedPageTokens = "signed"
+
+	// PageTokenTTLHeader is the header PagedExpand calls may send how long the page tokens they
+	// are given stay valid for in, as a duration such as "30s". Sending it implies
+	// SignedPageTokens, so that the expiration cannot be tampered with.
+	PageTokenTTLHeader = "x-showcase-page-token-ttl"
+
+	// PageTokenTamperedReason is the ErrorInfo reason of the calls asking for signed page tokens
+	// that send a token the server did not issue for their content.
+	PageTokenTamperedReason = "PAGE_TOKEN_TAMPERED"
+
+	// PageTokenExpiredReason is the ErrorInfo reason of the calls sending a page token whose TTL
+	// has passed. They fail with FAILED_PRECONDITION, as the listing must be restarted from the
+	// first page.
+	PageTokenExpiredReason = "PAGE_TOKEN_EXPIRED"
 )
 
 // NewEchoServer returns a new EchoServer for the Showcase API.
 func NewEchoServer() pb.EchoServer {
-	return &echoServerImpl{waiter: server.GetWaiterInstance()}
+	return NewRolloutEchoServer(nil)
+}
+
+// NewRolloutEchoServer returns a new EchoServer for the Showcase API serving each call to Echo
+// with the version of the schema rollout currently serves. If rollout is nil, the GREEN version
+// is always served.
+func NewRolloutEchoServer(rollout *server.SchemaRollout) pb.EchoServer {
+	key := make([]byte, 32)
+	crand.Read(key)
+	return &echoServerImpl{waiter: server.GetWaiterInstance(), rollout: rollout, pageTokenKey: key}
 }
 
 type echoServerImpl struct {
-	waiter server.Waiter
+	waiter  server.Waiter
+	rollout *server.SchemaRollout
+
+	// pageTokenKey is the key signed page tokens are signed with. It is random, so that the
+	// tokens cannot be forged, and only valid for as long as the server runs.
+	pageTokenKey []byte
+
+	// templateSeq numbers the calls to Echo rendering a response template.
+	templateSeq server.UniqID
 }
 
 func (s *echoServerImpl) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
@@ -45,17 +119,73 @@ func (s *echoServerImpl) Echo(ctx context.Context, in *pb.EchoRequest) (*pb.Echo
 	if err != nil {
 		return nil, err
 	}
+	content := in.GetContent()
+	if in.GetResponseTemplate() != "" {
+		content, err = renderResponseTemplate(ctx, in, s.templateSeq.Next()+1)
+		if err != nil {
+			return nil, err
+		}
+	}
+	label := in.GetLabel()
+	if s.rollout != nil && s.rollout.Serve() == pb.SchemaRollout_BLUE {
+		label = ""
+	}
+	if err := delayResponse(ctx, in.GetResponseDelay()); err != nil {
+		return nil, err
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(server.RequestCompressionHeader, server.RequestCompression(ctx)))
 	echoTrailers(ctx)
-	return &pb.EchoResponse{Content: in.GetContent(), Severity: in.GetSeverity()}, nil
+	return &pb.EchoResponse{Content: content, Severity: in.GetSeverity(), Label: label}, nil
 }
 
 func (s *echoServerImpl) Expand(in *pb.ExpandRequest, stream pb.Echo_ExpandServer) error {
-	for _, word := range strings.Fields(in.GetContent()) {
-		err := stream.Send(&pb.EchoResponse{Content: word})
+	delay, err := responseDelay(stream.Context(), in.GetResponseDelay())
+	if err != nil {
+		return err
+	}
+	jitter := in.GetJitter()
+	if jitter != nil && (jitter.CheckValid() != nil || jitter.AsDuration() < 0) {
+		return status.Errorf(codes.InvalidArgument, "jitter must be a non-negative duration, got %v", jitter)
+	}
+	seed := in.GetJitterSeed()
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	jitterRand := rand.New(rand.NewSource(seed))
+	words := strings.Fields(in.GetContent())
+	start, err := expandResumeIndex(in.GetContent(), in.GetStreamResumeToken(), len(words))
+	if err != nil {
+		return err
+	}
+	failure := in.GetFailAfter()
+	if err := checkStreamFailure("fail_after", failure); err != nil {
+		return err
+	}
+	for idx, sent := start, 0; idx < len(words); idx, sent = idx+1, sent+1 {
+		if err := streamFailure(failure, sent); err != nil {
+			echoStreamingTrailers(stream)
+			return err
+		}
+		wait := delay
+		if max := jitter.AsDuration(); max > 0 {
+			wait += time.Duration(jitterRand.Int63n(int64(max)))
+		}
+		if err := sleep(stream.Context(), wait); err != nil {
+			return err
+		}
+		err := stream.Send(&pb.EchoResponse{
+			Content:           words[idx],
+			StreamResumeToken: expandResumeToken(in.GetContent(), idx+1),
+		})
 		if err != nil {
 			return err
 		}
 	}
+	if failure != nil {
+		// The call sent fewer words than the response count of the failure.
+		echoStreamingTrailers(stream)
+		return status.ErrorProto(failure.GetError())
+	}
 	if in.GetError() != nil {
 		return status.ErrorProto(in.GetError())
 	}
@@ -63,18 +193,95 @@ func (s *echoServerImpl) Expand(in *pb.ExpandRequest, stream pb.Echo_ExpandServe
 	return nil
 }
 
+// checkStreamFailure returns an InvalidArgument error if failure, set in field, is not nil and
+// is invalid.
+func checkStreamFailure(field string, failure *pb.StreamFailure) error {
+	if failure != nil && (failure.GetResponseCount() < 0 || failure.GetError().GetCode() == int32(codes.OK)) {
+		return status.Errorf(codes.InvalidArgument, "%s must have a non-negative response_count and an error, got %v", field, failure)
+	}
+	return nil
+}
+
+// streamFailure returns the error a call fails with once it has sent sent responses, if failure
+// is not nil, or nil.
+func streamFailure(failure *pb.StreamFailure, sent int) error {
+	if failure != nil && sent == int(failure.GetResponseCount()) {
+		return status.ErrorProto(failure.GetError())
+	}
+	return nil
+}
+
+// expandResumeTokenPrefix prefixes the stream resume tokens of Expand, before their encoding.
+const expandResumeTokenPrefix = "expand/"
+
+// expandResumeToken returns the token resuming the Expand stream of content from the next-th
+// word. It is bound to the content, for it not to resume another call's stream.
+func expandResumeToken(content string, next int) string {
+	hash := fnv.New64a()
+	hash.Write([]byte(content))
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s%x/%d", expandResumeTokenPrefix, hash.Sum64(), next)))
+}
+
+// expandResumeIndex returns the index of the word of content, of count words, token resumes
+// the Expand stream from: 0 if token is empty.
+func expandResumeIndex(content, token string, count int) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	invalid := status.Errorf(codes.InvalidArgument, "stream_resume_token %q does not resume a stream of this content", token)
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, invalid
+	}
+	separator := strings.LastIndex(string(decoded), "/")
+	if separator < 0 {
+		return 0, invalid
+	}
+	next, err := strconv.Atoi(string(decoded[separator+1:]))
+	if err != nil || next < 0 || next > count || expandResumeToken(content, next) != token {
+		return 0, invalid
+	}
+	return next, nil
+}
+
 func (s *echoServerImpl) Collect(stream pb.Echo_CollectServer) error {
 	var resp []string
+	var failure *pb.CollectFailure
+	var readDelay time.Duration
+	var receivedBytes int64
 
-	for {
+	for received := 1; ; received++ {
 		req, err := stream.Recv()
 		if err == io.EOF {
 			echoStreamingTrailers(stream)
-			return stream.SendAndClose(&pb.EchoResponse{Content: strings.Join(resp, " ")})
+			return stream.SendAndClose(&pb.EchoResponse{
+				Content:          strings.Join(resp, " "),
+				ReceivedMessages: int32(received - 1),
+				ReceivedBytes:    receivedBytes,
+			})
 		}
 		if err != nil {
 			return err
 		}
+		receivedBytes += int64(proto.Size(req))
+		if req.GetFailAfter() != nil {
+			if received > 1 {
+				return status.Errorf(codes.InvalidArgument, "only the first request may set fail_after, but request %d did", received)
+			}
+			failure = req.GetFailAfter()
+			if failure.GetRequestCount() < 1 || failure.GetError().GetCode() == int32(codes.OK) {
+				return status.Errorf(codes.InvalidArgument, "fail_after must have a positive request_count and an error, got %v", failure)
+			}
+		}
+		if delay := req.GetReadDelay(); delay != nil {
+			if received > 1 {
+				return status.Errorf(codes.InvalidArgument, "only the first request may set read_delay, but request %d did", received)
+			}
+			if delay.CheckValid() != nil || delay.AsDuration() < 0 {
+				return status.Errorf(codes.InvalidArgument, "read_delay must be a non-negative duration, got %v", delay)
+			}
+			readDelay = delay.AsDuration()
+		}
 		s := status.ErrorProto(req.GetError())
 		if s != nil {
 			return s
@@ -82,26 +289,244 @@ func (s *echoServerImpl) Collect(stream pb.Echo_CollectServer) error {
 		if req.GetContent() != "" {
 			resp = append(resp, req.GetContent())
 		}
+		if failure != nil && received == int(failure.GetRequestCount()) {
+			echoStreamingTrailers(stream)
+			return status.ErrorProto(failure.GetError())
+		}
+		// Requests not read yet back up, until flow control blocks the client's sends.
+		if err := sleep(stream.Context(), readDelay); err != nil {
+			return err
+		}
 	}
 }
 
 func (s *echoServerImpl) Chat(stream pb.Echo_ChatServer) error {
-	for {
+	interval, count, err := chatPushes(stream.Context())
+	if err != nil {
+		return err
+	}
+	send := stream.Send
+	waitPushes := func() error { return nil }
+	if count > 0 {
+		var mu sync.Mutex
+		send = func(resp *pb.EchoResponse) error {
+			mu.Lock()
+			defer mu.Unlock()
+			return stream.Send(resp)
+		}
+		pusher := startChatPushes(stream.Context(), send, interval, count)
+		// The pushes must stop before the call ends, for the stream not to be sent on after.
+		defer pusher.stop()
+		waitPushes = pusher.wait
+	}
+
+	var seq int64
+	var failure *pb.StreamFailure
+	for received, responses := 1, 0; ; received++ {
 		req, err := stream.Recv()
 		if err == io.EOF {
+			// The call ends once the server is done pushing, to test half-closed streams.
+			if err := waitPushes(); err != nil {
+				return err
+			}
 			echoStreamingTrailers(stream)
 			return nil
 		}
 		if err != nil {
 			return err
 		}
+		if req.GetFailAfterResponses() != nil {
+			if received > 1 {
+				return status.Errorf(codes.InvalidArgument, "only the first request may set fail_after_responses, but request %d did", received)
+			}
+			failure = req.GetFailAfterResponses()
+			if err := checkStreamFailure("fail_after_responses", failure); err != nil {
+				return err
+			}
+			if err := streamFailure(failure, responses); err != nil {
+				echoStreamingTrailers(stream)
+				return err
+			}
+		}
 
 		s := status.ErrorProto(req.GetError())
 		if s != nil {
 			return s
 		}
-		stream.Send(&pb.EchoResponse{Content: req.GetContent()})
+		content := req.GetContent()
+		if req.GetResponseTemplate() != "" {
+			seq++
+			content, err = renderResponseTemplate(stream.Context(), req, seq)
+			if err != nil {
+				return err
+			}
+		}
+		if err := delayResponse(stream.Context(), req.GetResponseDelay()); err != nil {
+			return err
+		}
+		if err := send(&pb.EchoResponse{Content: content}); err != nil {
+			return err
+		}
+		responses++
+		if err := streamFailure(failure, responses); err != nil {
+			echoStreamingTrailers(stream)
+			return err
+		}
+	}
+}
+
+// chatPushes returns the interval and the number of the messages the server pushes on the Chat
+// call in ctx, as sent in its ChatPushIntervalHeader and ChatPushCountHeader.
+func chatPushes(ctx context.Context) (time.Duration, int, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	count := 0
+	if values := md.Get(ChatPushCountHeader); len(values) > 0 {
+		var err error
+		if count, err = strconv.Atoi(values[0]); err != nil || count < 0 {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "the %s header must be a non-negative number, got %q", ChatPushCountHeader, values[0])
+		}
+	}
+	interval := time.Second
+	if values := md.Get(ChatPushIntervalHeader); len(values) > 0 {
+		var err error
+		if interval, err = time.ParseDuration(values[0]); err != nil || interval <= 0 {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "the %s header must be a positive duration such as \"1.5s\", got %q", ChatPushIntervalHeader, values[0])
+		}
+	}
+	return interval, count, nil
+}
+
+// chatPusher pushes messages on a Chat stream.
+type chatPusher struct {
+	halt chan struct{}
+	done chan struct{}
+	err  error
+}
+
+// startChatPushes starts sending count messages with send, one every interval, until the
+// pusher is stopped or ctx is done.
+func startChatPushes(ctx context.Context, send func(*pb.EchoResponse) error, interval time.Duration, count int) *chatPusher {
+	p := &chatPusher{halt: make(chan struct{}), done: make(chan struct{})}
+	go func() {
+		defer close(p.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for pushed := 1; pushed <= count; pushed++ {
+			select {
+			case <-ticker.C:
+			case <-p.halt:
+				return
+			case <-ctx.Done():
+				p.err = status.FromContextError(ctx.Err()).Err()
+				return
+			}
+			if p.err = send(&pb.EchoResponse{Content: fmt.Sprintf("push %d of %d", pushed, count)}); p.err != nil {
+				return
+			}
+		}
+	}()
+	return p
+}
+
+// wait waits until all the messages are pushed, returning the error pushing them failed with.
+func (p *chatPusher) wait() error {
+	<-p.done
+	return p.err
+}
+
+// stop stops pushing messages, waiting until no more are sent.
+func (p *chatPusher) stop() {
+	select {
+	case <-p.done:
+	default:
+		close(p.halt)
+		<-p.done
+	}
+}
+
+// responseDelay returns how long to wait before each response to the call in ctx, asked for by
+// its request with delay or, if delay is nil, with its ResponseDelayHeader.
+func responseDelay(ctx context.Context, delay *durationpb.Duration) (time.Duration, error) {
+	if delay != nil {
+		if err := delay.CheckValid(); err != nil || delay.AsDuration() < 0 {
+			return 0, status.Errorf(codes.InvalidArgument, "response_delay must be a non-negative duration, got %v", delay)
+		}
+		return delay.AsDuration(), nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(ResponseDelayHeader)
+	if len(values) == 0 {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(values[0])
+	if err != nil || d < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "the %s header must be a non-negative duration such as \"1.5s\", got %q", ResponseDelayHeader, values[0])
+	}
+	return d, nil
+}
+
+// delayResponse waits for the delay asked for by a request with delay before responding to the
+// call in ctx.
+func delayResponse(ctx context.Context, delay *durationpb.Duration) error {
+	d, err := responseDelay(ctx, delay)
+	if err != nil {
+		return err
+	}
+	return sleep(ctx, d)
+}
+
+// sleep waits for d, failing with the status of ctx's error if it is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// renderResponseTemplate renders the response template of in, the seq-th templated request.
+func renderResponseTemplate(ctx context.Context, in *pb.EchoRequest, seq int64) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	template := in.GetResponseTemplate()
+	var b strings.Builder
+	for len(template) > 0 {
+		switch {
+		case strings.HasPrefix(template, "{{"), strings.HasPrefix(template, "}}"):
+			b.WriteByte(template[0])
+			template = template[2:]
+		case template[0] == '{':
+			end := strings.IndexByte(template, '}')
+			if end < 0 {
+				return "", status.Errorf(codes.InvalidArgument, "response template %q has an unclosed placeholder", in.GetResponseTemplate())
+			}
+			placeholder := template[1:end]
+			switch {
+			case placeholder == "content":
+				b.WriteString(in.GetContent())
+			case placeholder == "severity":
+				b.WriteString(in.GetSeverity().String())
+			case placeholder == "seq":
+				b.WriteString(strconv.FormatInt(seq, 10))
+			case strings.HasPrefix(placeholder, "header:"):
+				b.WriteString(strings.Join(md.Get(strings.TrimPrefix(placeholder, "header:")), ","))
+			default:
+				return "", status.Errorf(codes.InvalidArgument, "response template %q has unknown placeholder {%s}", in.GetResponseTemplate(), placeholder)
+			}
+			template = template[end+1:]
+		case template[0] == '}':
+			return "", status.Errorf(codes.InvalidArgument, "response template %q has an unmatched }", in.GetResponseTemplate())
+		default:
+			b.WriteByte(template[0])
+			template = template[1:]
+		}
 	}
+	return b.String(), nil
 }
 
 func (s *echoServerImpl) PagedExpandLegacy(ctx context.Context, in *pb.PagedExpandLegacyRequest) (*pb.PagedExpandResponse, error) {
@@ -114,13 +539,67 @@ func (s *echoServerImpl) PagedExpandLegacy(ctx context.Context, in *pb.PagedExpa
 }
 
 func (s *echoServerImpl) PagedExpand(ctx context.Context, in *pb.PagedExpandRequest) (*pb.PagedExpandResponse, error) {
+	signer, err := s.pageTokenSigner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pagedExpand(in, signer)
+	if err != nil {
+		return nil, err
+	}
+	echoTrailers(ctx)
+	return page, nil
+}
+
+// pageTokenSigner returns the signer of the page tokens of the call in ctx, or nil if the call
+// did not ask for signed page tokens.
+func (s *echoServerImpl) pageTokenSigner(ctx context.Context) (*pageTokenSigner, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(PageTokensHeader)
+	ttls := md.Get(PageTokenTTLHeader)
+	if len(values) == 0 && len(ttls) == 0 {
+		return nil, nil
+	}
+	if len(values) > 0 && values[0] != SignedPageTokens {
+		return nil, status.Errorf(codes.InvalidArgument, "the %s header must be %q, got %q", PageTokensHeader, SignedPageTokens, values[0])
+	}
+	signer := &pageTokenSigner{key: s.pageTokenKey}
+	if len(ttls) > 0 {
+		ttl, err := time.ParseDuration(ttls[0])
+		if err != nil || ttl <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "the %s header must be a positive duration such as \"30s\", got %q", PageTokenTTLHeader, ttls[0])
+		}
+		signer.ttl = ttl
+	}
+	return signer, nil
+}
+
+// pagedExpand returns the page of expanded words in asks for. If signer is not nil, the page
+// tokens are signed by it.
+func pagedExpand(in *pb.PagedExpandRequest, signer *pageTokenSigner) (*pb.PagedExpandResponse, error) {
 	if in.GetPageSize() < 0 {
 		return nil, status.Error(codes.InvalidArgument, "The page size provided must not be negative.")
 	}
+	if in.GetSkip() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "The number of words to skip must not be negative.")
+	}
 	words := strings.Fields(in.GetContent())
+	if in.GetReverse() {
+		for i, j := 0, len(words)-1; i < j; i, j = i+1, j-1 {
+			words[i], words[j] = words[j], words[i]
+		}
+	}
+	// Signed tokens are only valid for the listing, in the same direction, they were issued for.
+	listing := fmt.Sprintf("%t/%s", in.GetReverse(), in.GetContent())
 
 	start := int32(0)
-	if in.GetPageToken() != "" {
+	if in.GetPageToken() != "" && signer != nil {
+		next, err := signer.verify(listing, in.GetPageToken(), len(words))
+		if err != nil {
+			return nil, err
+		}
+		start = int32(next)
+	} else if in.GetPageToken() != "" {
 		token, err := strconv.Atoi(in.GetPageToken())
 		token32 := int32(token)
 		if err != nil || token32 < 0 || token32 >= int32(len(words)) {
@@ -132,12 +611,18 @@ func (s *echoServerImpl) PagedExpand(ctx context.Context, in *pb.PagedExpandRequ
 		}
 		start = token32
 	}
+	// As AIP-158 has it, the words are skipped from wherever the page token resumes the listing.
+	if in.GetSkip() >= int32(len(words))-start {
+		start = int32(len(words))
+	} else {
+		start += in.GetSkip()
+	}
 
 	pageSize := in.GetPageSize()
 	if pageSize == 0 {
 		pageSize = int32(len(words))
 	}
-	end := min(start+pageSize, int32(len(words)))
+	end := start + min(pageSize, int32(len(words))-start)
 
 	responses := []*pb.EchoResponse{}
 	for _, word := range words[start:end] {
@@ -145,17 +630,132 @@ func (s *echoServerImpl) PagedExpand(ctx context.Context, in *pb.PagedExpandRequ
 	}
 
 	nextToken := ""
-	if end < int32(len(words)) {
+	if end < int32(len(words)) && signer != nil {
+		nextToken = signer.sign(listing, int(end))
+	} else if end < int32(len(words)) {
 		nextToken = strconv.Itoa(int(end))
 	}
 
-	echoTrailers(ctx)
 	return &pb.PagedExpandResponse{
 		Responses:     responses,
 		NextPageToken: nextToken,
 	}, nil
 }
 
+// pageTokenSigner signs the page tokens PagedExpand returns, and verifies those it is sent.
+type pageTokenSigner struct {
+	key []byte
+
+	// ttl is how long the tokens signed stay valid for, or 0 if they do not expire.
+	ttl time.Duration
+}
+
+// sign returns the page token of the page of listing, which names the words expanded and their
+// order, starting at the word at index next.
+func (p *pageTokenSigner) sign(listing string, next int) string {
+	expires := int64(0)
+	if p.ttl > 0 {
+		expires = server.GetClockInstance().Now().Add(p.ttl).UnixNano()
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d/%d/%x", next, expires, p.mac(listing, next, expires))))
+}
+
+// verify returns the index of the word of listing, of count words, the page token starts at. It
+// fails with PageTokenTamperedReason unless the token was signed for listing, and with
+// PageTokenExpiredReason if its TTL has passed.
+func (p *pageTokenSigner) verify(listing, token string, count int) (int, error) {
+	tampered := pageTokenError(codes.InvalidArgument, PageTokenTamperedReason,
+		"The page token %q was not issued by this server for this content. Page tokens are opaque: pass back the next_page_token of the previous page unchanged.",
+		token)
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, tampered
+	}
+	parts := strings.SplitN(string(decoded), "/", 3)
+	if len(parts) != 3 {
+		return 0, tampered
+	}
+	next, err := strconv.Atoi(parts[0])
+	if err != nil || next < 0 || next >= count {
+		return 0, tampered
+	}
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || !hmac.Equal([]byte(parts[2]), []byte(fmt.Sprintf("%x", p.mac(listing, next, expires)))) {
+		return 0, tampered
+	}
+	if expires != 0 && !server.GetClockInstance().Now().Before(time.Unix(0, expires)) {
+		return 0, pageTokenError(codes.FailedPrecondition, PageTokenExpiredReason,
+			"The page token %q expired at %s. Restart the listing from the first page.",
+			token, time.Unix(0, expires).UTC().Format(time.RFC3339Nano))
+	}
+	return next, nil
+}
+
+// mac returns the HMAC-SHA256 of the page of listing starting at the word at index next whose
+// token expires at expires, in Unix nanoseconds.
+func (p *pageTokenSigner) mac(listing string, next int, expires int64) []byte {
+	mac := hmac.New(sha256.New, p.key)
+	fmt.Fprintf(mac, "pagedExpand/%d/%d/%s", next, expires, listing)
+	return mac.Sum(nil)
+}
+
+// pageTokenError returns an error with code whose ErrorInfo details name reason.
+func pageTokenError(code codes.Code, reason, format string, args ...interface{}) error {
+	st := status.Newf(code, format, args...)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: "showcase.googleapis.com",
+	}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+func (s *echoServerImpl) LongRunningPagedExpand(ctx context.Context, in *pb.LongRunningPagedExpandRequest) (*lropb.Operation, error) {
+	if in.GetRequest() == nil {
+		return nil, status.Error(codes.InvalidArgument, "The request to expand must be set.")
+	}
+	if _, err := pagedExpand(in.GetRequest(), nil); err != nil {
+		return nil, err
+	}
+	req := &pb.LongRunningPagedExpandRequest{Request: in.GetRequest(), EndTime: in.GetEndTime()}
+	if req.EndTime == nil {
+		req.EndTime = timestamppb.New(server.GetClockInstance().Now().Add(in.GetTtl().AsDuration()))
+	}
+	reqBytes, _ := proto.Marshal(req)
+	echoTrailers(ctx)
+	return pagedExpandOperation(pagedExpandOperationPrefix + base64.URLEncoding.EncodeToString(reqBytes))
+}
+
+// pagedExpandOperation returns the operation named name started by the LongRunningPagedExpand
+// method, whose response is the first page of expanded words once its end time has passed.
+func pagedExpandOperation(name string) (*lropb.Operation, error) {
+	reqBytes, err := base64.URLEncoding.DecodeString(strings.TrimPrefix(name, pagedExpandOperationPrefix))
+	in := &pb.LongRunningPagedExpandRequest{}
+	if err == nil {
+		err = proto.Unmarshal(reqBytes, in)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Operation %q not found.", name)
+	}
+	op := &lropb.Operation{
+		Name: name,
+		Done: !server.GetClockInstance().Now().Before(in.GetEndTime().AsTime()),
+	}
+	if !op.Done {
+		op.Metadata, _ = ptypes.MarshalAny(&pb.WaitMetadata{EndTime: in.GetEndTime()})
+		return op, nil
+	}
+	page, err := pagedExpand(in.GetRequest(), nil)
+	if err != nil {
+		op.Result = &lropb.Operation_Error{Error: status.Convert(err).Proto()}
+		return op, nil
+	}
+	response, _ := ptypes.MarshalAny(page)
+	op.Result = &lropb.Operation_Response{Response: response}
+	return op, nil
+}
+
 func min(x int32, y int32) int32 {
 	if x < y {
 		return x
@@ -164,10 +764,34 @@ func min(x int32, y int32) int32 {
 }
 
 func (s *echoServerImpl) Wait(ctx context.Context, in *pb.WaitRequest) (*lropb.Operation, error) {
+	if err := checkProgressInterval(in); err != nil {
+		return nil, err
+	}
 	echoTrailers(ctx)
 	return s.waiter.Wait(in), nil
 }
 
+func (s *echoServerImpl) NestedWait(ctx context.Context, in *pb.NestedWaitRequest) (*lropb.Operation, error) {
+	if err := checkProgressInterval(in.GetNext()); err != nil {
+		return nil, err
+	}
+	echoTrailers(ctx)
+	return s.waiter.NestedWait(in), nil
+}
+
+// checkProgressInterval returns an InvalidArgument error unless the progress_interval of in is
+// unset or positive.
+func checkProgressInterval(in *pb.WaitRequest) error {
+	interval := in.GetProgressInterval()
+	if interval == nil {
+		return nil
+	}
+	if err := interval.CheckValid(); err != nil || interval.AsDuration() <= 0 {
+		return status.Errorf(codes.InvalidArgument, "progress_interval must be a positive duration, got %v", interval)
+	}
+	return nil
+}
+
 func (s *echoServerImpl) Block(ctx context.Context, in *pb.BlockRequest) (*pb.BlockResponse, error) {
 	d, _ := ptypes.Duration(in.GetResponseDelay())
 	time.Sleep(d)
@@ -178,6 +802,180 @@ func (s *echoServerImpl) Block(ctx context.Context, in *pb.BlockRequest) (*pb.Bl
 	return in.GetSuccess(), nil
 }
 
+func (s *echoServerImpl) EchoHeaders(ctx context.Context, in *pb.EchoHeadersRequest) (*pb.EchoHeadersResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	wanted := map[string]bool{}
+	for _, name := range in.GetNames() {
+		wanted[strings.ToLower(name)] = true
+	}
+	names := []string{}
+	for name := range md {
+		if len(wanted) == 0 || wanted[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	headers := []*pb.EchoHeadersResponse_Header{}
+	for _, name := range names {
+		values := append([]string{}, md[name]...)
+		if strings.HasSuffix(name, "-bin") {
+			// Binary values are not valid proto strings until encoded back, as on the wire.
+			for idx, value := range values {
+				values[idx] = base64.StdEncoding.EncodeToString([]byte(value))
+			}
+		}
+		headers = append(headers, &pb.EchoHeadersResponse_Header{Name: name, Values: values})
+	}
+	echoTrailers(ctx)
+	return &pb.EchoHeadersResponse{Headers: headers}, nil
+}
+
+func (s *echoServerImpl) ProbeDeadline(ctx context.Context, in *pb.ProbeDeadlineRequest) (*pb.ProbeDeadlineResponse, error) {
+	resp := &pb.ProbeDeadlineResponse{}
+	if deadline, ok := ctx.Deadline(); ok {
+		resp.HasDeadline = true
+		resp.Deadline = timestamppb.New(deadline)
+		resp.Remaining = durationpb.New(time.Until(deadline))
+	}
+	if err := delayResponse(ctx, in.GetResponseDelay()); err != nil {
+		return nil, err
+	}
+	echoTrailers(ctx)
+	return resp, nil
+}
+
+// maxGeneratedPayload is the largest payload GeneratePayload returns, in bytes.
+const maxGeneratedPayload = 256 << 20
+
+// maxGeneratedChunks is the most chunks GeneratePayload splits a payload into.
+const maxGeneratedChunks = 1000000
+
+func (s *echoServerImpl) GeneratePayload(ctx context.Context, in *pb.GeneratePayloadRequest) (*pb.GeneratePayloadResponse, error) {
+	size := in.GetSize()
+	if size < 0 || size > maxGeneratedPayload {
+		return nil, status.Errorf(codes.InvalidArgument, "size must be from 0 to %d bytes, got %d", maxGeneratedPayload, size)
+	}
+	chunks := int64(in.GetChunkCount())
+	if chunks < 0 || chunks > maxGeneratedChunks {
+		return nil, status.Errorf(codes.InvalidArgument, "chunk_count must be from 0 to %d, got %d", maxGeneratedChunks, chunks)
+	}
+	if chunks == 0 {
+		chunks = 1
+	}
+
+	payload := make([]byte, size)
+	if in.GetRandom() {
+		rand.New(rand.NewSource(in.GetSeed())).Read(payload)
+	} else {
+		for idx := range payload {
+			payload[idx] = 'a' + byte(idx%26)
+		}
+	}
+	resp := &pb.GeneratePayloadResponse{Chunks: make([][]byte, 0, chunks), Size: size}
+	for chunk := int64(0); chunk < chunks; chunk++ {
+		// The lengths of the chunks differ by at most a byte.
+		resp.Chunks = append(resp.Chunks, payload[chunk*size/chunks:(chunk+1)*size/chunks])
+	}
+	echoTrailers(ctx)
+	return resp, nil
+}
+
+// maxFanOutTasks is the most sub-tasks FanOut runs.
+const maxFanOutTasks = 10000
+
+func (s *echoServerImpl) FanOut(ctx context.Context, in *pb.FanOutRequest) (*pb.FanOutResponse, error) {
+	count := int(in.GetTaskCount())
+	if count < 0 || count > maxFanOutTasks {
+		return nil, status.Errorf(codes.InvalidArgument, "task_count must be from 0 to %d, got %d", maxFanOutTasks, count)
+	}
+	concurrency := int(in.GetConcurrency())
+	if concurrency < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "concurrency must not be negative, got %d", concurrency)
+	}
+	if concurrency == 0 || concurrency > count {
+		concurrency = count
+	}
+	duration := in.GetTaskDuration().AsDuration()
+	if duration < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "task_duration must not be negative, got %s", duration)
+	}
+	failures := map[int]*status.Status{}
+	for idx, failure := range in.GetFailures() {
+		task := int(failure.GetIndex())
+		if task < 0 || task >= count {
+			return nil, status.Errorf(codes.InvalidArgument, "failures[%d].index must be from 0 to %d, got %d", idx, count-1, task)
+		}
+		if _, ok := failures[task]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "failures[%d].index repeats the index %d", idx, task)
+		}
+		st := status.FromProto(failure.GetError())
+		if st.Code() == codes.OK {
+			return nil, status.Errorf(codes.InvalidArgument, "failures[%d].error must not be OK", idx)
+		}
+		failures[task] = st
+	}
+
+	resp := &pb.FanOutResponse{Results: make([]*pb.FanOutResponse_Result, count)}
+	slots := make(chan struct{}, concurrency)
+	var mu sync.Mutex
+	running := 0
+	var wg sync.WaitGroup
+	for task := 0; task < count; task++ {
+		wg.Add(1)
+		go func(task int) {
+			defer wg.Done()
+			result := &pb.FanOutResponse_Result{Index: int32(task)}
+			resp.Results[task] = result
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				// The call fails as a whole.
+				return
+			}
+			defer func() { <-slots }()
+
+			mu.Lock()
+			running++
+			if running > int(resp.PeakConcurrency) {
+				resp.PeakConcurrency = int32(running)
+			}
+			mu.Unlock()
+			err := sleep(ctx, duration)
+			mu.Lock()
+			running--
+			mu.Unlock()
+
+			switch {
+			case err != nil:
+				return
+			case failures[task] != nil:
+				result.Status = failures[task].Proto()
+			default:
+				result.Status = status.New(codes.OK, "").Proto()
+				result.Content = fmt.Sprintf("task %d", task)
+			}
+		}(task)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	for _, result := range resp.GetResults() {
+		if result.GetStatus().GetCode() == int32(codes.OK) {
+			resp.SucceededCount++
+			continue
+		}
+		if in.GetFailOnError() {
+			return nil, status.ErrorProto(result.GetStatus())
+		}
+		resp.FailedCount++
+	}
+	echoTrailers(ctx)
+	return resp, nil
+}
+
 // echo any provided trailing metadata
 func echoTrailers(ctx context.Context) {
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -17,16 +17,23 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"math/rand"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	durpb "github.com/golang/protobuf/ptypes/duration"
+	"github.com/googleapis/gapic-showcase/server"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
 	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -55,6 +62,9 @@ func TestEcho_success(t *testing.T) {
 		if out.Severity != in.Severity {
 			t.Errorf("Echo severity(%d) returned %d", in.Severity, out.Severity)
 		}
+		if got := mockStream.header.Get("x-showcase-request-compression"); len(got) != 1 || got[0] != "identity" {
+			t.Errorf("Echo x-showcase-request-compression header: got %q, want identity", got)
+		}
 		mockStream.verify(err != nil)
 	}
 	in := &pb.EchoRequest{
@@ -92,6 +102,72 @@ func TestEcho_error(t *testing.T) {
 	}
 }
 
+func TestEcho_responseTemplate(t *testing.T) {
+	server := NewEchoServer()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-foo", "bar", "x-foo", "baz"))
+	for i, want := range []string{"hi-bar,baz-1-CRITICAL {}", "hi-bar,baz-2-CRITICAL {}"} {
+		out, err := server.Echo(ctx, &pb.EchoRequest{
+			Response:         &pb.EchoRequest_Content{Content: "hi"},
+			Severity:         pb.Severity_CRITICAL,
+			ResponseTemplate: "{content}-{header:x-foo}{header:x-absent}-{seq}-{severity} {{}}",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out.GetContent() != want {
+			t.Errorf("Echo %d: got content %q, want %q", i, out.GetContent(), want)
+		}
+	}
+}
+
+func TestEcho_responseTemplateInvalid(t *testing.T) {
+	server := NewEchoServer()
+	for _, template := range []string{"{unknown}", "{content", "content}"} {
+		_, err := server.Echo(context.Background(), &pb.EchoRequest{ResponseTemplate: template})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Echo with template %q: got %v, want InvalidArgument", template, err)
+		}
+	}
+}
+
+type sentChatStream struct {
+	reqs []*pb.EchoRequest
+	sent []string
+	pb.Echo_ChatServer
+}
+
+func (m *sentChatStream) Recv() (*pb.EchoRequest, error) {
+	if len(m.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := m.reqs[0]
+	m.reqs = m.reqs[1:]
+	return req, nil
+}
+
+func (m *sentChatStream) Send(r *pb.EchoResponse) error {
+	m.sent = append(m.sent, r.GetContent())
+	return nil
+}
+
+func (m *sentChatStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestChat_responseTemplate(t *testing.T) {
+	stream := &sentChatStream{reqs: []*pb.EchoRequest{
+		{Response: &pb.EchoRequest_Content{Content: "a"}, ResponseTemplate: "{seq}:{content}"},
+		{Response: &pb.EchoRequest_Content{Content: "b"}},
+		{Response: &pb.EchoRequest_Content{Content: "c"}, ResponseTemplate: "{seq}:{content}"},
+	}}
+	if err := NewEchoServer().Chat(stream); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"1:a", "b", "2:c"}; !reflect.DeepEqual(stream.sent, want) {
+		t.Errorf("Chat: sent %v, want %v", stream.sent, want)
+	}
+}
+
 type mockSTS struct {
 	stream grpc.ServerStream
 	t      *testing.T
@@ -103,14 +179,19 @@ func (m *mockSTS) SendHeader(md metadata.MD) error { return m.stream.SendHeader(
 func (m *mockSTS) SetTrailer(md metadata.MD) error { m.stream.SetTrailer(md); return nil }
 
 type mockUnaryStream struct {
-	trail []string
-	t     *testing.T
+	trail  []string
+	header metadata.MD
+	t      *testing.T
 	grpc.ServerStream
 }
 
 func (m *mockUnaryStream) Method() string                   { return "" }
 func (m *mockUnaryStream) Send(resp *pb.EchoResponse) error { return nil }
 func (m *mockUnaryStream) Context() context.Context         { return nil }
+func (m *mockUnaryStream) SetHeader(md metadata.MD) error {
+	m.header = metadata.Join(m.header, md)
+	return nil
+}
 func (m *mockUnaryStream) SetTrailer(md metadata.MD) {
 	m.trail = append(m.trail, md.Get("showcase-trailer")...)
 }
@@ -183,6 +264,10 @@ func (s *errorExpandStream) Send(resp *pb.EchoResponse) error {
 	return s.err
 }
 
+func (s *errorExpandStream) Context() context.Context {
+	return context.Background()
+}
+
 func TestExpand_streamErr(t *testing.T) {
 	e := errors.New("Test Error")
 	stream := &errorExpandStream{err: e}
@@ -268,6 +353,99 @@ func TestCollect(t *testing.T) {
 	}
 }
 
+func TestCollect_failAfter(t *testing.T) {
+	words := []string{"one", "two", "three", "four", "five"}
+	reqs := []*pb.EchoRequest{}
+	for _, word := range words {
+		reqs = append(reqs, &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: word}})
+	}
+	reqs[0].FailAfter = &pb.CollectFailure{RequestCount: 3, Error: &spb.Status{Code: int32(codes.Aborted), Message: "upload interrupted"}}
+	mockStream := &mockCollectStream{reqs: reqs, t: t}
+	err := NewEchoServer().Collect(mockStream)
+	if st := status.Convert(err); st.Code() != codes.Aborted || st.Message() != "upload interrupted" {
+		t.Errorf("Collect failing after 3 requests: got %v, want Aborted", err)
+	}
+	if len(mockStream.reqs) != 2 {
+		t.Errorf("Collect failing after 3 requests: want 2 requests left unread, got %d", len(mockStream.reqs))
+	}
+
+	for _, test := range []struct {
+		name string
+		reqs []*pb.EchoRequest
+	}{
+		{"no count", []*pb.EchoRequest{{FailAfter: &pb.CollectFailure{Error: &spb.Status{Code: int32(codes.Aborted)}}}}},
+		{"no error", []*pb.EchoRequest{{FailAfter: &pb.CollectFailure{RequestCount: 1}}}},
+		{"set late", []*pb.EchoRequest{{}, {FailAfter: &pb.CollectFailure{RequestCount: 1, Error: &spb.Status{Code: int32(codes.Aborted)}}}}},
+	} {
+		err := NewEchoServer().Collect(&mockCollectStream{reqs: test.reqs, t: t})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Collect with fail_after %s: got %v, want InvalidArgument", test.name, err)
+		}
+	}
+}
+
+// closedCollectStream is a Collect stream recording the response it is closed with.
+type closedCollectStream struct {
+	reqs []*pb.EchoRequest
+	resp *pb.EchoResponse
+	pb.Echo_CollectServer
+}
+
+func (m *closedCollectStream) Recv() (*pb.EchoRequest, error) {
+	if len(m.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := m.reqs[0]
+	m.reqs = m.reqs[1:]
+	return req, nil
+}
+
+func (m *closedCollectStream) SendAndClose(r *pb.EchoResponse) error {
+	m.resp = r
+	return nil
+}
+
+func (m *closedCollectStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestCollect_readDelay(t *testing.T) {
+	delay := 10 * time.Millisecond
+	reqs := []*pb.EchoRequest{
+		{Response: &pb.EchoRequest_Content{Content: "slow"}, ReadDelay: ptypes.DurationProto(delay)},
+		{Response: &pb.EchoRequest_Content{Content: "and"}},
+		{Response: &pb.EchoRequest_Content{Content: "steady"}},
+	}
+	size := int64(0)
+	for _, req := range reqs {
+		size += int64(proto.Size(req))
+	}
+	stream := &closedCollectStream{reqs: reqs}
+	start := time.Now()
+	if err := NewEchoServer().Collect(stream); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 3*delay {
+		t.Errorf("Collect reading every %v: want 3 requests read after %v, got %v", delay, 3*delay, elapsed)
+	}
+	if got := stream.resp; got.GetContent() != "slow and steady" || got.GetReceivedMessages() != 3 || got.GetReceivedBytes() != size {
+		t.Errorf("Collect: want 3 requests of %d bytes received, got %v", size, got)
+	}
+
+	for _, test := range []struct {
+		name string
+		reqs []*pb.EchoRequest
+	}{
+		{"negative", []*pb.EchoRequest{{ReadDelay: ptypes.DurationProto(-delay)}}},
+		{"set late", []*pb.EchoRequest{{}, {ReadDelay: ptypes.DurationProto(delay)}}},
+	} {
+		err := NewEchoServer().Collect(&closedCollectStream{reqs: test.reqs})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Collect with read_delay %s: got %v, want InvalidArgument", test.name, err)
+		}
+	}
+}
+
 type errorCollectStream struct {
 	err error
 	pb.Echo_CollectServer
@@ -369,6 +547,10 @@ func (s *errorChatStream) Recv() (*pb.EchoRequest, error) {
 	return nil, s.err
 }
 
+func (s *errorChatStream) Context() context.Context {
+	return context.Background()
+}
+
 func TestChat_streamErr(t *testing.T) {
 	e := errors.New("Test Error")
 	stream := &errorChatStream{err: e}
@@ -468,6 +650,175 @@ func TestPagedExpand(t *testing.T) {
 	}
 }
 
+func TestPagedExpand_signedTokens(t *testing.T) {
+	content := "The rain in Spain falls mainly on the plain!"
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(PageTokensHeader, SignedPageTokens))
+	server := NewEchoServer()
+
+	words := []string{}
+	tokens := []string{}
+	token := ""
+	for {
+		page, err := server.PagedExpand(ctx, &pb.PagedExpandRequest{Content: content, PageSize: 3, PageToken: token})
+		if err != nil {
+			t.Fatalf("PagedExpand with token %q: %v", token, err)
+		}
+		for _, response := range page.GetResponses() {
+			words = append(words, response.GetContent())
+		}
+		token = page.GetNextPageToken()
+		if token == "" {
+			break
+		}
+		if _, err := strconv.Atoi(token); err == nil {
+			t.Errorf("PagedExpand: want an opaque page token, got %q", token)
+		}
+		tokens = append(tokens, token)
+	}
+	if got := strings.Join(words, " "); got != content {
+		t.Errorf("PagedExpand: got %q, want %q", got, content)
+	}
+
+	otherContent, err := NewEchoServer().PagedExpand(ctx, &pb.PagedExpandRequest{Content: content, PageSize: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := []byte(tokens[0])
+	tampered[len(tampered)-1] ^= 1
+	for _, in := range []*pb.PagedExpandRequest{
+		{Content: content, PageToken: "3"},
+		{Content: content, PageToken: string(tampered)},
+		{Content: content, PageToken: otherContent.GetNextPageToken()},
+		{Content: "Different words entirely: a, b, c, d", PageToken: tokens[0]},
+	} {
+		_, err := server.PagedExpand(ctx, in)
+		st := status.Convert(err)
+		reason := ""
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok {
+				reason = info.GetReason()
+			}
+		}
+		if st.Code() != codes.InvalidArgument || reason != PageTokenTamperedReason {
+			t.Errorf("PagedExpand with token %q: want InvalidArgument with reason %s, got %v with reason %q", in.GetPageToken(), PageTokenTamperedReason, err, reason)
+		}
+	}
+
+	badCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(PageTokensHeader, "numeric"))
+	if _, err := server.PagedExpand(badCtx, &pb.PagedExpandRequest{Content: content}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PagedExpand with an unknown %s: want InvalidArgument, got %v", PageTokensHeader, err)
+	}
+}
+
+func TestPagedExpand_expiringTokens(t *testing.T) {
+	clock := server.GetClockInstance()
+	defer clock.Reset()
+	content := "The rain in Spain falls mainly on the plain!"
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(PageTokenTTLHeader, "1m"))
+	echo := NewEchoServer()
+
+	first, err := echo.PagedExpand(ctx, &pb.PagedExpandRequest{Content: content, PageSize: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := strconv.Atoi(first.GetNextPageToken()); err == nil {
+		t.Errorf("PagedExpand with a TTL: want an opaque page token, got %q", first.GetNextPageToken())
+	}
+
+	clock.Advance(30 * time.Second)
+	second, err := echo.PagedExpand(ctx, &pb.PagedExpandRequest{Content: content, PageSize: 3, PageToken: first.GetNextPageToken()})
+	if err != nil {
+		t.Fatalf("PagedExpand with a token within its TTL: %v", err)
+	}
+	if got := second.GetResponses()[0].GetContent(); got != "Spain" {
+		t.Errorf("PagedExpand with a token within its TTL: got %q first, want %q", got, "Spain")
+	}
+
+	clock.Advance(31 * time.Second)
+	_, err = echo.PagedExpand(ctx, &pb.PagedExpandRequest{Content: content, PageSize: 3, PageToken: first.GetNextPageToken()})
+	st := status.Convert(err)
+	reason := ""
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			reason = info.GetReason()
+		}
+	}
+	if st.Code() != codes.FailedPrecondition || reason != PageTokenExpiredReason {
+		t.Errorf("PagedExpand with an expired token: want FailedPrecondition with reason %s, got %v with reason %q", PageTokenExpiredReason, err, reason)
+	}
+
+	// The token issued after the first one expires later.
+	if _, err := echo.PagedExpand(ctx, &pb.PagedExpandRequest{Content: content, PageSize: 3, PageToken: second.GetNextPageToken()}); err != nil {
+		t.Errorf("PagedExpand with a later token within its TTL: %v", err)
+	}
+
+	for _, ttl := range []string{"soon", "0s", "-1m"} {
+		badCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(PageTokenTTLHeader, ttl))
+		if _, err := echo.PagedExpand(badCtx, &pb.PagedExpandRequest{Content: content}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("PagedExpand with a %s of %q: want InvalidArgument, got %v", PageTokenTTLHeader, ttl, err)
+		}
+	}
+}
+
+func TestPagedExpand_directional(t *testing.T) {
+	content := "one two three four five six seven"
+	echo := NewEchoServer()
+	list := func(ctx context.Context, in *pb.PagedExpandRequest) ([]string, []string) {
+		words, tokens := []string{}, []string{}
+		for {
+			page, err := echo.PagedExpand(ctx, in)
+			if err != nil {
+				t.Fatalf("PagedExpand(%v): %v", in, err)
+			}
+			for _, response := range page.GetResponses() {
+				words = append(words, response.GetContent())
+			}
+			if page.GetNextPageToken() == "" {
+				return words, tokens
+			}
+			tokens = append(tokens, page.GetNextPageToken())
+			in = proto.Clone(in).(*pb.PagedExpandRequest)
+			in.PageToken = page.GetNextPageToken()
+		}
+	}
+
+	for _, test := range []struct {
+		in   *pb.PagedExpandRequest
+		want string
+	}{
+		{&pb.PagedExpandRequest{Content: content, PageSize: 3, Reverse: true}, "seven six five four three two one"},
+		// The skip applies to every page requested with it, as paginators resend it.
+		{&pb.PagedExpandRequest{Content: content, PageSize: 2, Skip: 2}, "three four seven"},
+		{&pb.PagedExpandRequest{Content: content, PageSize: 2, Skip: 1, Reverse: true}, "six five three two"},
+		{&pb.PagedExpandRequest{Content: content, Skip: 7}, ""},
+		{&pb.PagedExpandRequest{Content: content, Skip: 100}, ""},
+	} {
+		if got, _ := list(context.Background(), test.in); strings.Join(got, " ") != test.want {
+			t.Errorf("PagedExpand(%v): got %q, want %q", test.in, strings.Join(got, " "), test.want)
+		}
+	}
+
+	// Skipping from a page token skips words after the position the token resumes from.
+	page, err := echo.PagedExpand(context.Background(), &pb.PagedExpandRequest{Content: content, PageSize: 2, PageToken: "2", Skip: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := page.GetResponses(); len(got) != 2 || got[0].GetContent() != "five" || page.GetNextPageToken() != "6" {
+		t.Errorf("PagedExpand skipping 2 from token 2: got %v", page)
+	}
+
+	if _, err := echo.PagedExpand(context.Background(), &pb.PagedExpandRequest{Content: content, Skip: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PagedExpand skipping -1: want InvalidArgument, got %v", err)
+	}
+
+	// Signed tokens do not resume a listing in the other direction.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(PageTokensHeader, SignedPageTokens))
+	_, tokens := list(ctx, &pb.PagedExpandRequest{Content: content, PageSize: 3, Reverse: true})
+	if _, err := echo.PagedExpand(ctx, &pb.PagedExpandRequest{Content: content, PageSize: 3, PageToken: tokens[0]}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PagedExpand forward with a reverse listing's token: want InvalidArgument, got %v", err)
+	}
+}
+
 // NOTE: The TestPagedExpandLegacy*() tests mirror the TestPagedExpand*() tests.
 
 func TestPagedExpandLegacy_invalidArgs(t *testing.T) {
@@ -573,6 +924,103 @@ func TestWait(t *testing.T) {
 	mockStream.verify(true)
 }
 
+func TestNestedWait(t *testing.T) {
+	endTime, _ := ptypes.TimestampProto(time.Now())
+	req := &pb.NestedWaitRequest{EndTime: endTime, Next: &pb.WaitRequest{}}
+	waiter := &mockWaiter{}
+	server := &echoServerImpl{waiter: waiter}
+	mockStream := &mockUnaryStream{t: t}
+	ctx := appendTestOutgoingMetadata(context.Background(), &mockSTS{t: t, stream: mockStream})
+	server.NestedWait(ctx, req)
+	if !proto.Equal(waiter.nestedReq, req) {
+		t.Error("Expected echo.NestedWait to defer to waiter.")
+	}
+	mockStream.verify(true)
+}
+
+func TestWait_invalidProgressInterval(t *testing.T) {
+	server := &echoServerImpl{waiter: &mockWaiter{}}
+	for _, interval := range []time.Duration{0, -time.Second} {
+		req := &pb.WaitRequest{ProgressInterval: ptypes.DurationProto(interval)}
+		if _, err := server.Wait(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Wait with a progress_interval of %v: want InvalidArgument, got %v", interval, err)
+		}
+		nested := &pb.NestedWaitRequest{Next: req}
+		if _, err := server.NestedWait(context.Background(), nested); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("NestedWait with a progress_interval of %v: want InvalidArgument, got %v", interval, err)
+		}
+	}
+}
+
+func TestLongRunningPagedExpand(t *testing.T) {
+	server := NewEchoServer()
+	request := &pb.PagedExpandRequest{Content: "a b c d e", PageSize: 2}
+	op, err := server.LongRunningPagedExpand(context.Background(), &pb.LongRunningPagedExpandRequest{Request: request})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !op.GetDone() {
+		t.Fatalf("LongRunningPagedExpand without a ttl: want the operation done, got %v", op)
+	}
+	first := &pb.PagedExpandResponse{}
+	if err := ptypes.UnmarshalAny(op.GetResponse(), first); err != nil {
+		t.Fatal(err)
+	}
+	if got := first.GetResponses(); len(got) != 2 || got[0].GetContent() != "a" || got[1].GetContent() != "b" {
+		t.Errorf("LongRunningPagedExpand: want the first page [a b], got %v", got)
+	}
+
+	request.PageToken = first.GetNextPageToken()
+	second, err := server.PagedExpand(context.Background(), request)
+	if err != nil {
+		t.Fatalf("PagedExpand with the operation's next_page_token: %v", err)
+	}
+	if got := second.GetResponses(); len(got) != 2 || got[0].GetContent() != "c" || got[1].GetContent() != "d" {
+		t.Errorf("PagedExpand: want the second page [c d], got %v", got)
+	}
+
+	ops := &operationsServerImpl{}
+	got, err := ops.GetOperation(context.Background(), &lropb.GetOperationRequest{Name: op.GetName()})
+	if err != nil || !proto.Equal(got, op) {
+		t.Errorf("GetOperation(%q): want %v, got %v, %v", op.GetName(), op, got, err)
+	}
+}
+
+func TestLongRunningPagedExpand_pending(t *testing.T) {
+	server := NewEchoServer()
+	op, err := server.LongRunningPagedExpand(context.Background(), &pb.LongRunningPagedExpandRequest{
+		Request: &pb.PagedExpandRequest{Content: "a b"},
+		Ttl:     ptypes.DurationProto(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.GetDone() {
+		t.Errorf("LongRunningPagedExpand with a ttl of an hour: want the operation pending, got %v", op)
+	}
+	if err := ptypes.UnmarshalAny(op.GetMetadata(), &pb.WaitMetadata{}); err != nil {
+		t.Errorf("LongRunningPagedExpand: want WaitMetadata, got %v", op.GetMetadata())
+	}
+}
+
+func TestLongRunningPagedExpand_invalid(t *testing.T) {
+	server := NewEchoServer()
+	for _, in := range []*pb.LongRunningPagedExpandRequest{
+		{},
+		{Request: &pb.PagedExpandRequest{Content: "a b", PageSize: -1}},
+		{Request: &pb.PagedExpandRequest{Content: "a b", PageToken: "5"}},
+	} {
+		if _, err := server.LongRunningPagedExpand(context.Background(), in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("LongRunningPagedExpand(%v): want InvalidArgument, got %v", in, err)
+		}
+	}
+	ops := &operationsServerImpl{}
+	_, err := ops.GetOperation(context.Background(), &lropb.GetOperationRequest{Name: pagedExpandOperationPrefix + "!"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetOperation of a malformed name: want NotFound, got %v", err)
+	}
+}
+
 func TestBlockSuccess(t *testing.T) {
 	tests := []struct {
 		seconds int64
@@ -645,3 +1093,507 @@ func appendTestOutgoingMetadata(ctx context.Context, stream grpc.ServerTransport
 	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("showcase-trailer", "show", "showcase-trailer", "case", "trailer", "trail"))
 	return ctx
 }
+
+func TestEcho_responseDelay(t *testing.T) {
+	server := NewEchoServer()
+	delay := 20 * time.Millisecond
+	withHeader := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ResponseDelayHeader, "20ms"))
+	for _, testCase := range []struct {
+		name string
+		ctx  context.Context
+		in   *pb.EchoRequest
+	}{
+		{"field", context.Background(), &pb.EchoRequest{ResponseDelay: ptypes.DurationProto(delay)}},
+		{"header", withHeader, &pb.EchoRequest{}},
+	} {
+		start := time.Now()
+		if _, err := server.Echo(testCase.ctx, testCase.in); err != nil {
+			t.Fatalf("%s: unexpected err %v", testCase.name, err)
+		}
+		if elapsed := time.Since(start); elapsed < delay {
+			t.Errorf("%s: want a response after %v, got one after %v", testCase.name, delay, elapsed)
+		}
+	}
+
+	// The field takes precedence over the header.
+	start := time.Now()
+	if _, err := server.Echo(withHeader, &pb.EchoRequest{ResponseDelay: &durpb.Duration{}}); err != nil || time.Since(start) >= delay {
+		t.Errorf("want no delay when response_delay is zero, got %v after %v", err, time.Since(start))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := server.Echo(ctx, &pb.EchoRequest{ResponseDelay: ptypes.DurationProto(time.Minute)})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("want DeadlineExceeded for a delay past the deadline, got %v", err)
+	}
+
+	for _, testCase := range []struct {
+		ctx context.Context
+		in  *pb.EchoRequest
+	}{
+		{context.Background(), &pb.EchoRequest{ResponseDelay: &durpb.Duration{Seconds: -1}}},
+		{metadata.NewIncomingContext(context.Background(), metadata.Pairs(ResponseDelayHeader, "soon")), &pb.EchoRequest{}},
+	} {
+		if _, err := server.Echo(testCase.ctx, testCase.in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Echo(%v): want InvalidArgument for an invalid delay, got %v", testCase.in, err)
+		}
+	}
+}
+
+func TestExpand_responseDelay(t *testing.T) {
+	delay := 10 * time.Millisecond
+	stream := &mockExpandStream{exp: []string{"a", "b", "c"}, t: t}
+	start := time.Now()
+	err := NewEchoServer().Expand(&pb.ExpandRequest{Content: "a b c", ResponseDelay: ptypes.DurationProto(delay)}, stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 3*delay {
+		t.Errorf("want 3 words sent after %v each, got them after %v", delay, elapsed)
+	}
+}
+
+func TestExpand_jitter(t *testing.T) {
+	jitter := 20 * time.Millisecond
+	seed := int64(42)
+	want := time.Duration(0)
+	r := rand.New(rand.NewSource(seed))
+	for i := 0; i < 3; i++ {
+		want += time.Duration(r.Int63n(int64(jitter)))
+	}
+
+	stream := &mockExpandStream{exp: []string{"a", "b", "c"}, t: t}
+	start := time.Now()
+	err := NewEchoServer().Expand(&pb.ExpandRequest{Content: "a b c", Jitter: ptypes.DurationProto(jitter), JitterSeed: seed}, stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < want {
+		t.Errorf("want 3 words sent after %v of jitter in all, got them after %v", want, elapsed)
+	}
+}
+
+func TestExpand_invalidJitter(t *testing.T) {
+	stream := &mockExpandStream{t: t}
+	err := NewEchoServer().Expand(&pb.ExpandRequest{Content: "a", Jitter: ptypes.DurationProto(-time.Second)}, stream)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expand with a negative jitter: want InvalidArgument, got %v", err)
+	}
+}
+
+// droppedExpandStream is an Expand stream dropping after it sends limit words, unless limit is
+// negative.
+type droppedExpandStream struct {
+	limit int
+	sent  []*pb.EchoResponse
+	pb.Echo_ExpandServer
+}
+
+func (s *droppedExpandStream) Send(resp *pb.EchoResponse) error {
+	if len(s.sent) == s.limit {
+		return status.Error(codes.Unavailable, "stream dropped")
+	}
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+func (s *droppedExpandStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestExpand_resume(t *testing.T) {
+	content := "the quick brown fox jumps"
+	server := NewEchoServer()
+	dropped := &droppedExpandStream{limit: 2}
+	if err := server.Expand(&pb.ExpandRequest{Content: content}, dropped); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Expand on a dropped stream: want Unavailable, got %v", err)
+	}
+	token := dropped.sent[len(dropped.sent)-1].GetStreamResumeToken()
+	if token == "" {
+		t.Fatal("Expand: want words with a stream_resume_token")
+	}
+
+	stream := &mockExpandStream{exp: []string{"brown", "fox", "jumps"}, t: t}
+	if err := server.Expand(&pb.ExpandRequest{Content: content, StreamResumeToken: token}, stream); err != nil {
+		t.Fatal(err)
+	}
+	stream.verify(false)
+
+	// Resuming after the last word sends nothing.
+	resumed := &droppedExpandStream{limit: -1}
+	if err := server.Expand(&pb.ExpandRequest{Content: content}, resumed); err != nil {
+		t.Fatal(err)
+	}
+	last := resumed.sent[len(resumed.sent)-1].GetStreamResumeToken()
+	stream = &mockExpandStream{t: t}
+	if err := server.Expand(&pb.ExpandRequest{Content: content, StreamResumeToken: last}, stream); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, in := range []*pb.ExpandRequest{
+		{Content: "other content", StreamResumeToken: token},
+		{Content: content, StreamResumeToken: "garbage"},
+		{Content: content, StreamResumeToken: expandResumeToken(content, 6)},
+	} {
+		if err := server.Expand(in, &mockExpandStream{t: t}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expand(%v): want InvalidArgument, got %v", in, err)
+		}
+	}
+}
+
+func TestExpand_failAfter(t *testing.T) {
+	aborted := &spb.Status{Code: int32(codes.Aborted), Message: "stream interrupted"}
+	for _, test := range []struct {
+		in   *pb.ExpandRequest
+		want []string
+	}{
+		{&pb.ExpandRequest{Content: "a b c d", FailAfter: &pb.StreamFailure{ResponseCount: 2, Error: aborted}}, []string{"a", "b"}},
+		{&pb.ExpandRequest{Content: "a b c d", FailAfter: &pb.StreamFailure{Error: aborted}}, []string{}},
+		{&pb.ExpandRequest{Content: "a b", FailAfter: &pb.StreamFailure{ResponseCount: 5, Error: aborted}}, []string{"a", "b"}},
+		{&pb.ExpandRequest{
+			Content:           "a b c d",
+			StreamResumeToken: expandResumeToken("a b c d", 1),
+			FailAfter:         &pb.StreamFailure{ResponseCount: 2, Error: aborted},
+		}, []string{"b", "c"}},
+	} {
+		stream := &droppedExpandStream{limit: -1}
+		err := NewEchoServer().Expand(test.in, stream)
+		if st := status.Convert(err); st.Code() != codes.Aborted || st.Message() != "stream interrupted" {
+			t.Errorf("Expand(%v): want Aborted, got %v", test.in, err)
+		}
+		got := []string{}
+		for _, resp := range stream.sent {
+			got = append(got, resp.GetContent())
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Expand(%v): want words %v before failing, got %v", test.in, test.want, got)
+		}
+	}
+
+	for _, failure := range []*pb.StreamFailure{
+		{ResponseCount: -1, Error: aborted},
+		{ResponseCount: 1},
+	} {
+		err := NewEchoServer().Expand(&pb.ExpandRequest{Content: "a", FailAfter: failure}, &droppedExpandStream{limit: -1})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expand failing after %v: want InvalidArgument, got %v", failure, err)
+		}
+	}
+}
+
+func TestChat_failAfterResponses(t *testing.T) {
+	reqs := []*pb.EchoRequest{}
+	for _, word := range []string{"one", "two", "three", "four"} {
+		reqs = append(reqs, &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: word}})
+	}
+	reqs[0].FailAfterResponses = &pb.StreamFailure{ResponseCount: 2, Error: &spb.Status{Code: int32(codes.Unavailable)}}
+	stream := &sentChatStream{reqs: reqs}
+	if err := NewEchoServer().Chat(stream); status.Code(err) != codes.Unavailable {
+		t.Errorf("Chat failing after 2 responses: want Unavailable, got %v", err)
+	}
+	if want := []string{"one", "two"}; !reflect.DeepEqual(stream.sent, want) {
+		t.Errorf("Chat failing after 2 responses: want %v sent, got %v", want, stream.sent)
+	}
+	if len(stream.reqs) != 2 {
+		t.Errorf("Chat failing after 2 responses: want 2 requests left unread, got %d", len(stream.reqs))
+	}
+
+	stream = &sentChatStream{reqs: []*pb.EchoRequest{{
+		Response:           &pb.EchoRequest_Content{Content: "one"},
+		FailAfterResponses: &pb.StreamFailure{Error: &spb.Status{Code: int32(codes.Unavailable)}},
+	}}}
+	if err := NewEchoServer().Chat(stream); status.Code(err) != codes.Unavailable || len(stream.sent) != 0 {
+		t.Errorf("Chat failing after 0 responses: want Unavailable before any response, got %v after %v", err, stream.sent)
+	}
+
+	failure := &pb.StreamFailure{ResponseCount: 1, Error: &spb.Status{Code: int32(codes.Unavailable)}}
+	for _, test := range []struct {
+		name string
+		reqs []*pb.EchoRequest
+	}{
+		{"no error", []*pb.EchoRequest{{FailAfterResponses: &pb.StreamFailure{ResponseCount: 1}}}},
+		{"negative count", []*pb.EchoRequest{{FailAfterResponses: &pb.StreamFailure{ResponseCount: -1, Error: failure.Error}}}},
+		{"set late", []*pb.EchoRequest{{}, {FailAfterResponses: failure}}},
+	} {
+		err := NewEchoServer().Chat(&sentChatStream{reqs: test.reqs})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Chat with fail_after_responses %s: got %v, want InvalidArgument", test.name, err)
+		}
+	}
+}
+
+func TestChat_responseDelay(t *testing.T) {
+	delay := 10 * time.Millisecond
+	stream := &sentChatStream{reqs: []*pb.EchoRequest{
+		{Response: &pb.EchoRequest_Content{Content: "a"}, ResponseDelay: ptypes.DurationProto(delay)},
+		{Response: &pb.EchoRequest_Content{Content: "b"}, ResponseDelay: ptypes.DurationProto(delay)},
+	}}
+	start := time.Now()
+	if err := NewEchoServer().Chat(stream); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 2*delay || len(stream.sent) != 2 {
+		t.Errorf("want 2 responses sent after %v each, got %v after %v", delay, stream.sent, elapsed)
+	}
+}
+
+func TestEchoHeaders(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+		"x-goog-request-params": {"name=users%2F1"},
+		"user-agent":            {"showcase-test/1.0"},
+		"x-goog-api-client":     {"gl-go/1.16 gapic/0.1"},
+		"trace-bin":             {"\x00\xff"},
+	})
+	resp, err := NewEchoServer().EchoHeaders(ctx, &pb.EchoHeadersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.EchoHeadersResponse{Headers: []*pb.EchoHeadersResponse_Header{
+		{Name: "trace-bin", Values: []string{"AP8="}},
+		{Name: "user-agent", Values: []string{"showcase-test/1.0"}},
+		{Name: "x-goog-api-client", Values: []string{"gl-go/1.16 gapic/0.1"}},
+		{Name: "x-goog-request-params", Values: []string{"name=users%2F1"}},
+	}}
+	if !proto.Equal(resp, want) {
+		t.Errorf("EchoHeaders: got %v, want %v", resp, want)
+	}
+
+	resp, err = NewEchoServer().EchoHeaders(ctx, &pb.EchoHeadersRequest{Names: []string{"X-Goog-Request-Params", "missing"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = &pb.EchoHeadersResponse{Headers: []*pb.EchoHeadersResponse_Header{
+		{Name: "x-goog-request-params", Values: []string{"name=users%2F1"}},
+	}}
+	if !proto.Equal(resp, want) {
+		t.Errorf("EchoHeaders of some names: got %v, want %v", resp, want)
+	}
+}
+
+func TestProbeDeadline(t *testing.T) {
+	s := NewEchoServer()
+	resp, err := s.ProbeDeadline(context.Background(), &pb.ProbeDeadlineRequest{})
+	if err != nil || resp.GetHasDeadline() || resp.GetDeadline() != nil {
+		t.Errorf("ProbeDeadline without a deadline: got %v, %v", resp, err)
+	}
+
+	deadline := time.Now().Add(time.Minute)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	resp, err = s.ProbeDeadline(ctx, &pb.ProbeDeadlineRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.GetHasDeadline() || !resp.GetDeadline().AsTime().Equal(deadline) {
+		t.Errorf("ProbeDeadline: got deadline %v, want %v", resp.GetDeadline().AsTime(), deadline)
+	}
+	if remaining := resp.GetRemaining().AsDuration(); remaining <= 0 || remaining > time.Minute {
+		t.Errorf("ProbeDeadline: got %v remaining, want up to a minute", remaining)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.ProbeDeadline(ctx, &pb.ProbeDeadlineRequest{ResponseDelay: ptypes.DurationProto(time.Minute)})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("ProbeDeadline delayed past its deadline: want DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestGeneratePayload(t *testing.T) {
+	s := NewEchoServer()
+	resp, err := s.GeneratePayload(context.Background(), &pb.GeneratePayloadRequest{Size: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetChunks()) != 1 || string(resp.GetChunks()[0]) != "abcdefghijklmnopqrstuvwxyzabcd" || resp.GetSize() != 30 {
+		t.Errorf("GeneratePayload of 30 bytes: got %v", resp)
+	}
+
+	resp, err = s.GeneratePayload(context.Background(), &pb.GeneratePayloadRequest{Size: 1000, ChunkCount: 7, Random: true, Seed: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+	total := 0
+	for _, chunk := range resp.GetChunks() {
+		if len(chunk) != 142 && len(chunk) != 143 {
+			t.Errorf("GeneratePayload in 7 chunks: got a chunk of %d bytes", len(chunk))
+		}
+		total += len(chunk)
+	}
+	if len(resp.GetChunks()) != 7 || total != 1000 {
+		t.Errorf("GeneratePayload in 7 chunks: got %d chunks of %d bytes in all", len(resp.GetChunks()), total)
+	}
+	again, err := s.GeneratePayload(context.Background(), &pb.GeneratePayloadRequest{Size: 1000, ChunkCount: 7, Random: true, Seed: 42})
+	if err != nil || !proto.Equal(resp, again) {
+		t.Errorf("GeneratePayload with the same seed: want the same payload, got %v", err)
+	}
+
+	for _, in := range []*pb.GeneratePayloadRequest{
+		{Size: -1},
+		{Size: maxGeneratedPayload + 1},
+		{Size: 10, ChunkCount: -1},
+		{Size: 10, ChunkCount: maxGeneratedChunks + 1},
+	} {
+		if _, err := s.GeneratePayload(context.Background(), in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GeneratePayload(%v): want InvalidArgument, got %v", in, err)
+		}
+	}
+}
+
+// pushedChatStream is a Chat stream whose client sends one request, then half-closes the stream
+// once release is closed, unless its context is done first.
+type pushedChatStream struct {
+	ctx     context.Context
+	release chan struct{}
+	sentReq bool
+
+	mu   sync.Mutex
+	sent []string
+	pb.Echo_ChatServer
+}
+
+func (m *pushedChatStream) Recv() (*pb.EchoRequest, error) {
+	if !m.sentReq {
+		m.sentReq = true
+		return &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hello"}}, nil
+	}
+	select {
+	case <-m.release:
+		return nil, io.EOF
+	case <-m.ctx.Done():
+		return nil, status.FromContextError(m.ctx.Err()).Err()
+	}
+}
+
+func (m *pushedChatStream) Send(r *pb.EchoResponse) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, r.GetContent())
+	return nil
+}
+
+func (m *pushedChatStream) Context() context.Context {
+	return m.ctx
+}
+
+func TestChat_pushes(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		ChatPushCountHeader, "3",
+		ChatPushIntervalHeader, "1ms"))
+	stream := &pushedChatStream{ctx: ctx, release: make(chan struct{})}
+	// The client half-closes straight away: the call still ends only after the pushes.
+	close(stream.release)
+	if err := NewEchoServer().Chat(stream); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"hello", "push 1 of 3", "push 2 of 3", "push 3 of 3"}
+	if !reflect.DeepEqual(stream.sent, want) {
+		t.Errorf("Chat with pushes: sent %v, want %v", stream.sent, want)
+	}
+
+	// Pushes stop with the call.
+	ctx, cancel := context.WithCancel(metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		ChatPushCountHeader, "1000",
+		ChatPushIntervalHeader, "1ms")))
+	stream = &pushedChatStream{ctx: ctx, release: make(chan struct{})}
+	time.AfterFunc(20*time.Millisecond, cancel)
+	if err := NewEchoServer().Chat(stream); status.Code(err) != codes.Canceled {
+		t.Errorf("Chat canceled while pushing: want Canceled, got %v", err)
+	}
+	stream.mu.Lock()
+	sent := len(stream.sent)
+	stream.mu.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+	if len(stream.sent) != sent {
+		t.Errorf("Chat canceled while pushing: pushed %d more messages after the call ended", len(stream.sent)-sent)
+	}
+
+	for _, md := range []metadata.MD{
+		metadata.Pairs(ChatPushCountHeader, "many"),
+		metadata.Pairs(ChatPushCountHeader, "-1"),
+		metadata.Pairs(ChatPushCountHeader, "1", ChatPushIntervalHeader, "soon"),
+		metadata.Pairs(ChatPushCountHeader, "1", ChatPushIntervalHeader, "0s"),
+		metadata.Pairs(ChatPushCountHeader, "1", ChatPushIntervalHeader, "-1s"),
+	} {
+		stream := &pushedChatStream{ctx: metadata.NewIncomingContext(context.Background(), md), release: make(chan struct{})}
+		if err := NewEchoServer().Chat(stream); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Chat with %v: want InvalidArgument, got %v", md, err)
+		}
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	s := NewEchoServer()
+	resp, err := s.FanOut(context.Background(), &pb.FanOutRequest{
+		TaskCount:    6,
+		Concurrency:  2,
+		TaskDuration: ptypes.DurationProto(5 * time.Millisecond),
+		Failures: []*pb.FanOutRequest_Failure{
+			{Index: 1, Error: &spb.Status{Code: int32(codes.NotFound), Message: "no item 1"}},
+			{Index: 4, Error: &spb.Status{Code: int32(codes.Unavailable), Message: "item 4 down"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(resp.GetResults()); got != 6 {
+		t.Fatalf("FanOut of 6 tasks: got %d results", got)
+	}
+	for idx, result := range resp.GetResults() {
+		want := codes.OK
+		switch idx {
+		case 1:
+			want = codes.NotFound
+		case 4:
+			want = codes.Unavailable
+		}
+		if result.GetIndex() != int32(idx) || codes.Code(result.GetStatus().GetCode()) != want {
+			t.Errorf("FanOut result %d: want index %d with %v, got %v", idx, idx, want, result)
+		}
+		if wantContent := fmt.Sprintf("task %d", idx); want == codes.OK && result.GetContent() != wantContent {
+			t.Errorf("FanOut result %d: want content %q, got %q", idx, wantContent, result.GetContent())
+		}
+	}
+	if resp.GetSucceededCount() != 4 || resp.GetFailedCount() != 2 {
+		t.Errorf("FanOut with 2 failures: got %d succeeded and %d failed", resp.GetSucceededCount(), resp.GetFailedCount())
+	}
+	if peak := resp.GetPeakConcurrency(); peak < 1 || peak > 2 {
+		t.Errorf("FanOut with a concurrency of 2: got a peak concurrency of %d", peak)
+	}
+
+	_, err = s.FanOut(context.Background(), &pb.FanOutRequest{
+		TaskCount:   3,
+		Failures:    []*pb.FanOutRequest_Failure{{Index: 2, Error: &spb.Status{Code: int32(codes.Aborted)}}},
+		FailOnError: true,
+	})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("FanOut failing on errors: want Aborted, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = s.FanOut(ctx, &pb.FanOutRequest{TaskCount: 100, Concurrency: 1, TaskDuration: ptypes.DurationProto(time.Second)})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("FanOut past its deadline: want DeadlineExceeded, got %v", err)
+	}
+
+	for _, in := range []*pb.FanOutRequest{
+		{TaskCount: -1},
+		{TaskCount: maxFanOutTasks + 1},
+		{TaskCount: 1, Concurrency: -1},
+		{TaskCount: 1, TaskDuration: ptypes.DurationProto(-time.Second)},
+		{TaskCount: 1, Failures: []*pb.FanOutRequest_Failure{{Index: 1, Error: &spb.Status{Code: int32(codes.Internal)}}}},
+		{TaskCount: 1, Failures: []*pb.FanOutRequest_Failure{{Index: 0}}},
+		{TaskCount: 2, Failures: []*pb.FanOutRequest_Failure{
+			{Index: 0, Error: &spb.Status{Code: int32(codes.Internal)}},
+			{Index: 0, Error: &spb.Status{Code: int32(codes.Internal)}},
+		}},
+	} {
+		if _, err := s.FanOut(context.Background(), in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("FanOut(%v): want InvalidArgument, got %v", in, err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewFailoverServer returns a new FailoverServer for the Showcase API, reflecting and driving
+// the state held by coordinator.
+func NewFailoverServer(coordinator *server.FailoverCoordinator) pb.FailoverServer {
+	return &failoverServerImpl{coordinator: coordinator}
+}
+
+type failoverServerImpl struct {
+	coordinator *server.FailoverCoordinator
+}
+
+func (s *failoverServerImpl) GetFailoverState(context.Context, *pb.GetFailoverStateRequest) (*pb.FailoverState, error) {
+	return s.coordinator.State(), nil
+}
+
+func (s *failoverServerImpl) TriggerFailover(ctx context.Context, _ *pb.TriggerFailoverRequest) (*pb.FailoverState, error) {
+	peer, term, err := s.coordinator.BeginFailover()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.DialContext(ctx, peer, grpc.WithInsecure())
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "could not reach failover peer %s: %v", peer, err)
+	}
+	defer conn.Close()
+	if _, err := pb.NewFailoverClient(conn).Handoff(ctx, &pb.HandoffRequest{Term: term}); err != nil {
+		return nil, status.Errorf(status.Code(err), "failover peer %s refused handoff: %v", peer, status.Convert(err).Message())
+	}
+
+	return s.coordinator.Demote(term), nil
+}
+
+func (s *failoverServerImpl) Handoff(_ context.Context, in *pb.HandoffRequest) (*pb.FailoverState, error) {
+	return s.coordinator.Promote(in.GetTerm())
+}
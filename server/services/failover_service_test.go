@@ -0,0 +1,58 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFailover(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	standby := server.NewFailoverCoordinator(pb.FailoverState_STANDBY, "leader")
+	s := grpc.NewServer(grpc.UnaryInterceptor(standby.UnaryInterceptor))
+	pb.RegisterFailoverServer(s, NewFailoverServer(standby))
+	go s.Serve(lis)
+	defer s.Stop()
+
+	leader := server.NewFailoverCoordinator(pb.FailoverState_LEADER, lis.Addr().String())
+	leaderServer := NewFailoverServer(leader)
+
+	state, err := leaderServer.TriggerFailover(context.Background(), &pb.TriggerFailoverRequest{})
+	if err != nil {
+		t.Fatalf("TriggerFailover: unexpected err %v", err)
+	}
+	if state.GetRole() != pb.FailoverState_STANDBY || state.GetTerm() != 1 {
+		t.Errorf("TriggerFailover: former leader should be standby in term 1, got %v", state)
+	}
+	if got := standby.State(); got.GetRole() != pb.FailoverState_LEADER || got.GetTerm() != 1 {
+		t.Errorf("TriggerFailover: peer should lead in term 1, got %v", got)
+	}
+
+	_, err = leaderServer.TriggerFailover(context.Background(), &pb.TriggerFailoverRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("TriggerFailover on standby: want FailedPrecondition, got %v", err)
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxFixtures is the largest number of resources of each kind a single CreateFixtures call may
+// create.
+const maxFixtures = 10000
+
+// fixtureIndexPlaceholder is replaced in the string fields of fixture templates with the index
+// of the resource being created.
+const fixtureIndexPlaceholder = "{index}"
+
+// maxContentionRate is the largest number of times per second a contention may mutate each
+// resource.
+const maxContentionRate = 1000
+
+// NewFixturesServer returns a new FixturesServer for the Showcase API, creating resources with
+// the given identity and messaging servers, and resetting the state of each scope with its
+// resetter.
+func NewFixturesServer(identityServer pb.IdentityServer, messagingServer pb.MessagingServer, resetters map[pb.ResetStateRequest_Scope]Resetter) pb.FixturesServer {
+	return &fixturesServerImpl{
+		identityServer:  identityServer,
+		messagingServer: messagingServer,
+		resetters:       resetters,
+		contentions:     map[string]*contention{},
+	}
+}
+
+type fixturesServerImpl struct {
+	identityServer  pb.IdentityServer
+	messagingServer pb.MessagingServer
+	resetters       map[pb.ResetStateRequest_Scope]Resetter
+
+	contentionUID server.UniqID
+	contentionMu  sync.Mutex
+	contentions   map[string]*contention
+}
+
+// contention is the state of the background mutations started by a StartContention call.
+type contention struct {
+	mu    sync.Mutex
+	state *pb.Contention
+	stop  func()
+	done  chan struct{}
+}
+
+func (s *fixturesServerImpl) CreateFixtures(ctx context.Context, in *pb.CreateFixturesRequest) (*pb.CreateFixturesResponse, error) {
+	blurbs := in.GetRoomCount()*in.GetBlurbsPerRoom() + in.GetUserCount()*in.GetBlurbsPerProfile()
+	for _, limit := range []struct {
+		field string
+		count int32
+	}{
+		{"user_count", in.GetUserCount()},
+		{"room_count", in.GetRoomCount()},
+		{"blurbs_per_room", in.GetBlurbsPerRoom()},
+		{"blurbs_per_profile", in.GetBlurbsPerProfile()},
+		{"the total number of blurbs", blurbs},
+	} {
+		if limit.count < 0 || limit.count > maxFixtures {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must be between 0 and %d, got %d", limit.field, maxFixtures, limit.count)
+		}
+	}
+	if in.GetBlurbsPerRoom() > 0 && in.GetUserCount() == 0 && in.GetBlurbTemplate().GetUser() == "" {
+		return nil, status.Error(codes.InvalidArgument, "blurbs posted in rooms need users to be created or the user of blurb_template to be set")
+	}
+
+	created := &pb.CreateFixturesResponse{}
+	for i := 0; i < int(in.GetUserCount()); i++ {
+		user, err := s.identityServer.CreateUser(ctx, &pb.CreateUserRequest{User: fromTemplate(in.GetUserTemplate(), i).(*pb.User)})
+		if err != nil {
+			return nil, withCreatedFixtures(err, created)
+		}
+		created.Users = append(created.Users, user.GetName())
+	}
+	for i := 0; i < int(in.GetRoomCount()); i++ {
+		room, err := s.messagingServer.CreateRoom(ctx, &pb.CreateRoomRequest{Room: fromTemplate(in.GetRoomTemplate(), i).(*pb.Room)})
+		if err != nil {
+			return nil, withCreatedFixtures(err, created)
+		}
+		created.Rooms = append(created.Rooms, room.GetName())
+	}
+
+	createBlurb := func(parent, user string) error {
+		blurb := fromTemplate(in.GetBlurbTemplate(), len(created.Blurbs)).(*pb.Blurb)
+		if blurb.GetUser() == "" {
+			blurb.User = user
+		}
+		blurb, err := s.messagingServer.CreateBlurb(ctx, &pb.CreateBlurbRequest{Parent: parent, Blurb: blurb})
+		if err != nil {
+			return err
+		}
+		created.Blurbs = append(created.Blurbs, blurb.GetName())
+		return nil
+	}
+	for _, room := range created.GetRooms() {
+		for i := 0; i < int(in.GetBlurbsPerRoom()); i++ {
+			user := ""
+			if len(created.GetUsers()) > 0 {
+				user = created.GetUsers()[len(created.GetBlurbs())%len(created.GetUsers())]
+			}
+			if err := createBlurb(room, user); err != nil {
+				return nil, withCreatedFixtures(err, created)
+			}
+		}
+	}
+	for _, user := range created.GetUsers() {
+		for i := 0; i < int(in.GetBlurbsPerProfile()); i++ {
+			if err := createBlurb(user+"/profile", user); err != nil {
+				return nil, withCreatedFixtures(err, created)
+			}
+		}
+	}
+	return created, nil
+}
+
+func (s *fixturesServerImpl) ResetState(_ context.Context, in *pb.ResetStateRequest) (*pb.ResetStateResponse, error) {
+	scopes := in.GetScopes()
+	if len(scopes) == 0 {
+		for value := range pb.ResetStateRequest_Scope_name {
+			if scope := pb.ResetStateRequest_Scope(value); scope != pb.ResetStateRequest_SCOPE_UNSPECIFIED {
+				scopes = append(scopes, scope)
+			}
+		}
+		sort.Slice(scopes, func(i, j int) bool { return scopes[i] < scopes[j] })
+	}
+	for _, scope := range scopes {
+		if _, ok := s.resetters[scope]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "scope %s cannot be reset", scope)
+		}
+	}
+
+	reset := &pb.ResetStateResponse{}
+	done := map[pb.ResetStateRequest_Scope]bool{}
+	for _, scope := range scopes {
+		if done[scope] {
+			continue
+		}
+		s.resetters[scope].ResetState()
+		done[scope] = true
+		reset.Scopes = append(reset.Scopes, scope)
+	}
+	return reset, nil
+}
+
+func (s *fixturesServerImpl) StartContention(ctx context.Context, in *pb.StartContentionRequest) (*pb.Contention, error) {
+	if len(in.GetResources()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "resources must name at least one user or room")
+	}
+	rate := in.GetMutationsPerSecond()
+	if !(rate > 0 && rate <= maxContentionRate) {
+		return nil, status.Errorf(codes.InvalidArgument, "mutations_per_second must be between 0 and %d, got %v", maxContentionRate, rate)
+	}
+	for _, name := range in.GetResources() {
+		if _, err := s.read(ctx, name); err != nil {
+			return nil, err
+		}
+	}
+
+	mutateCtx, stop := context.WithCancel(context.Background())
+	c := &contention{
+		state: &pb.Contention{
+			Name:               fmt.Sprintf("contentions/%d", s.contentionUID.Next()),
+			Resources:          in.GetResources(),
+			MutationsPerSecond: rate,
+			Active:             true,
+		},
+		done: make(chan struct{}),
+	}
+	if in.GetDuration() != nil {
+		if err := in.GetDuration().CheckValid(); err != nil || in.GetDuration().AsDuration() <= 0 {
+			stop()
+			return nil, status.Errorf(codes.InvalidArgument, "duration must be positive, got %v", in.GetDuration().AsDuration())
+		}
+		expire := time.Now().Add(in.GetDuration().AsDuration())
+		c.state.ExpireTime = timestamppb.New(expire)
+		mutateCtx, stop = context.WithDeadline(mutateCtx, expire)
+	}
+	c.stop = stop
+
+	s.contentionMu.Lock()
+	s.contentions[c.state.GetName()] = c
+	s.contentionMu.Unlock()
+	go s.contend(mutateCtx, c)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return proto.Clone(c.state).(*pb.Contention), nil
+}
+
+func (s *fixturesServerImpl) StopContention(_ context.Context, in *pb.StopContentionRequest) (*pb.Contention, error) {
+	s.contentionMu.Lock()
+	c, ok := s.contentions[in.GetName()]
+	s.contentionMu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "A contention with name %s not found.", in.GetName())
+	}
+	c.stop()
+	<-c.done
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return proto.Clone(c.state).(*pb.Contention), nil
+}
+
+// contend mutates the resources of c at its rate until ctx is done.
+func (s *fixturesServerImpl) contend(ctx context.Context, c *contention) {
+	defer close(c.done)
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / c.state.GetMutationsPerSecond()))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, name := range c.state.GetResources() {
+				if s.mutate(ctx, name) {
+					c.mu.Lock()
+					c.state.MutationCount++
+					c.mu.Unlock()
+				}
+			}
+		case <-ctx.Done():
+			c.mu.Lock()
+			c.state.Active = false
+			c.mu.Unlock()
+			return
+		}
+	}
+}
+
+// read returns the user or room named name.
+func (s *fixturesServerImpl) read(ctx context.Context, name string) (proto.Message, error) {
+	switch {
+	case strings.HasPrefix(name, "users/"):
+		return s.identityServer.GetUser(ctx, &pb.GetUserRequest{Name: name})
+	case strings.HasPrefix(name, "rooms/"):
+		return s.messagingServer.GetRoom(ctx, &pb.GetRoomRequest{Name: name})
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "%q is neither a user nor a room", name)
+}
+
+// mutate rewrites the user or room named name as it is, changing its etag, and returns whether
+// it did. Resources deleted since the contention started are left alone.
+func (s *fixturesServerImpl) mutate(ctx context.Context, name string) bool {
+	resource, err := s.read(ctx, name)
+	if err != nil {
+		return false
+	}
+	switch resource := proto.Clone(resource).(type) {
+	case *pb.User:
+		resource.Etag = ""
+		_, err = s.identityServer.UpdateUser(ctx, &pb.UpdateUserRequest{User: resource})
+	case *pb.Room:
+		resource.Etag = ""
+		_, err = s.messagingServer.UpdateRoom(ctx, &pb.UpdateRoomRequest{Room: resource})
+	}
+	return err == nil
+}
+
+// fromTemplate returns a copy of template with every occurrence of fixtureIndexPlaceholder in
+// its string fields replaced with index.
+func fromTemplate(template proto.Message, index int) proto.Message {
+	m := template.ProtoReflect()
+	if m.IsValid() {
+		m = proto.Clone(template).ProtoReflect()
+	} else {
+		m = m.New()
+	}
+	value := strconv.Itoa(index)
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated {
+			m.Set(fd, protoreflect.ValueOfString(strings.ReplaceAll(v.String(), fixtureIndexPlaceholder, value)))
+		}
+		return true
+	})
+	return m.Interface()
+}
+
+// withCreatedFixtures adds the resources created so far to the details of err.
+func withCreatedFixtures(err error, created *pb.CreateFixturesResponse) error {
+	s, _ := status.FromError(err)
+	spb := s.Proto()
+	spb.Message = fmt.Sprintf("creating fixtures: %s", spb.GetMessage())
+
+	details, err := ptypes.MarshalAny(created)
+	if err == nil {
+		spb.Details = append(spb.Details, details)
+	}
+
+	return status.ErrorProto(spb)
+}
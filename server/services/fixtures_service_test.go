@@ -0,0 +1,270 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestCreateFixtures(t *testing.T) {
+	identityServer := NewIdentityServer()
+	messagingServer := NewMessagingServer(identityServer)
+	s := NewFixturesServer(identityServer, messagingServer, nil)
+	ctx := context.Background()
+
+	created, err := s.CreateFixtures(ctx, &pb.CreateFixturesRequest{
+		UserCount:        3,
+		UserTemplate:     &pb.User{DisplayName: "user {index}", Email: "user{index}@example.com"},
+		RoomCount:        2,
+		RoomTemplate:     &pb.Room{DisplayName: "room {index}"},
+		BlurbsPerRoom:    4,
+		BlurbsPerProfile: 1,
+		BlurbTemplate:    &pb.Blurb{Content: &pb.Blurb_Text{Text: "blurb {index}"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created.GetUsers()) != 3 || len(created.GetRooms()) != 2 || len(created.GetBlurbs()) != 11 {
+		t.Fatalf("unexpected fixtures: %v", created)
+	}
+
+	user, err := identityServer.GetUser(ctx, &pb.GetUserRequest{Name: created.GetUsers()[2]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.GetEmail() != "user2@example.com" || user.GetDisplayName() != "user 2" {
+		t.Errorf("unexpected user: %v", user)
+	}
+
+	blurbs, err := messagingServer.ListBlurbs(ctx, &pb.ListBlurbsRequest{Parent: created.GetRooms()[1], PageSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blurbs.GetBlurbs()) != 4 {
+		t.Fatalf("want 4 blurbs in the room, got %v", blurbs)
+	}
+	if got := blurbs.GetBlurbs()[0]; got.GetText() != "blurb 4" || got.GetUser() != created.GetUsers()[1] {
+		t.Errorf("unexpected blurb: %v", got)
+	}
+
+	profile, err := messagingServer.ListBlurbs(ctx, &pb.ListBlurbsRequest{Parent: created.GetUsers()[0] + "/profile", PageSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profile.GetBlurbs()) != 1 || profile.GetBlurbs()[0].GetUser() != created.GetUsers()[0] {
+		t.Errorf("unexpected profile blurbs: %v", profile)
+	}
+}
+
+func TestCreateFixtures_partialFailure(t *testing.T) {
+	identityServer := NewIdentityServer()
+	s := NewFixturesServer(identityServer, NewMessagingServer(identityServer), nil)
+
+	// The email does not vary, so only the first user can be created.
+	_, err := s.CreateFixtures(context.Background(), &pb.CreateFixturesRequest{
+		UserCount:    2,
+		UserTemplate: &pb.User{DisplayName: "user {index}", Email: "same@example.com"},
+	})
+	st := status.Convert(err)
+	if st.Code() != codes.AlreadyExists {
+		t.Fatalf("want AlreadyExists, got %v", err)
+	}
+	if len(st.Details()) != 1 {
+		t.Fatalf("want the created fixtures in the details, got %v", st.Details())
+	}
+	if created, ok := st.Details()[0].(*pb.CreateFixturesResponse); !ok || len(created.GetUsers()) != 1 {
+		t.Errorf("unexpected details: %v", st.Details())
+	}
+}
+
+func TestCreateFixtures_invalid(t *testing.T) {
+	identityServer := NewIdentityServer()
+	s := NewFixturesServer(identityServer, NewMessagingServer(identityServer), nil)
+	for _, in := range []*pb.CreateFixturesRequest{
+		{UserCount: -1},
+		{RoomCount: maxFixtures + 1},
+		{RoomCount: 1, RoomTemplate: &pb.Room{DisplayName: "r"}, BlurbsPerRoom: 1},
+	} {
+		if _, err := s.CreateFixtures(context.Background(), in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%v: want InvalidArgument, got %v", in, err)
+		}
+	}
+}
+
+func TestResetState(t *testing.T) {
+	identityServer := NewIdentityServer()
+	messagingServer := NewMessagingServer(identityServer)
+	sequenceServer := NewSequenceServer()
+	s := NewFixturesServer(identityServer, messagingServer, map[pb.ResetStateRequest_Scope]Resetter{
+		pb.ResetStateRequest_IDENTITY:  identityServer.(Resetter),
+		pb.ResetStateRequest_MESSAGING: messagingServer.(Resetter),
+		pb.ResetStateRequest_SEQUENCES: sequenceServer.(Resetter),
+	})
+	ctx := context.Background()
+
+	created, err := s.CreateFixtures(ctx, &pb.CreateFixturesRequest{
+		UserCount:     1,
+		UserTemplate:  &pb.User{DisplayName: "user", Email: "user@example.com"},
+		RoomCount:     1,
+		RoomTemplate:  &pb.Room{DisplayName: "room"},
+		BlurbsPerRoom: 1,
+		BlurbTemplate: &pb.Blurb{Content: &pb.Blurb_Text{Text: "hi"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sequence, err := sequenceServer.CreateSequence(ctx, &pb.CreateSequenceRequest{Sequence: &pb.Sequence{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reset, err := s.ResetState(ctx, &pb.ResetStateRequest{Scopes: []pb.ResetStateRequest_Scope{pb.ResetStateRequest_MESSAGING}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reset.GetScopes()) != 1 || reset.GetScopes()[0] != pb.ResetStateRequest_MESSAGING {
+		t.Errorf("unexpected scopes reset: %v", reset)
+	}
+	if _, err := messagingServer.GetRoom(ctx, &pb.GetRoomRequest{Name: created.GetRooms()[0]}); status.Code(err) != codes.NotFound {
+		t.Errorf("room after reset: want NotFound, got %v", err)
+	}
+	if _, err := messagingServer.GetBlurb(ctx, &pb.GetBlurbRequest{Name: created.GetBlurbs()[0]}); status.Code(err) != codes.NotFound {
+		t.Errorf("blurb after reset: want NotFound, got %v", err)
+	}
+	if _, err := identityServer.GetUser(ctx, &pb.GetUserRequest{Name: created.GetUsers()[0]}); err != nil {
+		t.Errorf("user outside the reset scope: %v", err)
+	}
+
+	if _, err := s.ResetState(ctx, &pb.ResetStateRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("resetting all scopes with some unavailable: want InvalidArgument, got %v", err)
+	}
+	reset, err = s.ResetState(ctx, &pb.ResetStateRequest{Scopes: []pb.ResetStateRequest_Scope{pb.ResetStateRequest_IDENTITY, pb.ResetStateRequest_SEQUENCES}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := identityServer.GetUser(ctx, &pb.GetUserRequest{Name: created.GetUsers()[0]}); status.Code(err) != codes.NotFound {
+		t.Errorf("user after reset: want NotFound, got %v", err)
+	}
+	if _, err := sequenceServer.GetSequenceReport(ctx, &pb.GetSequenceReportRequest{Name: sequence.GetName() + "/sequenceReport"}); status.Code(err) != codes.NotFound {
+		t.Errorf("sequence report after reset: want NotFound, got %v", err)
+	}
+
+	// Names are not reused.
+	user, err := identityServer.CreateUser(ctx, &pb.CreateUserRequest{User: &pb.User{DisplayName: "user", Email: "user@example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.GetName() == created.GetUsers()[0] {
+		t.Errorf("user name %s was reused", user.GetName())
+	}
+}
+
+func TestContention(t *testing.T) {
+	identityServer := NewIdentityServer()
+	messagingServer := NewMessagingServer(identityServer)
+	s := NewFixturesServer(identityServer, messagingServer, nil)
+	ctx := context.Background()
+
+	user, err := identityServer.CreateUser(ctx, &pb.CreateUserRequest{User: &pb.User{DisplayName: "ekkodog", Email: "ekko@example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	room, err := messagingServer.CreateRoom(ctx, &pb.CreateRoomRequest{Room: &pb.Room{DisplayName: "Living room"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contention, err := s.StartContention(ctx, &pb.StartContentionRequest{
+		Resources:          []string{user.GetName(), room.GetName()},
+		MutationsPerSecond: 500,
+	})
+	if err != nil {
+		t.Fatalf("StartContention: unexpected err %+v", err)
+	}
+	if !contention.GetActive() || contention.GetExpireTime() != nil {
+		t.Errorf("StartContention: want an active contention not expiring, got %v", contention)
+	}
+
+	// An update carrying the etag it read is eventually aborted by a concurrent mutation.
+	aborted := false
+	for i := 0; i < 1000 && !aborted; i++ {
+		current, err := identityServer.GetUser(ctx, &pb.GetUserRequest{Name: user.GetName()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		update := &pb.User{Name: current.GetName(), DisplayName: "ekko", Email: current.GetEmail(), Etag: current.GetEtag()}
+		time.Sleep(time.Millisecond)
+		_, err = identityServer.UpdateUser(ctx, &pb.UpdateUserRequest{User: update})
+		aborted = status.Code(err) == codes.Aborted
+	}
+	if !aborted {
+		t.Error("UpdateUser: want an update with a stale etag aborted under contention")
+	}
+
+	stopped, err := s.StopContention(ctx, &pb.StopContentionRequest{Name: contention.GetName()})
+	if err != nil {
+		t.Fatalf("StopContention: unexpected err %+v", err)
+	}
+	if stopped.GetActive() || stopped.GetMutationCount() == 0 {
+		t.Errorf("StopContention: want an inactive contention having mutated, got %v", stopped)
+	}
+	current, _ := messagingServer.GetRoom(ctx, &pb.GetRoomRequest{Name: room.GetName()})
+	if current.GetEtag() == room.GetEtag() || current.GetDisplayName() != "Living room" {
+		t.Errorf("StopContention: want the room rewritten as it was with a new etag, got %v", current)
+	}
+
+	expiring, err := s.StartContention(ctx, &pb.StartContentionRequest{
+		Resources:          []string{room.GetName()},
+		MutationsPerSecond: 100,
+		Duration:           durationpb.New(10 * time.Millisecond),
+	})
+	if err != nil {
+		t.Fatalf("StartContention: unexpected err %+v", err)
+	}
+	if expiring, _ = s.StopContention(ctx, &pb.StopContentionRequest{Name: expiring.GetName()}); expiring.GetExpireTime() == nil {
+		t.Errorf("StartContention: want an expire_time for a duration, got %v", expiring)
+	}
+}
+
+func TestContention_invalid(t *testing.T) {
+	identityServer := NewIdentityServer()
+	s := NewFixturesServer(identityServer, NewMessagingServer(identityServer), nil)
+	ctx := context.Background()
+
+	for _, testCase := range []struct {
+		in   *pb.StartContentionRequest
+		want codes.Code
+	}{
+		{&pb.StartContentionRequest{MutationsPerSecond: 1}, codes.InvalidArgument},
+		{&pb.StartContentionRequest{Resources: []string{"users/0"}}, codes.InvalidArgument},
+		{&pb.StartContentionRequest{Resources: []string{"users/0"}, MutationsPerSecond: 5000}, codes.InvalidArgument},
+		{&pb.StartContentionRequest{Resources: []string{"users/0"}, MutationsPerSecond: 1}, codes.NotFound},
+		{&pb.StartContentionRequest{Resources: []string{"sessions/0"}, MutationsPerSecond: 1}, codes.InvalidArgument},
+	} {
+		if _, err := s.StartContention(ctx, testCase.in); status.Code(err) != testCase.want {
+			t.Errorf("StartContention(%v): want %v, got %v", testCase.in, testCase.want, err)
+		}
+	}
+	if _, err := s.StopContention(ctx, &pb.StopContentionRequest{Name: "contentions/0"}); status.Code(err) != codes.NotFound {
+		t.Errorf("StopContention: want NotFound, got %v", err)
+	}
+}
@@ -17,14 +17,18 @@ package services
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
+	"time"
 
-	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/googleapis/gapic-showcase/server"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // NewIdentityServer returns a new instance of showcase identity server.
@@ -32,6 +36,9 @@ func NewIdentityServer() pb.IdentityServer {
 	return &identityServerImpl{
 		token: server.NewTokenGenerator(),
 		keys:  map[string]int{},
+		feed:  server.NewChangeFeed(),
+
+		attempts: map[string]int32{},
 	}
 }
 
@@ -47,12 +54,18 @@ type ReadOnlyIdentityServer interface {
 }
 
 type identityServerImpl struct {
-	uid   server.UniqID
-	token server.TokenGenerator
+	uid     server.UniqID
+	etagUID server.UniqID
+	token   server.TokenGenerator
 
 	mu    sync.Mutex
 	keys  map[string]int
 	users []userEntry
+	feed  *server.ChangeFeed
+	store *server.Store
+
+	// attempts counts the attempts of the BatchWrite transactions not yet committed.
+	attempts map[string]int32
 }
 
 // Creates a user.
@@ -60,8 +73,22 @@ func (s *identityServerImpl) CreateUser(_ context.Context, in *pb.CreateUserRequ
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	u := in.GetUser()
+	rollback := s.checkpoint()
+	u, err := s.create(in.GetUser())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.save(); err != nil {
+		rollback()
+		return nil, err
+	}
+	s.feed.Publish(u.GetName(), server.ChangeCreated, u)
+
+	return u, nil
+}
 
+// create inserts u as a new user. s.mu must be held.
+func (s *identityServerImpl) create(u *pb.User) (*pb.User, error) {
 	// Ignore passed in name.
 	u.Name = ""
 
@@ -73,11 +100,12 @@ func (s *identityServerImpl) CreateUser(_ context.Context, in *pb.CreateUserRequ
 	// Assign info.
 	id := s.uid.Next()
 	name := fmt.Sprintf("users/%d", id)
-	now := ptypes.TimestampNow()
+	now := server.GetClockInstance().Timestamp()
 
 	u.Name = name
 	u.CreateTime = now
 	u.UpdateTime = now
+	u.Etag = nextEtag(&s.etagUID)
 
 	// Insert.
 	index := len(s.users)
@@ -117,30 +145,48 @@ func (s *identityServerImpl) UpdateUser(_ context.Context, in *pb.UpdateUserRequ
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	u := in.GetUser()
+	rollback := s.checkpoint()
+	updated, err := s.update(in.GetUser())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.save(); err != nil {
+		rollback()
+		return nil, err
+	}
+	s.feed.Publish(updated.GetName(), server.ChangeUpdated, updated)
+	return updated, nil
+}
+
+// update replaces the fields of the user named by u. s.mu must be held.
+func (s *identityServerImpl) update(u *pb.User) (*pb.User, error) {
 	i, ok := s.keys[u.GetName()]
 	if !ok || s.users[i].deleted {
 		return nil, status.Errorf(
 			codes.NotFound,
 			"A user with name %s not found.", u.GetName())
 	}
+	entry := s.users[i]
+	if err := checkEtag(u.GetEtag(), entry.user.GetEtag(), u.GetName()); err != nil {
+		return nil, err
+	}
 
 	err := s.validate(u)
 	if err != nil {
 		return nil, err
 	}
-	entry := s.users[i]
 	// Update store.
 	updated := &pb.User{
 		Name:                u.GetName(),
 		DisplayName:         u.GetDisplayName(),
 		Email:               u.GetEmail(),
 		CreateTime:          entry.user.GetCreateTime(),
-		UpdateTime:          ptypes.TimestampNow(),
+		UpdateTime:          server.GetClockInstance().Timestamp(),
 		Age:                 entry.user.Age,
 		EnableNotifications: entry.user.EnableNotifications,
 		HeightFeet:          entry.user.HeightFeet,
 		Nickname:            entry.user.Nickname,
+		Etag:                nextEtag(&s.etagUID),
 	}
 
 	// Use direct field access to avoid unwrapping and rewrapping the pointer value.
@@ -169,18 +215,115 @@ func (s *identityServerImpl) DeleteUser(_ context.Context, in *pb.DeleteUserRequ
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	i, ok := s.keys[in.GetName()]
+	rollback := s.checkpoint()
+	entry, err := s.delete(in.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.save(); err != nil {
+		rollback()
+		return nil, err
+	}
+	if !entry.deleted {
+		s.feed.Publish(entry.user.GetName(), server.ChangeDeleted, entry.user)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// delete marks the user named name as deleted, returning its entry as it was. s.mu must be
+// held.
+func (s *identityServerImpl) delete(name string) (userEntry, error) {
+	i, ok := s.keys[name]
 
 	if !ok {
-		return nil, status.Errorf(
+		return userEntry{}, status.Errorf(
 			codes.NotFound,
-			"A user with name %s not found.", in.GetName())
+			"A user with name %s not found.", name)
 	}
 
 	entry := s.users[i]
 	s.users[i] = userEntry{user: entry.user, deleted: true}
+	return entry, nil
+}
 
-	return &empty.Empty{}, nil
+// Applies a batch of mutations to users atomically.
+func (s *identityServerImpl) BatchWrite(_ context.Context, in *pb.BatchWriteRequest) (*pb.BatchWriteResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	txn := in.GetTransactionId()
+	s.attempts[txn]++
+	attempt := s.attempts[txn]
+	if txn == "" {
+		// Batches outside of a transaction are never retries.
+		attempt = 1
+		delete(s.attempts, txn)
+	}
+	abort := in.GetAbort()
+	aborting := attempt <= abort.GetAttempts()
+
+	// Stage the mutations on the live state, restoring it unless they all commit.
+	rollback := s.checkpoint()
+
+	written := []*pb.User{}
+	changes := []server.ChangeAction{}
+	for i, m := range in.GetMutations() {
+		if aborting && int32(i) == abort.GetAfterMutations() {
+			break
+		}
+		var (
+			user   *pb.User
+			action server.ChangeAction
+			err    error
+		)
+		switch op := m.GetOperation().(type) {
+		case *pb.UserMutation_Create:
+			user, err = s.create(proto.Clone(op.Create).(*pb.User))
+			action = server.ChangeCreated
+		case *pb.UserMutation_Update:
+			user, err = s.update(op.Update)
+			action = server.ChangeUpdated
+		case *pb.UserMutation_Delete:
+			var entry userEntry
+			entry, err = s.delete(op.Delete)
+			if err == nil && entry.deleted {
+				err = status.Errorf(codes.NotFound, "A user with name %s not found.", op.Delete)
+			}
+			user, action = entry.user, server.ChangeDeleted
+		default:
+			err = status.Error(codes.InvalidArgument, "The field `operation` is required.")
+		}
+		if err != nil {
+			rollback()
+			st, _ := status.FromError(err)
+			return nil, status.Errorf(st.Code(), "mutations[%d]: %s The batch was rolled back.", i, st.Message())
+		}
+		written = append(written, user)
+		changes = append(changes, action)
+	}
+	if aborting {
+		rollback()
+		st := status.Newf(codes.Aborted,
+			"Attempt %d of transaction %q was aborted after %d mutations and rolled back. Retry the transaction.",
+			attempt, txn, len(written))
+		if delay := abort.GetRetryDelay(); delay != nil {
+			if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: delay}); err == nil {
+				st = detailed
+			}
+		}
+		return nil, st.Err()
+	}
+
+	delete(s.attempts, txn)
+	if err := s.save(); err != nil {
+		rollback()
+		return nil, err
+	}
+	for i, user := range written {
+		s.feed.Publish(user.GetName(), changes[i], user)
+	}
+	return &pb.BatchWriteResponse{Users: written, Attempt: attempt}, nil
 }
 
 // Lists all users.
@@ -211,6 +354,59 @@ func (s *identityServerImpl) ListUsers(_ context.Context, in *pb.ListUsersReques
 	return &pb.ListUsersResponse{Users: users, NextPageToken: nextToken}, nil
 }
 
+// This returns a stream that emits the users as they are created, updated, or
+// deleted.
+func (s *identityServerImpl) WatchUsers(in *pb.WatchUsersRequest, stream pb.Identity_WatchUsersServer) error {
+	expire, err := watchExpiry(in.GetExpireTime())
+	if err != nil {
+		return err
+	}
+	all := func(*server.Change) bool { return true }
+	return s.feed.Watch(stream.Context(), in.GetResumeToken(), expire, all, func(change *server.Change) error {
+		return stream.Send(&pb.WatchUsersResponse{
+			User:        change.Resource.(*pb.User),
+			Action:      pb.WatchUsersResponse_Action(change.Action),
+			ResumeToken: change.ResumeToken,
+		})
+	})
+}
+
+// watchExpiry returns the time a watch expiring at expireTime, if set, closes at.
+func watchExpiry(expireTime *timestamppb.Timestamp) (time.Time, error) {
+	if expireTime == nil {
+		return time.Time{}, nil
+	}
+	if err := expireTime.CheckValid(); err != nil {
+		return time.Time{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return expireTime.AsTime(), nil
+}
+
+// nextEtag returns the etag of the next state written of a resource, unique among those issued
+// with uid.
+func nextEtag(uid *server.UniqID) string {
+	return strconv.FormatInt(uid.Next(), 16)
+}
+
+// checkEtag returns an Aborted error if etag, sent in an update of the resource named name, is
+// set and is not its current etag.
+func checkEtag(etag, current, name string) error {
+	if etag == "" || etag == current {
+		return nil
+	}
+	st := status.Newf(codes.Aborted,
+		"The etag %q is stale: %s was modified concurrently and its etag is now %q. Read it again and retry.",
+		etag, name, current)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "ETAG_MISMATCH",
+		Domain:   "showcase.googleapis.com",
+		Metadata: map[string]string{"resource": name, "etag": current},
+	}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
 func (s *identityServerImpl) validate(u *pb.User) error {
 	// Validate Required Fields.
 	if u.GetDisplayName() == "" {
@@ -238,3 +434,75 @@ func (s *identityServerImpl) validate(u *pb.User) error {
 	}
 	return nil
 }
+
+// ResetState deletes all users.
+func (s *identityServerImpl) ResetState() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = map[string]int{}
+	s.users = nil
+	s.attempts = map[string]int32{}
+	// There is no caller to report a failure to save to; the next change saves again.
+	s.save()
+}
+
+// identityStoreSection is the section of a server.Store the users are persisted to.
+const identityStoreSection = "identity"
+
+// Persist restores the users saved in store, if any, and saves the users there after every
+// change. Deleted users are not saved, so their names may be given to new users after a restart.
+func (s *identityServerImpl) Persist(store *server.Store) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved := &pb.ListUsersResponse{}
+	if _, err := store.Load(identityStoreSection, saved); err != nil {
+		return err
+	}
+	s.store = store
+	for _, u := range saved.GetUsers() {
+		s.keys[u.GetName()] = len(s.users)
+		s.users = append(s.users, userEntry{user: u})
+
+		// Keep new users from reusing the names and etags of the restored ones.
+		var id int64
+		if _, err := fmt.Sscanf(u.GetName(), "users/%d", &id); err == nil {
+			s.uid.Advance(id + 1)
+		}
+		if etag, err := strconv.ParseInt(u.GetEtag(), 16, 64); err == nil {
+			s.etagUID.Advance(etag + 1)
+		}
+	}
+	return nil
+}
+
+// checkpoint returns the function restoring the users as they are now, so that changes that
+// fail to commit or to be saved leave no trace. s.mu must be held.
+func (s *identityServerImpl) checkpoint() func() {
+	keys := make(map[string]int, len(s.keys))
+	for name, i := range s.keys {
+		keys[name] = i
+	}
+	users := append([]userEntry(nil), s.users...)
+	return func() {
+		s.keys = keys
+		s.users = users
+	}
+}
+
+// save persists the users that are not deleted, if the server has a store. s.mu must be held.
+func (s *identityServerImpl) save() error {
+	if s.store == nil {
+		return nil
+	}
+	saved := &pb.ListUsersResponse{}
+	for _, entry := range s.users {
+		if !entry.deleted {
+			saved.Users = append(saved.Users, entry.user)
+		}
+	}
+	if err := s.store.Save(identityStoreSection, saved); err != nil {
+		return status.Errorf(codes.Internal, "The users could not be persisted: %v", err)
+	}
+	return nil
+}
@@ -17,14 +17,22 @@ package services
 import (
 	"context"
 	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/googleapis/gapic-showcase/server"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_User_lifecycle(t *testing.T) {
@@ -400,3 +408,294 @@ func Test_List_invalidToken(t *testing.T) {
 		}
 	}
 }
+
+type mockWatchUsersStream struct {
+	ctx    context.Context
+	cancel func()
+	want   int
+	resps  []*pb.WatchUsersResponse
+	pb.Identity_WatchUsersServer
+}
+
+func (m *mockWatchUsersStream) Context() context.Context { return m.ctx }
+
+func (m *mockWatchUsersStream) Send(resp *pb.WatchUsersResponse) error {
+	m.resps = append(m.resps, resp)
+	if len(m.resps) == m.want {
+		m.cancel()
+	}
+	return nil
+}
+
+func TestWatchUsers(t *testing.T) {
+	s := NewIdentityServer()
+	token := s.(*identityServerImpl).feed.Head()
+
+	user, err := s.CreateUser(context.Background(), &pb.CreateUserRequest{
+		User: &pb.User{DisplayName: "ekkodog", Email: "ekko@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.UpdateUser(context.Background(), &pb.UpdateUserRequest{
+		User: &pb.User{Name: user.GetName(), DisplayName: "ekko", Email: "ekko@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.DeleteUser(context.Background(), &pb.DeleteUserRequest{Name: user.GetName()}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockWatchUsersStream{ctx: ctx, cancel: cancel, want: 3}
+	err = s.WatchUsers(&pb.WatchUsersRequest{ResumeToken: token}, stream)
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("WatchUsers: want Canceled once cancelled, got %v", err)
+	}
+	if len(stream.resps) != 3 {
+		t.Fatalf("WatchUsers: want 3 responses, got %v", stream.resps)
+	}
+	wantActions := []pb.WatchUsersResponse_Action{pb.WatchUsersResponse_CREATE, pb.WatchUsersResponse_UPDATE, pb.WatchUsersResponse_DELETE}
+	wantNames := []string{"ekkodog", "ekko", "ekko"}
+	for i, resp := range stream.resps {
+		if resp.GetAction() != wantActions[i] || resp.GetUser().GetDisplayName() != wantNames[i] {
+			t.Errorf("WatchUsers: response %d: want %v of %q, got %v", i, wantActions[i], wantNames[i], resp)
+		}
+	}
+
+	// Resuming from the last response misses nothing and repeats nothing.
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	resumed := &mockWatchUsersStream{ctx: ctx, cancel: cancel}
+	err = s.WatchUsers(&pb.WatchUsersRequest{
+		ResumeToken: stream.resps[2].GetResumeToken(),
+		ExpireTime:  timestamppb.New(time.Now().Add(10 * time.Millisecond)),
+	}, resumed)
+	if err != nil || len(resumed.resps) != 0 {
+		t.Errorf("WatchUsers: want no responses resuming from the last, got %v, %v", resumed.resps, err)
+	}
+
+	err = s.WatchUsers(&pb.WatchUsersRequest{ResumeToken: base64.StdEncoding.EncodeToString([]byte("token"))}, resumed)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("WatchUsers: want InvalidArgument for an invalid resume token, got %v", err)
+	}
+}
+
+func TestBatchWrite(t *testing.T) {
+	s := NewIdentityServer()
+	existing, err := s.CreateUser(context.Background(), &pb.CreateUserRequest{
+		User: &pb.User{DisplayName: "mishacat", Email: "misha@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.BatchWrite(context.Background(), &pb.BatchWriteRequest{
+		Mutations: []*pb.UserMutation{
+			{Operation: &pb.UserMutation_Create{Create: &pb.User{DisplayName: "ekkodog", Email: "ekko@example.com"}}},
+			{Operation: &pb.UserMutation_Update{Update: &pb.User{Name: existing.GetName(), DisplayName: "misha", Email: "misha@example.com"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchWrite: unexpected err %+v", err)
+	}
+	if len(resp.GetUsers()) != 2 || resp.GetUsers()[0].GetName() == "" || resp.GetUsers()[1].GetDisplayName() != "misha" || resp.GetAttempt() != 1 {
+		t.Errorf("BatchWrite: want the created and updated users in the first attempt, got %v", resp)
+	}
+	created := resp.GetUsers()[0]
+	if _, err := s.GetUser(context.Background(), &pb.GetUserRequest{Name: created.GetName()}); err != nil {
+		t.Errorf("BatchWrite: want %s committed, got %v", created.GetName(), err)
+	}
+
+	// A failing mutation rolls back those before it.
+	_, err = s.BatchWrite(context.Background(), &pb.BatchWriteRequest{
+		Mutations: []*pb.UserMutation{
+			{Operation: &pb.UserMutation_Delete{Delete: created.GetName()}},
+			{Operation: &pb.UserMutation_Create{Create: &pb.User{DisplayName: "rumble", Email: "rumble@example.com"}}},
+			{Operation: &pb.UserMutation_Update{Update: &pb.User{Name: "users/missing", DisplayName: "x", Email: "x@example.com"}}},
+		},
+	})
+	if status.Code(err) != codes.NotFound || !strings.Contains(err.Error(), "mutations[2]") {
+		t.Errorf("BatchWrite: want NotFound for mutations[2], got %v", err)
+	}
+	list, _ := s.ListUsers(context.Background(), &pb.ListUsersRequest{PageSize: 10})
+	if len(list.GetUsers()) != 2 {
+		t.Errorf("BatchWrite: want the failed batch rolled back, leaving 2 users, got %v", list.GetUsers())
+	}
+}
+
+func TestBatchWrite_abort(t *testing.T) {
+	s := NewIdentityServer()
+	in := &pb.BatchWriteRequest{
+		Mutations: []*pb.UserMutation{
+			{Operation: &pb.UserMutation_Create{Create: &pb.User{DisplayName: "ekkodog", Email: "ekko@example.com"}}},
+			{Operation: &pb.UserMutation_Create{Create: &pb.User{DisplayName: "mishacat", Email: "misha@example.com"}}},
+		},
+		TransactionId: "txn",
+		Abort: &pb.BatchWriteRequest_AbortOptions{
+			Attempts:       2,
+			AfterMutations: 1,
+			RetryDelay:     &durationpb.Duration{Seconds: 2},
+		},
+	}
+	for attempt := 1; attempt <= 2; attempt++ {
+		_, err := s.BatchWrite(context.Background(), in)
+		st, _ := status.FromError(err)
+		if st.Code() != codes.Aborted {
+			t.Fatalf("BatchWrite: attempt %d: want Aborted, got %v", attempt, err)
+		}
+		details := st.Details()
+		if len(details) != 1 || details[0].(*errdetails.RetryInfo).GetRetryDelay().GetSeconds() != 2 {
+			t.Errorf("BatchWrite: attempt %d: want a RetryInfo of 2s, got %v", attempt, details)
+		}
+		list, _ := s.ListUsers(context.Background(), &pb.ListUsersRequest{PageSize: 10})
+		if len(list.GetUsers()) != 0 {
+			t.Errorf("BatchWrite: attempt %d: want the aborted attempt rolled back, got %v", attempt, list.GetUsers())
+		}
+	}
+
+	resp, err := s.BatchWrite(context.Background(), in)
+	if err != nil {
+		t.Fatalf("BatchWrite: attempt 3: unexpected err %+v", err)
+	}
+	if resp.GetAttempt() != 3 || len(resp.GetUsers()) != 2 {
+		t.Errorf("BatchWrite: want both users created by attempt 3, got %v", resp)
+	}
+
+	// Once committed, the transaction's attempts start over.
+	in.Mutations = nil
+	if _, err := s.BatchWrite(context.Background(), in); status.Code(err) != codes.Aborted {
+		t.Errorf("BatchWrite: want a committed transaction's id to abort anew, got %v", err)
+	}
+}
+
+func Test_Update_staleEtag(t *testing.T) {
+	s := NewIdentityServer()
+	user, err := s.CreateUser(context.Background(), &pb.CreateUserRequest{
+		User: &pb.User{DisplayName: "ekkodog", Email: "ekko@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	etag := user.GetEtag()
+	updated, err := s.UpdateUser(context.Background(), &pb.UpdateUserRequest{
+		User: &pb.User{Name: user.GetName(), DisplayName: "ekko", Email: "ekko@example.com", Etag: etag},
+	})
+	if err != nil {
+		t.Fatalf("Update: unexpected err %+v", err)
+	}
+	if updated.GetEtag() == "" || updated.GetEtag() == etag {
+		t.Errorf("Update: want a new etag, got %q", updated.GetEtag())
+	}
+
+	_, err = s.UpdateUser(context.Background(), &pb.UpdateUserRequest{
+		User: &pb.User{Name: user.GetName(), DisplayName: "ekkodog", Email: "ekko@example.com", Etag: etag},
+	})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("Update: want Aborted for a stale etag, got %v", err)
+	}
+}
+
+// tempStorePath returns the path of a store file in a directory removed when the test ends.
+func tempStorePath(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return filepath.Join(dir, "state.json")
+}
+
+// persistedIdentityServer returns an identity server persisting its users to the store at path.
+func persistedIdentityServer(t *testing.T, path string) pb.IdentityServer {
+	store, err := server.OpenStore(path)
+	if err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+	s := NewIdentityServer()
+	if err := s.(Persister).Persist(store); err != nil {
+		t.Fatalf("Persist: %v", err)
+	}
+	return s
+}
+
+func Test_Persist_users(t *testing.T) {
+	path := tempStorePath(t)
+	ctx := context.Background()
+
+	s := persistedIdentityServer(t, path)
+	kept, err := s.CreateUser(ctx, &pb.CreateUserRequest{User: &pb.User{DisplayName: "Rumble", Email: "rumble@goodboi.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := s.CreateUser(ctx, &pb.CreateUserRequest{User: &pb.User{DisplayName: "Ekko", Email: "ekko@goodboi.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.DeleteUser(ctx, &pb.DeleteUserRequest{Name: deleted.GetName()}); err != nil {
+		t.Fatal(err)
+	}
+
+	restarted := persistedIdentityServer(t, path)
+	got, err := restarted.GetUser(ctx, &pb.GetUserRequest{Name: kept.GetName()})
+	if err != nil {
+		t.Fatalf("GetUser of a persisted user: %v", err)
+	}
+	if !proto.Equal(got, kept) {
+		t.Errorf("GetUser of a persisted user: got %v, want %v", got, kept)
+	}
+	if _, err := restarted.GetUser(ctx, &pb.GetUserRequest{Name: deleted.GetName()}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetUser of a deleted user: got %v, want NotFound", err)
+	}
+
+	created, err := restarted.CreateUser(ctx, &pb.CreateUserRequest{User: &pb.User{DisplayName: "Biscuit", Email: "biscuit@goodboi.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.GetName() == kept.GetName() {
+		t.Errorf("CreateUser after a restart reused the name %s", created.GetName())
+	}
+	if created.GetEtag() == kept.GetEtag() {
+		t.Errorf("CreateUser after a restart reused the etag %s", created.GetEtag())
+	}
+}
+
+func Test_Persist_usersSaveFailed(t *testing.T) {
+	path := tempStorePath(t)
+	ctx := context.Background()
+
+	s := persistedIdentityServer(t, path)
+	kept, err := s.CreateUser(ctx, &pb.CreateUserRequest{User: &pb.User{DisplayName: "Rumble", Email: "rumble@goodboi.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// With its directory gone the store cannot be written, and no change may stick.
+	os.RemoveAll(filepath.Dir(path))
+	if _, err := s.CreateUser(ctx, &pb.CreateUserRequest{User: &pb.User{DisplayName: "Ekko", Email: "ekko@goodboi.com"}}); err == nil {
+		t.Error("CreateUser: expected an error when the store cannot be written")
+	}
+	update := proto.Clone(kept).(*pb.User)
+	update.DisplayName = "Biscuit"
+	if _, err := s.UpdateUser(ctx, &pb.UpdateUserRequest{User: update}); err == nil {
+		t.Error("UpdateUser: expected an error when the store cannot be written")
+	}
+	if _, err := s.DeleteUser(ctx, &pb.DeleteUserRequest{Name: kept.GetName()}); err == nil {
+		t.Error("DeleteUser: expected an error when the store cannot be written")
+	}
+	if _, err := s.BatchWrite(ctx, &pb.BatchWriteRequest{Mutations: []*pb.UserMutation{
+		{Operation: &pb.UserMutation_Create{Create: &pb.User{DisplayName: "Ekko", Email: "ekko@goodboi.com"}}},
+		{Operation: &pb.UserMutation_Delete{Delete: kept.GetName()}},
+	}}); err == nil {
+		t.Error("BatchWrite: expected an error when the store cannot be written")
+	}
+
+	list, err := s.ListUsers(ctx, &pb.ListUsersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.GetUsers()) != 1 || !proto.Equal(list.GetUsers()[0], kept) {
+		t.Errorf("ListUsers after failed saves: got %v, want only %v", list.GetUsers(), kept)
+	}
+}
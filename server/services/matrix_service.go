@@ -0,0 +1,201 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxMatrixResponses is the largest number of responses a call to the Matrix service may ask
+// for.
+const maxMatrixResponses = 10000
+
+// matrixOperationPrefix is the prefix of the names of the operations started by the LongRunning
+// method, which are followed by the encoded request.
+const matrixOperationPrefix = "operations/google.showcase.v1beta1.Matrix/LongRunning/"
+
+// NewMatrixServer returns a new MatrixServer for the Showcase API.
+func NewMatrixServer() pb.MatrixServer {
+	return &matrixServerImpl{token: server.NewTokenGenerator()}
+}
+
+type matrixServerImpl struct {
+	token server.TokenGenerator
+}
+
+func (s *matrixServerImpl) Unary(_ context.Context, in *pb.MatrixRequest) (*pb.MatrixResponse, error) {
+	responses, err := matrixResponses(in, "Unary")
+	if err != nil {
+		return nil, err
+	}
+	return responses[0], nil
+}
+
+func (s *matrixServerImpl) NoContent(_ context.Context, in *pb.MatrixRequest) (*empty.Empty, error) {
+	if _, err := matrixResponses(in, "NoContent"); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *matrixServerImpl) ServerStream(in *pb.MatrixRequest, stream pb.Matrix_ServerStreamServer) error {
+	responses, err := matrixResponses(in, "ServerStream")
+	if err != nil {
+		return err
+	}
+	for _, resp := range responses {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *matrixServerImpl) ClientStream(stream pb.Matrix_ClientStreamServer) error {
+	contents := []string{}
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			responses, _ := matrixResponses(&pb.MatrixRequest{Content: strings.Join(contents, " ")}, "ClientStream")
+			return stream.SendAndClose(responses[0])
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := matrixResponses(in, "ClientStream"); err != nil {
+			return err
+		}
+		contents = append(contents, in.GetContent())
+	}
+}
+
+func (s *matrixServerImpl) BidiStream(stream pb.Matrix_BidiStreamServer) error {
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		responses, err := matrixResponses(in, "BidiStream")
+		if err != nil {
+			return err
+		}
+		for _, resp := range responses {
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *matrixServerImpl) LongRunning(_ context.Context, in *pb.MatrixRequest) (*lropb.Operation, error) {
+	if _, err := matrixResponses(in, "LongRunning"); err != nil {
+		return nil, err
+	}
+	reqBytes, _ := proto.Marshal(in)
+	return matrixOperation(matrixOperationPrefix + base64.URLEncoding.EncodeToString(reqBytes))
+}
+
+func (s *matrixServerImpl) PagedList(_ context.Context, in *pb.PagedListRequest) (*pb.PagedListResponse, error) {
+	if in.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "The page size provided must not be negative.")
+	}
+	responses, err := matrixResponses(&pb.MatrixRequest{
+		Content:       in.GetContent(),
+		ResponseCount: in.GetResponseCount(),
+		Error:         in.GetError(),
+	}, "PagedList")
+	if err != nil {
+		return nil, err
+	}
+	start, err := s.token.GetIndex(in.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	if start > len(responses) {
+		return nil, server.InvalidTokenErr
+	}
+	end := len(responses)
+	if size := int(in.GetPageSize()); size > 0 && start+size < end {
+		end = start + size
+	}
+	page := &pb.PagedListResponse{Responses: responses[start:end]}
+	if end < len(responses) {
+		page.NextPageToken = s.token.ForIndex(end)
+	}
+	return page, nil
+}
+
+// matrixResponses returns the responses of method to in, or the error in asks for.
+func matrixResponses(in *pb.MatrixRequest, method string) ([]*pb.MatrixResponse, error) {
+	if in.GetError() != nil {
+		return nil, status.ErrorProto(in.GetError())
+	}
+	count := in.GetResponseCount()
+	if count < 0 || count > maxMatrixResponses {
+		return nil, status.Errorf(codes.InvalidArgument, "response_count must be between 0 and %d, got %d", maxMatrixResponses, count)
+	}
+	if count == 0 {
+		count = 1
+	}
+	responses := []*pb.MatrixResponse{}
+	for i := int32(0); i < count; i++ {
+		responses = append(responses, &pb.MatrixResponse{
+			Content: in.GetContent(),
+			Index:   i,
+			Method:  "google.showcase.v1beta1.Matrix." + method,
+		})
+	}
+	return responses, nil
+}
+
+// matrixOperation returns the operation named name started by the LongRunning method, which is
+// always done.
+func matrixOperation(name string) (*lropb.Operation, error) {
+	reqBytes, err := base64.URLEncoding.DecodeString(strings.TrimPrefix(name, matrixOperationPrefix))
+	in := &pb.MatrixRequest{}
+	if err == nil {
+		err = proto.Unmarshal(reqBytes, in)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Operation %q not found.", name)
+	}
+	responses, err := matrixResponses(in, "LongRunning")
+	if err != nil {
+		return nil, err
+	}
+	metadata, _ := ptypes.MarshalAny(&pb.MatrixMetadata{Method: responses[0].GetMethod()})
+	response, _ := ptypes.MarshalAny(responses[0])
+	return &lropb.Operation{
+		Name:     name,
+		Done:     true,
+		Metadata: metadata,
+		Result:   &lropb.Operation_Response{Response: response},
+	}, nil
+}
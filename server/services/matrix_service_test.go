@@ -0,0 +1,207 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func startMatrixServer(t *testing.T) pb.MatrixClient {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	pb.RegisterMatrixServer(s, NewMatrixServer())
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewMatrixClient(conn)
+}
+
+func TestMatrix_unary(t *testing.T) {
+	s := NewMatrixServer()
+	resp, err := s.Unary(context.Background(), &pb.MatrixRequest{Content: "hello"})
+	if err != nil {
+		t.Fatalf("Unary: unexpected err %v", err)
+	}
+	if resp.GetContent() != "hello" || resp.GetMethod() != "google.showcase.v1beta1.Matrix.Unary" {
+		t.Errorf("Unary: got %v", resp)
+	}
+
+	if _, err := s.NoContent(context.Background(), &pb.MatrixRequest{}); err != nil {
+		t.Errorf("NoContent: unexpected err %v", err)
+	}
+
+	in := &pb.MatrixRequest{Error: &spb.Status{Code: int32(codes.Unavailable), Message: "down"}}
+	if _, err := s.Unary(context.Background(), in); status.Code(err) != codes.Unavailable {
+		t.Errorf("Unary: want Unavailable, got %v", err)
+	}
+	if _, err := s.NoContent(context.Background(), in); status.Code(err) != codes.Unavailable {
+		t.Errorf("NoContent: want Unavailable, got %v", err)
+	}
+	if _, err := s.Unary(context.Background(), &pb.MatrixRequest{ResponseCount: maxMatrixResponses + 1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Unary: want InvalidArgument for too many responses, got %v", err)
+	}
+}
+
+func TestMatrix_streams(t *testing.T) {
+	client := startMatrixServer(t)
+	ctx := context.Background()
+
+	server, err := client.ServerStream(ctx, &pb.MatrixRequest{Content: "hi", ResponseCount: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := int32(0); ; i++ {
+		resp, err := server.Recv()
+		if err == io.EOF {
+			if i != 3 {
+				t.Errorf("ServerStream: want 3 responses, got %d", i)
+			}
+			break
+		}
+		if err != nil {
+			t.Fatalf("ServerStream: unexpected err %v", err)
+		}
+		if resp.GetIndex() != i || resp.GetMethod() != "google.showcase.v1beta1.Matrix.ServerStream" {
+			t.Errorf("ServerStream: response %d: got %v", i, resp)
+		}
+	}
+
+	clientStream, err := client.ClientStream(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, content := range []string{"a", "b", "c"} {
+		if err := clientStream.Send(&pb.MatrixRequest{Content: content}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err := clientStream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("ClientStream: unexpected err %v", err)
+	}
+	if resp.GetContent() != "a b c" {
+		t.Errorf("ClientStream: want %q, got %q", "a b c", resp.GetContent())
+	}
+
+	bidi, err := client.BidiStream(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bidi.Send(&pb.MatrixRequest{Content: "x", ResponseCount: 2}); err != nil {
+		t.Fatal(err)
+	}
+	for i := int32(0); i < 2; i++ {
+		resp, err := bidi.Recv()
+		if err != nil {
+			t.Fatalf("BidiStream: unexpected err %v", err)
+		}
+		if resp.GetContent() != "x" || resp.GetIndex() != i {
+			t.Errorf("BidiStream: response %d: got %v", i, resp)
+		}
+	}
+	bidi.Send(&pb.MatrixRequest{Error: &spb.Status{Code: int32(codes.Aborted)}})
+	if _, err := bidi.Recv(); status.Code(err) != codes.Aborted {
+		t.Errorf("BidiStream: want Aborted, got %v", err)
+	}
+}
+
+func TestMatrix_longRunning(t *testing.T) {
+	s := NewMatrixServer()
+	op, err := s.LongRunning(context.Background(), &pb.MatrixRequest{Content: "done"})
+	if err != nil {
+		t.Fatalf("LongRunning: unexpected err %v", err)
+	}
+
+	got, err := NewOperationsServer(nil).GetOperation(context.Background(), &lropb.GetOperationRequest{Name: op.GetName()})
+	if err != nil {
+		t.Fatalf("GetOperation: unexpected err %v", err)
+	}
+	if !got.GetDone() {
+		t.Errorf("GetOperation: want a done operation, got %v", got)
+	}
+	metadata := &pb.MatrixMetadata{}
+	if err := ptypes.UnmarshalAny(got.GetMetadata(), metadata); err != nil {
+		t.Fatal(err)
+	}
+	if metadata.GetMethod() != "google.showcase.v1beta1.Matrix.LongRunning" {
+		t.Errorf("GetOperation: got metadata %v", metadata)
+	}
+	resp := &pb.MatrixResponse{}
+	if err := ptypes.UnmarshalAny(got.GetResponse(), resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetContent() != "done" {
+		t.Errorf("GetOperation: got response %v", resp)
+	}
+
+	_, err = NewOperationsServer(nil).GetOperation(context.Background(), &lropb.GetOperationRequest{Name: matrixOperationPrefix + "!"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetOperation: want NotFound for a malformed name, got %v", err)
+	}
+}
+
+func TestMatrix_pagedList(t *testing.T) {
+	s := NewMatrixServer()
+	indexes := []int32{}
+	in := &pb.PagedListRequest{ResponseCount: 5, PageSize: 2}
+	for pages := 0; ; pages++ {
+		page, err := s.PagedList(context.Background(), in)
+		if err != nil {
+			t.Fatalf("PagedList: unexpected err %v", err)
+		}
+		if len(page.GetResponses()) > 2 {
+			t.Errorf("PagedList: want at most 2 responses, got %d", len(page.GetResponses()))
+		}
+		for _, resp := range page.GetResponses() {
+			indexes = append(indexes, resp.GetIndex())
+		}
+		if page.GetNextPageToken() == "" {
+			if pages != 2 {
+				t.Errorf("PagedList: want 3 pages, got %d", pages+1)
+			}
+			break
+		}
+		in.PageToken = page.GetNextPageToken()
+	}
+	if len(indexes) != 5 || indexes[4] != 4 {
+		t.Errorf("PagedList: want responses 0 to 4, got %v", indexes)
+	}
+
+	if _, err := s.PagedList(context.Background(), &pb.PagedListRequest{PageToken: "bogus"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PagedList: want InvalidArgument for a bad token, got %v", err)
+	}
+	if _, err := s.PagedList(context.Background(), &pb.PagedListRequest{PageSize: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PagedList: want InvalidArgument for a negative page size, got %v", err)
+	}
+}
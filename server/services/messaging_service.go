@@ -19,6 +19,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -37,15 +38,24 @@ import (
 
 // NewMessagingServer returns an instance of a messaging server.
 func NewMessagingServer(identityServer ReadOnlyIdentityServer) MessagingServer {
+	return NewScheduledMessagingServer(identityServer, nil)
+}
+
+// NewScheduledMessagingServer returns an instance of a messaging server delivering blurb events
+// to the streams observing them in the order decided by scheduler. A nil scheduler delivers
+// events in the order the streams were opened.
+func NewScheduledMessagingServer(identityServer ReadOnlyIdentityServer, scheduler *server.StreamScheduler) MessagingServer {
 	return &messagingServerImpl{
 		identityServer: identityServer,
-		nowF:           time.Now,
+		scheduler:      scheduler,
+		nowF:           server.GetClockInstance().Now,
 		token:          server.NewTokenGenerator(),
 		roomKeys:       map[string]int{},
 		blurbKeys:      map[string]blurbIndex{},
 		blurbs:         map[string][]blurbEntry{},
 		parentUids:     map[string]*server.UniqID{},
 		observers:      map[string]map[string]blurbObserver{},
+		roomFeed:       server.NewChangeFeed(),
 	}
 }
 
@@ -63,18 +73,23 @@ type messagingServerImpl struct {
 	identityServer ReadOnlyIdentityServer
 
 	roomUID  server.UniqID
+	etagUID  server.UniqID
 	roomMu   sync.Mutex
 	roomKeys map[string]int
 	rooms    []roomEntry
+	roomFeed *server.ChangeFeed
 
 	blurbMu    sync.Mutex
 	blurbKeys  map[string]blurbIndex
 	blurbs     map[string][]blurbEntry
 	parentUids map[string]*server.UniqID
 
+	store *server.Store
+
 	obsMu     sync.Mutex
 	obsUID    server.UniqID
 	observers map[string]map[string]blurbObserver
+	scheduler *server.StreamScheduler
 }
 
 type roomEntry struct {
@@ -125,16 +140,23 @@ func (s *messagingServerImpl) CreateRoom(ctx context.Context, in *pb.CreateRoomR
 	// Assign info.
 	id := s.roomUID.Next()
 	name := fmt.Sprintf("rooms/%d", id)
-	now := ptypes.TimestampNow()
+	now := server.GetClockInstance().Timestamp()
 
 	r.Name = name
 	r.CreateTime = now
 	r.UpdateTime = now
+	r.Etag = nextEtag(&s.etagUID)
 
 	// Insert.
 	index := len(s.rooms)
 	s.rooms = append(s.rooms, roomEntry{room: r})
 	s.roomKeys[name] = index
+	if err := s.saveRooms(); err != nil {
+		s.rooms = s.rooms[:index]
+		delete(s.roomKeys, name)
+		return nil, err
+	}
+	s.roomFeed.Publish(name, server.ChangeCreated, r)
 
 	return r, nil
 }
@@ -183,6 +205,9 @@ func (s *messagingServerImpl) UpdateRoom(ctx context.Context, in *pb.UpdateRoomR
 	}
 
 	entry := s.rooms[i]
+	if err := checkEtag(r.GetEtag(), entry.room.GetEtag(), r.GetName()); err != nil {
+		return nil, err
+	}
 	// Validate Unique Fields.
 	uniqName := func(x *pb.Room) bool {
 		return x != entry.room && (r.GetDisplayName() == x.GetDisplayName())
@@ -200,9 +225,15 @@ func (s *messagingServerImpl) UpdateRoom(ctx context.Context, in *pb.UpdateRoomR
 		DisplayName: r.GetDisplayName(),
 		Description: r.GetDescription(),
 		CreateTime:  entry.room.GetCreateTime(),
-		UpdateTime:  ptypes.TimestampNow(),
+		UpdateTime:  server.GetClockInstance().Timestamp(),
+		Etag:        nextEtag(&s.etagUID),
 	}
 	s.rooms[i] = roomEntry{room: updated}
+	if err := s.saveRooms(); err != nil {
+		s.rooms[i] = entry
+		return nil, err
+	}
+	s.roomFeed.Publish(updated.GetName(), server.ChangeUpdated, updated)
 	return updated, nil
 }
 
@@ -221,6 +252,13 @@ func (s *messagingServerImpl) DeleteRoom(ctx context.Context, in *pb.DeleteRoomR
 
 	entry := s.rooms[i]
 	s.rooms[i] = roomEntry{room: entry.room, deleted: true}
+	if err := s.saveRooms(); err != nil {
+		s.rooms[i] = entry
+		return nil, err
+	}
+	if !entry.deleted {
+		s.roomFeed.Publish(entry.room.GetName(), server.ChangeDeleted, entry.room)
+	}
 
 	return &empty.Empty{}, nil
 }
@@ -272,6 +310,42 @@ func validateRoom(r *pb.Room) error {
 	return nil
 }
 
+// This returns a stream that emits the changes made to a chat room as it is
+// updated and deleted, closing after its deletion.
+func (s *messagingServerImpl) WatchRoom(in *pb.WatchRoomRequest, stream pb.Messaging_WatchRoomServer) error {
+	name := in.GetName()
+	expire, err := watchExpiry(in.GetExpireTime())
+	if err != nil {
+		return err
+	}
+	resumeToken := in.GetResumeToken()
+	if resumeToken == "" {
+		// Start from the room as it is, so that its deletion cannot be missed.
+		s.roomMu.Lock()
+		i, ok := s.roomKeys[name]
+		if ok && !s.rooms[i].deleted {
+			resumeToken = s.roomFeed.Head()
+		}
+		s.roomMu.Unlock()
+		if resumeToken == "" {
+			return status.Errorf(codes.NotFound, "A room with name %s not found.", name)
+		}
+	}
+
+	ofRoom := func(change *server.Change) bool { return change.Name == name }
+	return s.roomFeed.Watch(stream.Context(), resumeToken, expire, ofRoom, func(change *server.Change) error {
+		err := stream.Send(&pb.WatchRoomResponse{
+			Room:        change.Resource.(*pb.Room),
+			Action:      pb.WatchRoomResponse_Action(change.Action),
+			ResumeToken: change.ResumeToken,
+		})
+		if err == nil && change.Action == server.ChangeDeleted {
+			return io.EOF
+		}
+		return err
+	})
+}
+
 // Creates a blurb. If the parent is a room, the blurb is understood to be a
 // message in that room. If the parent is a profile, the blurb is understood
 // to be a post on the profile.
@@ -290,8 +364,8 @@ func (s *messagingServerImpl) CreateBlurb(ctx context.Context, in *pb.CreateBlur
 	}
 
 	// Assign info.
-	parentBs, ok := s.blurbs[parent]
-	if !ok {
+	parentBs, hadBlurbs := s.blurbs[parent]
+	if !hadBlurbs {
 		parentBs = []blurbEntry{}
 	}
 	puid, ok := s.parentUids[parent]
@@ -302,7 +376,7 @@ func (s *messagingServerImpl) CreateBlurb(ctx context.Context, in *pb.CreateBlur
 
 	id := puid.Next()
 	name := fmt.Sprintf("%s/blurbs/%d", parent, id)
-	now := ptypes.TimestampNow()
+	now := server.GetClockInstance().Timestamp()
 	switch legacyID := b.LegacyId.(type) {
 	case *pb.Blurb_LegacyRoomId:
 		name = fmt.Sprintf("%s/blurbs/legacy/%s.%d", parent, legacyID.LegacyRoomId, id)
@@ -317,14 +391,19 @@ func (s *messagingServerImpl) CreateBlurb(ctx context.Context, in *pb.CreateBlur
 	index := len(parentBs)
 	s.blurbs[parent] = append(parentBs, blurbEntry{blurb: b})
 	s.blurbKeys[name] = blurbIndex{row: parent, col: index}
+	if err := s.saveBlurbs(); err != nil {
+		if hadBlurbs {
+			s.blurbs[parent] = parentBs
+		} else {
+			delete(s.blurbs, parent)
+		}
+		delete(s.blurbKeys, name)
+		return nil, err
+	}
 
 	// Call observers.
-	s.obsMu.Lock()
-	defer s.obsMu.Unlock()
-	if parentObservers, ok := s.observers[parent]; ok {
-		for _, o := range parentObservers {
-			o.OnCreate(b)
-		}
+	for _, o := range s.scheduledObservers(parent, pb.StreamBlurbsResponse_CREATE, b) {
+		o.OnCreate(b)
 	}
 
 	return b, nil
@@ -372,16 +451,17 @@ func (s *messagingServerImpl) UpdateBlurb(ctx context.Context, in *pb.UpdateBlur
 	}
 	// Update store.
 	updated := proto.Clone(b).(*pb.Blurb)
-	updated.UpdateTime = ptypes.TimestampNow()
+	updated.UpdateTime = server.GetClockInstance().Timestamp()
+	previous := s.blurbs[i.row][i.col]
 	s.blurbs[i.row][i.col] = blurbEntry{blurb: updated}
+	if err := s.saveBlurbs(); err != nil {
+		s.blurbs[i.row][i.col] = previous
+		return nil, err
+	}
 
 	// Call observers.
-	s.obsMu.Lock()
-	defer s.obsMu.Unlock()
-	if parentObservers, ok := s.observers[i.row]; ok {
-		for _, o := range parentObservers {
-			o.OnUpdate(updated)
-		}
+	for _, o := range s.scheduledObservers(i.row, pb.StreamBlurbsResponse_UPDATE, updated) {
+		o.OnUpdate(updated)
 	}
 
 	return updated, nil
@@ -402,14 +482,14 @@ func (s *messagingServerImpl) DeleteBlurb(ctx context.Context, in *pb.DeleteBlur
 
 	entry := s.blurbs[i.row][i.col]
 	s.blurbs[i.row][i.col] = blurbEntry{blurb: entry.blurb, deleted: true}
+	if err := s.saveBlurbs(); err != nil {
+		s.blurbs[i.row][i.col] = entry
+		return nil, err
+	}
 
 	// Call observers.
-	s.obsMu.Lock()
-	defer s.obsMu.Unlock()
-	if parentObservers, ok := s.observers[i.row]; ok {
-		for _, o := range parentObservers {
-			o.OnDelete(entry.blurb)
-		}
+	for _, o := range s.scheduledObservers(i.row, pb.StreamBlurbsResponse_DELETE, entry.blurb) {
+		o.OnDelete(entry.blurb)
 	}
 
 	return &empty.Empty{}, nil
@@ -715,6 +795,29 @@ func (s *messagingServerImpl) registerObserver(parent string, o blurbObserver) s
 	return name
 }
 
+// scheduledObservers returns the observers of parent in the order they are to be told of the
+// action on b.
+func (s *messagingServerImpl) scheduledObservers(parent string, action pb.StreamBlurbsResponse_Action, b *pb.Blurb) []blurbObserver {
+	s.obsMu.Lock()
+	defer s.obsMu.Unlock()
+	names := []string{}
+	for name := range s.observers[parent] {
+		names = append(names, name)
+	}
+	// Observer names are assigned in increasing order as streams are opened.
+	sort.Slice(names, func(i, j int) bool {
+		x, _ := strconv.ParseInt(names[i], 10, 64)
+		y, _ := strconv.ParseInt(names[j], 10, 64)
+		return x < y
+	})
+
+	observers := []blurbObserver{}
+	for _, i := range s.scheduler.Order(fmt.Sprintf("%s %s", action, b.GetName()), len(names)) {
+		observers = append(observers, s.observers[parent][names[i]])
+	}
+	return observers
+}
+
 func (s *messagingServerImpl) hasObservers(parent string) bool {
 	s.obsMu.Lock()
 	defer s.obsMu.Unlock()
@@ -732,3 +835,121 @@ func (s *messagingServerImpl) removeObserver(parent string, name string) {
 		delete(s.observers, parent)
 	}
 }
+
+// ResetState deletes all rooms and blurbs. Streams watching blurbs stay open.
+func (s *messagingServerImpl) ResetState() {
+	s.roomMu.Lock()
+	s.roomKeys = map[string]int{}
+	s.rooms = nil
+	// There is no caller to report a failure to save to; the next change saves again.
+	s.saveRooms()
+	s.roomMu.Unlock()
+
+	s.blurbMu.Lock()
+	s.blurbKeys = map[string]blurbIndex{}
+	s.blurbs = map[string][]blurbEntry{}
+	s.saveBlurbs()
+	s.blurbMu.Unlock()
+}
+
+// The sections of a server.Store the rooms and blurbs are persisted to.
+const (
+	roomsStoreSection  = "messaging.rooms"
+	blurbsStoreSection = "messaging.blurbs"
+)
+
+// Persist restores the rooms and blurbs saved in store, if any, and saves them there after
+// every change. Deleted rooms and blurbs are not saved, so their names may be reused after a
+// restart.
+func (s *messagingServerImpl) Persist(store *server.Store) error {
+	s.roomMu.Lock()
+	defer s.roomMu.Unlock()
+	s.blurbMu.Lock()
+	defer s.blurbMu.Unlock()
+
+	rooms := &pb.ListRoomsResponse{}
+	if _, err := store.Load(roomsStoreSection, rooms); err != nil {
+		return err
+	}
+	blurbs := &pb.ListBlurbsResponse{}
+	if _, err := store.Load(blurbsStoreSection, blurbs); err != nil {
+		return err
+	}
+	s.store = store
+
+	// Keep new rooms and blurbs from reusing the names and etags of the restored ones.
+	for _, r := range rooms.GetRooms() {
+		s.roomKeys[r.GetName()] = len(s.rooms)
+		s.rooms = append(s.rooms, roomEntry{room: r})
+		var id int64
+		if _, err := fmt.Sscanf(r.GetName(), "rooms/%d", &id); err == nil {
+			s.roomUID.Advance(id + 1)
+		}
+		if etag, err := strconv.ParseInt(r.GetEtag(), 16, 64); err == nil {
+			s.etagUID.Advance(etag + 1)
+		}
+	}
+	for _, b := range blurbs.GetBlurbs() {
+		sep := strings.LastIndex(b.GetName(), "/blurbs/")
+		if sep < 0 {
+			continue
+		}
+		parent := b.GetName()[:sep]
+		s.blurbKeys[b.GetName()] = blurbIndex{row: parent, col: len(s.blurbs[parent])}
+		s.blurbs[parent] = append(s.blurbs[parent], blurbEntry{blurb: b})
+		puid, ok := s.parentUids[parent]
+		if !ok {
+			puid = &server.UniqID{}
+			s.parentUids[parent] = puid
+		}
+		// Legacy names end in the id after a "." or a "~".
+		suffix := b.GetName()[strings.LastIndexAny(b.GetName(), "/.~")+1:]
+		if id, err := strconv.ParseInt(suffix, 10, 64); err == nil {
+			puid.Advance(id + 1)
+		}
+	}
+	return nil
+}
+
+// saveRooms persists the rooms that are not deleted, if the server has a store. s.roomMu must
+// be held.
+func (s *messagingServerImpl) saveRooms() error {
+	if s.store == nil {
+		return nil
+	}
+	saved := &pb.ListRoomsResponse{}
+	for _, entry := range s.rooms {
+		if !entry.deleted {
+			saved.Rooms = append(saved.Rooms, entry.room)
+		}
+	}
+	if err := s.store.Save(roomsStoreSection, saved); err != nil {
+		return status.Errorf(codes.Internal, "The rooms could not be persisted: %v", err)
+	}
+	return nil
+}
+
+// saveBlurbs persists the blurbs that are not deleted, if the server has a store. s.blurbMu must
+// be held.
+func (s *messagingServerImpl) saveBlurbs() error {
+	if s.store == nil {
+		return nil
+	}
+	parents := make([]string, 0, len(s.blurbs))
+	for parent := range s.blurbs {
+		parents = append(parents, parent)
+	}
+	sort.Strings(parents)
+	saved := &pb.ListBlurbsResponse{}
+	for _, parent := range parents {
+		for _, entry := range s.blurbs[parent] {
+			if !entry.deleted {
+				saved.Blurbs = append(saved.Blurbs, entry.blurb)
+			}
+		}
+	}
+	if err := s.store.Save(blurbsStoreSection, saved); err != nil {
+		return status.Errorf(codes.Internal, "The blurbs could not be persisted: %v", err)
+	}
+	return nil
+}
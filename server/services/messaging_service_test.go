@@ -19,6 +19,8 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -1885,3 +1887,255 @@ func Test_Connect_creationFailure(t *testing.T) {
 			status.Code())
 	}
 }
+
+type recordingObserver struct {
+	id  int
+	log *[]string
+}
+
+func (o *recordingObserver) OnCreate(b *pb.Blurb) {
+	*o.log = append(*o.log, fmt.Sprintf("%d: create %s", o.id, b.GetName()))
+}
+
+func (o *recordingObserver) OnUpdate(b *pb.Blurb) {
+	*o.log = append(*o.log, fmt.Sprintf("%d: update %s", o.id, b.GetName()))
+}
+
+func (o *recordingObserver) OnDelete(b *pb.Blurb) {
+	*o.log = append(*o.log, fmt.Sprintf("%d: delete %s", o.id, b.GetName()))
+}
+
+func TestScheduledMessagingServer_deliveryOrder(t *testing.T) {
+	deliveries := func(scheduler *server.StreamScheduler) []string {
+		s := NewScheduledMessagingServer(&mockIdentityServer{}, scheduler).(*messagingServerImpl)
+		log := []string{}
+		for i := 0; i < 3; i++ {
+			s.registerObserver("users/rumble/profile", &recordingObserver{id: i, log: &log})
+		}
+		for i := 0; i < 10; i++ {
+			b, err := s.CreateBlurb(context.Background(), &pb.CreateBlurbRequest{
+				Parent: "users/rumble/profile",
+				Blurb:  &pb.Blurb{User: "users/rumble", Content: &pb.Blurb_Text{Text: "woof"}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := s.DeleteBlurb(context.Background(), &pb.DeleteBlurbRequest{Name: b.GetName()}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return log
+	}
+
+	unscheduled := deliveries(nil)
+	for i, entry := range unscheduled {
+		if !strings.HasPrefix(entry, fmt.Sprintf("%d: ", i%3)) {
+			t.Fatalf("unscheduled delivery %d: got %q, want delivery in the order observers registered", i, entry)
+		}
+	}
+
+	first, second := deliveries(server.NewStreamScheduler(7)), deliveries(server.NewStreamScheduler(7))
+	if strings.Join(first, "\n") != strings.Join(second, "\n") {
+		t.Errorf("deliveries with the same seed differ:\n%v\n%v", first, second)
+	}
+	if strings.Join(first, "\n") == strings.Join(unscheduled, "\n") {
+		t.Errorf("seeded deliveries were in the order observers registered: %v", first)
+	}
+}
+
+type mockWatchRoomStream struct {
+	resps []*pb.WatchRoomResponse
+	pb.Messaging_WatchRoomServer
+}
+
+func (m *mockWatchRoomStream) Context() context.Context { return context.Background() }
+
+func (m *mockWatchRoomStream) Send(resp *pb.WatchRoomResponse) error {
+	m.resps = append(m.resps, resp)
+	return nil
+}
+
+func TestWatchRoom(t *testing.T) {
+	s := NewMessagingServer(NewIdentityServer())
+	room, err := s.CreateRoom(context.Background(), &pb.CreateRoomRequest{Room: &pb.Room{DisplayName: "Living room"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := s.(*messagingServerImpl).roomFeed.Head()
+	other, err := s.CreateRoom(context.Background(), &pb.CreateRoomRequest{Room: &pb.Room{DisplayName: "Kitchen"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.UpdateRoom(context.Background(), &pb.UpdateRoomRequest{Room: &pb.Room{Name: room.GetName(), DisplayName: "Lounge"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.DeleteRoom(context.Background(), &pb.DeleteRoomRequest{Name: room.GetName()}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The stream closes after the room's deletion.
+	stream := &mockWatchRoomStream{}
+	if err := s.WatchRoom(&pb.WatchRoomRequest{Name: room.GetName(), ResumeToken: token}, stream); err != nil {
+		t.Fatalf("WatchRoom: unexpected err %v", err)
+	}
+	if len(stream.resps) != 2 ||
+		stream.resps[0].GetAction() != pb.WatchRoomResponse_UPDATE || stream.resps[0].GetRoom().GetDisplayName() != "Lounge" ||
+		stream.resps[1].GetAction() != pb.WatchRoomResponse_DELETE {
+		t.Errorf("WatchRoom: want the update and deletion of %s, got %v", room.GetName(), stream.resps)
+	}
+
+	err = s.WatchRoom(&pb.WatchRoomRequest{Name: room.GetName()}, &mockWatchRoomStream{})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("WatchRoom: want NotFound watching a deleted room, got %v", err)
+	}
+
+	expireTime, _ := ptypes.TimestampProto(time.Now().Add(10 * time.Millisecond))
+	stream = &mockWatchRoomStream{}
+	err = s.WatchRoom(&pb.WatchRoomRequest{Name: other.GetName(), ExpireTime: expireTime}, stream)
+	if err != nil || len(stream.resps) != 0 {
+		t.Errorf("WatchRoom: want an unchanged room's stream to expire empty, got %v, %v", stream.resps, err)
+	}
+}
+
+func Test_UpdateRoom_staleEtag(t *testing.T) {
+	s := NewMessagingServer(NewIdentityServer())
+	room, err := s.CreateRoom(context.Background(), &pb.CreateRoomRequest{Room: &pb.Room{DisplayName: "Living room"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.UpdateRoom(context.Background(), &pb.UpdateRoomRequest{
+		Room: &pb.Room{Name: room.GetName(), DisplayName: "Lounge", Etag: room.GetEtag()},
+	})
+	if err != nil {
+		t.Fatalf("UpdateRoom: unexpected err %+v", err)
+	}
+	_, err = s.UpdateRoom(context.Background(), &pb.UpdateRoomRequest{
+		Room: &pb.Room{Name: room.GetName(), DisplayName: "Den", Etag: room.GetEtag()},
+	})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("UpdateRoom: want Aborted for a stale etag, got %v", err)
+	}
+}
+
+func Test_Persist_roomsAndBlurbs(t *testing.T) {
+	path := tempStorePath(t)
+	ctx := context.Background()
+	restart := func() (pb.IdentityServer, MessagingServer) {
+		identity := persistedIdentityServer(t, path)
+		messaging := NewMessagingServer(identity)
+		store, err := server.OpenStore(path)
+		if err != nil {
+			t.Fatalf("OpenStore: %v", err)
+		}
+		if err := messaging.(Persister).Persist(store); err != nil {
+			t.Fatalf("Persist: %v", err)
+		}
+		return identity, messaging
+	}
+
+	_, s := restart()
+	room, err := s.CreateRoom(ctx, &pb.CreateRoomRequest{Room: &pb.Room{DisplayName: "Living Room"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blurb, err := s.CreateBlurb(ctx, &pb.CreateBlurbRequest{
+		Parent: room.GetName(),
+		Blurb:  &pb.Blurb{User: "users/rumble", Content: &pb.Blurb_Text{Text: "woof"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, restarted := restart()
+	gotRoom, err := restarted.GetRoom(ctx, &pb.GetRoomRequest{Name: room.GetName()})
+	if err != nil {
+		t.Fatalf("GetRoom of a persisted room: %v", err)
+	}
+	if !proto.Equal(gotRoom, room) {
+		t.Errorf("GetRoom of a persisted room: got %v, want %v", gotRoom, room)
+	}
+	gotBlurb, err := restarted.GetBlurb(ctx, &pb.GetBlurbRequest{Name: blurb.GetName()})
+	if err != nil {
+		t.Fatalf("GetBlurb of a persisted blurb: %v", err)
+	}
+	if !proto.Equal(gotBlurb, blurb) {
+		t.Errorf("GetBlurb of a persisted blurb: got %v, want %v", gotBlurb, blurb)
+	}
+
+	next, err := restarted.CreateBlurb(ctx, &pb.CreateBlurbRequest{
+		Parent: room.GetName(),
+		Blurb:  &pb.Blurb{User: "users/rumble", Content: &pb.Blurb_Text{Text: "bark"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.GetName() == blurb.GetName() {
+		t.Errorf("CreateBlurb after a restart reused the name %s", next.GetName())
+	}
+}
+
+func Test_Persist_roomsAndBlurbsSaveFailed(t *testing.T) {
+	path := tempStorePath(t)
+	ctx := context.Background()
+	store, err := server.OpenStore(path)
+	if err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+	s := NewMessagingServer(NewIdentityServer())
+	if err := s.(Persister).Persist(store); err != nil {
+		t.Fatalf("Persist: %v", err)
+	}
+	room, err := s.CreateRoom(ctx, &pb.CreateRoomRequest{Room: &pb.Room{DisplayName: "Living Room"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blurb, err := s.CreateBlurb(ctx, &pb.CreateBlurbRequest{
+		Parent: room.GetName(),
+		Blurb:  &pb.Blurb{User: "users/rumble", Content: &pb.Blurb_Text{Text: "woof"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// With its directory gone the store cannot be written, and no change may stick.
+	os.RemoveAll(filepath.Dir(path))
+	if _, err := s.CreateRoom(ctx, &pb.CreateRoomRequest{Room: &pb.Room{DisplayName: "Kitchen"}}); err == nil {
+		t.Error("CreateRoom: expected an error when the store cannot be written")
+	}
+	if _, err := s.UpdateRoom(ctx, &pb.UpdateRoomRequest{Room: &pb.Room{Name: room.GetName(), DisplayName: "Den"}}); err == nil {
+		t.Error("UpdateRoom: expected an error when the store cannot be written")
+	}
+	if _, err := s.DeleteRoom(ctx, &pb.DeleteRoomRequest{Name: room.GetName()}); err == nil {
+		t.Error("DeleteRoom: expected an error when the store cannot be written")
+	}
+	if _, err := s.CreateBlurb(ctx, &pb.CreateBlurbRequest{
+		Parent: room.GetName(),
+		Blurb:  &pb.Blurb{User: "users/rumble", Content: &pb.Blurb_Text{Text: "bark"}},
+	}); err == nil {
+		t.Error("CreateBlurb: expected an error when the store cannot be written")
+	}
+	if _, err := s.UpdateBlurb(ctx, &pb.UpdateBlurbRequest{
+		Blurb: &pb.Blurb{Name: blurb.GetName(), User: "users/rumble", Content: &pb.Blurb_Text{Text: "growl"}},
+	}); err == nil {
+		t.Error("UpdateBlurb: expected an error when the store cannot be written")
+	}
+	if _, err := s.DeleteBlurb(ctx, &pb.DeleteBlurbRequest{Name: blurb.GetName()}); err == nil {
+		t.Error("DeleteBlurb: expected an error when the store cannot be written")
+	}
+
+	rooms, err := s.ListRooms(ctx, &pb.ListRoomsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rooms.GetRooms()) != 1 || !proto.Equal(rooms.GetRooms()[0], room) {
+		t.Errorf("ListRooms after failed saves: got %v, want only %v", rooms.GetRooms(), room)
+	}
+	blurbs, err := s.ListBlurbs(ctx, &pb.ListBlurbsRequest{Parent: room.GetName()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blurbs.GetBlurbs()) != 1 || !proto.Equal(blurbs.GetBlurbs()[0], blurb) {
+		t.Errorf("ListBlurbs after failed saves: got %v, want only %v", blurbs.GetBlurbs(), blurb)
+	}
+}
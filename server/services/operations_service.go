@@ -34,29 +34,48 @@ import (
 
 // NewOperationsServer returns a new OperationsServer for the Showcase API.
 func NewOperationsServer(messagingServer MessagingServer) lropb.OperationsServer {
-	return &operationsServerImpl{waiter: server.GetWaiterInstance(), messagingServer: messagingServer}
+	return &operationsServerImpl{waiter: server.GetWaiterInstance(), messagingServer: messagingServer, token: server.NewTokenGenerator()}
 }
 
 type operationsServerImpl struct {
 	messagingServer MessagingServer
 	waiter          server.Waiter
+	token           server.TokenGenerator
 }
 
 func (s *operationsServerImpl) GetOperation(ctx context.Context, in *lropb.GetOperationRequest) (*lropb.Operation, error) {
-	if op, err := s.handleWait(in); op != nil || err != nil {
+	return s.getOperation(in, true)
+}
+
+// getOperation returns the operation named in the request, counting the call as a poll of it if
+// poll is true.
+func (s *operationsServerImpl) getOperation(in *lropb.GetOperationRequest, poll bool) (*lropb.Operation, error) {
+	if op, err := s.handleWait(in, poll); op != nil || err != nil {
+		return op, err
+	}
+	if op, err := s.handleNestedWait(in); op != nil || err != nil {
 		return op, err
 	}
 	if op, err := s.handleSearchBlurbs(in); op != nil || err != nil {
 		return op, err
 	}
+	if strings.HasPrefix(in.GetName(), matrixOperationPrefix) {
+		return matrixOperation(in.GetName())
+	}
+	if strings.HasPrefix(in.GetName(), pagedExpandOperationPrefix) {
+		return pagedExpandOperation(in.GetName())
+	}
 	return nil, status.Errorf(codes.NotFound, "Operation %q not found.", in.Name)
 }
 
-func (s *operationsServerImpl) handleWait(in *lropb.GetOperationRequest) (*lropb.Operation, error) {
+func (s *operationsServerImpl) handleWait(in *lropb.GetOperationRequest, poll bool) (*lropb.Operation, error) {
 	prefix := "operations/google.showcase.v1beta1.Echo/Wait/"
 	if !strings.HasPrefix(in.Name, prefix) {
 		return nil, nil
 	}
+	if s.waiter.Deleted(in.GetName()) {
+		return nil, status.Errorf(codes.NotFound, "Operation %q not found.", in.Name)
+	}
 
 	waitReq := &pb.WaitRequest{}
 	encodedBytes := strings.TrimPrefix(in.Name, prefix)
@@ -70,9 +89,36 @@ func (s *operationsServerImpl) handleWait(in *lropb.GetOperationRequest) (*lropb
 		return nil, status.Errorf(codes.NotFound, "Operation %q not found.", in.Name)
 	}
 
+	if poll {
+		return s.waiter.Poll(waitReq), nil
+	}
 	return s.waiter.Wait(waitReq), nil
 }
 
+func (s *operationsServerImpl) handleNestedWait(in *lropb.GetOperationRequest) (*lropb.Operation, error) {
+	prefix := "operations/google.showcase.v1beta1.Echo/NestedWait/"
+	if !strings.HasPrefix(in.Name, prefix) {
+		return nil, nil
+	}
+	if s.waiter.Deleted(in.GetName()) {
+		return nil, status.Errorf(codes.NotFound, "Operation %q not found.", in.Name)
+	}
+
+	nestedReq := &pb.NestedWaitRequest{}
+	encodedBytes := strings.TrimPrefix(in.Name, prefix)
+	nestedReqBytes, err := base64.StdEncoding.DecodeString(encodedBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Operation %q not found.", in.Name)
+	}
+
+	err = proto.Unmarshal(nestedReqBytes, nestedReq)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Operation %q not found.", in.Name)
+	}
+
+	return s.waiter.NestedWait(nestedReq), nil
+}
+
 func (s *operationsServerImpl) handleSearchBlurbs(in *lropb.GetOperationRequest) (*lropb.Operation, error) {
 	prefix := "operations/google.showcase.v1beta1.Messaging/SearchBlurbs/"
 	if !strings.HasPrefix(in.GetName(), prefix) {
@@ -128,50 +174,128 @@ func searchFilterFunc(query string) func(b *pb.Blurb) bool {
 	}
 }
 
-// CancelOperation returns a successful response if the resource name is not blank
+// CancelOperation cancels the Wait or NestedWait operation named in the request which, unless
+// done by then, completes with a CANCELLED error. Other operations are done once started, so
+// cancelling them succeeds without effect.
 func (s operationsServerImpl) CancelOperation(ctx context.Context, in *lropb.CancelOperationRequest) (*empty.Empty, error) {
 	if in.Name == "" {
 		return nil, status.Error(codes.NotFound, "cannot cancel operation without a name.")
 	}
+	if !isWaitOperation(in.GetName()) {
+		return &empty.Empty{}, nil
+	}
+	if _, err := s.getOperation(&lropb.GetOperationRequest{Name: in.GetName()}, false); err != nil {
+		return nil, err
+	}
+	s.waiter.Cancel(in.GetName())
 	return &empty.Empty{}, nil
 }
 
-// ListOperations returns a fixed response matching the given PageSize if the resource name is not blank
+// isWaitOperation returns whether name is that of a Wait or NestedWait operation.
+func isWaitOperation(name string) bool {
+	return strings.HasPrefix(name, "operations/google.showcase.v1beta1.Echo/Wait/") ||
+		strings.HasPrefix(name, "operations/google.showcase.v1beta1.Echo/NestedWait/")
+}
+
+// ListOperations lists the Wait and NestedWait operations started and not deleted, oldest
+// first, whose names start with the name in the request followed by a slash, so that
+// "operations" lists them all. The filter may require operations to be done or not, as in
+// "done = true", and their names to match a pattern that may end with a wildcard, as in
+// name = "operations/google.showcase.v1beta1.Echo/Wait/*"; both may be required, joined with AND.
 func (s operationsServerImpl) ListOperations(ctx context.Context, in *lropb.ListOperationsRequest) (*lropb.ListOperationsResponse, error) {
 	if in.Name == "" {
 		return nil, status.Error(codes.NotFound, "cannot list operation without a name.")
 	}
+	matches, err := operationsFilter(in.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	start, err := s.token.GetIndex(in.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(in.GetPageSize())
+	if pageSize <= 0 || pageSize > maxOperationsPageSize {
+		pageSize = maxOperationsPageSize
+	}
 
-	var operations []*lropb.Operation
-	if in.PageSize > 0 {
-		for i := 1; i <= int(in.PageSize); i++ {
-			var result *lropb.Operation_Response
-			if i%2 == 0 {
-				result = &lropb.Operation_Response{}
-			}
-			operations = append(operations, &lropb.Operation{
-				Name:   "the/thing/" + strconv.Itoa(i),
-				Done:   result != nil,
-				Result: result,
-			})
+	parent := strings.TrimSuffix(in.GetName(), "/") + "/"
+	response := &lropb.ListOperationsResponse{Operations: []*lropb.Operation{}}
+	for _, started := range s.waiter.Operations() {
+		op := started.Operation
+		if started.Position < int64(start) || !strings.HasPrefix(op.GetName(), parent) || !matches(op) {
+			continue
 		}
-	} else {
-		operations = append(operations, &lropb.Operation{
-			Name: "a/pending/thing",
-			Done: false,
-		})
+		if len(response.Operations) == pageSize {
+			response.NextPageToken = s.token.ForIndex(int(started.Position))
+			break
+		}
+		response.Operations = append(response.Operations, op)
 	}
+	return response, nil
+}
+
+// maxOperationsPageSize is the number of operations ListOperations lists at most per page, and
+// by default.
+const maxOperationsPageSize = 100
 
-	return &lropb.ListOperationsResponse{
-		Operations: operations,
+// operationsFilter returns the function deciding whether an operation matches filter.
+func operationsFilter(filter string) (func(*lropb.Operation) bool, error) {
+	conditions := []func(*lropb.Operation) bool{}
+	if strings.TrimSpace(filter) != "" {
+		for _, term := range strings.Split(filter, " AND ") {
+			parts := strings.SplitN(term, "=", 2)
+			if len(parts) != 2 {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid filter term %q: want a field, \"=\" and a value", term)
+			}
+			field, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+			switch field {
+			case "done":
+				done, err := strconv.ParseBool(value)
+				if err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid filter term %q: done must be true or false", term)
+				}
+				conditions = append(conditions, func(op *lropb.Operation) bool { return op.GetDone() == done })
+			case "name":
+				pattern, err := strconv.Unquote(value)
+				if err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid filter term %q: name must be a quoted string", term)
+				}
+				if strings.HasSuffix(pattern, "*") {
+					prefix := strings.TrimSuffix(pattern, "*")
+					conditions = append(conditions, func(op *lropb.Operation) bool { return strings.HasPrefix(op.GetName(), prefix) })
+				} else {
+					conditions = append(conditions, func(op *lropb.Operation) bool { return op.GetName() == pattern })
+				}
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "invalid filter term %q: operations can only be filtered by done and name", term)
+			}
+		}
+	}
+	return func(op *lropb.Operation) bool {
+		for _, condition := range conditions {
+			if !condition(op) {
+				return false
+			}
+		}
+		return true
 	}, nil
 }
 
-// DeleteOperation returns a successful response if the resource name is not blank
+// DeleteOperation deletes the Wait or NestedWait operation named in the request, which is no
+// longer found afterwards. Deleting an operation does not cancel it. Deleting other operations
+// succeeds without effect.
 func (s operationsServerImpl) DeleteOperation(ctx context.Context, in *lropb.DeleteOperationRequest) (*empty.Empty, error) {
 	if in.Name == "" {
 		return nil, status.Error(codes.NotFound, "cannot delete operation without a name.")
 	}
+	if !isWaitOperation(in.GetName()) {
+		return &empty.Empty{}, nil
+	}
+	if _, err := s.getOperation(&lropb.GetOperationRequest{Name: in.GetName()}, false); err != nil {
+		return nil, err
+	}
+	s.waiter.Delete(in.GetName())
 	return &empty.Empty{}, nil
 }
 
@@ -194,3 +318,9 @@ func (s operationsServerImpl) WaitOperation(ctx context.Context, in *lropb.WaitO
 		Result: result,
 	}, nil
 }
+
+// ResetState forgets the operations started, cancelled and deleted. Operations are otherwise
+// encoded in their names, so the server holds no other state about them.
+func (s *operationsServerImpl) ResetState() {
+	s.waiter.Reset()
+}
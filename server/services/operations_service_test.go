@@ -48,6 +48,24 @@ func TestGetOperation_wait(t *testing.T) {
 	}
 }
 
+func TestGetOperation_nestedWait(t *testing.T) {
+	endTime, _ := ptypes.TimestampProto(time.Now())
+	nestedReq := &pb.NestedWaitRequest{EndTime: endTime, Next: &pb.WaitRequest{}}
+	nameBytes, _ := proto.Marshal(nestedReq)
+	req := &lropb.GetOperationRequest{
+		Name: fmt.Sprintf(
+			"operations/google.showcase.v1beta1.Echo/NestedWait/%s",
+			base64.StdEncoding.EncodeToString(nameBytes)),
+	}
+
+	waiter := &mockWaiter{}
+	server := &operationsServerImpl{waiter: waiter}
+	server.GetOperation(context.Background(), req)
+	if !proto.Equal(waiter.nestedReq, nestedReq) {
+		t.Error("Expected echo.NestedWait to defer to waiter.")
+	}
+}
+
 type messagingServerWrapper struct {
 	listReq *pb.ListBlurbsRequest
 
@@ -253,30 +271,145 @@ func TestCancelOperation_notFound(t *testing.T) {
 	}
 }
 
-func TestServerListOperation(t *testing.T) {
+func TestCancelAndDeleteOperation_wait(t *testing.T) {
+	waitReq := &pb.WaitRequest{End: &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(time.Hour)}}
+	name := server.GetWaiterInstance().Wait(waitReq).GetName()
 	server := NewOperationsServer(nil)
-	res, err := server.ListOperations(context.Background(), &lropb.ListOperationsRequest{
-		Name: "something",
-	})
+	defer server.(Resetter).ResetState()
+	ctx := context.Background()
+
+	if _, err := server.CancelOperation(ctx, &lropb.CancelOperationRequest{Name: name}); err != nil {
+		t.Fatalf("CancelOperation(%q): %v", name, err)
+	}
+	op, err := server.GetOperation(ctx, &lropb.GetOperationRequest{Name: name})
 	if err != nil {
-		t.Error("ListOperations should have been successful")
+		t.Fatalf("GetOperation(%q): %v", name, err)
 	}
-	if len(res.Operations) != 1 {
-		t.Error("ListOperations should have a result")
+	if !op.GetDone() || codes.Code(op.GetError().GetCode()) != codes.Canceled {
+		t.Errorf("GetOperation after CancelOperation: want done with a CANCELLED error, got %v", op)
 	}
 
-	res, err = server.ListOperations(context.Background(), &lropb.ListOperationsRequest{
-		Name:     "other",
-		PageSize: 8,
-	})
-	if err != nil {
-		t.Error("ListOperations should have been successful")
+	if _, err := server.DeleteOperation(ctx, &lropb.DeleteOperationRequest{Name: name}); err != nil {
+		t.Fatalf("DeleteOperation(%q): %v", name, err)
+	}
+	if _, err := server.GetOperation(ctx, &lropb.GetOperationRequest{Name: name}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetOperation after DeleteOperation: want NotFound, got %v", err)
+	}
+	if _, err := server.CancelOperation(ctx, &lropb.CancelOperationRequest{Name: name}); status.Code(err) != codes.NotFound {
+		t.Errorf("CancelOperation after DeleteOperation: want NotFound, got %v", err)
+	}
+	if _, err := server.DeleteOperation(ctx, &lropb.DeleteOperationRequest{Name: name}); status.Code(err) != codes.NotFound {
+		t.Errorf("DeleteOperation after DeleteOperation: want NotFound, got %v", err)
 	}
-	if len(res.Operations) != 8 {
-		t.Error("ListOperations should have 8 results")
+}
+
+func TestGetOperation_pendingPolls(t *testing.T) {
+	waitReq := &pb.WaitRequest{PendingPolls: proto.Int32(1)}
+	name := server.GetWaiterInstance().Wait(waitReq).GetName()
+	server := NewOperationsServer(nil)
+	defer server.(Resetter).ResetState()
+	for poll, wantDone := range []bool{false, true} {
+		op, err := server.GetOperation(context.Background(), &lropb.GetOperationRequest{Name: name})
+		if err != nil {
+			t.Fatalf("GetOperation(%q): %v", name, err)
+		}
+		if op.GetDone() != wantDone {
+			t.Errorf("poll %d: want done=%v, got %v", poll+1, wantDone, op)
+		}
 	}
-	if len(res.NextPageToken) > 0 {
-		t.Error("ListOperations should not have more than 1 page")
+}
+
+func TestServerListOperation(t *testing.T) {
+	waiter := server.GetWaiterInstance()
+	server := NewOperationsServer(nil)
+	server.(Resetter).ResetState()
+	defer server.(Resetter).ResetState()
+	pending := waiter.Wait(&pb.WaitRequest{End: &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(time.Hour)}}).GetName()
+	done := waiter.Wait(&pb.WaitRequest{End: &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(0)}}).GetName()
+	nested := waiter.NestedWait(&pb.NestedWaitRequest{Ttl: ptypes.DurationProto(time.Hour)}).GetName()
+	deleted := waiter.Wait(&pb.WaitRequest{End: &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(time.Hour)}}).GetName()
+	ctx := context.Background()
+	if _, err := server.DeleteOperation(ctx, &lropb.DeleteOperationRequest{Name: deleted}); err != nil {
+		t.Fatalf("DeleteOperation(%q): %v", deleted, err)
+	}
+
+	for _, test := range []struct {
+		name   string
+		filter string
+		want   []string
+	}{
+		{name: "operations", want: []string{pending, done, nested}},
+		{name: "operations/", want: []string{pending, done, nested}},
+		{name: "operations/google.showcase.v1beta1.Echo/Wait", want: []string{pending, done}},
+		{name: "operations/google.showcase.v1beta1.Echo/NestedWait", want: []string{nested}},
+		{name: "operations/google.showcase.v1beta1.Messaging", want: []string{}},
+		{name: "operations", filter: "done = true", want: []string{done}},
+		{name: "operations", filter: "done=false", want: []string{pending, nested}},
+		{name: "operations", filter: `name = "operations/google.showcase.v1beta1.Echo/Nested*"`, want: []string{nested}},
+		{name: "operations", filter: fmt.Sprintf("done = false AND name = %q", pending), want: []string{pending}},
+	} {
+		res, err := server.ListOperations(ctx, &lropb.ListOperationsRequest{Name: test.name, Filter: test.filter})
+		if err != nil {
+			t.Errorf("ListOperations(%q, %q): %v", test.name, test.filter, err)
+			continue
+		}
+		got := []string{}
+		for _, op := range res.GetOperations() {
+			got = append(got, op.GetName())
+		}
+		if fmt.Sprint(got) != fmt.Sprint(test.want) || res.GetNextPageToken() != "" {
+			t.Errorf("ListOperations(%q, %q): want %v, got %v (next page %q)", test.name, test.filter, test.want, got, res.GetNextPageToken())
+		}
+	}
+
+	for _, filter := range []string{"done", "done = maybe", "metadata = 1", "name = x OR done = true"} {
+		_, err := server.ListOperations(ctx, &lropb.ListOperationsRequest{Name: "operations", Filter: filter})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ListOperations(%q): want InvalidArgument, got %v", filter, err)
+		}
+	}
+}
+
+func TestServerListOperation_pages(t *testing.T) {
+	waiter := server.GetWaiterInstance()
+	server := NewOperationsServer(nil)
+	server.(Resetter).ResetState()
+	defer server.(Resetter).ResetState()
+	want := []string{}
+	for i := 0; i < 5; i++ {
+		want = append(want, waiter.Wait(&pb.WaitRequest{
+			End: &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(time.Duration(i+1) * time.Hour)},
+		}).GetName())
+	}
+
+	got := []string{}
+	req := &lropb.ListOperationsRequest{Name: "operations", PageSize: 2}
+	for pages := 1; ; pages++ {
+		res, err := server.ListOperations(context.Background(), req)
+		if err != nil {
+			t.Fatalf("ListOperations(%v): %v", req, err)
+		}
+		if len(res.GetOperations()) > 2 {
+			t.Errorf("page %d: want at most 2 operations, got %d", pages, len(res.GetOperations()))
+		}
+		for _, op := range res.GetOperations() {
+			got = append(got, op.GetName())
+		}
+		if res.GetNextPageToken() == "" {
+			if pages != 3 {
+				t.Errorf("want 3 pages, got %d", pages)
+			}
+			break
+		}
+		req.PageToken = res.GetNextPageToken()
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	req.PageToken = "not a token"
+	if _, err := server.ListOperations(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListOperations with an invalid page token: want InvalidArgument, got %v", err)
 	}
 }
 
@@ -0,0 +1,40 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+)
+
+// NewRolloutServer returns a new RolloutServer for the Showcase API, driving the schema rollout
+// the Echo service follows.
+func NewRolloutServer(rollout *server.SchemaRollout) pb.RolloutServer {
+	return &rolloutServerImpl{rollout: rollout}
+}
+
+type rolloutServerImpl struct {
+	rollout *server.SchemaRollout
+}
+
+func (s *rolloutServerImpl) SetSchemaRollout(_ context.Context, in *pb.SchemaRollout) (*pb.SchemaRollout, error) {
+	return s.rollout.Set(in)
+}
+
+func (s *rolloutServerImpl) GetSchemaRollout(context.Context, *pb.GetSchemaRolloutRequest) (*pb.SchemaRollout, error) {
+	return s.rollout.Get(), nil
+}
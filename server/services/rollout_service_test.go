@@ -0,0 +1,51 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+)
+
+func TestRollout_echo(t *testing.T) {
+	rollout := server.NewSchemaRollout()
+	rolloutServer := NewRolloutServer(rollout)
+	echoServer := NewRolloutEchoServer(rollout)
+	ctx := context.Background()
+
+	if _, err := rolloutServer.SetSchemaRollout(ctx, &pb.SchemaRollout{Version: pb.SchemaRollout_BLUE, SwitchEvery: 1}); err != nil {
+		t.Fatalf("SetSchemaRollout: unexpected err %v", err)
+	}
+	for i, want := range []string{"", "hello", ""} {
+		resp, err := echoServer.Echo(ctx, &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hi"}, Label: "hello"})
+		if err != nil {
+			t.Fatalf("Echo: unexpected err %v", err)
+		}
+		if resp.GetLabel() != want || resp.GetContent() != "hi" {
+			t.Errorf("call %d: want label %q, got %v", i, want, resp)
+		}
+	}
+
+	state, err := rolloutServer.GetSchemaRollout(ctx, &pb.GetSchemaRolloutRequest{})
+	if err != nil {
+		t.Fatalf("GetSchemaRollout: unexpected err %v", err)
+	}
+	if state.GetVersion() != pb.SchemaRollout_BLUE || state.GetCallsServed() != 1 {
+		t.Errorf("GetSchemaRollout: unexpected state %v", state)
+	}
+}
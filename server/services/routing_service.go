@@ -0,0 +1,83 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const routingServicePrefix = "/google.showcase.v1beta1.Routing/"
+
+// NewRoutingServer returns a new RoutingServer for the Showcase API.
+func NewRoutingServer() pb.RoutingServer {
+	verifier, err := server.NewRoutingHeaderVerifier(server.ShowcasePackage)
+	if err != nil {
+		log.Fatalf("Failed to load routing header rules: %v", err)
+	}
+	return &routingServerImpl{verifier: verifier}
+}
+
+type routingServerImpl struct {
+	verifier *server.RoutingHeaderVerifier
+}
+
+func (s *routingServerImpl) RouteOverlapping(ctx context.Context, in *pb.RoutingRequest) (*pb.RoutingResponse, error) {
+	return s.route(ctx, "RouteOverlapping", in)
+}
+
+func (s *routingServerImpl) RouteMultipleTemplates(ctx context.Context, in *pb.RoutingRequest) (*pb.RoutingResponse, error) {
+	return s.route(ctx, "RouteMultipleTemplates", in)
+}
+
+func (s *routingServerImpl) RouteOmitted(ctx context.Context, in *pb.RoutingRequest) (*pb.RoutingResponse, error) {
+	return s.route(ctx, "RouteOmitted", in)
+}
+
+func (s *routingServerImpl) RouteNested(ctx context.Context, in *pb.RoutingRequest) (*pb.RoutingResponse, error) {
+	return s.route(ctx, "RouteNested", in)
+}
+
+func (s *routingServerImpl) RouteEmptyRule(ctx context.Context, in *pb.RoutingRequest) (*pb.RoutingResponse, error) {
+	return s.route(ctx, "RouteEmptyRule", in)
+}
+
+// route reports the routing header received for in alongside the one the method's annotation
+// prescribes.
+func (s *routingServerImpl) route(ctx context.Context, method string, in *pb.RoutingRequest) (*pb.RoutingResponse, error) {
+	expected, _ := s.verifier.Expected(routingServicePrefix+method, in)
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	header := md.Get(server.RoutingHeaderKey)
+	received, err := server.ParseRoutingHeader(header)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "malformed %s header: %v", server.RoutingHeaderKey, err)
+	}
+
+	return &pb.RoutingResponse{
+		RoutingParams:         received,
+		RoutingHeader:         strings.Join(header, "&"),
+		ExpectedRoutingHeader: server.FormatRoutingHeader(expected),
+		Matches:               reflect.DeepEqual(received, expected),
+	}, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRoutingExpectedHeaders(t *testing.T) {
+	s := NewRoutingServer()
+	type routeFunc func(context.Context, *pb.RoutingRequest) (*pb.RoutingResponse, error)
+
+	tests := []struct {
+		name     string
+		route    routeFunc
+		req      *pb.RoutingRequest
+		expected string
+	}{
+		{
+			"overlapping, last match wins",
+			s.RouteOverlapping,
+			&pb.RoutingRequest{Name: "projects/p/instances/i/tables/t"},
+			"routing_id=projects%2Fp%2Finstances%2Fi",
+		},
+		{
+			"overlapping, earlier match kept",
+			s.RouteOverlapping,
+			&pb.RoutingRequest{Name: "projects/p"},
+			"routing_id=projects%2Fp",
+		},
+		{
+			"overlapping, later field overrides",
+			s.RouteOverlapping,
+			&pb.RoutingRequest{Name: "projects/p", AppProfileId: "profile"},
+			"routing_id=profile",
+		},
+		{
+			"multiple templates",
+			s.RouteMultipleTemplates,
+			&pb.RoutingRequest{Name: "projects/p/instances/i/tables/t", AppProfileId: "profile"},
+			"app_profile_id=profile&instance=instances%2Fi&project=projects%2Fp&table=tables%2Ft",
+		},
+		{
+			"multiple templates, partial match",
+			s.RouteMultipleTemplates,
+			&pb.RoutingRequest{Name: "projects/p"},
+			"project=projects%2Fp",
+		},
+		{
+			"omitted on no match",
+			s.RouteOmitted,
+			&pb.RoutingRequest{Name: "projects/p", AppProfileId: "apps/a"},
+			"",
+		},
+		{
+			"omitted, matches",
+			s.RouteOmitted,
+			&pb.RoutingRequest{Name: "regions/r/zones/z", AppProfileId: "profiles/a"},
+			"profile=profiles%2Fa&region=regions%2Fr",
+		},
+		{
+			"nested",
+			s.RouteNested,
+			&pb.RoutingRequest{Resource: &pb.RoutingResource{Name: "regions/r/zones/z/tables/t"}},
+			"table_location=regions%2Fr%2Fzones%2Fz",
+		},
+		{
+			"nested, unset",
+			s.RouteNested,
+			&pb.RoutingRequest{},
+			"",
+		},
+		{
+			"empty rule",
+			s.RouteEmptyRule,
+			&pb.RoutingRequest{Name: "projects/p"},
+			"",
+		},
+	}
+	for _, tt := range tests {
+		resp, err := tt.route(context.Background(), tt.req)
+		if err != nil {
+			t.Errorf("%s: unexpected err %+v", tt.name, err)
+			continue
+		}
+		if got := resp.GetExpectedRoutingHeader(); got != tt.expected {
+			t.Errorf("%s: expected header %q, got %q", tt.name, tt.expected, got)
+		}
+		if got, want := resp.GetMatches(), tt.expected == ""; got != want {
+			t.Errorf("%s: without a header, expected matches=%v, got %v", tt.name, want, got)
+		}
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-goog-request-params", tt.expected))
+		resp, err = tt.route(ctx, tt.req)
+		if err != nil {
+			t.Errorf("%s: unexpected err %+v", tt.name, err)
+			continue
+		}
+		if !resp.GetMatches() {
+			t.Errorf("%s: sending the expected header %q did not match, received %v", tt.name, tt.expected, resp.GetRoutingParams())
+		}
+		if got := resp.GetRoutingHeader(); got != tt.expected {
+			t.Errorf("%s: expected routing header to be echoed as %q, got %q", tt.name, tt.expected, got)
+		}
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/googleapis/gapic-showcase/server"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -132,13 +133,27 @@ func (s *sequenceServerImpl) AttemptSequence(ctx context.Context, in *pb.Attempt
 		)
 	}
 
-	rep.Attempts = append(rep.Attempts, &pb.SequenceReport_Attempt{
+	md, _ := metadata.FromIncomingContext(ctx)
+	attempt := &pb.SequenceReport_Attempt{
 		AttemptNumber:   int32(n),
 		AttemptDeadline: dpb,
 		ResponseTime:    rpb,
 		AttemptDelay:    attDelay,
 		Status:          st.Proto(),
-	})
+		Connection:      server.ConnectionID(ctx),
+		ClientAttempt:   server.ClientAttempt(md),
+	}
+	attempt.RetryKind = pb.SequenceReport_FIRST_ATTEMPT
+	if n > 0 {
+		attempt.RetryKind = retryKind(rep.GetAttempts()[n-1], attempt)
+	}
+	switch attempt.RetryKind {
+	case pb.SequenceReport_APPLICATION_RETRY:
+		rep.ApplicationRetries++
+	case pb.SequenceReport_TRANSPARENT_RETRY:
+		rep.TransparentRetries++
+	}
+	rep.Attempts = append(rep.Attempts, attempt)
 
 	return &empty.Empty{}, st.Err()
 }
@@ -163,6 +178,16 @@ func (s *sequenceServerImpl) GetSequenceReport(ctx context.Context, in *pb.GetSe
 	return report.(*pb.SequenceReport), nil
 }
 
+// retryKind classifies attempt, a retry of prev. Unless the client reported both attempts, it is
+// taken for an application retry, as the server cannot tell it was not a new call.
+func retryKind(prev, attempt *pb.SequenceReport_Attempt) pb.SequenceReport_RetryKind {
+	if prev.GetClientAttempt() > 0 && attempt.GetClientAttempt() > 0 &&
+		attempt.GetClientAttempt() <= prev.GetClientAttempt() {
+		return pb.SequenceReport_TRANSPARENT_RETRY
+	}
+	return pb.SequenceReport_APPLICATION_RETRY
+}
+
 func report(n string) string {
 	return fmt.Sprintf("%s/sequenceReport", n)
 }
@@ -176,3 +201,13 @@ func clone(s *pb.Sequence) *pb.Sequence {
 		Responses: r,
 	}
 }
+
+// ResetState deletes all sequences and their reports.
+func (s *sequenceServerImpl) ResetState() {
+	for _, m := range []*sync.Map{&s.sequences, &s.reports} {
+		m.Range(func(key, _ interface{}) bool {
+			m.Delete(key)
+			return true
+		})
+	}
+}
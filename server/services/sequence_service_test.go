@@ -20,8 +20,10 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/googleapis/gapic-showcase/server"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -223,3 +225,59 @@ func TestAttemptSequenceMissingName(t *testing.T) {
 		t.Errorf("%s: expected error to be %s but was %s", t.Name(), codes.InvalidArgument, c)
 	}
 }
+
+func TestSequenceRetryKinds(t *testing.T) {
+	s := NewSequenceServer()
+	ok := &pb.Sequence_Response{Status: status.New(codes.OK, "OK").Proto()}
+	seq, err := s.CreateSequence(context.Background(), &pb.CreateSequenceRequest{
+		Sequence: &pb.Sequence{Responses: []*pb.Sequence_Response{ok, ok, ok, ok}},
+	})
+	if err != nil {
+		t.Fatalf("CreateSequence: unexpected err %+v", err)
+	}
+
+	// The client library retries its second attempt twice in gRPC, then makes a third attempt
+	// the server cannot tell apart from a new call.
+	headers := []metadata.MD{
+		metadata.Pairs("x-goog-api-client", "gl-go/1.16.0 gccl-attempt-count/1"),
+		metadata.Pairs("x-goog-api-client", "gl-go/1.16.0 gccl-attempt-count/2"),
+		metadata.Pairs("x-goog-api-client", "gl-go/1.16.0 gccl-attempt-count/2", server.PreviousAttemptsHeader, "1"),
+		metadata.MD{},
+	}
+	for _, md := range headers {
+		ctx := server.WithConnectionID(metadata.NewIncomingContext(context.Background(), md), "127.0.0.1:5000")
+		if _, err := s.AttemptSequence(ctx, &pb.AttemptSequenceRequest{Name: seq.GetName()}); err != nil {
+			t.Fatalf("AttemptSequence: unexpected err %+v", err)
+		}
+	}
+
+	report, err := s.GetSequenceReport(context.Background(), &pb.GetSequenceReportRequest{Name: report(seq.GetName())})
+	if err != nil {
+		t.Fatalf("GetSequenceReport: unexpected err %+v", err)
+	}
+	want := []struct {
+		clientAttempt int32
+		kind          pb.SequenceReport_RetryKind
+	}{
+		{1, pb.SequenceReport_FIRST_ATTEMPT},
+		{2, pb.SequenceReport_APPLICATION_RETRY},
+		{2, pb.SequenceReport_TRANSPARENT_RETRY},
+		{0, pb.SequenceReport_APPLICATION_RETRY},
+	}
+	if len(report.GetAttempts()) != len(want) {
+		t.Fatalf("%s: got %d attempts, want %d", t.Name(), len(report.GetAttempts()), len(want))
+	}
+	for i, a := range report.GetAttempts() {
+		if a.GetClientAttempt() != want[i].clientAttempt || a.GetRetryKind() != want[i].kind {
+			t.Errorf("attempt %d: got client attempt %d of kind %v, want %d of kind %v",
+				i, a.GetClientAttempt(), a.GetRetryKind(), want[i].clientAttempt, want[i].kind)
+		}
+		if a.GetConnection() != "127.0.0.1:5000" {
+			t.Errorf("attempt %d: got connection %q, want %q", i, a.GetConnection(), "127.0.0.1:5000")
+		}
+	}
+	if report.GetApplicationRetries() != 2 || report.GetTransparentRetries() != 1 {
+		t.Errorf("%s: got %d application and %d transparent retries, want 2 and 1",
+			t.Name(), report.GetApplicationRetries(), report.GetTransparentRetries())
+	}
+}
@@ -25,16 +25,44 @@ import (
 	lropb "google.golang.org/genproto/googleapis/longrunning"
 )
 
+// Resetter is implemented by the servers whose state can be discarded by the ResetState method
+// of the Fixtures service.
+type Resetter interface {
+	// ResetState discards the state of the server, as if it had just started.
+	ResetState()
+}
+
+// Persister is implemented by the servers whose state can be persisted, so that it survives
+// restarts of the server.
+type Persister interface {
+	// Persist restores the state saved in store, if any, and saves the state of the server
+	// there after every change.
+	Persist(store *server.Store) error
+}
+
 // Backend contains the various service backends that will be
 // accessible via one or more transport endpoints.
 type Backend struct {
 	// Showcase schema
+	AuditLogServer        pb.AuditLogServer
+	BarrierServer         pb.BarrierServer
+	ClockServer           pb.ClockServer
+	CryptoServer          pb.CryptoServer
 	EchoServer            pb.EchoServer
+	FailoverServer        pb.FailoverServer
+	FixturesServer        pb.FixturesServer
 	IdentityServer        pb.IdentityServer
+	MatrixServer          pb.MatrixServer
 	MessagingServer       pb.MessagingServer
+	RolloutServer         pb.RolloutServer
+	RoutingServer         pb.RoutingServer
 	SequenceServiceServer pb.SequenceServiceServer
+	ShowcaseAdminServer   pb.ShowcaseAdminServer
 	ComplianceServer      pb.ComplianceServer
+	DebugServer           pb.DebugServer
 	TestingServer         pb.TestingServer
+	TransportServer       pb.TransportServer
+	WebhookServiceServer  pb.WebhookServiceServer
 
 	// Supporting protos
 	OperationsServer lropb.OperationsServer
@@ -42,6 +70,33 @@ type Backend struct {
 	IAMPolicyServer  iampb.IAMPolicyServer
 
 	// Other supporting data structures
-	StdLog, ErrLog   *log.Logger
-	ObserverRegistry server.GrpcObserverRegistry
+	StdLog, ErrLog      *log.Logger
+	ObserverRegistry    server.GrpcObserverRegistry
+	FailoverCoordinator *server.FailoverCoordinator
+	TransportMonitor    *server.TransportMonitor
+	ConnectionManager   *server.ConnectionManager
+	ProxyMimic          *server.ProxyMimic
+	BarrierManager      *server.BarrierManager
+	BusyWork            *server.BusyWork
+	BinaryLogger        *server.BinaryLogger
+	PacketRecorder      *server.PacketRecorder
+	AuthorityRecorder   *server.AuthorityRecorder
+	UniverseDomain      *server.UniverseDomain
+	SchemaRollout       *server.SchemaRollout
+	PayloadCorruptor    *server.PayloadCorruptor
+	FaultInjector       *server.FaultInjector
+	ServerControls      *server.ServerControls
+	HealthStatus        *server.HealthStatus
+	Metrics             *server.Metrics
+	Tracer              *server.Tracer
+	ResponseCache       *server.ResponseCache
+	LoadShedder         *server.LoadShedder
+	PriorityScheduler   *server.PriorityScheduler
+	CallCapture         *server.CallCapture
+	DeadlineRace        *server.DeadlineRace
+	StickySessions      *server.StickySessions
+	IdempotencyKeys     *server.IdempotencyKeys
+	AuditLog            *server.AuditLog
+	ServerEvents        *server.ServerEvents
+	ResponseHooks       *server.ResponseHooks
 }
@@ -0,0 +1,161 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// NewShowcaseAdminServer returns a new ShowcaseAdminServer for the Showcase API, changing the
+// behaviors controls, faults and health own, and resetting state through fixtures. ResetServer
+// restores the configuration they have when the server is created.
+func NewShowcaseAdminServer(controls *server.ServerControls, faults *server.FaultInjector, health *server.HealthStatus, fixtures pb.FixturesServer) pb.ShowcaseAdminServer {
+	s := &showcaseAdminServerImpl{controls: controls, faults: faults, health: health, fixtures: fixtures}
+	s.initial = s.config()
+	return s
+}
+
+type showcaseAdminServerImpl struct {
+	controls *server.ServerControls
+	faults   *server.FaultInjector
+	health   *server.HealthStatus
+	fixtures pb.FixturesServer
+	initial  *pb.ServerConfig
+}
+
+func (s *showcaseAdminServerImpl) GetServerConfig(_ context.Context, _ *pb.GetServerConfigRequest) (*pb.ServerConfig, error) {
+	return s.config(), nil
+}
+
+func (s *showcaseAdminServerImpl) UpdateServerConfig(_ context.Context, in *pb.UpdateServerConfigRequest) (*pb.ServerConfig, error) {
+	if in.GetConfig() == nil {
+		return nil, status.Error(codes.InvalidArgument, "The config to update the server to must be set.")
+	}
+	updated := s.config()
+	paths := in.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"fault_rate", "fault_code", "latency", "feature_flags", "not_serving_services", "brownout"}
+	}
+	restartBrownout := false
+	for _, path := range paths {
+		switch path {
+		case "fault_rate":
+			updated.FaultRate = in.GetConfig().GetFaultRate()
+		case "fault_code":
+			updated.FaultCode = in.GetConfig().GetFaultCode()
+		case "latency":
+			updated.Latency = in.GetConfig().GetLatency()
+		case "feature_flags":
+			updated.FeatureFlags = in.GetConfig().GetFeatureFlags()
+		case "not_serving_services":
+			updated.NotServingServices = in.GetConfig().GetNotServingServices()
+		case "brownout":
+			updated.Brownout = in.GetConfig().GetBrownout()
+			restartBrownout = true
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "The update_mask path %q is not a field of ServerConfig.", path)
+		}
+	}
+	if err := s.apply(updated, restartBrownout); err != nil {
+		return nil, err
+	}
+	return s.config(), nil
+}
+
+func (s *showcaseAdminServerImpl) ResetServer(ctx context.Context, _ *pb.ResetServerRequest) (*pb.ResetServerResponse, error) {
+	if err := s.apply(s.initial, true); err != nil {
+		return nil, err
+	}
+	if _, err := s.fixtures.ResetState(ctx, &pb.ResetStateRequest{}); err != nil {
+		return nil, err
+	}
+	return &pb.ResetServerResponse{Config: s.config()}, nil
+}
+
+// config returns the current configuration of the server.
+func (s *showcaseAdminServerImpl) config() *pb.ServerConfig {
+	rate, code := s.faults.Config()
+	config := &pb.ServerConfig{
+		FaultRate:    rate,
+		FaultCode:    server.CodeName(code),
+		Latency:      durationpb.New(s.controls.Latency()),
+		FeatureFlags: s.controls.FeatureFlags(),
+
+		NotServingServices: s.health.NotServing(),
+	}
+	if brownout, current := s.faults.Brownout(); brownout != nil {
+		config.Brownout = &pb.Brownout{Repeat: brownout.Repeat, CurrentFaultRate: current}
+		for _, point := range brownout.Points {
+			config.Brownout.Points = append(config.Brownout.Points, &pb.Brownout_Point{
+				Offset:    durationpb.New(point.Offset),
+				FaultRate: point.Rate,
+			})
+		}
+	}
+	return config
+}
+
+// apply updates the server to config, unless some of it is invalid. The brownout of config
+// only replaces the current one, starting its curve over, if restartBrownout is true.
+func (s *showcaseAdminServerImpl) apply(config *pb.ServerConfig, restartBrownout bool) error {
+	config = proto.Clone(config).(*pb.ServerConfig)
+	code := codes.Unavailable
+	if config.GetFaultCode() != "" {
+		var err error
+		if code, err = server.ParseCode(config.GetFaultCode()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid fault_code: %v", err)
+		}
+	}
+	latency := time.Duration(0)
+	if config.GetLatency() != nil {
+		if err := config.GetLatency().CheckValid(); err != nil || config.GetLatency().AsDuration() < 0 {
+			return status.Errorf(codes.InvalidArgument, "The latency must be a non-negative duration, got %v.", config.GetLatency())
+		}
+		latency = config.GetLatency().AsDuration()
+	}
+	var brownout *server.Brownout
+	if config.GetBrownout() != nil {
+		brownout = &server.Brownout{Repeat: config.GetBrownout().GetRepeat()}
+		for _, point := range config.GetBrownout().GetPoints() {
+			if err := point.GetOffset().CheckValid(); err != nil {
+				return status.Errorf(codes.InvalidArgument, "Invalid brownout offset %v: %v", point.GetOffset(), err)
+			}
+			brownout.Points = append(brownout.Points, server.BrownoutPoint{Offset: point.GetOffset().AsDuration(), Rate: point.GetFaultRate()})
+		}
+		if err := brownout.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid brownout: %v", err)
+		}
+	}
+	if err := s.faults.Configure(config.GetFaultRate(), code); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid fault injection: %v", err)
+	}
+	if restartBrownout {
+		if err := s.faults.ConfigureBrownout(brownout); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid brownout: %v", err)
+		}
+	}
+	s.controls.SetLatency(latency)
+	s.controls.SetFeatureFlags(config.GetFeatureFlags())
+	s.health.SetNotServing(config.GetNotServingServices())
+	return nil
+}
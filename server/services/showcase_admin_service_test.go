@@ -0,0 +1,215 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type resetRecorder struct {
+	pb.FixturesServer
+	resets int
+}
+
+func (r *resetRecorder) ResetState(context.Context, *pb.ResetStateRequest) (*pb.ResetStateResponse, error) {
+	r.resets++
+	return &pb.ResetStateResponse{}, nil
+}
+
+func newTestShowcaseAdminServer(t *testing.T) (pb.ShowcaseAdminServer, *server.ServerControls, *server.FaultInjector, *resetRecorder) {
+	controls := server.NewServerControls()
+	faults, err := server.NewFaultInjector(0, "UNAVAILABLE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixtures := &resetRecorder{}
+	return NewShowcaseAdminServer(controls, faults, server.NewHealthStatus(nil), fixtures), controls, faults, fixtures
+}
+
+func TestUpdateServerConfig(t *testing.T) {
+	admin, controls, faults, _ := newTestShowcaseAdminServer(t)
+	initial := &pb.ServerConfig{FaultCode: "UNAVAILABLE", Latency: durationpb.New(0), FeatureFlags: []string{}}
+	if got, err := admin.GetServerConfig(context.Background(), &pb.GetServerConfigRequest{}); err != nil || !proto.Equal(got, initial) {
+		t.Errorf("GetServerConfig: want %v, got %v, %v", initial, got, err)
+	}
+
+	got, err := admin.UpdateServerConfig(context.Background(), &pb.UpdateServerConfigRequest{
+		Config: &pb.ServerConfig{
+			FaultRate:    0.25,
+			FaultCode:    "resource_exhausted",
+			Latency:      durationpb.New(time.Second),
+			FeatureFlags: []string{"strict"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.ServerConfig{FaultRate: 0.25, FaultCode: "RESOURCE_EXHAUSTED", Latency: durationpb.New(time.Second), FeatureFlags: []string{"strict"}}
+	if !proto.Equal(got, want) {
+		t.Errorf("UpdateServerConfig: want %v, got %v", want, got)
+	}
+	if rate, code := faults.Config(); rate != 0.25 || code != codes.ResourceExhausted {
+		t.Errorf("UpdateServerConfig: want faults of 0.25, ResourceExhausted, got %v, %v", rate, code)
+	}
+	if controls.Latency() != time.Second || !controls.Enabled("strict") {
+		t.Errorf("UpdateServerConfig: want a latency of 1s and strict on, got %v and %v", controls.Latency(), controls.Enabled("strict"))
+	}
+
+	got, err = admin.UpdateServerConfig(context.Background(), &pb.UpdateServerConfigRequest{
+		Config:     &pb.ServerConfig{FaultRate: 1},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"fault_rate"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want.FaultRate = 1
+	if !proto.Equal(got, want) {
+		t.Errorf("UpdateServerConfig of fault_rate: want %v, got %v", want, got)
+	}
+}
+
+func TestUpdateServerConfig_invalid(t *testing.T) {
+	admin, _, faults, _ := newTestShowcaseAdminServer(t)
+	for _, in := range []*pb.UpdateServerConfigRequest{
+		{},
+		{Config: &pb.ServerConfig{FaultRate: 2}},
+		{Config: &pb.ServerConfig{FaultCode: "FLAKY"}},
+		{Config: &pb.ServerConfig{FaultCode: "OK"}},
+		{Config: &pb.ServerConfig{Latency: durationpb.New(-time.Second)}},
+		{Config: &pb.ServerConfig{}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"port"}}},
+	} {
+		if _, err := admin.UpdateServerConfig(context.Background(), in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("UpdateServerConfig(%v): want InvalidArgument, got %v", in, err)
+		}
+	}
+	if rate, code := faults.Config(); rate != 0 || code != codes.Unavailable {
+		t.Errorf("invalid updates should change nothing, got faults of %v, %v", rate, code)
+	}
+}
+
+func TestResetServer(t *testing.T) {
+	admin, controls, faults, fixtures := newTestShowcaseAdminServer(t)
+	_, err := admin.UpdateServerConfig(context.Background(), &pb.UpdateServerConfigRequest{
+		Config: &pb.ServerConfig{FaultRate: 1, FaultCode: "INTERNAL", Latency: durationpb.New(time.Second), FeatureFlags: []string{"strict"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := admin.ResetServer(context.Background(), &pb.ResetServerRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate, code := faults.Config(); rate != 0 || code != codes.Unavailable || controls.Latency() != 0 || controls.Enabled("strict") {
+		t.Errorf("ResetServer: want the initial configuration restored, got %v", resp.GetConfig())
+	}
+	if fixtures.resets != 1 {
+		t.Errorf("ResetServer: want the state reset once, got %d times", fixtures.resets)
+	}
+}
+
+func TestUpdateServerConfig_brownout(t *testing.T) {
+	admin, _, faults, _ := newTestShowcaseAdminServer(t)
+	brownout := &pb.Brownout{
+		Points: []*pb.Brownout_Point{
+			{Offset: durationpb.New(0), FaultRate: 1},
+			{Offset: durationpb.New(time.Hour), FaultRate: 0},
+		},
+		Repeat: true,
+	}
+	got, err := admin.UpdateServerConfig(context.Background(), &pb.UpdateServerConfigRequest{
+		Config:     &pb.ServerConfig{Brownout: brownout},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"brownout"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.GetBrownout().GetPoints()) != 2 || !got.GetBrownout().GetRepeat() || got.GetBrownout().GetCurrentFaultRate() < 0.99 {
+		t.Errorf("UpdateServerConfig: want the brownout started, got %v", got.GetBrownout())
+	}
+	if b, _ := faults.Brownout(); b == nil || b.Points[1] != (server.BrownoutPoint{Offset: time.Hour, Rate: 0}) {
+		t.Errorf("UpdateServerConfig: want the faults to follow the brownout, got %+v", b)
+	}
+
+	// Updating other fields keeps the brownout going.
+	if _, err := admin.UpdateServerConfig(context.Background(), &pb.UpdateServerConfigRequest{
+		Config:     &pb.ServerConfig{Latency: durationpb.New(time.Millisecond)},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"latency"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := faults.Brownout(); b == nil {
+		t.Error("UpdateServerConfig of the latency: want the brownout kept")
+	}
+
+	for _, invalid := range []*pb.Brownout{
+		{},
+		{Points: []*pb.Brownout_Point{{Offset: durationpb.New(0), FaultRate: 2}}},
+		{Points: []*pb.Brownout_Point{{Offset: &durationpb.Duration{Seconds: 1, Nanos: -1}, FaultRate: 1}}},
+	} {
+		_, err := admin.UpdateServerConfig(context.Background(), &pb.UpdateServerConfigRequest{
+			Config:     &pb.ServerConfig{Brownout: invalid},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"brownout"}},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("UpdateServerConfig(%v): want InvalidArgument, got %v", invalid, err)
+		}
+	}
+
+	if _, err := admin.ResetServer(context.Background(), &pb.ResetServerRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := faults.Brownout(); b != nil {
+		t.Errorf("ResetServer: want the brownout cleared, got %+v", b)
+	}
+}
+
+func TestUpdateServerConfig_notServing(t *testing.T) {
+	faults, err := server.NewFaultInjector(0, "UNAVAILABLE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	health := server.NewHealthStatus([]string{"google.showcase.v1beta1.Echo"})
+	admin := NewShowcaseAdminServer(server.NewServerControls(), faults, health, &resetRecorder{})
+
+	got, err := admin.UpdateServerConfig(context.Background(), &pb.UpdateServerConfigRequest{
+		Config:     &pb.ServerConfig{NotServingServices: []string{"google.showcase.v1beta1.Identity"}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"not_serving_services"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"google.showcase.v1beta1.Identity"}; !reflect.DeepEqual(got.GetNotServingServices(), want) ||
+		!reflect.DeepEqual(health.NotServing(), want) {
+		t.Errorf("UpdateServerConfig: want %q not serving, got %q in the config and %q in health", want, got.GetNotServingServices(), health.NotServing())
+	}
+
+	if _, err := admin.ResetServer(context.Background(), &pb.ResetServerRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"google.showcase.v1beta1.Echo"}; !reflect.DeepEqual(health.NotServing(), want) {
+		t.Errorf("ResetServer: want %q not serving, got %q", want, health.NotServing())
+	}
+}
@@ -15,6 +15,7 @@
 package services
 
 import (
+	"github.com/googleapis/gapic-showcase/server"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
 	lropb "google.golang.org/genproto/googleapis/longrunning"
 )
@@ -22,10 +23,31 @@ import (
 // Mock waiter type used in echo_service_test and operations_service_test to
 // check that they defer to the waiter.
 type mockWaiter struct {
-	req *pb.WaitRequest
+	req       *pb.WaitRequest
+	nestedReq *pb.NestedWaitRequest
 }
 
 func (w *mockWaiter) Wait(req *pb.WaitRequest) *lropb.Operation {
 	w.req = req
 	return nil
 }
+
+func (w *mockWaiter) NestedWait(req *pb.NestedWaitRequest) *lropb.Operation {
+	w.nestedReq = req
+	return nil
+}
+
+func (w *mockWaiter) Poll(req *pb.WaitRequest) *lropb.Operation {
+	w.req = req
+	return nil
+}
+
+func (w *mockWaiter) Cancel(name string) {}
+
+func (w *mockWaiter) Delete(name string) {}
+
+func (w *mockWaiter) Deleted(name string) bool { return false }
+
+func (w *mockWaiter) Operations() []server.StartedOperation { return nil }
+
+func (w *mockWaiter) Reset() {}
@@ -25,23 +25,21 @@ import (
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
 	"github.com/googleapis/gapic-showcase/server/spec"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// NewTestingServer returns a new TestingServer for the Showcase API.
-func NewTestingServer(observerRegistry server.GrpcObserverRegistry) pb.TestingServer {
-	name := fmt.Sprintf("sessions/-")
-	defaultSession := server.NewSession(name, pb.Session_V1_LATEST, observerRegistry)
-	defaultSession.RegisterTests(spec.ShowcaseTests(name, pb.Session_V1_LATEST))
-	sessions := []sessionEntry{{session: defaultSession}}
-	keys := map[string]int{name: len(sessions) - 1}
-
+// NewTestingServer returns a new TestingServer for the Showcase API. Session reports are
+// recorded in leaderboard.
+func NewTestingServer(observerRegistry server.GrpcObserverRegistry, leaderboard *server.Leaderboard) pb.TestingServer {
 	s := &testingServerImpl{
 		token:            server.NewTokenGenerator(),
 		observerRegistry: observerRegistry,
-		keys:             keys,
-		sessions:         sessions,
+		leaderboard:      leaderboard,
 	}
+	s.ResetState()
 
 	return s
 }
@@ -55,10 +53,14 @@ type testingServerImpl struct {
 	uid              server.UniqID
 	token            server.TokenGenerator
 	observerRegistry server.GrpcObserverRegistry
+	leaderboard      *server.Leaderboard
 
 	mu       sync.Mutex
 	keys     map[string]int
 	sessions []sessionEntry
+
+	// scratchpads holds the scratchpad entries of each session, by session name and key.
+	scratchpads map[string]map[string]*pb.ScratchpadEntry
 }
 
 func (s *testingServerImpl) CreateSession(_ context.Context, req *pb.CreateSessionRequest) (*pb.Session, error) {
@@ -139,21 +141,42 @@ func (s *testingServerImpl) DeleteSession(_ context.Context, req *pb.DeleteSessi
 
 	entry := s.sessions[i]
 	s.sessions[i] = sessionEntry{session: entry.session, deleted: true}
+	delete(s.scratchpads, req.GetName())
 
 	return &empty.Empty{}, nil
 }
 
-func (s *testingServerImpl) ReportSession(_ context.Context, req *pb.ReportSessionRequest) (*pb.ReportSessionResponse, error) {
+func (s *testingServerImpl) ReportSession(ctx context.Context, req *pb.ReportSessionRequest) (*pb.ReportSessionResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if i, ok := s.keys[req.GetName()]; ok && !s.sessions[i].deleted {
-		return s.sessions[i].session.GetReport(), nil
+	i, ok := s.keys[req.GetName()]
+	if !ok || s.sessions[i].deleted {
+		return nil, status.Errorf(
+			codes.NotFound, "A session with name %s not found.",
+			req.GetName())
 	}
 
-	return nil, status.Errorf(
-		codes.NotFound, "A session with name %s not found.",
-		req.GetName())
+	report := s.sessions[i].session.GetReport()
+	language, version := req.GetClientLanguage(), req.GetClientVersion()
+	if language == "" || version == "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		headerLanguage, headerVersion := server.ClientIdentity(strings.Join(md.Get("x-goog-api-client"), " "))
+		if language == "" {
+			language = headerLanguage
+		}
+		if version == "" {
+			version = headerVersion
+		}
+	}
+	if err := s.leaderboard.Record(language, version, req.GetName(), report.GetResult()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export conformance summary: %v", err)
+	}
+	return report, nil
+}
+
+func (s *testingServerImpl) GetConformanceSummary(_ context.Context, req *pb.GetConformanceSummaryRequest) (*pb.ConformanceSummary, error) {
+	return s.leaderboard.Summary(req.GetClientLanguage()), nil
 }
 
 func (s *testingServerImpl) ListTests(_ context.Context, in *pb.ListTestsRequest) (*pb.ListTestsResponse, error) {
@@ -190,3 +213,96 @@ func (s *testingServerImpl) VerifyTest(context.Context, *pb.VerifyTestRequest) (
 	// This should be handled by the test observers.
 	return &pb.VerifyTestResponse{}, nil
 }
+
+// ResetState deletes all sessions, along with the calls they recorded, leaving only a fresh
+// default session.
+func (s *testingServerImpl) ResetState() {
+	name := fmt.Sprintf("sessions/-")
+	defaultSession := server.NewSession(name, pb.Session_V1_LATEST, s.observerRegistry)
+	defaultSession.RegisterTests(spec.ShowcaseTests(name, pb.Session_V1_LATEST))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = []sessionEntry{{session: defaultSession}}
+	s.keys = map[string]int{name: len(s.sessions) - 1}
+	s.scratchpads = map[string]map[string]*pb.ScratchpadEntry{}
+}
+
+func (s *testingServerImpl) GetScratchpadEntry(_ context.Context, req *pb.GetScratchpadEntryRequest) (*pb.ScratchpadEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, key, err := s.scratchpadKey(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	entry, ok := s.scratchpads[session][key]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "A scratchpad entry with name %s not found.", req.GetName())
+	}
+	return proto.Clone(entry).(*pb.ScratchpadEntry), nil
+}
+
+func (s *testingServerImpl) SetScratchpadEntry(_ context.Context, req *pb.SetScratchpadEntryRequest) (*pb.ScratchpadEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := req.GetEntry().GetName()
+	session, key, err := s.scratchpadKey(name)
+	if err != nil {
+		return nil, err
+	}
+	current, exists := s.scratchpads[session][key]
+	switch expected := req.GetExpectedVersion(); {
+	case expected < -1:
+		return nil, status.Errorf(codes.InvalidArgument, "expected_version must be -1, 0 or a version, got %d", expected)
+	case expected == -1 && exists:
+		return nil, status.Errorf(codes.Aborted, "The scratchpad entry %s already exists, at version %d.", name, current.GetVersion())
+	case expected > 0 && !exists:
+		return nil, status.Errorf(codes.Aborted, "The scratchpad entry %s does not exist, not at version %d.", name, expected)
+	case expected > 0 && current.GetVersion() != expected:
+		return nil, status.Errorf(codes.Aborted, "The scratchpad entry %s is at version %d, not %d.", name, current.GetVersion(), expected)
+	}
+
+	entry := &pb.ScratchpadEntry{
+		Name:       name,
+		Value:      req.GetEntry().GetValue(),
+		Version:    current.GetVersion() + 1,
+		UpdateTime: timestamppb.Now(),
+	}
+	if s.scratchpads[session] == nil {
+		s.scratchpads[session] = map[string]*pb.ScratchpadEntry{}
+	}
+	s.scratchpads[session][key] = entry
+	return proto.Clone(entry).(*pb.ScratchpadEntry), nil
+}
+
+func (s *testingServerImpl) DeleteScratchpadEntry(_ context.Context, req *pb.DeleteScratchpadEntryRequest) (*empty.Empty, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, key, err := s.scratchpadKey(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.scratchpads[session][key]; !ok {
+		return nil, status.Errorf(codes.NotFound, "A scratchpad entry with name %s not found.", req.GetName())
+	}
+	delete(s.scratchpads[session], key)
+	return &empty.Empty{}, nil
+}
+
+// scratchpadKey returns the session and the key named by name, the name of a scratchpad entry,
+// failing if the name is malformed or the session does not exist. It must be called with s.mu
+// held.
+func (s *testingServerImpl) scratchpadKey(name string) (string, string, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "sessions" || parts[1] == "" || parts[2] != "scratchpad" || parts[3] == "" {
+		return "", "", status.Errorf(codes.InvalidArgument, "A scratchpad entry name must be sessions/{session}/scratchpad/{key}, got %q.", name)
+	}
+	session := strings.Join(parts[:2], "/")
+	if i, ok := s.keys[session]; !ok || s.sessions[i].deleted {
+		return "", "", status.Errorf(codes.NotFound, "A session with name %s not found.", session)
+	}
+	return session, parts[3], nil
+}
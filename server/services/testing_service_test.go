@@ -24,11 +24,12 @@ import (
 	"github.com/googleapis/gapic-showcase/server"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 func Test_Session_lifecycle(t *testing.T) {
-	s := NewTestingServer(server.ShowcaseObserverRegistry())
+	s := NewTestingServer(server.ShowcaseObserverRegistry(), server.NewLeaderboard(""))
 
 	first, err := s.CreateSession(
 		context.Background(),
@@ -105,7 +106,7 @@ func Test_Session_lifecycle(t *testing.T) {
 }
 
 func Test_GetSession_deleted(t *testing.T) {
-	s := NewTestingServer(server.ShowcaseObserverRegistry())
+	s := NewTestingServer(server.ShowcaseObserverRegistry(), server.NewLeaderboard(""))
 	created, err := s.CreateSession(
 		context.Background(),
 		&pb.CreateSessionRequest{
@@ -168,7 +169,7 @@ func Test_ListSessions_invalidToken(t *testing.T) {
 }
 
 func Test_DeleteSession_notFound(t *testing.T) {
-	s := NewTestingServer(server.ShowcaseObserverRegistry())
+	s := NewTestingServer(server.ShowcaseObserverRegistry(), server.NewLeaderboard(""))
 	_, err := s.DeleteSession(
 		context.Background(),
 		&pb.DeleteSessionRequest{Name: "invalid"})
@@ -182,7 +183,7 @@ func Test_DeleteSession_notFound(t *testing.T) {
 }
 
 func Test_ReportSession_notFound(t *testing.T) {
-	s := NewTestingServer(server.ShowcaseObserverRegistry())
+	s := NewTestingServer(server.ShowcaseObserverRegistry(), server.NewLeaderboard(""))
 	_, err := s.ReportSession(
 		context.Background(),
 		&pb.ReportSessionRequest{Name: "not found"})
@@ -222,8 +223,9 @@ func Test_ReportSession(t *testing.T) {
 	keys := map[string]int{"name": 0}
 
 	s := &testingServerImpl{
-		keys:     keys,
-		sessions: sessions,
+		leaderboard: server.NewLeaderboard(""),
+		keys:        keys,
+		sessions:    sessions,
 	}
 
 	got, err := s.ReportSession(
@@ -241,8 +243,60 @@ func Test_ReportSession(t *testing.T) {
 	}
 }
 
+func Test_ReportSession_leaderboard(t *testing.T) {
+	sessions := []sessionEntry{
+		{session: &sessionMock{wantReport: &pb.ReportSessionResponse{Result: pb.ReportSessionResponse_PASSED}}},
+		{session: &sessionMock{wantReport: &pb.ReportSessionResponse{Result: pb.ReportSessionResponse_FAILED}}},
+	}
+	keys := map[string]int{"sessions/pass": 0, "sessions/fail": 1}
+
+	s := &testingServerImpl{
+		leaderboard: server.NewLeaderboard(""),
+		keys:        keys,
+		sessions:    sessions,
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-goog-api-client", "gl-go/1.16.0 gapic/0.1.0 gax/2.0.5"))
+	for _, req := range []*pb.ReportSessionRequest{
+		{Name: "sessions/pass"},
+		{Name: "sessions/pass"},
+		{Name: "sessions/fail"},
+		{Name: "sessions/pass", ClientLanguage: "python", ClientVersion: "1.2.3"},
+	} {
+		if _, err := s.ReportSession(ctx, req); err != nil {
+			t.Fatalf("ReportSession(%v): unexpected err %+v", req, err)
+		}
+	}
+
+	got, err := s.GetConformanceSummary(context.Background(), &pb.GetConformanceSummaryRequest{})
+	if err != nil {
+		t.Fatalf("GetConformanceSummary: unexpected err %+v", err)
+	}
+	if len(got.GetEntries()) != 2 {
+		t.Fatalf("GetConformanceSummary: want 2 entries, got %+v", got)
+	}
+	goEntry, pyEntry := got.GetEntries()[0], got.GetEntries()[1]
+	if goEntry.GetClientLanguage() != "go" || goEntry.GetClientVersion() != "0.1.0" || goEntry.GetPassed() != 1 || goEntry.GetFailed() != 1 {
+		t.Errorf("GetConformanceSummary: unexpected go entry %+v", goEntry)
+	}
+	if goEntry.GetLatestSession() != "sessions/fail" || goEntry.GetLatestResult() != pb.ReportSessionResponse_FAILED {
+		t.Errorf("GetConformanceSummary: unexpected latest report in go entry %+v", goEntry)
+	}
+	if pyEntry.GetClientLanguage() != "python" || pyEntry.GetClientVersion() != "1.2.3" || pyEntry.GetPassed() != 1 {
+		t.Errorf("GetConformanceSummary: unexpected python entry %+v", pyEntry)
+	}
+
+	got, err = s.GetConformanceSummary(context.Background(), &pb.GetConformanceSummaryRequest{ClientLanguage: "python"})
+	if err != nil {
+		t.Fatalf("GetConformanceSummary: unexpected err %+v", err)
+	}
+	if len(got.GetEntries()) != 1 || got.GetEntries()[0].GetClientLanguage() != "python" {
+		t.Errorf("GetConformanceSummary(python): unexpected summary %+v", got)
+	}
+}
+
 func Test_ListTests_notFound(t *testing.T) {
-	s := NewTestingServer(server.ShowcaseObserverRegistry())
+	s := NewTestingServer(server.ShowcaseObserverRegistry(), server.NewLeaderboard(""))
 	_, err := s.ListTests(
 		context.Background(),
 		&pb.ListTestsRequest{Parent: "not found"})
@@ -284,7 +338,7 @@ func Test_ListTests(t *testing.T) {
 }
 
 func Test_DeleteTest_notFound(t *testing.T) {
-	s := NewTestingServer(server.ShowcaseObserverRegistry())
+	s := NewTestingServer(server.ShowcaseObserverRegistry(), server.NewLeaderboard(""))
 	_, err := s.DeleteTest(
 		context.Background(),
 		&pb.DeleteTestRequest{Name: "not found"})
@@ -321,7 +375,7 @@ func Test_DeleteTests(t *testing.T) {
 }
 
 func Test_VerifyTest(t *testing.T) {
-	s := NewTestingServer(server.ShowcaseObserverRegistry())
+	s := NewTestingServer(server.ShowcaseObserverRegistry(), server.NewLeaderboard(""))
 	got, err := s.VerifyTest(context.Background(), &pb.VerifyTestRequest{})
 	if err != nil {
 		t.Errorf("VerifyTest: unexpected err %+v", err)
@@ -330,3 +384,90 @@ func Test_VerifyTest(t *testing.T) {
 		t.Errorf("VerifyTest want %+v got %+v", &pb.VerifyTestResponse{}, got)
 	}
 }
+
+func Test_Scratchpad(t *testing.T) {
+	s := NewTestingServer(server.ShowcaseObserverRegistry(), server.NewLeaderboard(""))
+	ctx := context.Background()
+	session, err := s.CreateSession(ctx, &pb.CreateSessionRequest{Session: &pb.Session{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := session.GetName() + "/scratchpad/barrier"
+
+	if _, err := s.GetScratchpadEntry(ctx, &pb.GetScratchpadEntryRequest{Name: name}); status.Code(err) != codes.NotFound {
+		t.Errorf("Get of an unset entry: want NotFound, got %v", err)
+	}
+	first, err := s.SetScratchpadEntry(ctx, &pb.SetScratchpadEntryRequest{
+		Entry:           &pb.ScratchpadEntry{Name: name, Value: []byte("ready")},
+		ExpectedVersion: -1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.GetVersion() != 1 || first.GetUpdateTime() == nil {
+		t.Errorf("Set of a new entry: want version 1 with an update time, got %v", first)
+	}
+
+	for _, expected := range []int64{-1, 2} {
+		_, err := s.SetScratchpadEntry(ctx, &pb.SetScratchpadEntryRequest{
+			Entry:           &pb.ScratchpadEntry{Name: name, Value: []byte("raced")},
+			ExpectedVersion: expected,
+		})
+		if status.Code(err) != codes.Aborted {
+			t.Errorf("Set expecting version %d of an entry at version 1: want Aborted, got %v", expected, err)
+		}
+	}
+	second, err := s.SetScratchpadEntry(ctx, &pb.SetScratchpadEntryRequest{
+		Entry:           &pb.ScratchpadEntry{Name: name, Value: []byte("done")},
+		ExpectedVersion: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.GetScratchpadEntry(ctx, &pb.GetScratchpadEntryRequest{Name: name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, second) || string(got.GetValue()) != "done" || got.GetVersion() != 2 {
+		t.Errorf("Get: want %v, got %v", second, got)
+	}
+
+	// Scratchpads are not shared across sessions.
+	if _, err := s.GetScratchpadEntry(ctx, &pb.GetScratchpadEntryRequest{Name: "sessions/-/scratchpad/barrier"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Get from another session: want NotFound, got %v", err)
+	}
+
+	if _, err := s.DeleteScratchpadEntry(ctx, &pb.DeleteScratchpadEntryRequest{Name: name}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.DeleteScratchpadEntry(ctx, &pb.DeleteScratchpadEntryRequest{Name: name}); status.Code(err) != codes.NotFound {
+		t.Errorf("Delete of a deleted entry: want NotFound, got %v", err)
+	}
+
+	if _, err := s.SetScratchpadEntry(ctx, &pb.SetScratchpadEntryRequest{Entry: &pb.ScratchpadEntry{Name: name}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.DeleteSession(ctx, &pb.DeleteSessionRequest{Name: session.GetName()}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetScratchpadEntry(ctx, &pb.GetScratchpadEntryRequest{Name: name}); status.Code(err) != codes.NotFound {
+		t.Errorf("Get from a deleted session: want NotFound, got %v", err)
+	}
+}
+
+func Test_Scratchpad_invalid(t *testing.T) {
+	s := NewTestingServer(server.ShowcaseObserverRegistry(), server.NewLeaderboard(""))
+	for _, name := range []string{"", "sessions/-", "sessions/-/scratchpad/", "sessions/-/notes/key", "sessions/-/scratchpad/a/b"} {
+		_, err := s.SetScratchpadEntry(context.Background(), &pb.SetScratchpadEntryRequest{Entry: &pb.ScratchpadEntry{Name: name}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Set of %q: want InvalidArgument, got %v", name, err)
+		}
+	}
+	_, err := s.SetScratchpadEntry(context.Background(), &pb.SetScratchpadEntryRequest{
+		Entry:           &pb.ScratchpadEntry{Name: "sessions/-/scratchpad/key"},
+		ExpectedVersion: -2,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Set expecting version -2: want InvalidArgument, got %v", err)
+	}
+}
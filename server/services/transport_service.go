@@ -0,0 +1,166 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"net"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewTransportServer returns a new TransportServer for the Showcase API, reporting on the
+// connections observed by monitor, acting on those managed by connections, listing the calls
+// captured by binaryLogger, which is nil if binary logging is off, capturing packets with
+// recorder, checking the authority of calls with authorities and verifying the signature of
+// calls with signer.
+func NewTransportServer(monitor *server.TransportMonitor, connections *server.ConnectionManager, binaryLogger *server.BinaryLogger, recorder *server.PacketRecorder, authorities *server.AuthorityRecorder, signer *server.RequestSigner, captures *server.CallCapture) pb.TransportServer {
+	return &transportServerImpl{monitor: monitor, connections: connections, binaryLogger: binaryLogger, recorder: recorder, authorities: authorities, signer: signer, captures: captures}
+}
+
+type transportServerImpl struct {
+	monitor      *server.TransportMonitor
+	connections  *server.ConnectionManager
+	binaryLogger *server.BinaryLogger
+	recorder     *server.PacketRecorder
+	authorities  *server.AuthorityRecorder
+	signer       *server.RequestSigner
+	captures     *server.CallCapture
+}
+
+func (s *transportServerImpl) GetStreamQueueReport(ctx context.Context, in *pb.GetStreamQueueReportRequest) (*pb.StreamQueueReport, error) {
+	host := ""
+	if in.GetCallerOnly() {
+		var err error
+		if host, err = callerHost(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return s.monitor.Report(host), nil
+}
+
+// callerHost returns the host the caller's connection comes from.
+func callerHost(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.FailedPrecondition, "the caller's address is unknown")
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return "", status.Errorf(codes.FailedPrecondition, "the caller's address %q has no host: %v", p.Addr, err)
+	}
+	return host, nil
+}
+
+func (s *transportServerImpl) TriggerGoAway(ctx context.Context, in *pb.TriggerGoAwayRequest) (*pb.TriggerGoAwayResponse, error) {
+	if err := s.connections.ScheduleGoAway(ctx, http2.ErrCode(in.GetErrorCode()), in.GetDebugData()); err != nil {
+		return nil, err
+	}
+	p, _ := peer.FromContext(ctx)
+	return &pb.TriggerGoAwayResponse{RemoteAddress: p.Addr.String()}, nil
+}
+
+func (s *transportServerImpl) ListBinaryLogEntries(ctx context.Context, in *pb.ListBinaryLogEntriesRequest) (*pb.ListBinaryLogEntriesResponse, error) {
+	if s.binaryLogger == nil {
+		return nil, status.Error(codes.FailedPrecondition, "binary logging is off; select the methods to log with --binary-log-methods")
+	}
+	host := ""
+	if in.GetCallerOnly() {
+		var err error
+		if host, err = callerHost(ctx); err != nil {
+			return nil, err
+		}
+	}
+	resp := &pb.ListBinaryLogEntriesResponse{}
+	for _, entry := range s.binaryLogger.Entries(in.GetMethod(), in.GetCallId(), host) {
+		any, err := ptypes.MarshalAny(entry)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "packing binary log entry: %v", err)
+		}
+		resp.Entries = append(resp.Entries, any)
+	}
+	return resp, nil
+}
+
+func (s *transportServerImpl) StartPacketCapture(_ context.Context, in *pb.StartPacketCaptureRequest) (*pb.PacketCapture, error) {
+	if in.GetCaptureId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "capture_id must be set")
+	}
+	capture, err := s.recorder.Start(in.GetCaptureId())
+	if err != nil {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	return packetCaptureProto(capture), nil
+}
+
+func (s *transportServerImpl) StopPacketCapture(_ context.Context, in *pb.StopPacketCaptureRequest) (*pb.PacketCapture, error) {
+	capture, err := s.recorder.Stop(in.GetCaptureId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return packetCaptureProto(capture), nil
+}
+
+func (s *transportServerImpl) ListAuthorities(_ context.Context, in *pb.ListAuthoritiesRequest) (*pb.ListAuthoritiesResponse, error) {
+	return &pb.ListAuthoritiesResponse{Records: s.authorities.Records(in.GetMethod())}, nil
+}
+
+func (s *transportServerImpl) ListCapturedCalls(_ context.Context, in *pb.ListCapturedCallsRequest) (*pb.ListCapturedCallsResponse, error) {
+	if in.GetMethod() == "" {
+		return nil, status.Error(codes.InvalidArgument, "the method whose calls to list must be set")
+	}
+	if in.GetCount() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "the count of calls to list must not be negative, got %d", in.GetCount())
+	}
+	return &pb.ListCapturedCallsResponse{Calls: s.captures.Calls(in.GetMethod(), int(in.GetCount()))}, nil
+}
+
+func (s *transportServerImpl) ExpectAuthority(_ context.Context, in *pb.ExpectAuthorityRequest) (*pb.ExpectAuthorityResponse, error) {
+	return &pb.ExpectAuthorityResponse{PreviousAuthority: s.authorities.Expect(in.GetAuthority())}, nil
+}
+
+func packetCaptureProto(capture *server.Capture) *pb.PacketCapture {
+	return &pb.PacketCapture{
+		CaptureId: capture.ID,
+		StartTime: timestamppb.New(capture.StartTime),
+		Packets:   int32(capture.Packets),
+		Truncated: capture.Truncated,
+		Pcap:      capture.Data,
+	}
+}
+
+func (s *transportServerImpl) VerifySignature(ctx context.Context, in *pb.VerifySignatureRequest) (*pb.VerifySignatureResponse, error) {
+	request, ok := server.SignedRequestFromContext(ctx)
+	if !ok {
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(in)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not encode the request: %v", err)
+		}
+		method, _ := grpc.Method(ctx)
+		md, _ := metadata.FromIncomingContext(ctx)
+		request = &server.SignedRequest{Method: "POST", Path: method, Headers: md.Get, Body: body}
+	}
+	return s.signer.Verify(request), nil
+}
@@ -0,0 +1,244 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	binlogpb "google.golang.org/grpc/binarylog/grpc_binarylog_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGetStreamQueueReport(t *testing.T) {
+	monitor := server.NewTransportMonitor(4)
+	for _, addr := range []string{"127.0.0.1:5000", "192.0.2.1:6000"} {
+		tcpAddr, _ := net.ResolveTCPAddr("tcp", addr)
+		ctx := monitor.TagConn(context.Background(), &stats.ConnTagInfo{RemoteAddr: tcpAddr})
+		monitor.HandleConn(ctx, &stats.ConnBegin{})
+	}
+	s := NewTransportServer(monitor, server.NewConnectionManager(0), nil, server.NewPacketRecorder(), server.NewAuthorityRecorder(), server.NewRequestSigner(server.DefaultSigningKey), server.NewCallCapture())
+
+	report, err := s.GetStreamQueueReport(context.Background(), &pb.GetStreamQueueReportRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.GetMaxConcurrentStreams() != 4 || len(report.GetConnections()) != 2 {
+		t.Errorf("unexpected report: %v", report)
+	}
+
+	caller := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 7000}})
+	report, err = s.GetStreamQueueReport(caller, &pb.GetStreamQueueReportRequest{CallerOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.GetConnections()) != 1 || report.GetConnections()[0].GetRemoteAddress() != "192.0.2.1:6000" {
+		t.Errorf("caller only: unexpected report: %v", report)
+	}
+
+	_, err = s.GetStreamQueueReport(context.Background(), &pb.GetStreamQueueReportRequest{CallerOnly: true})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("caller only without a peer: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestTriggerGoAway_notGRPC(t *testing.T) {
+	s := NewTransportServer(server.NewTransportMonitor(0), server.NewConnectionManager(0), nil, server.NewPacketRecorder(), server.NewAuthorityRecorder(), server.NewRequestSigner(server.DefaultSigningKey), server.NewCallCapture())
+	_, err := s.TriggerGoAway(context.Background(), &pb.TriggerGoAwayRequest{DebugData: "bye"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("TriggerGoAway outside a gRPC call: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestListBinaryLogEntries(t *testing.T) {
+	s := NewTransportServer(server.NewTransportMonitor(0), server.NewConnectionManager(0), nil, server.NewPacketRecorder(), server.NewAuthorityRecorder(), server.NewRequestSigner(server.DefaultSigningKey), server.NewCallCapture())
+	_, err := s.ListBinaryLogEntries(context.Background(), &pb.ListBinaryLogEntriesRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ListBinaryLogEntries with binary logging off: got %v, want FailedPrecondition", err)
+	}
+
+	logger, err := server.NewBinaryLogger([]string{"*"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, method := range []string{"/google.showcase.v1beta1.Echo/Echo", "/google.showcase.v1beta1.Echo/Expand"} {
+		ctx := logger.TagRPC(context.Background(), &stats.RPCTagInfo{FullMethodName: method})
+		logger.HandleRPC(ctx, &stats.InHeader{FullMethod: method, RemoteAddr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 5000}})
+		logger.HandleRPC(ctx, &stats.End{})
+	}
+	s = NewTransportServer(server.NewTransportMonitor(0), server.NewConnectionManager(0), logger, server.NewPacketRecorder(), server.NewAuthorityRecorder(), server.NewRequestSigner(server.DefaultSigningKey), server.NewCallCapture())
+
+	resp, err := s.ListBinaryLogEntries(context.Background(), &pb.ListBinaryLogEntriesRequest{Method: "/google.showcase.v1beta1.Echo/Expand"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetEntries()) != 2 {
+		t.Fatalf("got %d entries, want 2", len(resp.GetEntries()))
+	}
+	entry := &binlogpb.GrpcLogEntry{}
+	if err := ptypes.UnmarshalAny(resp.GetEntries()[0], entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.GetClientHeader().GetMethodName() != "/google.showcase.v1beta1.Echo/Expand" {
+		t.Errorf("unexpected entry %v", entry)
+	}
+
+	caller := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.2"), Port: 7000}})
+	resp, err = s.ListBinaryLogEntries(caller, &pb.ListBinaryLogEntriesRequest{CallerOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetEntries()) != 0 {
+		t.Errorf("caller only: got entries of another host: %v", resp.GetEntries())
+	}
+}
+
+func TestPacketCapture(t *testing.T) {
+	s := NewTransportServer(server.NewTransportMonitor(0), server.NewConnectionManager(0), nil, server.NewPacketRecorder(), server.NewAuthorityRecorder(), server.NewRequestSigner(server.DefaultSigningKey), server.NewCallCapture())
+	if _, err := s.StartPacketCapture(context.Background(), &pb.StartPacketCaptureRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("StartPacketCapture without an ID: got %v, want InvalidArgument", err)
+	}
+	capture, err := s.StartPacketCapture(context.Background(), &pb.StartPacketCaptureRequest{CaptureId: "session"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if capture.GetCaptureId() != "session" || capture.GetStartTime() == nil || len(capture.GetPcap()) != 0 {
+		t.Errorf("StartPacketCapture: unexpected %v", capture)
+	}
+	if _, err := s.StartPacketCapture(context.Background(), &pb.StartPacketCaptureRequest{CaptureId: "session"}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("StartPacketCapture of a started capture: got %v, want AlreadyExists", err)
+	}
+
+	capture, err = s.StopPacketCapture(context.Background(), &pb.StopPacketCaptureRequest{CaptureId: "session"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(capture.GetPcap()) != 24 || capture.GetPackets() != 0 {
+		t.Errorf("StopPacketCapture: want an empty pcap, got %v", capture)
+	}
+	if _, err := s.StopPacketCapture(context.Background(), &pb.StopPacketCaptureRequest{CaptureId: "session"}); status.Code(err) != codes.NotFound {
+		t.Errorf("StopPacketCapture of a stopped capture: got %v, want NotFound", err)
+	}
+}
+
+func TestAuthorities(t *testing.T) {
+	authorities := server.NewAuthorityRecorder()
+	s := NewTransportServer(server.NewTransportMonitor(0), server.NewConnectionManager(0), nil, server.NewPacketRecorder(), authorities, server.NewRequestSigner(server.DefaultSigningKey), server.NewCallCapture())
+
+	resp, err := s.ExpectAuthority(context.Background(), &pb.ExpectAuthorityRequest{Authority: "localhost"})
+	if err != nil || resp.GetPreviousAuthority() != "" {
+		t.Fatalf("ExpectAuthority: got %v, %v", resp, err)
+	}
+	authorities.Observe("grpc", "/google.showcase.v1beta1.Echo/Echo", "example.com", false)
+	authorities.Observe("rest", "POST /v1beta1/echo:echo", "localhost:7469", false)
+
+	list, err := s.ListAuthorities(context.Background(), &pb.ListAuthoritiesRequest{Method: "/google.showcase.v1beta1.Echo/Echo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.GetRecords()) != 1 || list.GetRecords()[0].GetAuthority() != "example.com" || !list.GetRecords()[0].GetRejected() {
+		t.Errorf("ListAuthorities: got %v", list)
+	}
+
+	resp, err = s.ExpectAuthority(context.Background(), &pb.ExpectAuthorityRequest{})
+	if err != nil || resp.GetPreviousAuthority() != "localhost" {
+		t.Errorf("ExpectAuthority: want previous authority %q, got %v, %v", "localhost", resp, err)
+	}
+}
+
+func TestListCapturedCalls(t *testing.T) {
+	captures := server.NewCallCapture()
+	s := NewTransportServer(server.NewTransportMonitor(0), server.NewConnectionManager(0), nil, server.NewPacketRecorder(), server.NewAuthorityRecorder(), server.NewRequestSigner(server.DefaultSigningKey), captures)
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+	for _, content := range []string{"first", "second"} {
+		captures.UnaryInterceptor(context.Background(), &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: content}}, info,
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &pb.EchoResponse{Content: req.(*pb.EchoRequest).GetContent()}, nil
+			})
+	}
+
+	list, err := s.ListCapturedCalls(context.Background(), &pb.ListCapturedCallsRequest{Method: info.FullMethod, Count: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := &pb.EchoResponse{}
+	if len(list.GetCalls()) != 1 || len(list.GetCalls()[0].GetResponses()) != 1 ||
+		list.GetCalls()[0].GetResponses()[0].UnmarshalTo(response) != nil || response.GetContent() != "second" {
+		t.Errorf("ListCapturedCalls: want the last call, got %v", list)
+	}
+
+	for _, in := range []*pb.ListCapturedCallsRequest{{}, {Method: info.FullMethod, Count: -1}} {
+		if _, err := s.ListCapturedCalls(context.Background(), in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ListCapturedCalls(%v): got %v, want InvalidArgument", in, err)
+		}
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	pb.RegisterTransportServer(s, NewTransportServer(server.NewTransportMonitor(0), server.NewConnectionManager(0), nil, server.NewPacketRecorder(), server.NewAuthorityRecorder(), server.NewRequestSigner("key"), server.NewCallCapture()))
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewTransportClient(conn)
+
+	in := &pb.VerifySignatureRequest{Payload: []byte("hi")}
+	body, _ := proto.MarshalOptions{Deterministic: true}.Marshal(in)
+	digest := sha256.Sum256(body)
+	canonical := "SHOWCASE-HMAC-SHA256\n" +
+		"POST /google.showcase.v1beta1.Transport/VerifySignature\n" +
+		"x-client:showcase\n" +
+		hex.EncodeToString(digest[:])
+	mac := hmac.New(sha256.New, []byte("key"))
+	mac.Write([]byte(canonical))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		"x-client", "showcase",
+		server.SignedHeadersHeader, "x-client",
+		server.SignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	resp, err := client.VerifySignature(ctx, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.GetValid() || resp.GetCanonicalRequest() != canonical {
+		t.Errorf("want a valid signature over\n%s\ngot %v", canonical, resp)
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-client", "tampered")
+	if resp, err := client.VerifySignature(ctx, in); err != nil || resp.GetValid() {
+		t.Errorf("want a signature mismatch for a tampered header, got %v, %v", resp, err)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The headers of webhook deliveries.
+const (
+	WebhookHeader          = "x-showcase-webhook"
+	WebhookAttemptHeader   = "x-showcase-webhook-attempt"
+	WebhookTimestampHeader = "x-showcase-webhook-timestamp"
+	WebhookSignatureHeader = "x-showcase-webhook-signature"
+)
+
+// webhookTimeout is how long a delivery attempt may take to be answered.
+const webhookTimeout = 10 * time.Second
+
+// NewWebhookServer returns a new WebhookServiceServer for the Showcase API, delivering webhooks
+// signed by signer.
+func NewWebhookServer(signer *server.RequestSigner) pb.WebhookServiceServer {
+	return &webhookServerImpl{
+		signer:   signer,
+		client:   &http.Client{Timeout: webhookTimeout},
+		nowF:     time.Now,
+		webhooks: map[string]*pb.Webhook{},
+	}
+}
+
+type webhookServerImpl struct {
+	uid    server.UniqID
+	signer *server.RequestSigner
+	client *http.Client
+	nowF   func() time.Time
+
+	mu       sync.Mutex
+	webhooks map[string]*pb.Webhook
+}
+
+func (s *webhookServerImpl) CreateWebhook(_ context.Context, in *pb.CreateWebhookRequest) (*pb.Webhook, error) {
+	w := proto.Clone(in.GetWebhook()).(*pb.Webhook)
+	if u, err := url.Parse(w.GetUrl()); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "url must be an absolute http or https URL, got %q", w.GetUrl())
+	}
+	if w.GetDelay() != nil {
+		if err := w.GetDelay().CheckValid(); err != nil || w.GetDelay().AsDuration() < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "delay must be a non-negative duration, got %v", w.GetDelay())
+		}
+	}
+	if w.GetMaxAttempts() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_attempts must not be negative, got %d", w.GetMaxAttempts())
+	}
+	if w.GetMaxAttempts() == 0 {
+		w.MaxAttempts = 1
+	}
+
+	w.Name = fmt.Sprintf("webhooks/%d", s.uid.Next())
+	w.State = pb.Webhook_PENDING
+	w.Deliveries = nil
+	w.CreateTime = timestamppb.New(s.nowF())
+
+	s.mu.Lock()
+	s.webhooks[w.GetName()] = w
+	s.mu.Unlock()
+	s.schedule(w.GetName(), w.GetDelay().AsDuration(), 1)
+
+	return proto.Clone(w).(*pb.Webhook), nil
+}
+
+func (s *webhookServerImpl) GetWebhook(_ context.Context, in *pb.GetWebhookRequest) (*pb.Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w, ok := s.webhooks[in.GetName()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "A webhook with name %s not found.", in.GetName())
+	}
+	return proto.Clone(w).(*pb.Webhook), nil
+}
+
+// schedule makes delivery attempt number attempt of the webhook named name after delay.
+func (s *webhookServerImpl) schedule(name string, delay time.Duration, attempt int32) {
+	time.AfterFunc(delay, func() { s.deliver(name, attempt) })
+}
+
+// deliver makes delivery attempt number attempt of the webhook named name, scheduling the next
+// one if it fails and the webhook has attempts left.
+func (s *webhookServerImpl) deliver(name string, attempt int32) {
+	s.mu.Lock()
+	w, ok := s.webhooks[name]
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	target, payload := w.GetUrl(), []byte(w.GetPayload())
+	s.mu.Unlock()
+
+	now := s.nowF()
+	delivery := &pb.Webhook_Delivery{Attempt: attempt, Time: timestamppb.New(now)}
+	if code, err := s.post(name, target, payload, attempt, now); err != nil {
+		delivery.Error = err.Error()
+	} else {
+		delivery.StatusCode = int32(code)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Deliveries = append(w.Deliveries, delivery)
+	switch {
+	case delivery.GetStatusCode() >= 200 && delivery.GetStatusCode() < 300:
+		w.State = pb.Webhook_DELIVERED
+	case attempt < w.GetMaxAttempts():
+		s.schedule(name, w.GetDelay().AsDuration(), attempt+1)
+	default:
+		w.State = pb.Webhook_FAILED
+	}
+}
+
+// post POSTs payload to target, signed at now, returning the HTTP status it was answered with.
+func (s *webhookServerImpl) post(name, target string, payload []byte, attempt int32, now time.Time) (int, error) {
+	request, err := http.NewRequest(http.MethodPost, target, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WebhookHeader, name)
+	request.Header.Set(WebhookAttemptHeader, strconv.Itoa(int(attempt)))
+	request.Header.Set(WebhookTimestampHeader, timestamp)
+	request.Header.Set(WebhookSignatureHeader, s.signer.SignPayload(timestamp, payload))
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	response.Body.Close()
+	return response.StatusCode, nil
+}
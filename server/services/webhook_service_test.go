@@ -0,0 +1,145 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/googleapis/gapic-showcase/server"
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// awaitWebhook polls the webhook named name until it is no longer pending.
+func awaitWebhook(t *testing.T, s pb.WebhookServiceServer, name string) *pb.Webhook {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		w, err := s.GetWebhook(context.Background(), &pb.GetWebhookRequest{Name: name})
+		if err != nil {
+			t.Fatalf("GetWebhook: %v", err)
+		}
+		if w.GetState() != pb.Webhook_PENDING {
+			return w
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("webhook %s still pending after %d deliveries", name, len(w.GetDeliveries()))
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestWebhook_delivered(t *testing.T) {
+	signer := server.NewRequestSigner(server.DefaultSigningKey)
+	var (
+		mu       sync.Mutex
+		requests []*http.Request
+		bodies   []string
+	)
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r)
+		bodies = append(bodies, string(body))
+		if len(requests) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer target.Close()
+
+	s := NewWebhookServer(signer)
+	created, err := s.CreateWebhook(context.Background(), &pb.CreateWebhookRequest{Webhook: &pb.Webhook{
+		Url:         target.URL + "/hook",
+		Payload:     `{"event":"created"}`,
+		Delay:       durationpb.New(time.Millisecond),
+		MaxAttempts: 3,
+	}})
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	if created.GetState() != pb.Webhook_PENDING {
+		t.Errorf("CreateWebhook: got state %v, want PENDING", created.GetState())
+	}
+
+	w := awaitWebhook(t, s, created.GetName())
+	if w.GetState() != pb.Webhook_DELIVERED {
+		t.Errorf("state: got %v, want DELIVERED", w.GetState())
+	}
+	deliveries := w.GetDeliveries()
+	if len(deliveries) != 2 || deliveries[0].GetStatusCode() != http.StatusServiceUnavailable || deliveries[1].GetStatusCode() != http.StatusOK {
+		t.Fatalf("deliveries: got %v, want a 503 then a 200", deliveries)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for i, r := range requests {
+		if got := r.Header.Get(WebhookHeader); got != created.GetName() {
+			t.Errorf("request %d: got %s %q, want %q", i, WebhookHeader, got, created.GetName())
+		}
+		if got, want := r.Header.Get(WebhookAttemptHeader), []string{"1", "2"}[i]; got != want {
+			t.Errorf("request %d: got %s %q, want %q", i, WebhookAttemptHeader, got, want)
+		}
+		want := signer.SignPayload(r.Header.Get(WebhookTimestampHeader), []byte(bodies[i]))
+		if got := r.Header.Get(WebhookSignatureHeader); got != want {
+			t.Errorf("request %d: got signature %q, want %q", i, got, want)
+		}
+		if bodies[i] != `{"event":"created"}` {
+			t.Errorf("request %d: got body %q", i, bodies[i])
+		}
+	}
+}
+
+func TestWebhook_failed(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer target.Close()
+
+	s := NewWebhookServer(server.NewRequestSigner(server.DefaultSigningKey))
+	created, err := s.CreateWebhook(context.Background(), &pb.CreateWebhookRequest{Webhook: &pb.Webhook{Url: target.URL, MaxAttempts: 2}})
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	w := awaitWebhook(t, s, created.GetName())
+	if w.GetState() != pb.Webhook_FAILED || len(w.GetDeliveries()) != 2 {
+		t.Errorf("got state %v after %d deliveries, want FAILED after 2", w.GetState(), len(w.GetDeliveries()))
+	}
+}
+
+func TestWebhook_invalid(t *testing.T) {
+	s := NewWebhookServer(server.NewRequestSigner(server.DefaultSigningKey))
+	for _, w := range []*pb.Webhook{
+		{},
+		{Url: "/relative"},
+		{Url: "ftp://example.com"},
+		{Url: "http://example.com", Delay: durationpb.New(-time.Second)},
+		{Url: "http://example.com", MaxAttempts: -1},
+	} {
+		if _, err := s.CreateWebhook(context.Background(), &pb.CreateWebhookRequest{Webhook: w}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("CreateWebhook(%v): got %v, want InvalidArgument", w, err)
+		}
+	}
+	if _, err := s.GetWebhook(context.Background(), &pb.GetWebhookRequest{Name: "webhooks/7"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetWebhook of an unknown webhook: got %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+)
+
+const (
+	// DefaultSigningKey is the key requests are signed with unless the server is given
+	// another. It is a test key, shared by clients and servers alike.
+	DefaultSigningKey = "showcase-signing-key"
+
+	// SignatureHeader is the header carrying the lowercase hex signature of a request.
+	SignatureHeader = "x-showcase-signature"
+
+	// SignedHeadersHeader is the header listing the names of the headers a request signed,
+	// separated by semicolons.
+	SignedHeadersHeader = "x-showcase-signed-headers"
+
+	// signingAlgorithm is the first line of canonical requests.
+	signingAlgorithm = "SHOWCASE-HMAC-SHA256"
+)
+
+// SignedRequest is the part of a call its signature is computed over.
+type SignedRequest struct {
+	// Method is the HTTP method of the call, "POST" for gRPC calls.
+	Method string
+
+	// Path is the path of the call, followed by "?" and the query string if any. The path of
+	// gRPC calls is their full method name.
+	Path string
+
+	// Headers returns the values of the header with the given lowercase name.
+	Headers func(name string) []string
+
+	// Body is the body of the call.
+	Body []byte
+}
+
+// RequestSigner verifies the HMAC-SHA256 signatures of requests made with a shared key, so
+// that clients implementing request signing can check their canonicalization.
+type RequestSigner struct {
+	key []byte
+}
+
+// NewRequestSigner creates a RequestSigner verifying signatures made with key.
+func NewRequestSigner(key string) *RequestSigner {
+	return &RequestSigner{key: []byte(key)}
+}
+
+// Verify verifies the signature of request.
+func (s *RequestSigner) Verify(request *SignedRequest) *pb.VerifySignatureResponse {
+	signed := []string{}
+	for _, header := range request.Headers(SignedHeadersHeader) {
+		for _, name := range strings.Split(header, ";") {
+			if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+				signed = append(signed, name)
+			}
+		}
+	}
+	sort.Strings(signed)
+
+	lines := []string{signingAlgorithm, request.Method + " " + request.Path}
+	for _, name := range signed {
+		values := []string{}
+		for _, value := range request.Headers(name) {
+			values = append(values, strings.TrimSpace(value))
+		}
+		lines = append(lines, name+":"+strings.Join(values, ","))
+	}
+	digest := sha256.Sum256(request.Body)
+	lines = append(lines, hex.EncodeToString(digest[:]))
+	canonical := strings.Join(lines, "\n")
+
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(canonical))
+	expected := hex.EncodeToString(mac.Sum(nil))
+	signature := ""
+	if values := request.Headers(SignatureHeader); len(values) > 0 {
+		signature = strings.ToLower(strings.TrimSpace(values[0]))
+	}
+	return &pb.VerifySignatureResponse{
+		Valid:             signature != "" && hmac.Equal([]byte(signature), []byte(expected)),
+		CanonicalRequest:  canonical,
+		ExpectedSignature: expected,
+		Signature:         signature,
+		SignedHeaders:     signed,
+	}
+}
+
+type signedRequestKey struct{}
+
+// WithSignedRequest returns ctx carrying request, as captured off the wire by a REST endpoint.
+func WithSignedRequest(ctx context.Context, request *SignedRequest) context.Context {
+	return context.WithValue(ctx, signedRequestKey{}, request)
+}
+
+// SignedRequestFromContext returns the request captured in ctx by WithSignedRequest, if any.
+func SignedRequestFromContext(ctx context.Context) (*SignedRequest, bool) {
+	request, ok := ctx.Value(signedRequestKey{}).(*SignedRequest)
+	return request, ok
+}
+
+// SignPayload returns the lowercase hex HMAC-SHA256 of timestamp, a ".", and payload, as the
+// server signs the webhooks it delivers.
+func (s *RequestSigner) SignPayload(timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+)
+
+func sign(key, canonical string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(canonical))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestRequestSigner_verify(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Goog-Api-Client", " gl-go/1.16 ")
+	header.Add("X-Custom", "a")
+	header.Add("X-Custom", "b")
+	header.Set(SignedHeadersHeader, "x-goog-api-client; X-Custom")
+	request := &SignedRequest{
+		Method:  "POST",
+		Path:    "/v1beta1/transport:verifySignature?alt=json",
+		Headers: header.Values,
+		Body:    []byte(`{"payload":"aGk="}`),
+	}
+	wantCanonical := "SHOWCASE-HMAC-SHA256\n" +
+		"POST /v1beta1/transport:verifySignature?alt=json\n" +
+		"x-custom:a,b\n" +
+		"x-goog-api-client:gl-go/1.16\n" +
+		"6ab7472756e9bf0a501ad356c512c37e8a1c2e47aa3cee946fecab999448b9b8"
+
+	signer := NewRequestSigner("key")
+	got := signer.Verify(request)
+	if got.GetCanonicalRequest() != wantCanonical {
+		t.Errorf("want canonical request\n%s\ngot\n%s", wantCanonical, got.GetCanonicalRequest())
+	}
+	if got.GetValid() || got.GetSignature() != "" {
+		t.Errorf("an unsigned request should not be valid, got %v", got)
+	}
+	if want := []string{"x-custom", "x-goog-api-client"}; len(got.GetSignedHeaders()) != 2 || got.GetSignedHeaders()[0] != want[0] || got.GetSignedHeaders()[1] != want[1] {
+		t.Errorf("want signed headers %v, got %v", want, got.GetSignedHeaders())
+	}
+
+	header.Set(SignatureHeader, sign("key", wantCanonical))
+	if got := signer.Verify(request); !got.GetValid() || got.GetExpectedSignature() != got.GetSignature() {
+		t.Errorf("a correctly signed request should be valid, got %v", got)
+	}
+	header.Set(SignatureHeader, sign("other key", wantCanonical))
+	if got := signer.Verify(request); got.GetValid() {
+		t.Errorf("a request signed with another key should not be valid, got %v", got)
+	}
+}
+
+func TestSignedRequestFromContext(t *testing.T) {
+	if _, ok := SignedRequestFromContext(context.Background()); ok {
+		t.Errorf("want no request in an empty context")
+	}
+	request := &SignedRequest{Method: "GET"}
+	if got, ok := SignedRequestFromContext(WithSignedRequest(context.Background(), request)); !ok || got != request {
+		t.Errorf("want the request put in the context, got %v", got)
+	}
+}
+
+func TestRequestSigner_signPayload(t *testing.T) {
+	got := NewRequestSigner("key").SignPayload("1554144706", []byte(`{"event":"created"}`))
+	if want := sign("key", `1554144706.{"event":"created"}`); got != want {
+		t.Errorf("SignPayload: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+const (
+	// StickySessionCookie is the cookie REST clients are issued their session in.
+	StickySessionCookie = "showcase-session"
+
+	// StickySessionHeader is the response header echoing the session a REST call was
+	// served in.
+	StickySessionHeader = "X-Showcase-Session"
+
+	// StickyBackendHeader is the response header echoing the simulated backend a REST
+	// call's session is bound to.
+	StickyBackendHeader = "X-Showcase-Session-Backend"
+)
+
+// StickySessions assigns REST clients sessions, carried in a cookie and each bound to one of a
+// number of simulated backends, so that clients' cookie jars and the session affinity of the
+// load balancers in front of servers can be tested.
+type StickySessions struct {
+	backends int
+	require  bool
+
+	mu       sync.Mutex
+	sessions map[string]int
+	next     int
+}
+
+// NewStickySessions creates StickySessions binding sessions to backends simulated backends in
+// turn. If require is true, the calls not carrying a session are refused once issued one.
+func NewStickySessions(backends int, require bool) (*StickySessions, error) {
+	if backends <= 0 {
+		return nil, fmt.Errorf("invalid number of backends %d: must be positive", backends)
+	}
+	return &StickySessions{backends: backends, require: require, sessions: map[string]int{}}, nil
+}
+
+// Required returns whether the calls not carrying a session are refused.
+func (s *StickySessions) Required() bool {
+	return s.require
+}
+
+// Assign returns the session a call carrying the session cookie value id is served in, and
+// the backend that session is bound to. If id is not a session that was issued, a new session
+// is issued, bound to the next backend, and issued is true.
+func (s *StickySessions) Assign(id string) (session string, backend string, issued bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if idx, ok := s.sessions[id]; ok {
+		return id, backendName(idx), false
+	}
+	raw := make([]byte, 8)
+	rand.Read(raw)
+	session = hex.EncodeToString(raw)
+	idx := s.next % s.backends
+	s.next++
+	s.sessions[session] = idx
+	return session, backendName(idx), true
+}
+
+func backendName(idx int) string {
+	return fmt.Sprintf("backend-%d", idx)
+}
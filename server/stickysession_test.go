@@ -0,0 +1,48 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import "testing"
+
+func TestStickySessions(t *testing.T) {
+	if _, err := NewStickySessions(0, false); err == nil {
+		t.Errorf("NewStickySessions: want an error for no backends")
+	}
+	sessions, err := NewStickySessions(2, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sessions.Required() {
+		t.Errorf("Required: want true")
+	}
+
+	first, firstBackend, issued := sessions.Assign("")
+	if !issued || first == "" || firstBackend != "backend-0" {
+		t.Errorf("Assign(\"\"): want a new session on backend-0, got %q on %q, issued %v", first, firstBackend, issued)
+	}
+	second, secondBackend, issued := sessions.Assign("forged")
+	if !issued || second == first || secondBackend != "backend-1" {
+		t.Errorf("Assign(forged): want a new session on backend-1, got %q on %q, issued %v", second, secondBackend, issued)
+	}
+	_, thirdBackend, _ := sessions.Assign("")
+	if thirdBackend != "backend-0" {
+		t.Errorf("Assign(\"\"): want backends assigned in turn, got %q", thirdBackend)
+	}
+
+	again, backend, issued := sessions.Assign(first)
+	if issued || again != first || backend != firstBackend {
+		t.Errorf("Assign(%q): want the session kept on %q, got %q on %q, issued %v", first, firstBackend, again, backend, issued)
+	}
+}
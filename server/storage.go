@@ -0,0 +1,192 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Store persists the state of services to a file, so that it survives restarts of the server.
+// The file holds a JSON object with one section per kind of state, each the JSON form of a
+// proto message. It is replaced atomically, by renaming a fully written and synced file over it,
+// and the sections saved while it is being written are batched into the next write.
+type Store struct {
+	mu       sync.Mutex
+	path     string
+	sections map[string]json.RawMessage
+
+	// written holds the sections as last written to the file, restored when a write fails.
+	written map[string]json.RawMessage
+
+	// write replaces the contents of the file, and is only replaced by tests.
+	write func(path string, sections map[string]json.RawMessage) error
+
+	// staged is the generation at which each section was last saved. pending is the batch of
+	// the saves the next write is to hold, and flushing whether a Save is writing the file,
+	// which the saves in other batches wait on with done.
+	generation uint64
+	staged     map[string]uint64
+	pending    *storeBatch
+	flushing   bool
+	done       *sync.Cond
+}
+
+// storeBatch is the outcome of the write holding a batch of saves.
+type storeBatch struct {
+	written bool
+	err     error
+}
+
+// OpenStore opens the store persisted to path, loading the state saved there if the file
+// exists.
+func OpenStore(path string) (*Store, error) {
+	s := &Store{path: path, sections: map[string]json.RawMessage{}, written: map[string]json.RawMessage{}, write: write, staged: map[string]uint64{}}
+	s.done = sync.NewCond(&s.mu)
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.sections); err != nil {
+		return nil, err
+	}
+	for section, data := range s.sections {
+		s.written[section] = data
+	}
+	return s, nil
+}
+
+// Path returns the file the store is persisted to.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Load reads the state saved in section into m, returning whether any was saved. A nil Store
+// has none.
+func (s *Store) Load(section string, m proto.Message) (bool, error) {
+	if s == nil {
+		return false, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.sections[section]
+	if !ok {
+		return false, nil
+	}
+	return true, protojson.Unmarshal(data, m)
+}
+
+// Save replaces the state saved in section with m, returning once the file holds it. If the file
+// cannot be written, the section keeps the state last written. Saving to a nil Store does
+// nothing.
+func (s *Store) Save(section string, m proto.Message) error {
+	if s == nil {
+		return nil
+	}
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generation++
+	s.sections[section] = data
+	s.staged[section] = s.generation
+	if s.pending == nil {
+		s.pending = &storeBatch{}
+	}
+	batch := s.pending
+	for s.flushing && !batch.written {
+		s.done.Wait()
+	}
+	if batch.written {
+		return batch.err
+	}
+
+	// No write is under way: write the file, for as long as sections are saved while it is
+	// written.
+	s.flushing = true
+	for s.pending != nil {
+		s.flush()
+	}
+	s.flushing = false
+	return batch.err
+}
+
+// flush writes the sections saved so far to the file, waking the saves of the pending batch. If
+// the write fails, the sections it held are restored as last written. s.mu must be held, and is
+// released while writing.
+func (s *Store) flush() {
+	batch, generation := s.pending, s.generation
+	s.pending = nil
+	sections := make(map[string]json.RawMessage, len(s.sections))
+	for section, data := range s.sections {
+		sections[section] = data
+	}
+	s.mu.Unlock()
+	err := s.write(s.path, sections)
+	s.mu.Lock()
+
+	if err == nil {
+		s.written = sections
+	} else {
+		for section, staged := range s.staged {
+			if staged > generation {
+				continue
+			}
+			if data, ok := s.written[section]; ok {
+				s.sections[section] = data
+			} else {
+				delete(s.sections, section)
+			}
+		}
+	}
+	batch.written, batch.err = true, err
+	s.done.Broadcast()
+}
+
+// write atomically replaces the contents of the file at path with sections.
+func write(path string, sections map[string]json.RawMessage) error {
+	data, err := json.MarshalIndent(sections, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
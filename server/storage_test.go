@@ -0,0 +1,236 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "state.json")
+
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+	if ok, err := store.Load("users", &pb.ListUsersResponse{}); ok || err != nil {
+		t.Errorf("Load before Save: got (%t, %v), want (false, nil)", ok, err)
+	}
+	want := &pb.ListUsersResponse{Users: []*pb.User{{Name: "users/3", DisplayName: "Rumble"}}}
+	if err := store.Save("users", want); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	reopened, err := OpenStore(path)
+	if err != nil {
+		t.Fatalf("OpenStore of the saved file: %v", err)
+	}
+	got := &pb.ListUsersResponse{}
+	if ok, err := reopened.Load("users", got); !ok || err != nil {
+		t.Fatalf("Load after Save: got (%t, %v), want (true, nil)", ok, err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("Load: got %v, want %v", got, want)
+	}
+}
+
+func TestStore_nil(t *testing.T) {
+	var store *Store
+	if err := store.Save("users", &pb.ListUsersResponse{}); err != nil {
+		t.Errorf("Save: %v", err)
+	}
+	if ok, err := store.Load("users", &pb.ListUsersResponse{}); ok || err != nil {
+		t.Errorf("Load: got (%t, %v), want (false, nil)", ok, err)
+	}
+}
+
+func TestOpenStore_malformed(t *testing.T) {
+	file, err := ioutil.TempFile("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString("{")
+	file.Close()
+	if _, err := OpenStore(file.Name()); err == nil {
+		t.Error("OpenStore of a malformed file: expected an error")
+	}
+}
+
+func TestStore_writeFailed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := OpenStore(filepath.Join(dir, "state.json"))
+	if err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+	want := &pb.ListUsersResponse{Users: []*pb.User{{Name: "users/3", DisplayName: "Rumble"}}}
+	if err := store.Save("users", want); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	os.RemoveAll(dir)
+	if err := store.Save("users", &pb.ListUsersResponse{}); err == nil {
+		t.Error("Save to a removed directory: expected an error")
+	}
+	if err := store.Save("rooms", &pb.ListRoomsResponse{}); err == nil {
+		t.Error("Save to a removed directory: expected an error")
+	}
+	got := &pb.ListUsersResponse{}
+	if ok, err := store.Load("users", got); !ok || err != nil {
+		t.Fatalf("Load after a failed Save: got (%t, %v), want (true, nil)", ok, err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("Load after a failed Save: got %v, want %v", got, want)
+	}
+	if ok, err := store.Load("rooms", &pb.ListRoomsResponse{}); ok || err != nil {
+		t.Errorf("Load of a section never written: got (%t, %v), want (false, nil)", ok, err)
+	}
+}
+
+func TestStore_concurrentSaves(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "state.json")
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+
+	sections := []string{"users", "rooms", "blurbs", "sequences"}
+	var wg sync.WaitGroup
+	for _, section := range sections {
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(section string, i int) {
+				defer wg.Done()
+				m := &pb.ListUsersResponse{NextPageToken: fmt.Sprint(i)}
+				if err := store.Save(section, m); err != nil {
+					t.Errorf("Save(%q): %v", section, err)
+				}
+			}(section, i)
+		}
+	}
+	wg.Wait()
+
+	// Every section holds one of the values saved to it, and the file holds the same.
+	reopened, err := OpenStore(path)
+	if err != nil {
+		t.Fatalf("OpenStore of the saved file: %v", err)
+	}
+	for _, section := range sections {
+		saved, persisted := &pb.ListUsersResponse{}, &pb.ListUsersResponse{}
+		if ok, err := store.Load(section, saved); !ok || err != nil {
+			t.Fatalf("Load(%q): got (%t, %v), want (true, nil)", section, ok, err)
+		}
+		if ok, err := reopened.Load(section, persisted); !ok || err != nil {
+			t.Fatalf("Load(%q) of the saved file: got (%t, %v), want (true, nil)", section, ok, err)
+		}
+		if !proto.Equal(saved, persisted) {
+			t.Errorf("Load(%q): the file holds %v, the store %v", section, persisted, saved)
+		}
+	}
+}
+
+func TestStore_concurrentSaveFailed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "state.json")
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+
+	// The first write waits for a second save to be pending behind it, and the second write,
+	// holding only that save, fails.
+	started, release := make(chan struct{}), make(chan struct{})
+	writes := 0
+	store.write = func(path string, sections map[string]json.RawMessage) error {
+		writes++
+		switch writes {
+		case 1:
+			close(started)
+			<-release
+			return write(path, sections)
+		case 2:
+			return errors.New("disk full")
+		}
+		return write(path, sections)
+	}
+
+	var wg sync.WaitGroup
+	var usersErr, roomsErr error
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		usersErr = store.Save("users", &pb.ListUsersResponse{NextPageToken: "users"})
+	}()
+	<-started
+	go func() {
+		defer wg.Done()
+		roomsErr = store.Save("rooms", &pb.ListRoomsResponse{NextPageToken: "rooms"})
+	}()
+	for pending := false; !pending; {
+		store.mu.Lock()
+		pending = store.pending != nil
+		store.mu.Unlock()
+	}
+	close(release)
+	wg.Wait()
+
+	// Each save reports the outcome of its own write.
+	if usersErr != nil {
+		t.Errorf("Save(\"users\") written before the failed write: %v", usersErr)
+	}
+	if roomsErr == nil {
+		t.Error("Save(\"rooms\") held by the failed write: expected an error")
+	}
+	reopened, err := OpenStore(path)
+	if err != nil {
+		t.Fatalf("OpenStore of the saved file: %v", err)
+	}
+	for _, s := range []*Store{store, reopened} {
+		if ok, err := s.Load("users", &pb.ListUsersResponse{}); !ok || err != nil {
+			t.Errorf("Load(\"users\"): got (%t, %v), want (true, nil)", ok, err)
+		}
+		if ok, err := s.Load("rooms", &pb.ListRoomsResponse{}); ok || err != nil {
+			t.Errorf("Load(\"rooms\") of a failed save: got (%t, %v), want (false, nil)", ok, err)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ReceivedMessagesTrailer is the trailer reporting how many messages the server received on
+	// a stream.
+	ReceivedMessagesTrailer = "x-showcase-received-messages"
+
+	// ReceivedBytesTrailer is the trailer reporting the total size, serialized, of the messages
+	// the server received on a stream.
+	ReceivedBytesTrailer = "x-showcase-received-bytes"
+
+	// SentMessagesTrailer is the trailer reporting how many messages the server sent on a
+	// stream.
+	SentMessagesTrailer = "x-showcase-sent-messages"
+
+	// SentBytesTrailer is the trailer reporting the total size, serialized, of the messages the
+	// server sent on a stream.
+	SentBytesTrailer = "x-showcase-sent-bytes"
+)
+
+// StreamCountsInterceptor implements grpc.StreamServerInterceptor, reporting in trailers how many
+// messages, and how many bytes of them, the server received and sent on each stream, so that
+// clients can assert that none were lost or duplicated on their side.
+func StreamCountsInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	counted := &countingServerStream{ServerStream: ss}
+	err := handler(srv, counted)
+	ss.SetTrailer(counted.trailer())
+	return err
+}
+
+// countingServerStream is a stream counting the messages received and sent on it.
+type countingServerStream struct {
+	grpc.ServerStream
+
+	mu                              sync.Mutex
+	receivedMessages, receivedBytes int
+	sentMessages, sentBytes         int
+}
+
+func (s *countingServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.mu.Lock()
+		s.receivedMessages++
+		s.receivedBytes += messageSize(m)
+		s.mu.Unlock()
+	}
+	return err
+}
+
+func (s *countingServerStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.mu.Lock()
+		s.sentMessages++
+		s.sentBytes += messageSize(m)
+		s.mu.Unlock()
+	}
+	return err
+}
+
+// trailer returns the trailer reporting the counts.
+func (s *countingServerStream) trailer() metadata.MD {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return metadata.Pairs(
+		ReceivedMessagesTrailer, strconv.Itoa(s.receivedMessages),
+		ReceivedBytesTrailer, strconv.Itoa(s.receivedBytes),
+		SentMessagesTrailer, strconv.Itoa(s.sentMessages),
+		SentBytesTrailer, strconv.Itoa(s.sentBytes))
+}
+
+// messageSize returns the serialized size of m, or 0 if it is not a proto message.
+func messageSize(m interface{}) int {
+	if message, ok := m.(proto.Message); ok {
+		return proto.Size(message)
+	}
+	return 0
+}
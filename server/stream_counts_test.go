@@ -0,0 +1,128 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// countedEcho expands and collects words.
+type countedEcho struct {
+	*pb.UnimplementedEchoServer
+}
+
+func (countedEcho) Expand(in *pb.ExpandRequest, stream pb.Echo_ExpandServer) error {
+	for _, word := range strings.Fields(in.GetContent()) {
+		if err := stream.Send(&pb.EchoResponse{Content: word}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (countedEcho) Collect(stream pb.Echo_CollectServer) error {
+	words := []string{}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&pb.EchoResponse{Content: strings.Join(words, " ")})
+		}
+		if err != nil {
+			return err
+		}
+		words = append(words, req.GetContent())
+	}
+}
+
+func TestStreamCountsInterceptor(t *testing.T) {
+	s := grpc.NewServer(grpc.StreamInterceptor(StreamCountsInterceptor))
+	pb.RegisterEchoServer(s, countedEcho{&pb.UnimplementedEchoServer{}})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewEchoClient(conn)
+
+	check := func(method string, trailer metadata.MD, want map[string]int) {
+		for name, count := range want {
+			if got := trailer.Get(name); len(got) != 1 || got[0] != strconv.Itoa(count) {
+				t.Errorf("%s: got %s %q, want %d", method, name, got, count)
+			}
+		}
+	}
+
+	request := &pb.ExpandRequest{Content: "one two three"}
+	expand, err := client.Expand(context.Background(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sentBytes := 0
+	for {
+		resp, err := expand.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		sentBytes += proto.Size(resp)
+	}
+	check("Expand", expand.Trailer(), map[string]int{
+		ReceivedMessagesTrailer: 1,
+		ReceivedBytesTrailer:    proto.Size(request),
+		SentMessagesTrailer:     3,
+		SentBytesTrailer:        sentBytes,
+	})
+
+	collect, err := client.Collect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	receivedBytes := 0
+	for _, word := range []string{"a", "bb"} {
+		req := &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: word}}
+		receivedBytes += proto.Size(req)
+		if err := collect.Send(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err := collect.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("Collect", collect.Trailer(), map[string]int{
+		ReceivedMessagesTrailer: 2,
+		ReceivedBytesTrailer:    receivedBytes,
+		SentMessagesTrailer:     1,
+		SentBytesTrailer:        proto.Size(resp),
+	})
+}
@@ -0,0 +1,334 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// TraceparentHeader is the W3C Trace Context header carrying the trace and parent span of a
+	// call. The server echoes it back in the response metadata.
+	TraceparentHeader = "traceparent"
+
+	// TracestateHeader is the W3C Trace Context header carrying vendor-specific trace state. The
+	// server echoes it back along with TraceparentHeader.
+	TracestateHeader = "tracestate"
+
+	// TracerName is the name of the instrumentation scope, and of the service, that the server's
+	// spans are exported under.
+	TracerName = "gapic-showcase"
+
+	// otlpExportInterval is how often an OTLPExporter sends the spans that have ended.
+	otlpExportInterval = time.Second
+
+	// maxPendingSpans is how many spans an OTLPExporter holds on to while they cannot be sent.
+	maxPendingSpans = 1000
+)
+
+// SpanContext identifies a span, and the trace it is part of, the way W3C Trace Context does.
+type SpanContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Flags   byte
+}
+
+// ParseTraceparent parses the value of a TraceparentHeader, returning false if it is invalid.
+// Values of versions after 00 are parsed as version 00, as the specification requires.
+func ParseTraceparent(value string) (SpanContext, bool) {
+	var sc SpanContext
+	value = strings.TrimSpace(value)
+	if len(value) < 55 || value[2] != '-' || value[35] != '-' || value[52] != '-' {
+		return sc, false
+	}
+	version := value[:2]
+	if version == "ff" || (version == "00" && len(value) != 55) || (len(value) > 55 && value[55] != '-') {
+		return sc, false
+	}
+	flags, err := hex.DecodeString(value[53:55])
+	if _, verr := hex.DecodeString(version); err != nil || verr != nil || strings.ToLower(value) != value {
+		return sc, false
+	}
+	if _, err := hex.Decode(sc.TraceID[:], []byte(value[3:35])); err != nil {
+		return sc, false
+	}
+	if _, err := hex.Decode(sc.SpanID[:], []byte(value[36:52])); err != nil {
+		return sc, false
+	}
+	if sc.TraceID == ([16]byte{}) || sc.SpanID == ([8]byte{}) {
+		return sc, false
+	}
+	sc.Flags = flags[0]
+	return sc, true
+}
+
+// Traceparent returns the TraceparentHeader value naming the span.
+func (sc SpanContext) Traceparent() string {
+	return fmt.Sprintf("00-%x-%x-%02x", sc.TraceID, sc.SpanID, sc.Flags)
+}
+
+// Span is a call served by the server, as part of the trace the client started, if any.
+type Span struct {
+	Name         string
+	Context      SpanContext
+	ParentSpanID [8]byte
+	Start, End   time.Time
+
+	// Attributes hold string or int64 values, named by the OpenTelemetry semantic conventions.
+	Attributes map[string]interface{}
+
+	// Failed, when true, means the call failed with StatusMessage.
+	Failed        bool
+	StatusMessage string
+}
+
+// SpanExporter sends the spans that have ended to a tracing backend.
+type SpanExporter interface {
+	ExportSpans(spans []*Span)
+}
+
+// Tracer starts a server span for every call, as a child of the span the client sent in its
+// TraceparentHeader, if any, and hands the spans to its SpanExporter once they end.
+type Tracer struct {
+	exporter SpanExporter
+	nowF     func() time.Time
+}
+
+// NewTracer creates a Tracer exporting spans to exporter, or dropping them if exporter is nil.
+func NewTracer(exporter SpanExporter) *Tracer {
+	return &Tracer{exporter: exporter, nowF: time.Now}
+}
+
+// Start starts the span of a call named name, a child of the span traceparent names if valid,
+// or else the root of a new trace.
+func (t *Tracer) Start(name, traceparent string) *Span {
+	span := &Span{Name: name, Start: t.nowF(), Attributes: map[string]interface{}{}}
+	if parent, ok := ParseTraceparent(traceparent); ok {
+		span.Context.TraceID = parent.TraceID
+		span.Context.Flags = parent.Flags
+		span.ParentSpanID = parent.SpanID
+	} else {
+		rand.Read(span.Context.TraceID[:])
+		span.Context.Flags = 1
+	}
+	rand.Read(span.Context.SpanID[:])
+	return span
+}
+
+// End ends span, as failed with message if failed is true.
+func (t *Tracer) End(span *Span, failed bool, message string) {
+	span.End = t.nowF()
+	span.Failed = failed
+	span.StatusMessage = message
+	if t.exporter != nil {
+		t.exporter.ExportSpans([]*Span{span})
+	}
+}
+
+// startCall starts the span of a gRPC call to method, echoing the trace context it carries.
+func (t *Tracer) startCall(ctx context.Context, method string) *Span {
+	md, _ := metadata.FromIncomingContext(ctx)
+	traceparent := ""
+	if values := md.Get(TraceparentHeader); len(values) > 0 {
+		traceparent = values[0]
+		echo := metadata.Pairs(TraceparentHeader, traceparent)
+		if state := md.Get(TracestateHeader); len(state) > 0 {
+			echo.Set(TracestateHeader, state...)
+		}
+		grpc.SetHeader(ctx, echo)
+	}
+	span := t.Start(strings.TrimPrefix(method, "/"), traceparent)
+	span.Attributes["rpc.system"] = "grpc"
+	if parts := strings.SplitN(strings.TrimPrefix(method, "/"), "/", 2); len(parts) == 2 {
+		span.Attributes["rpc.service"] = parts[0]
+		span.Attributes["rpc.method"] = parts[1]
+	}
+	return span
+}
+
+// endCall ends the span of a gRPC call that returned err.
+func (t *Tracer) endCall(span *Span, err error) {
+	st := status.Convert(err)
+	span.Attributes["rpc.grpc.status_code"] = int64(st.Code())
+	t.End(span, st.Code() != codes.OK, st.Message())
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, tracing calls.
+func (t *Tracer) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	span := t.startCall(ctx, info.FullMethod)
+	resp, err := handler(ctx, req)
+	t.endCall(span, err)
+	return resp, err
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, tracing calls.
+func (t *Tracer) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	span := t.startCall(ss.Context(), info.FullMethod)
+	err := handler(srv, ss)
+	t.endCall(span, err)
+	return err
+}
+
+// OTLPExporter is a SpanExporter sending spans to an OpenTelemetry collector over OTLP/HTTP,
+// encoded as JSON, every otlpExportInterval.
+type OTLPExporter struct {
+	url    string
+	client *http.Client
+	errLog *log.Logger
+
+	mu      sync.Mutex
+	pending []*Span
+}
+
+// NewOTLPExporter creates an OTLPExporter sending spans to the collector at endpoint, such as
+// "http://localhost:4318". Failures to send spans are reported to errLog.
+func NewOTLPExporter(endpoint string, errLog *log.Logger) *OTLPExporter {
+	e := &OTLPExporter{
+		url:    strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		client: &http.Client{Timeout: 10 * time.Second},
+		errLog: errLog,
+	}
+	go func() {
+		for range time.Tick(otlpExportInterval) {
+			if err := e.Flush(); err != nil && e.errLog != nil {
+				e.errLog.Printf("Failed to export spans to %s: %v", e.url, err)
+			}
+		}
+	}()
+	return e
+}
+
+// ExportSpans queues spans to be sent, dropping the oldest ones if too many are queued.
+func (e *OTLPExporter) ExportSpans(spans []*Span) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.pending = append(e.pending, spans...)
+	if len(e.pending) > maxPendingSpans {
+		e.pending = e.pending[len(e.pending)-maxPendingSpans:]
+	}
+}
+
+// Flush sends the spans queued. They are queued again if the collector cannot be reached.
+func (e *OTLPExporter) Flush() error {
+	e.mu.Lock()
+	spans := e.pending
+	e.pending = nil
+	e.mu.Unlock()
+	if len(spans) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(otlpRequest(spans))
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.Post(e.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		e.ExportSpans(spans)
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("the collector answered %s", resp.Status)
+	}
+	return nil
+}
+
+// otlpRequest returns the JSON encoding of the ExportTraceServiceRequest sending spans.
+func otlpRequest(spans []*Span) map[string]interface{} {
+	encoded := []interface{}{}
+	for _, span := range spans {
+		attributes := []interface{}{}
+		for _, key := range sortedKeys(span.Attributes) {
+			value := map[string]interface{}{}
+			switch v := span.Attributes[key].(type) {
+			case int64:
+				value["intValue"] = strconv.FormatInt(v, 10)
+			default:
+				value["stringValue"] = fmt.Sprint(v)
+			}
+			attributes = append(attributes, map[string]interface{}{"key": key, "value": value})
+		}
+		code := 1 // STATUS_CODE_OK
+		if span.Failed {
+			code = 2 // STATUS_CODE_ERROR
+		}
+		s := map[string]interface{}{
+			"traceId":           hex.EncodeToString(span.Context.TraceID[:]),
+			"spanId":            hex.EncodeToString(span.Context.SpanID[:]),
+			"name":              span.Name,
+			"kind":              2, // SPAN_KIND_SERVER
+			"startTimeUnixNano": strconv.FormatInt(span.Start.UnixNano(), 10),
+			"endTimeUnixNano":   strconv.FormatInt(span.End.UnixNano(), 10),
+			"attributes":        attributes,
+			"status":            map[string]interface{}{"code": code, "message": span.StatusMessage},
+		}
+		if span.ParentSpanID != ([8]byte{}) {
+			s["parentSpanId"] = hex.EncodeToString(span.ParentSpanID[:])
+		}
+		encoded = append(encoded, s)
+	}
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{map[string]interface{}{
+			"resource": map[string]interface{}{
+				"attributes": []interface{}{map[string]interface{}{
+					"key":   "service.name",
+					"value": map[string]interface{}{"stringValue": TracerName},
+				}},
+			},
+			"scopeSpans": []interface{}{map[string]interface{}{
+				"scope": map[string]interface{}{"name": TracerName},
+				"spans": encoded,
+			}},
+		}},
+	}
+}
+
+// sortedKeys returns the keys of attributes in order.
+func sortedKeys(attributes map[string]interface{}) []string {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,178 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const testTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestParseTraceparent(t *testing.T) {
+	for _, test := range []struct {
+		value string
+		want  bool
+	}{
+		{testTraceparent, true},
+		{"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-future", true},
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", false},
+		{"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", false},
+		{"00-00000000000000000000000000000000-00f067aa0ba902b7-01", false},
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01", false},
+		{"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01", false},
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7", false},
+		{"", false},
+	} {
+		sc, ok := ParseTraceparent(test.value)
+		if ok != test.want {
+			t.Errorf("ParseTraceparent(%q): got %v, want %v", test.value, ok, test.want)
+		}
+		if ok && sc.Traceparent()[2:] != test.value[2:55] {
+			t.Errorf("ParseTraceparent(%q): got %q back", test.value, sc.Traceparent())
+		}
+	}
+}
+
+// recordingExporter keeps the spans it is handed.
+type recordingExporter struct {
+	mu    sync.Mutex
+	spans []*Span
+}
+
+func (e *recordingExporter) ExportSpans(spans []*Span) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spans = append(e.spans, spans...)
+}
+
+func TestTracer_UnaryInterceptor(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer := NewTracer(exporter)
+	s := grpc.NewServer(grpc.UnaryInterceptor(tracer.UnaryInterceptor), grpc.StreamInterceptor(tracer.StreamInterceptor))
+	pb.RegisterEchoServer(s, forwardedEcho{&pb.UnimplementedEchoServer{}})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewEchoClient(conn)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), TraceparentHeader, testTraceparent, TracestateHeader, "vendor=value")
+	var header metadata.MD
+	if _, err := client.Echo(ctx, &pb.EchoRequest{}, grpc.Header(&header)); err != nil {
+		t.Fatal(err)
+	}
+	if got := header.Get(TraceparentHeader); len(got) != 1 || got[0] != testTraceparent {
+		t.Errorf("Echo: want traceparent %q echoed, got %q", testTraceparent, got)
+	}
+	if got := header.Get(TracestateHeader); len(got) != 1 || got[0] != "vendor=value" {
+		t.Errorf("Echo: want tracestate echoed, got %q", got)
+	}
+
+	header = nil
+	if _, err := client.Block(context.Background(), &pb.BlockRequest{}, grpc.Header(&header)); err == nil {
+		t.Fatal("Block: want an error from the unimplemented method")
+	}
+	if got := header.Get(TraceparentHeader); len(got) != 0 {
+		t.Errorf("Block: want no traceparent echoed without one sent, got %q", got)
+	}
+
+	if len(exporter.spans) != 2 {
+		t.Fatalf("want 2 spans exported, got %d", len(exporter.spans))
+	}
+	child, root := exporter.spans[0], exporter.spans[1]
+	parent, _ := ParseTraceparent(testTraceparent)
+	if child.Context.TraceID != parent.TraceID || child.ParentSpanID != parent.SpanID || child.Context.SpanID == parent.SpanID {
+		t.Errorf("Echo: want a child span of %s, got %s with parent %x", testTraceparent, child.Context.Traceparent(), child.ParentSpanID)
+	}
+	if child.Name != "google.showcase.v1beta1.Echo/Echo" || child.Failed || child.Attributes["rpc.method"] != "Echo" {
+		t.Errorf("Echo: unexpected span %+v", child)
+	}
+	if root.ParentSpanID != ([8]byte{}) || root.Context.TraceID == parent.TraceID || !root.Failed ||
+		root.Attributes["rpc.grpc.status_code"] != int64(12) {
+		t.Errorf("Block: want a failed root span, got %+v", root)
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var got struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []struct {
+					TraceID      string `json:"traceId"`
+					SpanID       string `json:"spanId"`
+					ParentSpanID string `json:"parentSpanId"`
+					Name         string
+					Kind         int
+					Attributes   []struct {
+						Key   string
+						Value map[string]string
+					}
+					Status struct{ Code int }
+				}
+			}
+		}
+	}
+	var path, contentType string
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, contentType = r.URL.Path, r.Header.Get("Content-Type")
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &got)
+	}))
+	defer collector.Close()
+
+	tracer := NewTracer(NewOTLPExporter(collector.URL+"/", nil))
+	span := tracer.Start("google.showcase.v1beta1.Echo/Echo", testTraceparent)
+	span.Attributes["rpc.system"] = "grpc"
+	span.Attributes["rpc.grpc.status_code"] = int64(0)
+	tracer.End(span, false, "")
+	if err := tracer.exporter.(*OTLPExporter).Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if path != "/v1/traces" || !strings.HasPrefix(contentType, "application/json") {
+		t.Errorf("want spans posted as JSON to /v1/traces, got %q to %q", contentType, path)
+	}
+	if len(got.ResourceSpans) != 1 || len(got.ResourceSpans[0].ScopeSpans) != 1 || len(got.ResourceSpans[0].ScopeSpans[0].Spans) != 1 {
+		t.Fatalf("want one span exported, got %+v", got)
+	}
+	exported := got.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	if exported.TraceID != "4bf92f3577b34da6a3ce929d0e0e4736" || exported.ParentSpanID != "00f067aa0ba902b7" ||
+		len(exported.SpanID) != 16 || exported.Kind != 2 || exported.Status.Code != 1 {
+		t.Errorf("unexpected span exported: %+v", exported)
+	}
+	if len(exported.Attributes) != 2 || exported.Attributes[0].Key != "rpc.grpc.status_code" ||
+		exported.Attributes[0].Value["intValue"] != "0" || exported.Attributes[1].Value["stringValue"] != "grpc" {
+		t.Errorf("unexpected attributes exported: %+v", exported.Attributes)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// streamQueueWindow is how soon after a stream slot is freed on a saturated connection a new
+// stream must open to be counted as having been queued by the client.
+const streamQueueWindow = 50 * time.Millisecond
+
+type connectionKey struct{}
+
+type rpcServiceKey struct{}
+
+// trackedConnection is what a TransportMonitor knows about one gRPC connection.
+type trackedConnection struct {
+	remoteAddress string
+	openTime      time.Time
+	activeStreams int32
+	peakStreams   int32
+	totalStreams  int64
+	queuedStreams int64
+	lastQueued    time.Time
+
+	// slotFreed is when a stream last closed while the connection was at the stream limit.
+	slotFreed time.Time
+}
+
+// TransportMonitor is a grpc stats.Handler keeping track of the streams open on each gRPC
+// connection to the server.
+type TransportMonitor struct {
+	maxConcurrentStreams uint32
+
+	mu          sync.Mutex
+	connections map[string]*trackedConnection
+	services    map[string]*pb.RuntimeStats_Service
+	nowF        func() time.Time
+}
+
+// NewTransportMonitor creates a TransportMonitor for a server advertising maxConcurrentStreams,
+// where 0 means unlimited.
+func NewTransportMonitor(maxConcurrentStreams uint32) *TransportMonitor {
+	return &TransportMonitor{
+		maxConcurrentStreams: maxConcurrentStreams,
+		connections:          map[string]*trackedConnection{},
+		services:             map[string]*pb.RuntimeStats_Service{},
+		nowF:                 time.Now,
+	}
+}
+
+// TagConn implements stats.Handler.
+func (m *TransportMonitor) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	return context.WithValue(ctx, connectionKey{}, info.RemoteAddr.String())
+}
+
+// HandleConn implements stats.Handler.
+func (m *TransportMonitor) HandleConn(ctx context.Context, s stats.ConnStats) {
+	key, _ := ctx.Value(connectionKey{}).(string)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	switch s.(type) {
+	case *stats.ConnBegin:
+		m.connections[key] = &trackedConnection{remoteAddress: key, openTime: m.nowF()}
+	case *stats.ConnEnd:
+		delete(m.connections, key)
+	}
+}
+
+// TagRPC implements stats.Handler.
+func (m *TransportMonitor) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	service := strings.TrimPrefix(info.FullMethodName, "/")
+	if i := strings.LastIndex(service, "/"); i >= 0 {
+		service = service[:i]
+	}
+	return context.WithValue(ctx, rpcServiceKey{}, service)
+}
+
+// HandleRPC implements stats.Handler.
+func (m *TransportMonitor) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	key, _ := ctx.Value(connectionKey{}).(string)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if name, ok := ctx.Value(rpcServiceKey{}).(string); ok {
+		service, ok := m.services[name]
+		if !ok {
+			service = &pb.RuntimeStats_Service{}
+			m.services[name] = service
+		}
+		switch s.(type) {
+		case *stats.Begin:
+			service.ActiveStreams++
+			service.TotalStreams++
+		case *stats.End:
+			service.ActiveStreams--
+		}
+	}
+	conn, ok := m.connections[key]
+	if !ok {
+		return
+	}
+	now := m.nowF()
+	switch s.(type) {
+	case *stats.Begin:
+		if !conn.slotFreed.IsZero() && now.Sub(conn.slotFreed) <= streamQueueWindow &&
+			uint32(conn.activeStreams)+1 == m.maxConcurrentStreams {
+			conn.queuedStreams++
+			conn.lastQueued = now
+			conn.slotFreed = time.Time{}
+		}
+		conn.activeStreams++
+		conn.totalStreams++
+		if conn.activeStreams > conn.peakStreams {
+			conn.peakStreams = conn.activeStreams
+		}
+	case *stats.End:
+		if m.maxConcurrentStreams > 0 && uint32(conn.activeStreams) == m.maxConcurrentStreams {
+			conn.slotFreed = now
+		}
+		conn.activeStreams--
+	}
+}
+
+// Report returns the streams open on each connection. If host is not empty, only connections
+// from that host are reported.
+func (m *TransportMonitor) Report(host string) *pb.StreamQueueReport {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	report := &pb.StreamQueueReport{MaxConcurrentStreams: int32(m.maxConcurrentStreams)}
+	for _, conn := range m.connections {
+		if host != "" {
+			if connHost, _, err := net.SplitHostPort(conn.remoteAddress); err != nil || connHost != host {
+				continue
+			}
+		}
+		entry := &pb.StreamQueueReport_Connection{
+			RemoteAddress: conn.remoteAddress,
+			OpenTime:      timestamppb.New(conn.openTime),
+			ActiveStreams: conn.activeStreams,
+			PeakStreams:   conn.peakStreams,
+			TotalStreams:  conn.totalStreams,
+			QueuedStreams: conn.queuedStreams,
+		}
+		if !conn.lastQueued.IsZero() {
+			entry.LastQueuedTime = timestamppb.New(conn.lastQueued)
+		}
+		report.Connections = append(report.Connections, entry)
+	}
+	sort.Slice(report.Connections, func(i, j int) bool {
+		a, b := report.Connections[i], report.Connections[j]
+		if !a.GetOpenTime().AsTime().Equal(b.GetOpenTime().AsTime()) {
+			return a.GetOpenTime().AsTime().Before(b.GetOpenTime().AsTime())
+		}
+		return a.GetRemoteAddress() < b.GetRemoteAddress()
+	})
+	return report
+}
+
+// Services returns the streams opened on each service that was called, keyed by full service
+// name.
+func (m *TransportMonitor) Services() map[string]*pb.RuntimeStats_Service {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	services := map[string]*pb.RuntimeStats_Service{}
+	for name, service := range m.services {
+		services[name] = proto.Clone(service).(*pb.RuntimeStats_Service)
+	}
+	return services
+}
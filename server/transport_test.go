@@ -0,0 +1,88 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc"
+)
+
+// slowEcho echoes after a delay, so that concurrent calls overlap.
+type slowEcho struct {
+	*pb.UnimplementedEchoServer
+}
+
+func (slowEcho) Echo(_ context.Context, in *pb.EchoRequest) (*pb.EchoResponse, error) {
+	time.Sleep(100 * time.Millisecond)
+	return &pb.EchoResponse{Content: in.GetContent()}, nil
+}
+
+func TestTransportMonitor(t *testing.T) {
+	monitor := NewTransportMonitor(1)
+	s := grpc.NewServer(grpc.StatsHandler(monitor), grpc.MaxConcurrentStreams(1))
+	pb.RegisterEchoServer(s, slowEcho{&pb.UnimplementedEchoServer{}})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	defer s.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := pb.NewEchoClient(conn)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Echo(context.Background(), &pb.EchoRequest{Response: &pb.EchoRequest_Content{Content: "hi"}}); err != nil {
+				t.Errorf("Echo: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	report := monitor.Report("")
+	if report.GetMaxConcurrentStreams() != 1 || len(report.GetConnections()) != 1 {
+		t.Fatalf("unexpected report: %v", report)
+	}
+	c := report.GetConnections()[0]
+	if c.GetTotalStreams() != 3 || c.GetPeakStreams() != 1 || c.GetActiveStreams() != 0 {
+		t.Errorf("unexpected stream counts: %v", c)
+	}
+	if c.GetQueuedStreams() != 2 || c.GetLastQueuedTime() == nil {
+		t.Errorf("want 2 queued streams, got %v", c)
+	}
+
+	echo := monitor.Services()["google.showcase.v1beta1.Echo"]
+	if echo.GetTotalStreams() != 3 || echo.GetActiveStreams() != 0 {
+		t.Errorf("unexpected Echo stream counts: %v", echo)
+	}
+
+	if got := monitor.Report("192.0.2.1").GetConnections(); len(got) != 0 {
+		t.Errorf("report for another host: got %v", got)
+	}
+}
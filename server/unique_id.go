@@ -27,3 +27,14 @@ type UniqID struct {
 func (u *UniqID) Next() int64 {
 	return atomic.AddInt64(&u.i, 1) - 1
 }
+
+// Advance makes sure no id lower than next is given out anymore, so that the ids of restored
+// resources are not reused.
+func (u *UniqID) Advance(next int64) {
+	for {
+		current := atomic.LoadInt64(&u.i)
+		if current >= next || atomic.CompareAndSwapInt64(&u.i, current, next) {
+			return
+		}
+	}
+}
@@ -23,3 +23,12 @@ func TestUniqID_Next(t *testing.T) {
 		t.Errorf("Next: got %d, want %d", got, 2)
 	}
 }
+
+func TestUniqID_Advance(t *testing.T) {
+	u := &UniqID{}
+	u.Advance(5)
+	u.Advance(3)
+	if got := u.Next(); got != 5 {
+		t.Errorf("Next after Advance: got %d, want %d", got, 5)
+	}
+}
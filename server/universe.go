@@ -0,0 +1,135 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// UniverseDomainMismatchReason is the ErrorInfo reason of the calls not sent to an endpoint
+	// in the server's universe domain.
+	UniverseDomainMismatchReason = "UNIVERSE_DOMAIN_MISMATCH"
+
+	// UniverseCredentialsReason is the ErrorInfo reason of the calls whose credentials were not
+	// issued for the server's universe domain.
+	UniverseCredentialsReason = "UNIVERSE_DOMAIN_CREDENTIALS_MISMATCH"
+
+	// UniverseDomainHeader is the header clients may send the universe domain of their
+	// credentials in. When sent, it must match the server's universe domain.
+	UniverseDomainHeader = "x-goog-universe-domain"
+)
+
+// UniverseDomain simulates a server in a universe other than googleapis.com, as in a Trusted
+// Partner Cloud, only accepting the calls that clients configured for that universe would send.
+type UniverseDomain struct {
+	domain string
+}
+
+// NewUniverseDomain creates a UniverseDomain for domain, such as "example.com".
+func NewUniverseDomain(domain string) *UniverseDomain {
+	return &UniverseDomain{domain: strings.ToLower(strings.Trim(domain, "."))}
+}
+
+// Domain returns the universe domain.
+func (u *UniverseDomain) Domain() string {
+	return u.domain
+}
+
+// Check returns an error unless a call was sent to authority, a host in the universe domain
+// optionally followed by a port, with authorization, the values of its Authorization header,
+// holding bearer credentials, and the universe, the values of its UniverseDomainHeader, naming
+// the universe domain if set. The error is FailedPrecondition for calls sent to another
+// universe, and Unauthenticated for calls whose credentials are missing or are for another
+// universe; its ErrorInfo details name the reason.
+func (u *UniverseDomain) Check(authority string, authorization, universe []string) error {
+	host := authority
+	if h, _, err := net.SplitHostPort(authority); err == nil {
+		host = h
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host != u.domain && !strings.HasSuffix(host, "."+u.domain) {
+		return u.error(codes.FailedPrecondition, UniverseDomainMismatchReason,
+			"the call was sent to %q, an endpoint outside of the universe domain %q", authority, u.domain)
+	}
+	if len(authorization) != 1 || !strings.HasPrefix(strings.ToLower(authorization[0]), "bearer ") ||
+		strings.TrimSpace(authorization[0][len("bearer "):]) == "" {
+		return u.error(codes.Unauthenticated, UniverseCredentialsReason,
+			"the call does not carry bearer credentials for the universe domain %q", u.domain)
+	}
+	for _, value := range universe {
+		if !strings.EqualFold(strings.Trim(value, "."), u.domain) {
+			return u.error(codes.Unauthenticated, UniverseCredentialsReason,
+				"the call's credentials are for the universe domain %q, not %q", value, u.domain)
+		}
+	}
+	return nil
+}
+
+func (u *UniverseDomain) error(code codes.Code, reason, format string, args ...interface{}) error {
+	st, err := status.Newf(code, format, args...).WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   u.domain,
+		Metadata: map[string]string{"universeDomain": u.domain},
+	})
+	if err != nil {
+		return status.Errorf(code, format, args...)
+	}
+	return st.Err()
+}
+
+// check checks a gRPC call.
+func (u *UniverseDomain) check(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	authority := ""
+	if values := md.Get(":authority"); len(values) > 0 {
+		authority = values[0]
+	}
+	return u.Check(authority, md.Get("authorization"), md.Get(UniverseDomainHeader))
+}
+
+// UnaryInterceptor implements grpc.UnaryServerInterceptor, failing the calls not meant for the
+// universe domain.
+func (u *UniverseDomain) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := u.check(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor implements grpc.StreamServerInterceptor, failing the calls not meant for
+// the universe domain.
+func (u *UniverseDomain) StreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := u.check(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
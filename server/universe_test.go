@@ -0,0 +1,79 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUniverseDomain(t *testing.T) {
+	u := NewUniverseDomain("Example.com.")
+	if got := u.Domain(); got != "example.com" {
+		t.Errorf("Domain: got %q, want %q", got, "example.com")
+	}
+	bearer := []string{"Bearer token"}
+	for _, testCase := range []struct {
+		label         string
+		authority     string
+		authorization []string
+		universe      []string
+		wantCode      codes.Code
+		wantReason    string
+	}{
+		{label: "service endpoint", authority: "showcase.example.com:443", authorization: bearer},
+		{label: "universe apex", authority: "example.com", authorization: bearer},
+		{label: "matching credentials", authority: "showcase.example.com", authorization: bearer, universe: []string{"example.com"}},
+		{label: "default universe", authority: "showcase.googleapis.com:443", authorization: bearer, wantCode: codes.FailedPrecondition, wantReason: UniverseDomainMismatchReason},
+		{label: "lookalike domain", authority: "showcase.notexample.com", authorization: bearer, wantCode: codes.FailedPrecondition, wantReason: UniverseDomainMismatchReason},
+		{label: "no credentials", authority: "showcase.example.com", wantCode: codes.Unauthenticated, wantReason: UniverseCredentialsReason},
+		{label: "empty token", authority: "showcase.example.com", authorization: []string{"Bearer "}, wantCode: codes.Unauthenticated, wantReason: UniverseCredentialsReason},
+		{label: "other universe credentials", authority: "showcase.example.com", authorization: bearer, universe: []string{"googleapis.com"}, wantCode: codes.Unauthenticated, wantReason: UniverseCredentialsReason},
+	} {
+		err := u.Check(testCase.authority, testCase.authorization, testCase.universe)
+		st := status.Convert(err)
+		if st.Code() != testCase.wantCode {
+			t.Errorf("%s: want code %v, got %v", testCase.label, testCase.wantCode, err)
+			continue
+		}
+		if testCase.wantReason == "" {
+			continue
+		}
+		if details := st.Details(); len(details) != 1 || details[0].(*errdetails.ErrorInfo).GetReason() != testCase.wantReason {
+			t.Errorf("%s: want ErrorInfo reason %q, got %v", testCase.label, testCase.wantReason, details)
+		}
+	}
+}
+
+func TestUniverseDomain_interceptor(t *testing.T) {
+	u := NewUniverseDomain("example.com")
+	handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/google.showcase.v1beta1.Echo/Echo"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(":authority", "showcase.example.com", "authorization", "Bearer token"))
+	if _, err := u.UnaryInterceptor(ctx, nil, info, handler); err != nil {
+		t.Errorf("UnaryInterceptor: unexpected err %v", err)
+	}
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(":authority", "localhost:7469", "authorization", "Bearer token"))
+	if _, err := u.UnaryInterceptor(ctx, nil, info, handler); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UnaryInterceptor: want FailedPrecondition, got %v", err)
+	}
+}
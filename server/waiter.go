@@ -17,16 +17,22 @@ package server
 import (
 	"encoding/base64"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	pb "github.com/googleapis/gapic-showcase/server/genproto"
 	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var waiterSingleton Waiter = &waiterImpl{
-	nowF: time.Now,
+	nowF: GetClockInstance().Now,
 }
 
 // GetWaiterInstance returns the waiter singleton.
@@ -34,39 +40,210 @@ func GetWaiterInstance() Waiter {
 	return waiterSingleton
 }
 
-// Waiter handles the echo.Wait method for both the LRO service and the echo service.
+// Waiter handles the echo.Wait and echo.NestedWait methods for both the LRO service and the
+// echo service.
 type Waiter interface {
 	Wait(req *pb.WaitRequest) *lropb.Operation
+	NestedWait(req *pb.NestedWaitRequest) *lropb.Operation
+
+	// Poll returns the operation req names, as Wait does, counting the call as a poll of it.
+	Poll(req *pb.WaitRequest) *lropb.Operation
+
+	// Cancel makes the operation named name, unless it is done by then, complete with a
+	// CANCELLED error.
+	Cancel(name string)
+
+	// Delete forgets the operation named name, so that it is no longer found.
+	Delete(name string)
+
+	// Deleted returns whether the operation named name was deleted.
+	Deleted(name string) bool
+
+	// Operations returns the operations started and not deleted, as they are now, oldest first.
+	// Only the most recent maxStartedOperations operations started are kept.
+	Operations() []StartedOperation
+
+	// Reset forgets the operations started, cancelled, deleted and polled.
+	Reset()
+}
+
+// maxStartedOperations is the number of most recent operations a Waiter keeps to list.
+const maxStartedOperations = 1000
+
+// StartedOperation is an operation a Waiter started, along with the position it was started at,
+// counting from 1.
+type StartedOperation struct {
+	Position  int64
+	Operation *lropb.Operation
 }
 
 type waiterImpl struct {
 	nowF func() time.Time
+
+	mu        sync.Mutex
+	cancelled map[string]cancellation
+	deleted   map[string]bool
+	polls     map[string]int32
+	started   map[string]*startedOperation
+	order     []string
+	count     int64
 }
 
-func (w *waiterImpl) Wait(req *pb.WaitRequest) *lropb.Operation {
-	endTime := time.Unix(0, 0).UTC()
-	if ttl := req.GetTtl(); ttl != nil {
-		duration, _ := ptypes.Duration(ttl)
-		endTime = w.nowF().Add(duration)
+// startedOperation is an operation started, which refresh returns the current state of.
+type startedOperation struct {
+	position int64
+	refresh  func() *lropb.Operation
+}
+
+// cancellation records when an operation was cancelled: at what time, and after how many polls.
+type cancellation struct {
+	at    time.Time
+	polls int32
+}
+
+func (w *waiterImpl) Cancel(name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.cancelled == nil {
+		w.cancelled = map[string]cancellation{}
 	}
-	if end := req.GetEndTime(); end != nil {
-		endTime, _ = ptypes.Timestamp(end)
+	if _, ok := w.cancelled[name]; !ok {
+		w.cancelled[name] = cancellation{at: w.nowF(), polls: w.polls[name]}
+	}
+}
+
+func (w *waiterImpl) Delete(name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.deleted == nil {
+		w.deleted = map[string]bool{}
 	}
+	w.deleted[name] = true
+}
+
+func (w *waiterImpl) Deleted(name string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.deleted[name]
+}
+
+func (w *waiterImpl) Reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.cancelled = nil
+	w.deleted = nil
+	w.polls = nil
+	w.started = nil
+	w.order = nil
+	w.count = 0
+}
+
+func (w *waiterImpl) Operations() []StartedOperation {
+	w.mu.Lock()
+	started := []*startedOperation{}
+	for _, name := range w.order {
+		if !w.deleted[name] {
+			started = append(started, w.started[name])
+		}
+	}
+	w.mu.Unlock()
+
+	operations := make([]StartedOperation, len(started))
+	for i, op := range started {
+		operations[i] = StartedOperation{Position: op.position, Operation: op.refresh()}
+	}
+	return operations
+}
+
+// start records that the operation named name, whose current state refresh returns, started,
+// unless it is already known.
+func (w *waiterImpl) start(name string, refresh func() *lropb.Operation) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.started == nil {
+		w.started = map[string]*startedOperation{}
+	}
+	if _, ok := w.started[name]; ok {
+		return
+	}
+	w.count++
+	w.started[name] = &startedOperation{position: w.count, refresh: refresh}
+	w.order = append(w.order, name)
+	if len(w.order) > maxStartedOperations {
+		delete(w.started, w.order[0])
+		w.order = w.order[1:]
+	}
+}
+
+// cancelledPending returns whether the operation named name was cancelled while pending: before
+// end, the time it would otherwise complete, or, if pendingPolls is set, before it was polled
+// more than that many times.
+func (w *waiterImpl) cancelledPending(name string, end time.Time, pendingPolls *int32) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	c, ok := w.cancelled[name]
+	if !ok {
+		return false
+	}
+	if pendingPolls != nil {
+		return c.polls <= *pendingPolls
+	}
+	return !c.at.After(end)
+}
+
+// polled returns how many times the operation named name was polled, counting one more poll if
+// poll is true.
+func (w *waiterImpl) polled(name string, poll bool) int32 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if poll {
+		if w.polls == nil {
+			w.polls = map[string]int32{}
+		}
+		w.polls[name]++
+	}
+	return w.polls[name]
+}
+
+func (w *waiterImpl) Wait(req *pb.WaitRequest) *lropb.Operation {
+	return w.wait(req, false)
+}
+
+func (w *waiterImpl) Poll(req *pb.WaitRequest) *lropb.Operation {
+	return w.wait(req, true)
+}
+
+func (w *waiterImpl) wait(req *pb.WaitRequest, poll bool) *lropb.Operation {
+	endTime := w.endTime(req.GetTtl(), req.GetEndTime())
 	endTimeProto, _ := ptypes.TimestampProto(endTime)
 	req.End = &pb.WaitRequest_EndTime{
 		EndTime: endTimeProto,
 	}
+	if req.GetProgressInterval() != nil && req.GetStartTime() == nil {
+		req.StartTime = timestamppb.New(w.nowF())
+	}
 
 	done := w.nowF().After(endTime)
 	reqBytes, _ := proto.Marshal(req)
 	name := fmt.Sprintf(
 		"operations/google.showcase.v1beta1.Echo/Wait/%s",
 		base64.StdEncoding.EncodeToString(reqBytes))
+	started := proto.Clone(req).(*pb.WaitRequest)
+	w.start(name, func() *lropb.Operation { return w.Wait(proto.Clone(started).(*pb.WaitRequest)) })
+	if req.PendingPolls != nil {
+		done = w.polled(name, poll) > req.GetPendingPolls()
+	}
 	answer := &lropb.Operation{
 		Name: name,
 		Done: done,
 	}
 
+	if w.cancelledPending(name, endTime, req.PendingPolls) {
+		answer.Done = true
+		answer.Result = &lropb.Operation_Error{Error: status.New(codes.Canceled, "The operation was cancelled.").Proto()}
+		return answer
+	}
+
 	if done && (req.GetError() != nil) {
 		answer.Result = &lropb.Operation_Error{Error: req.GetError()}
 	}
@@ -77,9 +254,122 @@ func (w *waiterImpl) Wait(req *pb.WaitRequest) *lropb.Operation {
 	}
 
 	if !done {
+		answer.Metadata = waitMetadata(req, w.nowF())
+	}
+
+	return answer
+}
+
+// waitMetadata returns the metadata, at now, of the pending Wait operation req names, as its
+// metadata variant has it.
+func waitMetadata(req *pb.WaitRequest, now time.Time) *anypb.Any {
+	endTime := req.GetEndTime()
+	meta, _ := ptypes.MarshalAny(waitProgress(req, now))
+	switch req.GetMetadataVariant() {
+	case pb.WaitRequest_METADATA_MISSING:
+		return nil
+	case pb.WaitRequest_METADATA_WRONG_TYPE:
+		meta, _ = ptypes.MarshalAny(&pb.WaitResponse{Content: endTime.AsTime().Format(time.RFC3339Nano)})
+	case pb.WaitRequest_METADATA_UNKNOWN_TYPE:
+		meta.TypeUrl = "type.googleapis.com/google.showcase.v1beta1.UnknownWaitMetadata"
+	case pb.WaitRequest_METADATA_MALFORMED:
+		// A varint whose continuation bit promises a byte that never comes.
+		meta.Value = []byte{0xff}
+	}
+	return meta
+}
+
+func (w *waiterImpl) NestedWait(req *pb.NestedWaitRequest) *lropb.Operation {
+	endTime := w.endTime(req.GetTtl(), req.GetEndTime())
+	endTimeProto, _ := ptypes.TimestampProto(endTime)
+	req.EndTime = endTimeProto
+	req.Ttl = nil
+
+	// The ttl of the second operation runs from the end of the first, so that the second
+	// operation keeps its name however often the first is polled.
+	next := &pb.WaitRequest{}
+	if req.GetNext() != nil {
+		next = proto.Clone(req.GetNext()).(*pb.WaitRequest)
+	}
+	if next.GetEndTime() == nil {
+		duration, _ := ptypes.Duration(next.GetTtl())
+		nextEndProto, _ := ptypes.TimestampProto(endTime.Add(duration))
+		next.End = &pb.WaitRequest_EndTime{
+			EndTime: nextEndProto,
+		}
+	}
+	req.Next = next
+
+	done := w.nowF().After(endTime)
+	reqBytes, _ := proto.Marshal(req)
+	name := fmt.Sprintf(
+		"operations/google.showcase.v1beta1.Echo/NestedWait/%s",
+		base64.StdEncoding.EncodeToString(reqBytes))
+	started := proto.Clone(req).(*pb.NestedWaitRequest)
+	w.start(name, func() *lropb.Operation { return w.NestedWait(proto.Clone(started).(*pb.NestedWaitRequest)) })
+	answer := &lropb.Operation{
+		Name: name,
+		Done: done,
+	}
+
+	if w.cancelledPending(name, endTime, nil) {
+		answer.Done = true
+		answer.Result = &lropb.Operation_Error{Error: status.New(codes.Canceled, "The operation was cancelled.").Proto()}
+		return answer
+	}
+
+	if done {
+		// The second operation starts as the first ends, so that its name is the same
+		// however late the first is polled.
+		nextReq := proto.Clone(next).(*pb.WaitRequest)
+		if nextReq.GetProgressInterval() != nil && nextReq.GetStartTime() == nil {
+			nextReq.StartTime = endTimeProto
+		}
+		nextOp := w.Wait(nextReq)
+		resp, _ := ptypes.MarshalAny(&pb.NestedWaitResponse{Operation: nextOp})
+		answer.Result = &lropb.Operation_Response{Response: resp}
+	} else {
 		meta, _ := ptypes.MarshalAny(&pb.WaitMetadata{EndTime: endTimeProto})
 		answer.Metadata = meta
 	}
 
 	return answer
 }
+
+// waitProgress returns the WaitMetadata, at now, of the pending Wait operation req names,
+// reporting its progress as of the last update if req has a progress interval.
+func waitProgress(req *pb.WaitRequest, now time.Time) *pb.WaitMetadata {
+	meta := &pb.WaitMetadata{EndTime: req.GetEndTime()}
+	interval := req.GetProgressInterval().AsDuration()
+	if req.GetProgressInterval() == nil || interval <= 0 || req.GetStartTime() == nil {
+		return meta
+	}
+	start, end := req.GetStartTime().AsTime(), req.GetEndTime().AsTime()
+	elapsed := now.Sub(start).Truncate(interval)
+	total := end.Sub(start)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	if elapsed > total {
+		elapsed = total
+	}
+	if total > 0 {
+		meta.ProgressPercent = int32(elapsed * 100 / total)
+	}
+	meta.TimeRemaining = durationpb.New(total - elapsed)
+	meta.UpdateTime = timestamppb.New(start.Add(elapsed))
+	return meta
+}
+
+// endTime returns the time an operation lasting ttl from now, or ending at end if set, completes.
+func (w *waiterImpl) endTime(ttl *durationpb.Duration, end *timestamppb.Timestamp) time.Time {
+	endTime := time.Unix(0, 0).UTC()
+	if ttl != nil {
+		duration, _ := ptypes.Duration(ttl)
+		endTime = w.nowF().Add(duration)
+	}
+	if end != nil {
+		endTime, _ = ptypes.Timestamp(end)
+	}
+	return endTime
+}
@@ -146,6 +146,126 @@ func TestWait_error(t *testing.T) {
 	}
 }
 
+func TestWait_metadataVariant(t *testing.T) {
+	nowF := func() time.Time { return time.Unix(1, 0) }
+	endTime := timestampProto(time.Unix(2, 0))
+
+	tests := []struct {
+		variant pb.WaitRequest_MetadataVariant
+		typeURL string
+		decodes bool
+	}{
+		{pb.WaitRequest_METADATA_VARIANT_UNSPECIFIED, "type.googleapis.com/google.showcase.v1beta1.WaitMetadata", true},
+		{pb.WaitRequest_METADATA_MISSING, "", false},
+		{pb.WaitRequest_METADATA_WRONG_TYPE, "type.googleapis.com/google.showcase.v1beta1.WaitResponse", false},
+		{pb.WaitRequest_METADATA_UNKNOWN_TYPE, "type.googleapis.com/google.showcase.v1beta1.UnknownWaitMetadata", false},
+		{pb.WaitRequest_METADATA_MALFORMED, "type.googleapis.com/google.showcase.v1beta1.WaitMetadata", false},
+	}
+	for _, test := range tests {
+		req := &pb.WaitRequest{
+			End:             &pb.WaitRequest_EndTime{EndTime: endTime},
+			MetadataVariant: test.variant,
+		}
+		waiter := &waiterImpl{nowF: nowF}
+		op := waiter.Wait(req)
+
+		if op.Done {
+			t.Errorf("Wait() for %s expected done=false got done=true", test.variant)
+		}
+		if got := op.GetMetadata().GetTypeUrl(); got != test.typeURL {
+			t.Errorf("Wait() for %s expected metadata type %q, got %q", test.variant, test.typeURL, got)
+		}
+		meta := &pb.WaitMetadata{}
+		if decodes := op.GetMetadata() != nil && ptypes.UnmarshalAny(op.GetMetadata(), meta) == nil; decodes != test.decodes {
+			t.Errorf("Wait() for %s expected the metadata to unpack as WaitMetadata=%v, got %v", test.variant, test.decodes, decodes)
+		}
+	}
+}
+
+func TestNestedWait_pending(t *testing.T) {
+	nowF := func() time.Time { return time.Unix(1, 0) }
+	req := &pb.NestedWaitRequest{
+		Ttl:  ptypes.DurationProto(time.Second),
+		Next: &pb.WaitRequest{End: &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(2 * time.Second)}},
+	}
+
+	waiter := &waiterImpl{nowF: nowF}
+	op := waiter.NestedWait(req)
+
+	if op.Done {
+		t.Errorf("NestedWait() for %q expected done=false got done=true", req)
+	}
+	want := &pb.NestedWaitRequest{
+		EndTime: timestampProto(time.Unix(2, 0)),
+		Next:    &pb.WaitRequest{End: &pb.WaitRequest_EndTime{EndTime: timestampProto(time.Unix(4, 0))}},
+	}
+	checkNestedName(t, want, op)
+
+	meta := &pb.WaitMetadata{}
+	ptypes.UnmarshalAny(op.GetMetadata(), meta)
+	if !proto.Equal(want.GetEndTime(), meta.GetEndTime()) {
+		t.Errorf("NestedWait() expected metadata with EndTime=%q, got %q", want.GetEndTime(), meta.GetEndTime())
+	}
+
+	// Polling the first operation by name, later, must not move the second one.
+	later := &waiterImpl{nowF: func() time.Time { return time.Unix(1, 500) }}
+	if again := later.NestedWait(want); again.Name != op.Name {
+		t.Errorf("NestedWait() renamed the operation from %q to %q", op.Name, again.Name)
+	}
+}
+
+func TestNestedWait_done(t *testing.T) {
+	success := &pb.WaitResponse{Content: "Hello World!"}
+	req := &pb.NestedWaitRequest{
+		EndTime: timestampProto(time.Unix(2, 0)),
+		Next: &pb.WaitRequest{
+			End:      &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(2 * time.Second)},
+			Response: &pb.WaitRequest_Success{Success: success},
+		},
+	}
+
+	for _, test := range []struct {
+		now      time.Time
+		nextDone bool
+	}{
+		{now: time.Unix(3, 0), nextDone: false},
+		{now: time.Unix(5, 0), nextDone: true},
+	} {
+		waiter := &waiterImpl{nowF: func() time.Time { return test.now }}
+		op := waiter.NestedWait(proto.Clone(req).(*pb.NestedWaitRequest))
+
+		if !op.Done {
+			t.Fatalf("NestedWait() at %v expected done=true got done=false", test.now)
+		}
+		if op.Metadata != nil {
+			t.Errorf("NestedWait() expected nil metadata, got %q", op.Metadata)
+		}
+		resp := &pb.NestedWaitResponse{}
+		ptypes.UnmarshalAny(op.GetResponse(), resp)
+		next := resp.GetOperation()
+		if !strings.HasPrefix(next.GetName(), "operations/google.showcase.v1beta1.Echo/Wait/") {
+			t.Errorf("NestedWait() expected the response to name a Wait operation, got %q", next.GetName())
+		}
+		if next.GetDone() != test.nextDone {
+			t.Errorf("NestedWait() at %v expected the second operation done=%v, got %v", test.now, test.nextDone, next.GetDone())
+		}
+		if test.nextDone {
+			got := &pb.WaitResponse{}
+			ptypes.UnmarshalAny(next.GetResponse(), got)
+			if !proto.Equal(got, success) {
+				t.Errorf("NestedWait() expected the second operation to succeed with %q, got %q", success, got)
+			}
+		}
+		want := waiter.Wait(&pb.WaitRequest{
+			End:      &pb.WaitRequest_EndTime{EndTime: timestampProto(time.Unix(4, 0))},
+			Response: &pb.WaitRequest_Success{Success: success},
+		})
+		if next.GetName() != want.GetName() {
+			t.Errorf("NestedWait() expected the second operation %q, got %q", want.GetName(), next.GetName())
+		}
+	}
+}
+
 func timestampProto(t time.Time) *timestamp.Timestamp {
 	ts, _ := ptypes.TimestampProto(t)
 	return ts
@@ -171,3 +291,199 @@ func checkName(t *testing.T, req *pb.WaitRequest, op *lropb.Operation) {
 			nameProto)
 	}
 }
+
+func checkNestedName(t *testing.T, want *pb.NestedWaitRequest, op *lropb.Operation) {
+	prefix := "operations/google.showcase.v1beta1.Echo/NestedWait/"
+	if !strings.HasPrefix(op.Name, prefix) {
+		t.Errorf("NestedWait() expected op.Name prefix %q, got: %s", prefix, op.Name)
+	}
+	nameProto := &pb.NestedWaitRequest{}
+	bytes, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(op.Name, prefix))
+	proto.Unmarshal(bytes, nameProto)
+	if !proto.Equal(nameProto, want) {
+		t.Errorf("NestedWait() expected unmarshalled name=%q, got name=%q", want, nameProto)
+	}
+}
+
+func TestWait_progress(t *testing.T) {
+	now := time.Unix(100, 0)
+	waiter := &waiterImpl{nowF: func() time.Time { return now }}
+	op := waiter.Wait(&pb.WaitRequest{
+		End:              &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(10 * time.Second)},
+		ProgressInterval: ptypes.DurationProto(2 * time.Second),
+	})
+	name := op.GetName()
+
+	for _, test := range []struct {
+		now           time.Time
+		wantPercent   int32
+		wantRemaining time.Duration
+		wantUpdate    time.Time
+	}{
+		{now: time.Unix(100, 0), wantPercent: 0, wantRemaining: 10 * time.Second, wantUpdate: time.Unix(100, 0)},
+		{now: time.Unix(101, 0), wantPercent: 0, wantRemaining: 10 * time.Second, wantUpdate: time.Unix(100, 0)},
+		{now: time.Unix(103, 0), wantPercent: 20, wantRemaining: 8 * time.Second, wantUpdate: time.Unix(102, 0)},
+		{now: time.Unix(109, 500), wantPercent: 80, wantRemaining: 2 * time.Second, wantUpdate: time.Unix(108, 0)},
+	} {
+		now = test.now
+		// Polls decode the request from the operation's name, as GetOperation does.
+		req := &pb.WaitRequest{}
+		data, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(name, "operations/google.showcase.v1beta1.Echo/Wait/"))
+		proto.Unmarshal(data, req)
+		op := waiter.Wait(req)
+		if op.GetName() != name || op.GetDone() {
+			t.Errorf("Wait() at %v: want %q pending, got %q done=%v", test.now, name, op.GetName(), op.GetDone())
+		}
+		meta := &pb.WaitMetadata{}
+		ptypes.UnmarshalAny(op.GetMetadata(), meta)
+		if meta.GetProgressPercent() != test.wantPercent ||
+			meta.GetTimeRemaining().AsDuration() != test.wantRemaining ||
+			!meta.GetUpdateTime().AsTime().Equal(test.wantUpdate) {
+			t.Errorf("Wait() at %v: want %d%% with %v remaining as of %v, got %v", test.now, test.wantPercent, test.wantRemaining, test.wantUpdate, meta)
+		}
+	}
+
+	// Operations not asking for progress do not report it.
+	op = waiter.Wait(&pb.WaitRequest{End: &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(10 * time.Second)}})
+	meta := &pb.WaitMetadata{}
+	ptypes.UnmarshalAny(op.GetMetadata(), meta)
+	if meta.GetTimeRemaining() != nil || meta.GetUpdateTime() != nil {
+		t.Errorf("Wait() without a progress interval: want no progress, got %v", meta)
+	}
+}
+
+func TestWait_cancel(t *testing.T) {
+	now := time.Unix(1, 0)
+	waiter := &waiterImpl{nowF: func() time.Time { return now }}
+	success := &pb.WaitResponse{Content: "Hello World!"}
+	pending := waiter.Wait(&pb.WaitRequest{
+		End:      &pb.WaitRequest_EndTime{EndTime: timestampProto(time.Unix(2, 0))},
+		Response: &pb.WaitRequest_Success{Success: success},
+	})
+	done := waiter.Wait(&pb.WaitRequest{
+		End:      &pb.WaitRequest_EndTime{EndTime: timestampProto(time.Unix(0, 0))},
+		Response: &pb.WaitRequest_Success{Success: success},
+	})
+	nested := waiter.NestedWait(&pb.NestedWaitRequest{EndTime: timestampProto(time.Unix(2, 0))})
+	for _, op := range []*lropb.Operation{pending, done, nested} {
+		waiter.Cancel(op.GetName())
+	}
+
+	// The operations pending when cancelled stay cancelled once their end time passes.
+	now = time.Unix(3, 0)
+	for _, op := range []*lropb.Operation{pending, nested} {
+		again := pollWait(waiter, op.GetName())
+		if !again.GetDone() || again.GetError().GetCode() != 1 {
+			t.Errorf("polling the cancelled operation %q: want done with a CANCELLED error, got %v", op.GetName(), again)
+		}
+	}
+	if again := pollWait(waiter, done.GetName()); again.GetError() != nil || again.GetResponse() == nil {
+		t.Errorf("cancelling the done operation %q changed its result to %v", done.GetName(), again)
+	}
+
+	waiter.Delete(pending.GetName())
+	if !waiter.Deleted(pending.GetName()) || waiter.Deleted(done.GetName()) {
+		t.Errorf("Deleted() should only report the operation deleted")
+	}
+	waiter.Reset()
+	if waiter.Deleted(pending.GetName()) {
+		t.Errorf("Reset() should forget the operations deleted")
+	}
+	if again := pollWait(waiter, pending.GetName()); again.GetError() != nil {
+		t.Errorf("Reset() should forget the operations cancelled, got %v", again)
+	}
+}
+
+// pollWait polls the Wait or NestedWait operation named name.
+func pollWait(w *waiterImpl, name string) *lropb.Operation {
+	for prefix, nested := range map[string]bool{
+		"operations/google.showcase.v1beta1.Echo/Wait/":       false,
+		"operations/google.showcase.v1beta1.Echo/NestedWait/": true,
+	} {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		b, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(name, prefix))
+		if nested {
+			req := &pb.NestedWaitRequest{}
+			proto.Unmarshal(b, req)
+			return w.NestedWait(req)
+		}
+		req := &pb.WaitRequest{}
+		proto.Unmarshal(b, req)
+		return w.Wait(req)
+	}
+	return nil
+}
+
+func TestWait_pendingPolls(t *testing.T) {
+	waiter := &waiterImpl{nowF: func() time.Time { return time.Unix(1, 0) }}
+	success := &pb.WaitResponse{Content: "Hello World!"}
+	req := &pb.WaitRequest{
+		End:          &pb.WaitRequest_EndTime{EndTime: timestampProto(time.Unix(0, 0))},
+		Response:     &pb.WaitRequest_Success{Success: success},
+		PendingPolls: proto.Int32(2),
+	}
+	op := waiter.Wait(req)
+	if op.GetDone() {
+		t.Fatalf("Wait() with pending polls should not be done whatever its end time, got %v", op)
+	}
+	cancelled := waiter.Wait(&pb.WaitRequest{PendingPolls: proto.Int32(1)})
+
+	for poll, wantDone := range []bool{false, false, true, true} {
+		again := waiter.Poll(proto.Clone(req).(*pb.WaitRequest))
+		if again.GetName() != op.GetName() {
+			t.Errorf("poll %d renamed the operation from %q to %q", poll+1, op.GetName(), again.GetName())
+		}
+		if again.GetDone() != wantDone {
+			t.Errorf("poll %d: want done=%v, got %v", poll+1, wantDone, again)
+		}
+		if wantDone && again.GetResponse() == nil {
+			t.Errorf("poll %d: want the operation to succeed, got %v", poll+1, again)
+		}
+	}
+	// Cancelling an operation done after its polls has no effect; cancelling one still pending
+	// completes it with a CANCELLED error at its next poll.
+	waiter.Cancel(op.GetName())
+	if again := pollWait(waiter, op.GetName()); again.GetResponse() == nil {
+		t.Errorf("cancelling the done operation %q changed its result to %v", op.GetName(), again)
+	}
+	waiter.Cancel(cancelled.GetName())
+	if again := waiter.Poll(&pb.WaitRequest{End: &pb.WaitRequest_EndTime{EndTime: timestampProto(time.Unix(0, 0))}, PendingPolls: proto.Int32(1)}); again.GetError().GetCode() != 1 {
+		t.Errorf("polling the cancelled operation: want a CANCELLED error, got %v", again)
+	}
+}
+
+func TestWaiter_operations(t *testing.T) {
+	now := time.Unix(1, 0)
+	waiter := &waiterImpl{nowF: func() time.Time { return now }}
+	first := waiter.Wait(&pb.WaitRequest{End: &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(time.Second)}})
+	deleted := waiter.Wait(&pb.WaitRequest{End: &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(time.Hour)}})
+	nested := waiter.NestedWait(&pb.NestedWaitRequest{Ttl: ptypes.DurationProto(time.Minute)})
+	// Polling an operation started already keeps its position.
+	pollWait(waiter, first.GetName())
+	waiter.Delete(deleted.GetName())
+
+	now = now.Add(2 * time.Second)
+	ops := waiter.Operations()
+	if len(ops) != 2 || ops[0].Operation.GetName() != first.GetName() || ops[1].Operation.GetName() != nested.GetName() {
+		t.Fatalf("Operations(): want %q and %q, got %v", first.GetName(), nested.GetName(), ops)
+	}
+	if ops[0].Position >= ops[1].Position {
+		t.Errorf("Operations(): want increasing positions, got %d and %d", ops[0].Position, ops[1].Position)
+	}
+	if !ops[0].Operation.GetDone() || ops[1].Operation.GetDone() {
+		t.Errorf("Operations(): want the current state of the operations, got %v", ops)
+	}
+
+	for i := 0; i < maxStartedOperations; i++ {
+		waiter.Wait(&pb.WaitRequest{End: &pb.WaitRequest_Ttl{Ttl: ptypes.DurationProto(time.Duration(i+1) * time.Millisecond)}})
+	}
+	if ops := waiter.Operations(); len(ops) != maxStartedOperations || ops[0].Operation.GetName() == first.GetName() {
+		t.Errorf("Operations(): want the %d most recent operations, got %d starting with %q", maxStartedOperations, len(ops), ops[0].Operation.GetName())
+	}
+	waiter.Reset()
+	if ops := waiter.Operations(); len(ops) != 0 {
+		t.Errorf("Operations() after Reset(): want none, got %d", len(ops))
+	}
+}
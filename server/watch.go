@@ -0,0 +1,236 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxFeedChanges is the number of most recent changes a ChangeFeed keeps for watches to
+	// resume from.
+	maxFeedChanges = 1000
+
+	// watchBuffer is the number of changes a watch may fall behind by before it is
+	// aborted.
+	watchBuffer = 100
+)
+
+// ChangeAction is the kind of change made to a resource. Its values are those of the Action
+// enums of the watch responses.
+type ChangeAction int
+
+const (
+	// ChangeCreated is the change of a resource being created.
+	ChangeCreated ChangeAction = iota + 1
+
+	// ChangeUpdated is the change of a resource being updated.
+	ChangeUpdated
+
+	// ChangeDeleted is the change of a resource being deleted.
+	ChangeDeleted
+)
+
+// Change is a change made to a resource, as sent to watches.
+type Change struct {
+	// Name is the resource name of the resource changed.
+	Name string
+
+	// Action is the kind of change made.
+	Action ChangeAction
+
+	// Resource is the resource as of the change. For deletions, it is the resource as it
+	// was last.
+	Resource proto.Message
+
+	// ResumeToken resumes a watch from after this change.
+	ResumeToken string
+
+	seq int64
+}
+
+// ChangeFeed publishes the changes made to a collection of resources to the watches open on
+// it. Each change carries a resume token, so that watches which were interrupted can be
+// resumed without missing any change.
+type ChangeFeed struct {
+	mu      sync.Mutex
+	id      string
+	seq     int64
+	changes []*Change
+	watches map[*feedWatch]bool
+}
+
+// feedWatch is a watch open on a ChangeFeed.
+type feedWatch struct {
+	match   func(*Change) bool
+	changes chan *Change
+}
+
+// NewChangeFeed creates a ChangeFeed. The resume tokens of its changes are not valid for any
+// other feed.
+func NewChangeFeed() *ChangeFeed {
+	raw := make([]byte, 8)
+	rand.Read(raw)
+	return &ChangeFeed{id: hex.EncodeToString(raw), watches: map[*feedWatch]bool{}}
+}
+
+// Publish sends the change of action to resource, named name, to the watches matching it.
+// Callers publish changes in the order they are made.
+func (f *ChangeFeed) Publish(name string, action ChangeAction, resource proto.Message) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.seq++
+	change := &Change{
+		Name:        name,
+		Action:      action,
+		Resource:    proto.Clone(resource),
+		ResumeToken: f.token(f.seq),
+		seq:         f.seq,
+	}
+	f.changes = append(f.changes, change)
+	if len(f.changes) > maxFeedChanges {
+		f.changes = f.changes[len(f.changes)-maxFeedChanges:]
+	}
+	for watch := range f.watches {
+		if !watch.match(change) {
+			continue
+		}
+		select {
+		case watch.changes <- change:
+		default:
+			close(watch.changes)
+			delete(f.watches, watch)
+		}
+	}
+}
+
+// Head returns the resume token of the last change published, resuming watches from the
+// changes made from now on.
+func (f *ChangeFeed) Head() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.token(f.seq)
+}
+
+// Watch sends the changes matching match, published from now on or, if resumeToken is not
+// empty, from after the change it was sent with, until ctx is done, expire, unless zero, is
+// reached, or send fails. Watch returns nil if send returns io.EOF, or once expired.
+//
+// Resume tokens that are malformed or from another feed are InvalidArgument, and those of
+// changes no longer kept are OutOfRange. Watches falling too far behind are Aborted, and may
+// be resumed from the last change they were sent.
+func (f *ChangeFeed) Watch(ctx context.Context, resumeToken string, expire time.Time, match func(*Change) bool, send func(*Change) error) error {
+	watch := &feedWatch{match: match, changes: make(chan *Change, watchBuffer)}
+	f.mu.Lock()
+	backlog, err := f.since(resumeToken)
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	f.watches[watch] = true
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		delete(f.watches, watch)
+	}()
+
+	var expired <-chan time.Time
+	if !expire.IsZero() {
+		timer := time.NewTimer(time.Until(expire))
+		defer timer.Stop()
+		expired = timer.C
+	}
+	for _, change := range backlog {
+		if !match(change) {
+			continue
+		}
+		if done, err := deliver(send, change); done {
+			return err
+		}
+	}
+	for {
+		select {
+		case change, ok := <-watch.changes:
+			if !ok {
+				return status.Errorf(codes.Aborted, "the watch fell more than %d changes behind: resume it from the last resume token received", watchBuffer)
+			}
+			if done, err := deliver(send, change); done {
+				return err
+			}
+		case <-expired:
+			return nil
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// deliver sends change, returning whether the watch is done, and the error it ends with.
+func deliver(send func(*Change) error, change *Change) (bool, error) {
+	err := send(change)
+	if err == io.EOF {
+		return true, nil
+	}
+	return err != nil, err
+}
+
+// since returns the changes published after the one resumeToken was sent with. f.mu must be
+// held.
+func (f *ChangeFeed) since(resumeToken string) ([]*Change, error) {
+	if resumeToken == "" {
+		return nil, nil
+	}
+	seq, ok := f.parseToken(resumeToken)
+	if !ok || seq > f.seq {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resume token %q", resumeToken)
+	}
+	if len(f.changes) > 0 && f.changes[0].seq > seq+1 {
+		return nil, status.Errorf(codes.OutOfRange, "the resume token %q has expired: start a new watch", resumeToken)
+	}
+	backlog := []*Change{}
+	for _, change := range f.changes {
+		if change.seq > seq {
+			backlog = append(backlog, change)
+		}
+	}
+	return backlog, nil
+}
+
+func (f *ChangeFeed) token(seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%d", f.id, seq)))
+}
+
+func (f *ChangeFeed) parseToken(token string) (int64, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !strings.HasPrefix(string(raw), f.id+":") {
+		return 0, false
+	}
+	seq, err := strconv.ParseInt(strings.TrimPrefix(string(raw), f.id+":"), 10, 64)
+	return seq, err == nil && seq >= 0
+}
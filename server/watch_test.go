@@ -0,0 +1,132 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	pb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// waitForWatches waits for n watches to be open on feed.
+func waitForWatches(feed *ChangeFeed, n int) {
+	for {
+		feed.mu.Lock()
+		open := len(feed.watches)
+		feed.mu.Unlock()
+		if open == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestChangeFeed_resume(t *testing.T) {
+	feed := NewChangeFeed()
+	all := func(*Change) bool { return true }
+	feed.Publish("rooms/0", ChangeCreated, &pb.Room{Name: "rooms/0"})
+	token := feed.Head()
+	feed.Publish("rooms/0", ChangeUpdated, &pb.Room{Name: "rooms/0", DisplayName: "Living room"})
+	feed.Publish("rooms/1", ChangeCreated, &pb.Room{Name: "rooms/1"})
+	feed.Publish("rooms/0", ChangeDeleted, &pb.Room{Name: "rooms/0", DisplayName: "Living room"})
+
+	got := []ChangeAction{}
+	ofRoom := func(change *Change) bool { return change.Name == "rooms/0" }
+	err := feed.Watch(context.Background(), token, time.Time{}, ofRoom, func(change *Change) error {
+		got = append(got, change.Action)
+		if change.Action == ChangeDeleted {
+			return io.EOF
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != ChangeUpdated || got[1] != ChangeDeleted {
+		t.Errorf("want the update and deletion of rooms/0 after the token, got %v", got)
+	}
+
+	for _, token := range []string{"not-a-token", NewChangeFeed().Head(), feed.token(feed.seq + 1)} {
+		err := feed.Watch(context.Background(), token, time.Time{}, all, func(*Change) error { return nil })
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("token %q: want InvalidArgument, got %v", token, err)
+		}
+	}
+
+	for i := 0; i < maxFeedChanges; i++ {
+		feed.Publish("rooms/2", ChangeUpdated, &pb.Room{Name: "rooms/2"})
+	}
+	err = feed.Watch(context.Background(), token, time.Time{}, all, func(*Change) error { return nil })
+	if status.Code(err) != codes.OutOfRange {
+		t.Errorf("want OutOfRange resuming from a change no longer kept, got %v", err)
+	}
+}
+
+func TestChangeFeed_live(t *testing.T) {
+	feed := NewChangeFeed()
+	changes := make(chan *Change, 10)
+	done := make(chan error)
+	go func() {
+		done <- feed.Watch(context.Background(), "", time.Now().Add(time.Hour), func(*Change) bool { return true }, func(change *Change) error {
+			changes <- change
+			return nil
+		})
+	}()
+	waitForWatches(feed, 1)
+
+	room := &pb.Room{Name: "rooms/0", DisplayName: "Living room"}
+	feed.Publish("rooms/0", ChangeCreated, room)
+	room.DisplayName = "Kitchen"
+	change := <-changes
+	if got := change.Resource.(*pb.Room).GetDisplayName(); got != "Living room" || change.Action != ChangeCreated {
+		t.Errorf("want the creation of the room as published, got %v %v", change.Action, change.Resource)
+	}
+	if change.ResumeToken != feed.Head() {
+		t.Errorf("want the last change to carry the head token %q, got %q", feed.Head(), change.ResumeToken)
+	}
+
+	// The watch can take no more than cap(changes) + 1 changes off its buffer before blocking.
+	for i := 0; i < watchBuffer+cap(changes)+2; i++ {
+		feed.Publish("rooms/0", ChangeUpdated, room)
+	}
+	// Drain the changes for the watch to notice it fell behind.
+	go func() {
+		for range changes {
+		}
+	}()
+	if err := <-done; status.Code(err) != codes.Aborted {
+		t.Errorf("want Aborted for a watch falling behind, got %v", err)
+	}
+}
+
+func TestChangeFeed_expire(t *testing.T) {
+	feed := NewChangeFeed()
+	err := feed.Watch(context.Background(), "", time.Now().Add(10*time.Millisecond), func(*Change) bool { return true }, func(*Change) error { return nil })
+	if err != nil {
+		t.Errorf("want an expired watch to end cleanly, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = feed.Watch(ctx, "", time.Time{}, func(*Change) bool { return true }, func(*Change) error { return nil })
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("want Canceled for a cancelled watch, got %v", err)
+	}
+}
@@ -59,10 +59,10 @@ func CompileProtos(version string) {
 		"--proto_path=schema",
 		"--go_cli_out=" + filepath.Join("cmd", "gapic-showcase"),
 		"--go_cli_opt=root=gapic-showcase",
-		"--go_cli_opt=gapic=github.com/googleapis/gapic-showcase/client",
+		"--go_cli_opt=gapic=github.com/googleapis/gapic-showcase/client/apiv1beta1",
 		"--go_cli_opt=fmt=false",
 		"--go_gapic_out=" + outDir,
-		"--go_gapic_opt=go-gapic-package=github.com/googleapis/gapic-showcase/client;client",
+		"--go_gapic_opt=go-gapic-package=github.com/googleapis/gapic-showcase/client/apiv1beta1;showcase",
 		"--go_gapic_opt=grpc-service-config=schema/google/showcase/v1beta1/showcase_grpc_service_config.json",
 		"--go_gapic_opt=api-service-config=schema/google/showcase/v1beta1/showcase_v1beta1.yaml",
 		"--go_gapic_opt=metadata",
@@ -96,6 +96,39 @@ func CompileProtos(version string) {
 			"cmd/gapic-showcase/wait.go",
 			"s/EndEnd_time/EndEndTime/g",
 		},
+		// Commands for methods whose request has no fields do not use these imports.
+		{
+			"cmd/gapic-showcase/get-clock.go",
+			`/"github.com\/golang\/protobuf\/jsonpb"/d; /"os"/d`,
+		},
+		{
+			"cmd/gapic-showcase/get-failover-state.go",
+			`/"github.com\/golang\/protobuf\/jsonpb"/d; /"os"/d`,
+		},
+		{
+			"cmd/gapic-showcase/trigger-failover.go",
+			`/"github.com\/golang\/protobuf\/jsonpb"/d; /"os"/d`,
+		},
+		{
+			"cmd/gapic-showcase/reset-clock.go",
+			`/"github.com\/golang\/protobuf\/jsonpb"/d; /"os"/d`,
+		},
+		{
+			"cmd/gapic-showcase/get-runtime-stats.go",
+			`/"github.com\/golang\/protobuf\/jsonpb"/d; /"os"/d`,
+		},
+		{
+			"cmd/gapic-showcase/get-schema-rollout.go",
+			`/"github.com\/golang\/protobuf\/jsonpb"/d; /"os"/d`,
+		},
+		{
+			"cmd/gapic-showcase/get-server-config.go",
+			`/"github.com\/golang\/protobuf\/jsonpb"/d; /"os"/d`,
+		},
+		{
+			"cmd/gapic-showcase/reset-server.go",
+			`/"github.com\/golang\/protobuf\/jsonpb"/d; /"os"/d`,
+		},
 	}
 	command = []string{
 		"sed",
@@ -46,11 +46,7 @@ func NewView(model *gomodel.Model) (*goview.View, error) {
 		file.P("")
 
 		fileImports := map[string]string{
-			"context":  "",
 			"net/http": "",
-			"github.com/googleapis/gapic-showcase/util/genrest/resttools": "",
-			"github.com/gorilla/mux":                               "gmux",
-			"github.com/googleapis/gapic-showcase/server/genproto": "genprotopb",
 		}
 
 		// TODO: Properly deal with import strings. They may need to be taken out of the gomodel
@@ -79,9 +75,23 @@ func NewView(model *gomodel.Model) (*goview.View, error) {
 				continue
 			}
 
+			fileImports["github.com/googleapis/gapic-showcase/util/genrest/resttools"] = ""
+			fileImports["github.com/gorilla/mux"] = "gmux"
+			fileImports["github.com/googleapis/gapic-showcase/server/genproto"] = "genprotopb"
 			source.P(`  urlPathParams := gmux.Vars(r)`)
 			source.P("  numUrlPathParams := len(urlPathParams)")
 			source.P("")
+			bodyField := ""
+			switch handler.RequestBodyFieldSpec {
+			case gomodel.BodyFieldAll:
+				bodyField = "*"
+			case gomodel.BodyFieldSingle:
+				bodyField = handler.RequestBodyFieldProtoName
+				excludedQueryParams = append(excludedQueryParams, handler.RequestBodyFieldProtoName)
+			}
+			excludedQueryParams = append(excludedQueryParams, handler.PathTemplate.ListVariables()...)
+			source.P("  resttools.SetTranscodingHeader(w, %q, urlPathParams, %q, r.URL.Query(), %#v)", handler.URIPattern, bodyField, excludedQueryParams)
+			source.P("")
 			// TODO: Consider factoring out code shared among handlers into a single
 			// place, so that handlers only provide the relevant values (e.g. expected
 			// number of path variables, etc.)
@@ -152,7 +162,6 @@ func NewView(model *gomodel.Model) (*goview.View, error) {
 				source.P("  }")
 				source.P("  %s.%s = &%s", handler.RequestVariable, handler.RequestBodyFieldName, handler.RequestBodyFieldVariable)
 				source.P("")
-				excludedQueryParams = append(excludedQueryParams, handler.RequestBodyFieldProtoName)
 
 			default:
 				source.P("  if err := resttools.CheckRequestFormat(nil, r, %s.ProtoReflect()); err != nil {", handler.RequestVariable)
@@ -166,7 +175,6 @@ func NewView(model *gomodel.Model) (*goview.View, error) {
 			source.P("    return")
 			source.P("  }")
 			source.P("")
-			excludedQueryParams = append(excludedQueryParams, handler.PathTemplate.ListVariables()...)
 
 			if handler.RequestBodyFieldSpec != gomodel.BodyFieldAll {
 				source.P("  // TODO: Decide whether query-param value or URL-path value takes precedence when a field appears in both")
@@ -184,26 +192,36 @@ func NewView(model *gomodel.Model) (*goview.View, error) {
 				source.P("  }")
 				source.P("")
 			}
+			source.P("  if err := resttools.CheckRequiredFields(%s.ProtoReflect()); err != nil {", handler.RequestVariable)
+			source.P(`    backend.Error(w, http.StatusBadRequest, "REST request failed required field check: %%s", err)`)
+			source.P("    return")
+			source.P("  }")
+			source.P("")
+			source.P("  if err := resttools.CheckFieldConstraints(%s.ProtoReflect()); err != nil {", handler.RequestVariable)
+			source.P(`    backend.Error(w, http.StatusBadRequest, "REST request failed field constraint check: %%s", err)`)
+			source.P("    return")
+			source.P("  }")
 			source.P("")
 			source.P("  marshaler := resttools.ToJSON()")
 			source.P("  requestJSON, _ := marshaler.Marshal(%s)", handler.RequestVariable)
 			source.P(`  backend.StdLog.Printf("  request: %%s", requestJSON)`)
 			source.P("")
 			// TODO: In the future, we may want to redirect all REST-endpoint requests to the gRPC endpoint so that the gRPC-registered observers get invoked.
-			source.P("  %s, err := backend.%sServer.%s(context.Background(), %s)", handler.ResponseVariable, service.ShortName, handler.GoMethod, handler.RequestVariable)
+			source.P("  %s, err := backend.%sServer.%s(r.Context(), %s)", handler.ResponseVariable, service.ShortName, handler.GoMethod, handler.RequestVariable)
 			source.P("  if err != nil {")
 			source.P("    // TODO: Properly handle error. Is StatusInternalServerError (500) the right response?")
 			source.P(`    backend.Error(w, http.StatusInternalServerError, "server error: %%s", err.Error())`)
 			source.P("    return")
 			source.P("  }")
 			source.P("")
-			source.P("  json, err := marshaler.Marshal(%s)", handler.ResponseVariable)
+			source.P("  body, contentType, err := resttools.MarshalResponse(r.Header, marshaler, %s)", handler.ResponseVariable)
 			source.P("  if err != nil {")
-			source.P(`    backend.Error(w, http.StatusInternalServerError, "error json-encoding response: %%s", err.Error())`)
+			source.P(`    backend.Error(w, http.StatusInternalServerError, "error encoding response: %%s", err.Error())`)
 			source.P("    return")
 			source.P("  }")
 			source.P("")
-			source.P("  w.Write(json)")
+			source.P(`  w.Header().Set("Content-Type", contentType)`)
+			source.P("  w.Write(body)")
 			source.P("}\n")
 		}
 
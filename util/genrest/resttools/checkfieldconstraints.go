@@ -0,0 +1,39 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resttools
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/googleapis/gapic-showcase/server"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CheckFieldConstraints verifies that the fields of message, and of the messages set within it,
+// satisfy their google.showcase.v1beta1.constraints annotations, as the gRPC surface does. The
+// returned error lists one field violation per constraint violated, naming the field by its path
+// in the proto message.
+func CheckFieldConstraints(message protoreflect.Message) error {
+	violations := server.FieldConstraintViolations(message)
+	if len(violations) == 0 {
+		return nil
+	}
+	descriptions := []string{}
+	for _, violation := range violations {
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s", violation.GetField(), violation.GetDescription()))
+	}
+	return fmt.Errorf("(FieldConstraintViolationError) field violations: [%s]", strings.Join(descriptions, "; "))
+}
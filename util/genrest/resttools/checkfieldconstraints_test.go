@@ -0,0 +1,67 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resttools
+
+import (
+	"strings"
+	"testing"
+
+	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCheckFieldConstraints(t *testing.T) {
+	for idx, testCase := range []struct {
+		label      string
+		request    proto.Message
+		violations []string
+	}{
+		{
+			label:   "satisfied",
+			request: &genprotopb.CreateUserRequest{User: &genprotopb.User{DisplayName: "mila", Email: "mila@example.com", Age: proto.Int32(7)}},
+		},
+		{
+			label:   "unset optional field",
+			request: &genprotopb.CreateUserRequest{User: &genprotopb.User{DisplayName: "mila", Email: "mila@example.com"}},
+		},
+		{
+			label:      "nested fields",
+			request:    &genprotopb.CreateUserRequest{User: &genprotopb.User{Email: "mila", Age: proto.Int32(-1)}},
+			violations: []string{"user.email: must match the pattern", "user.age: must be at least 0, but is -1"},
+		},
+		{
+			label:      "top-level fields",
+			request:    &genprotopb.GeneratePayloadRequest{Size: -1, ChunkCount: 1000001},
+			violations: []string{"size: must be at least 0, but is -1", "chunk_count: must be at most 1000000, but is 1000001"},
+		},
+	} {
+		err := CheckFieldConstraints(testCase.request.ProtoReflect())
+		if len(testCase.violations) == 0 {
+			if err != nil {
+				t.Errorf("test case %d[%q]: unexpected error: %s", idx, testCase.label, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("test case %d[%q]: expected error listing %v", idx, testCase.label, testCase.violations)
+			continue
+		}
+		for _, violation := range testCase.violations {
+			if !strings.Contains(err.Error(), violation) {
+				t.Errorf("test case %d[%q]: error %q does not list %q", idx, testCase.label, err, violation)
+			}
+		}
+	}
+}
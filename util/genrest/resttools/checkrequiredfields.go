@@ -0,0 +1,97 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resttools
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CheckRequiredFields verifies that every field annotated as REQUIRED in message, and in the
+// messages set within it, has been populated from the URL path, the query params or the body of
+// the REST request. The returned error lists one field violation per missing field, naming the
+// field by its lower-camel-cased path as it would appear in the request.
+//
+// In requests carrying a field mask, as partial updates do, only the top-level fields are
+// checked: the resource being updated need only contain the fields the mask names.
+func CheckRequiredFields(message protoreflect.Message) error {
+	violations := missingRequiredFields(message, "", !hasFieldMask(message))
+	if len(violations) == 0 {
+		return nil
+	}
+	for idx, field := range violations {
+		violations[idx] = fmt.Sprintf("%s: required field is missing", field)
+	}
+	return fmt.Errorf("(RequiredFieldMissingError) field violations: [%s]", strings.Join(violations, "; "))
+}
+
+// missingRequiredFields returns the paths, each prefixed by prefix, of the REQUIRED fields in
+// message, or also in its populated submessages if nested is true, that are not set.
+func missingRequiredFields(message protoreflect.Message, prefix string, nested bool) []string {
+	missing := []string{}
+	fields := message.Descriptor().Fields()
+	for idx := 0; idx < fields.Len(); idx++ {
+		field := fields.Get(idx)
+		path := prefix + field.JSONName()
+		if !message.Has(field) {
+			if isRequired(field) {
+				missing = append(missing, path)
+			}
+			continue
+		}
+		if !nested || field.Kind() != protoreflect.MessageKind || field.IsMap() {
+			continue
+		}
+		if field.IsList() {
+			list := message.Get(field).List()
+			for elem := 0; elem < list.Len(); elem++ {
+				missing = append(missing, missingRequiredFields(list.Get(elem).Message(), fmt.Sprintf("%s[%d].", path, elem), true)...)
+			}
+			continue
+		}
+		missing = append(missing, missingRequiredFields(message.Get(field).Message(), path+".", true)...)
+	}
+	return missing
+}
+
+// hasFieldMask returns whether message has a google.protobuf.FieldMask field that is set.
+func hasFieldMask(message protoreflect.Message) bool {
+	fields := message.Descriptor().Fields()
+	for idx := 0; idx < fields.Len(); idx++ {
+		field := fields.Get(idx)
+		if field.Kind() == protoreflect.MessageKind && field.Message().FullName() == "google.protobuf.FieldMask" && message.Has(field) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRequired returns whether field carries the REQUIRED field behavior annotation.
+func isRequired(field protoreflect.FieldDescriptor) bool {
+	options := field.Options()
+	if options == nil || !proto.HasExtension(options, annotations.E_FieldBehavior) {
+		return false
+	}
+	for _, behavior := range proto.GetExtension(options, annotations.E_FieldBehavior).([]annotations.FieldBehavior) {
+		if behavior == annotations.FieldBehavior_REQUIRED {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resttools
+
+import (
+	"strings"
+	"testing"
+
+	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestCheckRequiredFields(t *testing.T) {
+	for idx, testCase := range []struct {
+		label   string
+		request proto.Message
+		missing []string
+	}{
+		{
+			label:   "all set",
+			request: &genprotopb.CreateUserRequest{User: &genprotopb.User{DisplayName: "mila", Email: "mila@example.com"}},
+		},
+		{
+			label:   "unset parent message",
+			request: &genprotopb.CreateUserRequest{},
+		},
+		{
+			label:   "nested fields",
+			request: &genprotopb.CreateUserRequest{User: &genprotopb.User{}},
+			missing: []string{"user.displayName", "user.email"},
+		},
+		{
+			label:   "top-level field",
+			request: &genprotopb.GetUserRequest{},
+			missing: []string{"name"},
+		},
+		{
+			label: "partial update",
+			request: &genprotopb.UpdateUserRequest{
+				User:       &genprotopb.User{Nickname: proto.String("jonas")},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"nickname"}},
+			},
+		},
+	} {
+		err := CheckRequiredFields(testCase.request.ProtoReflect())
+		if len(testCase.missing) == 0 {
+			if err != nil {
+				t.Errorf("test case %d[%q]: unexpected error: %s", idx, testCase.label, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("test case %d[%q]: expected error listing %v", idx, testCase.label, testCase.missing)
+			continue
+		}
+		for _, field := range testCase.missing {
+			if !strings.Contains(err.Error(), field+": required field is missing") {
+				t.Errorf("test case %d[%q]: error %q does not list %q", idx, testCase.label, err, field)
+			}
+		}
+	}
+}
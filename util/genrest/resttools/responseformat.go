@@ -0,0 +1,98 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resttools
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	headerNameAccept = "Accept"
+
+	// HeaderValueContentTypeProto is the media type of the REST responses encoded as binary
+	// protocol buffers rather than as JSON.
+	HeaderValueContentTypeProto = "application/x-protobuf"
+)
+
+// protoMediaTypes are the media types requesting binary protocol buffer responses.
+var protoMediaTypes = map[string]bool{
+	HeaderValueContentTypeProto: true,
+	"application/protobuf":      true,
+}
+
+// WantsProto returns whether the Accept values in header prefer binary protocol buffer
+// responses to JSON ones. A proto media type must be accepted with a higher quality than
+// "application/json", and at least as high as any wildcard, since JSON remains the default.
+func WantsProto(header http.Header) bool {
+	protoQ, jsonQ, wildcardQ := 0.0, 0.0, 0.0
+	for _, value := range header.Values(headerNameAccept) {
+		for _, item := range strings.Split(value, ",") {
+			params := strings.Split(item, ";")
+			mediaType := strings.ToLower(strings.TrimSpace(params[0]))
+			q := 1.0
+			for _, param := range params[1:] {
+				name, value, found := cutParam(param)
+				if found && name == "q" {
+					if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+						q = parsed
+					}
+				}
+			}
+			switch {
+			case protoMediaTypes[mediaType]:
+				protoQ = maxQ(protoQ, q)
+			case mediaType == headerValueContentTypeJSON:
+				jsonQ = maxQ(jsonQ, q)
+			case mediaType == "*/*" || mediaType == "application/*":
+				wildcardQ = maxQ(wildcardQ, q)
+			}
+		}
+	}
+	return protoQ > 0 && protoQ > jsonQ && protoQ >= wildcardQ
+}
+
+// MarshalResponse encodes response as the REST request with header asked for: as a binary
+// protocol buffer if WantsProto(header), or with marshaler as JSON otherwise. It returns the
+// encoded response along with its media type, to be sent as its Content-Type.
+func MarshalResponse(header http.Header, marshaler *protojson.MarshalOptions, response proto.Message) ([]byte, string, error) {
+	if WantsProto(header) {
+		body, err := proto.Marshal(response)
+		return body, HeaderValueContentTypeProto, err
+	}
+	body, err := marshaler.Marshal(response)
+	return body, headerValueContentTypeJSON, err
+}
+
+// cutParam splits a media type parameter such as "q=0.5" into its lower-cased name and its
+// value.
+func cutParam(param string) (string, string, bool) {
+	idx := strings.Index(param, "=")
+	if idx < 0 {
+		return "", "", false
+	}
+	return strings.ToLower(strings.TrimSpace(param[:idx])), strings.TrimSpace(param[idx+1:]), true
+}
+
+func maxQ(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
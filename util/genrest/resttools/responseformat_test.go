@@ -0,0 +1,84 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resttools
+
+import (
+	"net/http"
+	"testing"
+
+	genprotopb "github.com/googleapis/gapic-showcase/server/genproto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWantsProto(t *testing.T) {
+	for _, testCase := range []struct {
+		accept []string
+		want   bool
+	}{
+		{accept: nil, want: false},
+		{accept: []string{"application/json"}, want: false},
+		{accept: []string{"*/*"}, want: false},
+		{accept: []string{"text/html"}, want: false},
+		{accept: []string{"application/x-protobuf"}, want: true},
+		{accept: []string{"Application/Protobuf"}, want: true},
+		{accept: []string{"application/x-protobuf, */*"}, want: true},
+		{accept: []string{"application/x-protobuf, application/json"}, want: false},
+		{accept: []string{"application/x-protobuf", "application/json;q=0.5"}, want: true},
+		{accept: []string{"application/json;q=0.9, application/x-protobuf"}, want: true},
+		{accept: []string{"application/x-protobuf;q=0.5, application/json"}, want: false},
+		{accept: []string{"application/x-protobuf;q=0"}, want: false},
+		{accept: []string{"application/x-protobuf;q=0.5, */*;q=0.8"}, want: false},
+	} {
+		header := http.Header{headerNameAccept: testCase.accept}
+		if got := WantsProto(header); got != testCase.want {
+			t.Errorf("WantsProto(Accept: %q): got %v, want %v", testCase.accept, got, testCase.want)
+		}
+	}
+}
+
+func TestMarshalResponse(t *testing.T) {
+	response := &genprotopb.EchoResponse{Content: "hello"}
+
+	body, contentType, err := MarshalResponse(http.Header{}, ToJSON(), response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != headerValueContentTypeJSON {
+		t.Errorf("JSON Content-Type: got %q, want %q", contentType, headerValueContentTypeJSON)
+	}
+	decoded := &genprotopb.EchoResponse{}
+	if err := FromJSON().Unmarshal(body, decoded); err != nil {
+		t.Fatalf("could not decode the JSON body: %v", err)
+	}
+	if !proto.Equal(decoded, response) {
+		t.Errorf("JSON body: got %v, want %v", decoded, response)
+	}
+
+	header := http.Header{headerNameAccept: []string{HeaderValueContentTypeProto}}
+	body, contentType, err = MarshalResponse(header, ToJSON(), response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != HeaderValueContentTypeProto {
+		t.Errorf("proto Content-Type: got %q, want %q", contentType, HeaderValueContentTypeProto)
+	}
+	decoded = &genprotopb.EchoResponse{}
+	if err := proto.Unmarshal(body, decoded); err != nil {
+		t.Fatalf("could not decode the proto body: %v", err)
+	}
+	if !proto.Equal(decoded, response) {
+		t.Errorf("proto body: got %v, want %v", decoded, response)
+	}
+}
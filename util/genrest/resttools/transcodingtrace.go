@@ -0,0 +1,74 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resttools
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+// TranscodingHeader is the response header in which REST handlers describe how they mapped the
+// request onto the proto request message.
+const TranscodingHeader = "X-Showcase-Transcoding"
+
+// TranscodingTrace describes how a REST request was mapped onto a proto request message. It is
+// sent JSON-encoded in the TranscodingHeader of every response, so that generator authors can
+// see where the server's transcoding differs from what their client expects.
+type TranscodingTrace struct {
+	// Template is the HTTP binding pattern the request matched.
+	Template string `json:"template"`
+
+	// PathBindings are the values bound to the variables of Template.
+	PathBindings map[string]string `json:"pathBindings"`
+
+	// BodyField is the field populated from the request body: "*" for the whole message, or
+	// empty if the binding has no body.
+	BodyField string `json:"bodyField,omitempty"`
+
+	// UnconsumedQueryParams are the query params that did not map to a field, either because
+	// the body populates the whole message or because they name a field already bound from
+	// the path or the body.
+	UnconsumedQueryParams []string `json:"unconsumedQueryParams"`
+}
+
+// SetTranscodingHeader sets the TranscodingHeader of w to describe a request matching template,
+// whose path variables were bound to pathParams, whose body populates bodyField and whose query
+// params are query. excludedQueryParams are the field paths query params may not set.
+func SetTranscodingHeader(w http.ResponseWriter, template string, pathParams map[string]string, bodyField string, query url.Values, excludedQueryParams []string) {
+	trace := &TranscodingTrace{
+		Template:              template,
+		PathBindings:          pathParams,
+		BodyField:             bodyField,
+		UnconsumedQueryParams: KeysMatchPath(query, excludedQueryParams),
+	}
+	if bodyField == "*" {
+		trace.UnconsumedQueryParams = []string{}
+		for key := range query {
+			trace.UnconsumedQueryParams = append(trace.UnconsumedQueryParams, key)
+		}
+	}
+	if trace.PathBindings == nil {
+		trace.PathBindings = map[string]string{}
+	}
+	sort.Strings(trace.UnconsumedQueryParams)
+
+	value, err := json.Marshal(trace)
+	if err != nil {
+		return
+	}
+	w.Header().Set(TranscodingHeader, string(value))
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resttools
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestSetTranscodingHeader(t *testing.T) {
+	for idx, testCase := range []struct {
+		label     string
+		bodyField string
+		query     url.Values
+		excluded  []string
+		want      []string
+	}{
+		{
+			label:    "query params all consumed",
+			query:    url.Values{"pageSize": {"3"}},
+			excluded: []string{"parent"},
+			want:     []string{},
+		},
+		{
+			label:    "query param duplicating a path variable",
+			query:    url.Values{"parent.id": {"3"}, "pageSize": {"3"}},
+			excluded: []string{"parent"},
+			want:     []string{"parent.id"},
+		},
+		{
+			label:     "body populating the whole message",
+			bodyField: "*",
+			query:     url.Values{"b": {"1"}, "a": {"2"}},
+			want:      []string{"a", "b"},
+		},
+	} {
+		recorder := httptest.NewRecorder()
+		SetTranscodingHeader(recorder, "/v1beta1/{parent=rooms/*}/blurbs", map[string]string{"parent": "rooms/1"}, testCase.bodyField, testCase.query, testCase.excluded)
+
+		trace := &TranscodingTrace{}
+		if err := json.Unmarshal([]byte(recorder.Header().Get(TranscodingHeader)), trace); err != nil {
+			t.Fatalf("test case %d[%q]: %s", idx, testCase.label, err)
+		}
+		if trace.Template != "/v1beta1/{parent=rooms/*}/blurbs" || trace.PathBindings["parent"] != "rooms/1" || trace.BodyField != testCase.bodyField {
+			t.Errorf("test case %d[%q]: got trace %+v", idx, testCase.label, trace)
+		}
+		if !reflect.DeepEqual(trace.UnconsumedQueryParams, testCase.want) {
+			t.Errorf("test case %d[%q]: unconsumed query params: got %v, want %v", idx, testCase.label, trace.UnconsumedQueryParams, testCase.want)
+		}
+	}
+}